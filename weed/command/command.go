@@ -26,6 +26,7 @@ var Commands = []*Command{
 	cmdFilerReplicate,
 	cmdFilerSynchronize,
 	cmdFix,
+	cmdFtp,
 	cmdFuse,
 	cmdMaster,
 	cmdMasterFollower,
@@ -35,6 +36,7 @@ var Commands = []*Command{
 	cmdMsgBroker,
 	cmdScaffold,
 	cmdServer,
+	cmdSftp,
 	cmdShell,
 	cmdUpload,
 	cmdVersion,
@@ -85,7 +85,9 @@ func init() {
 	filerS3Options.tlsCertificate = cmdFiler.Flag.String("s3.cert.file", "", "path to the TLS certificate file")
 	filerS3Options.config = cmdFiler.Flag.String("s3.config", "", "path to the config file")
 	filerS3Options.auditLogConfig = cmdFiler.Flag.String("s3.auditLogConfig", "", "path to the audit log config file")
+	filerS3Options.rateLimitConfig = cmdFiler.Flag.String("s3.rateLimitConfig", "", "path to the rate limit config file, instead of /etc/iam/rate_limit.json in the filer")
 	filerS3Options.allowEmptyFolder = cmdFiler.Flag.Bool("s3.allowEmptyFolder", true, "allow empty folders")
+	filerS3Options.lifecycleIntervalMinutes = cmdFiler.Flag.Int("s3.lifecycleIntervalMinutes", 60, "interval in minutes to apply bucket lifecycle rules, 0 to disable")
 
 	// start webdav on filer
 	filerStartWebDav = cmdFiler.Flag.Bool("webdav", false, "whether to start webdav gateway")
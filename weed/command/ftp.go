@@ -0,0 +1,158 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"os/user"
+	"strconv"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/ftpd"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	ftpserver "github.com/fclairamb/ftpserverlib"
+)
+
+var (
+	ftpStandaloneOptions FtpOptions
+)
+
+type FtpOptions struct {
+	filer            *string
+	ip               *string
+	bindIp           *string
+	port             *int
+	ftpRoot          *string
+	passivePortStart *int
+	passivePortStop  *int
+	config           *string
+	tlsPrivateKey    *string
+	tlsCertificate   *string
+	collection       *string
+	replication      *string
+	disk             *string
+}
+
+func init() {
+	cmdFtp.Run = runFtp // break init cycle
+	ftpStandaloneOptions.filer = cmdFtp.Flag.String("filer", "localhost:8888", "filer server address")
+	ftpStandaloneOptions.ip = cmdFtp.Flag.String("ip", util.DetectedHostAddress(), "public ip address announced for passive transfers")
+	ftpStandaloneOptions.bindIp = cmdFtp.Flag.String("ip.bind", "", "ip address to bind to")
+	ftpStandaloneOptions.port = cmdFtp.Flag.Int("port", 8021, "ftp server listen port")
+	ftpStandaloneOptions.ftpRoot = cmdFtp.Flag.String("ftpRoot", "/ftp", "filer folder holding the home directories")
+	ftpStandaloneOptions.passivePortStart = cmdFtp.Flag.Int("port.passive.start", 30000, "passive transfer port range start")
+	ftpStandaloneOptions.passivePortStop = cmdFtp.Flag.Int("port.passive.stop", 30100, "passive transfer port range stop")
+	ftpStandaloneOptions.config = cmdFtp.Flag.String("config", "", "path to the S3 identities file, read from the filer if not set")
+	ftpStandaloneOptions.tlsPrivateKey = cmdFtp.Flag.String("key.file", "", "path to the TLS private key file for explicit FTPS")
+	ftpStandaloneOptions.tlsCertificate = cmdFtp.Flag.String("cert.file", "", "path to the TLS certificate file for explicit FTPS")
+	ftpStandaloneOptions.collection = cmdFtp.Flag.String("collection", "", "collection to create the files")
+	ftpStandaloneOptions.replication = cmdFtp.Flag.String("replication", "", "replication to create the files")
+	ftpStandaloneOptions.disk = cmdFtp.Flag.String("disk", "", "[hdd|ssd|<tag>] hard drive or solid state drive or any tag")
+}
+
+var cmdFtp = &Command{
+	UsageLine: "ftp [-port=8021] [-filer=<ip:port>] [-cert.file=<path> -key.file=<path>]",
+	Short:     "start an ftp server that is backed by a filer",
+	Long: `start an ftp server that is backed by a filer.
+
+	Users log in with the S3 identities, using either the identity name or the access key
+	as the user name, and the secret key as the password. The identities are read from the
+	-config file, or from the filer if not set, as configured by "weed shell" s3.configure.
+
+	Identities with the "Admin" action can access the whole -ftpRoot folder. Other identities
+	are confined to their home directory "<ftpRoot>/<identity name>", which is created on login.
+	The "Read" or "List" action allows downloading, and the "Write" action allows changes.
+
+	With -cert.file and -key.file, the clients can switch to TLS with "AUTH TLS".
+
+`,
+}
+
+func runFtp(cmd *Command, args []string) bool {
+
+	util.LoadConfiguration("security", false)
+
+	return ftpStandaloneOptions.startFtpServer()
+
+}
+
+func (ftpOpt *FtpOptions) startFtpServer() bool {
+
+	// detect current user
+	uid, gid := uint32(0), uint32(0)
+	if u, err := user.Current(); err == nil {
+		if parsedId, pe := strconv.ParseUint(u.Uid, 10, 32); pe == nil {
+			uid = uint32(parsedId)
+		}
+		if parsedId, pe := strconv.ParseUint(u.Gid, 10, 32); pe == nil {
+			gid = uint32(parsedId)
+		}
+	}
+
+	filerAddress := pb.ServerAddress(*ftpOpt.filer)
+
+	grpcDialOption := security.LoadClientTLS(util.GetViper(), "grpc.client")
+
+	var cipher bool
+	// connect to filer
+	for {
+		err := pb.WithGrpcFilerClient(false, filerAddress, grpcDialOption, func(client filer_pb.SeaweedFilerClient) error {
+			resp, err := client.GetFilerConfiguration(context.Background(), &filer_pb.GetFilerConfigurationRequest{})
+			if err != nil {
+				return fmt.Errorf("get filer %s configuration: %v", filerAddress, err)
+			}
+			cipher = resp.Cipher
+			return nil
+		})
+		if err != nil {
+			glog.V(0).Infof("wait to connect to filer %s grpc address %s", *ftpOpt.filer, filerAddress.ToGrpcAddress())
+			time.Sleep(time.Second)
+		} else {
+			glog.V(0).Infof("connected to filer %s grpc address %s", *ftpOpt.filer, filerAddress.ToGrpcAddress())
+			break
+		}
+	}
+
+	listenAddress := util.JoinHostPort(*ftpOpt.bindIp, *ftpOpt.port)
+	// the control connections stay idle between commands
+	ftpListener, err := util.NewListener(listenAddress, 0)
+	if err != nil {
+		glog.Fatalf("FTP server listener on %s error: %v", listenAddress, err)
+	}
+
+	ftpServerDriver, err := ftpd.NewFtpServer(ftpListener, &ftpd.FtpServerOption{
+		Filer:            filerAddress,
+		IP:               *ftpOpt.ip,
+		IpBind:           *ftpOpt.bindIp,
+		Port:             *ftpOpt.port,
+		FtpRoot:          *ftpOpt.ftpRoot,
+		GrpcDialOption:   grpcDialOption,
+		PassivePortStart: *ftpOpt.passivePortStart,
+		PassivePortStop:  *ftpOpt.passivePortStop,
+		IdentityConfig:   *ftpOpt.config,
+		TlsCertificate:   *ftpOpt.tlsCertificate,
+		TlsPrivateKey:    *ftpOpt.tlsPrivateKey,
+		Collection:       *ftpOpt.collection,
+		Replication:      *ftpOpt.replication,
+		DiskType:         *ftpOpt.disk,
+		Cipher:           cipher,
+		Uid:              uid,
+		Gid:              gid,
+	})
+	if err != nil {
+		glog.Fatalf("FTP server startup error: %v", err)
+	}
+
+	ftpServer := ftpserver.NewFtpServer(ftpServerDriver)
+
+	glog.V(0).Infof("Start Seaweed FTP Server %s at %s", util.Version(), listenAddress)
+	if err = ftpServer.ListenAndServe(); err != nil {
+		glog.Fatalf("FTP server fail to serve: %v", err)
+	}
+
+	return true
+
+}
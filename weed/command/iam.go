@@ -35,7 +35,14 @@ func init() {
 var cmdIam = &Command{
 	UsageLine: "iam [-port=8111] [-filer=<ip:port>] [-masters=<ip:port>,<ip:port>]",
 	Short:     "start a iam API compatible server",
-	Long:      "start a iam API compatible server.",
+	Long: `start a iam API compatible server.
+
+	It also serves the STS AssumeRole and GetSessionToken APIs, if jwt.s3_sts.key is set in security.toml.
+	An identity can assume the roles, i.e. other identities, listed in its actions as "AssumeRole:<role name>".
+	The temporary credentials can be scoped down further by an inline session policy.
+	With jwt.oidc configured, AssumeRoleWithWebIdentity exchanges an OpenID Connect ID token
+	for temporary credentials of the identity mapped from its claims.
+	`,
 }
 
 func runIam(cmd *Command, args []string) bool {
@@ -156,7 +156,7 @@ func RunMount2(option *MountOptions, umask os.FileMode) bool {
 		SingleThreaded:           false,
 		DisableXAttrs:            false,
 		Debug:                    *option.debug,
-		EnableLocks:              false,
+		EnableLocks:              true,
 		ExplicitDataCacheControl: false,
 		DirectMount:              true,
 		DirectMountFlags:         0,
@@ -24,16 +24,18 @@ var (
 )
 
 type S3Options struct {
-	filer            *string
-	bindIp           *string
-	port             *int
-	config           *string
-	domainName       *string
-	tlsPrivateKey    *string
-	tlsCertificate   *string
-	metricsHttpPort  *int
-	allowEmptyFolder *bool
-	auditLogConfig   *string
+	filer                    *string
+	bindIp                   *string
+	port                     *int
+	config                   *string
+	domainName               *string
+	tlsPrivateKey            *string
+	tlsCertificate           *string
+	metricsHttpPort          *int
+	allowEmptyFolder         *bool
+	lifecycleIntervalMinutes *int
+	auditLogConfig           *string
+	rateLimitConfig          *string
 }
 
 func init() {
@@ -44,10 +46,12 @@ func init() {
 	s3StandaloneOptions.domainName = cmdS3.Flag.String("domainName", "", "suffix of the host name in comma separated list, {bucket}.{domainName}")
 	s3StandaloneOptions.config = cmdS3.Flag.String("config", "", "path to the config file")
 	s3StandaloneOptions.auditLogConfig = cmdS3.Flag.String("auditLogConfig", "", "path to the audit log config file")
+	s3StandaloneOptions.rateLimitConfig = cmdS3.Flag.String("rateLimitConfig", "", "path to the rate limit config file, instead of /etc/iam/rate_limit.json in the filer")
 	s3StandaloneOptions.tlsPrivateKey = cmdS3.Flag.String("key.file", "", "path to the TLS private key file")
 	s3StandaloneOptions.tlsCertificate = cmdS3.Flag.String("cert.file", "", "path to the TLS certificate file")
 	s3StandaloneOptions.metricsHttpPort = cmdS3.Flag.Int("metricsPort", 0, "Prometheus metrics listen port")
 	s3StandaloneOptions.allowEmptyFolder = cmdS3.Flag.Bool("allowEmptyFolder", true, "allow empty folders")
+	s3StandaloneOptions.lifecycleIntervalMinutes = cmdS3.Flag.Int("lifecycleIntervalMinutes", 60, "interval in minutes to apply bucket lifecycle rules, 0 to disable")
 }
 
 var cmdS3 = &Command{
@@ -177,13 +181,15 @@ func (s3opt *S3Options) startS3Server() bool {
 	router := mux.NewRouter().SkipClean(true)
 
 	_, s3ApiServer_err := s3api.NewS3ApiServer(router, &s3api.S3ApiServerOption{
-		Filer:            filerAddress,
-		Port:             *s3opt.port,
-		Config:           *s3opt.config,
-		DomainName:       *s3opt.domainName,
-		BucketsPath:      filerBucketsPath,
-		GrpcDialOption:   grpcDialOption,
-		AllowEmptyFolder: *s3opt.allowEmptyFolder,
+		Filer:             filerAddress,
+		Port:              *s3opt.port,
+		Config:            *s3opt.config,
+		DomainName:        *s3opt.domainName,
+		BucketsPath:       filerBucketsPath,
+		GrpcDialOption:    grpcDialOption,
+		AllowEmptyFolder:  *s3opt.allowEmptyFolder,
+		LifecycleInterval: time.Duration(*s3opt.lifecycleIntervalMinutes) * time.Minute,
+		RateLimitConfig:   *s3opt.rateLimitConfig,
 	})
 	if s3ApiServer_err != nil {
 		glog.Fatalf("S3 API Server startup error: %v", s3ApiServer_err)
@@ -0,0 +1,144 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"os/user"
+	"strconv"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/sftpd"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+var (
+	sftpStandaloneOptions SftpOptions
+)
+
+type SftpOptions struct {
+	filer       *string
+	bindIp      *string
+	port        *int
+	sftpRoot    *string
+	config      *string
+	hostKey     *string
+	collection  *string
+	replication *string
+	disk        *string
+}
+
+func init() {
+	cmdSftp.Run = runSftp // break init cycle
+	sftpStandaloneOptions.filer = cmdSftp.Flag.String("filer", "localhost:8888", "filer server address")
+	sftpStandaloneOptions.bindIp = cmdSftp.Flag.String("ip.bind", "", "ip address to bind to")
+	sftpStandaloneOptions.port = cmdSftp.Flag.Int("port", 2022, "sftp server listen port")
+	sftpStandaloneOptions.sftpRoot = cmdSftp.Flag.String("sftpRoot", "/sftp", "filer folder holding the home directories")
+	sftpStandaloneOptions.config = cmdSftp.Flag.String("config", "", "path to the S3 identities file, read from the filer if not set")
+	sftpStandaloneOptions.hostKey = cmdSftp.Flag.String("hostKey", "", "path to the ssh host private key file, generated and kept in the filer if not set")
+	sftpStandaloneOptions.collection = cmdSftp.Flag.String("collection", "", "collection to create the files")
+	sftpStandaloneOptions.replication = cmdSftp.Flag.String("replication", "", "replication to create the files")
+	sftpStandaloneOptions.disk = cmdSftp.Flag.String("disk", "", "[hdd|ssd|<tag>] hard drive or solid state drive or any tag")
+}
+
+var cmdSftp = &Command{
+	UsageLine: "sftp [-port=2022] [-filer=<ip:port>] [-hostKey=<path>]",
+	Short:     "start an sftp server that is backed by a filer",
+	Long: `start an sftp server that is backed by a filer.
+
+	Users log in with the S3 identities, using either the identity name or the access key
+	as the user name. The identities are read from the -config file, or from the filer if not set,
+	as configured by "weed shell" s3.configure.
+
+	With password authentication, the password is the secret key.
+	With public key authentication, the keys are added by "weed shell" sftp.configure, and kept in the
+	filer key-value store. A credential whose secret key is a public key in the authorized_keys format,
+	like "ssh-ed25519 AAAA...", is also accepted for public key authentication, but not as a password.
+
+	Identities with the "Admin" action can access the whole -sftpRoot folder. Other identities
+	are confined to their home directory "<sftpRoot>/<identity name>", which is created on login.
+	The "Read" or "List" action allows downloading, and the "Write" action allows changes.
+
+`,
+}
+
+func runSftp(cmd *Command, args []string) bool {
+
+	util.LoadConfiguration("security", false)
+
+	return sftpStandaloneOptions.startSftpServer()
+
+}
+
+func (sftpOpt *SftpOptions) startSftpServer() bool {
+
+	// detect current user
+	uid, gid := uint32(0), uint32(0)
+	if u, err := user.Current(); err == nil {
+		if parsedId, pe := strconv.ParseUint(u.Uid, 10, 32); pe == nil {
+			uid = uint32(parsedId)
+		}
+		if parsedId, pe := strconv.ParseUint(u.Gid, 10, 32); pe == nil {
+			gid = uint32(parsedId)
+		}
+	}
+
+	filerAddress := pb.ServerAddress(*sftpOpt.filer)
+
+	grpcDialOption := security.LoadClientTLS(util.GetViper(), "grpc.client")
+
+	var cipher bool
+	// connect to filer
+	for {
+		err := pb.WithGrpcFilerClient(false, filerAddress, grpcDialOption, func(client filer_pb.SeaweedFilerClient) error {
+			resp, err := client.GetFilerConfiguration(context.Background(), &filer_pb.GetFilerConfigurationRequest{})
+			if err != nil {
+				return fmt.Errorf("get filer %s configuration: %v", filerAddress, err)
+			}
+			cipher = resp.Cipher
+			return nil
+		})
+		if err != nil {
+			glog.V(0).Infof("wait to connect to filer %s grpc address %s", *sftpOpt.filer, filerAddress.ToGrpcAddress())
+			time.Sleep(time.Second)
+		} else {
+			glog.V(0).Infof("connected to filer %s grpc address %s", *sftpOpt.filer, filerAddress.ToGrpcAddress())
+			break
+		}
+	}
+
+	sftpServer, err := sftpd.NewSftpServer(&sftpd.SftpServerOption{
+		Filer:          filerAddress,
+		SftpRoot:       *sftpOpt.sftpRoot,
+		GrpcDialOption: grpcDialOption,
+		IdentityConfig: *sftpOpt.config,
+		HostKeyFile:    *sftpOpt.hostKey,
+		Collection:     *sftpOpt.collection,
+		Replication:    *sftpOpt.replication,
+		DiskType:       *sftpOpt.disk,
+		Cipher:         cipher,
+		Uid:            uid,
+		Gid:            gid,
+	})
+	if err != nil {
+		glog.Fatalf("SFTP server startup error: %v", err)
+	}
+
+	listenAddress := util.JoinHostPort(*sftpOpt.bindIp, *sftpOpt.port)
+	// the ssh sessions stay idle between requests
+	sftpListener, err := util.NewListener(listenAddress, 0)
+	if err != nil {
+		glog.Fatalf("SFTP server listener on %s error: %v", listenAddress, err)
+	}
+
+	glog.V(0).Infof("Start Seaweed SFTP Server %s at %s", util.Version(), listenAddress)
+	if err = sftpServer.Serve(sftpListener); err != nil {
+		glog.Fatalf("SFTP server fail to serve: %v", err)
+	}
+
+	return true
+
+}
@@ -85,6 +85,7 @@ func ResolveOneChunkManifest(lookupFileIdFn wdclient.LookupFileIdFunctionType, c
 
 	// IsChunkManifest
 	bytesBuffer := bytesBufferPool.Get().(*bytes.Buffer)
+	bytesBuffer.Reset()
 	defer bytesBufferPool.Put(bytesBuffer)
 	err := fetchWholeChunk(bytesBuffer, lookupFileIdFn, chunk.GetFileIdString(), chunk.CipherKey, chunk.IsCompressed)
 	if err != nil {
@@ -169,6 +170,10 @@ func retriedStreamFetchChunkData(writer io.Writer, urlStrings []string, cipherKe
 					data = data[toBeSkipped:]
 					localProcesed += toBeSkipped
 				}
+				if size > 0 && totalWritten+len(data) > size {
+					// the chunk may be trimmed shorter than the stored data, e.g., after truncating the file
+					data = data[:size-totalWritten]
+				}
 				writer.Write(data)
 				localProcesed += len(data)
 				totalWritten += len(data)
@@ -0,0 +1,157 @@
+package filer
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+// Chunks can be shared by several entries, e.g., after a server side copy_file_range.
+// The extra references of each shared chunk are counted in the filer store,
+// and deleting a shared chunk only releases one reference.
+//
+// The counts are kept in the filer store, so chunks are only shared while all peer filers use the same store.
+// A peer filer on a separate store joining later does not know the counts of the already shared chunks.
+//
+// The counts are only changed by the owner filer, the active filer with the smallest address, the same as the file locks.
+// The other filers forward the changes to it, so that concurrent changes of the same count are not lost.
+// Filers on separate stores change their own counts.
+
+const (
+	chunkReferencePrefix = "chunk.ref."
+)
+
+type chunkReferences struct {
+	sync.Mutex // serializes the changes of the counts on the owner filer
+	// forward changes the counts on the owner filer, replaced in tests
+	forward func(ctx context.Context, owner pb.ServerAddress, fileIds []string, release bool) ([]string, error)
+}
+
+// CanShareChunks tells whether the references of shared chunks are visible to all the filers
+func (f *Filer) CanShareChunks() bool {
+	return f.MetaAggregator == nil || !f.MetaAggregator.HasPeersOnSeparateStores()
+}
+
+// ReferenceChunks adds one reference to each of the chunks, so that they are kept when deleted from other entries
+func (f *Filer) ReferenceChunks(ctx context.Context, fileIds []string) error {
+	if len(fileIds) == 0 {
+		return nil
+	}
+	_, err := f.changeChunkReferences(ctx, fileIds, false)
+	return err
+}
+
+// releaseChunkReferences releases one reference of each shared file id, and returns the file ids no longer referenced
+func (f *Filer) releaseChunkReferences(fileIds []string) (toDelete []string, err error) {
+	if len(fileIds) == 0 {
+		return nil, nil
+	}
+	return f.changeChunkReferences(context.Background(), fileIds, true)
+}
+
+// UpdateChunkReferences changes the counts forwarded by the other filers, and fails if this filer is not the owner
+func (f *Filer) UpdateChunkReferences(ctx context.Context, fileIds []string, release bool) (unreferenced []string, err error) {
+	if owner, isOwner := f.chunkReferenceOwner(); !isOwner {
+		return nil, fmt.Errorf("chunk references are owned by %s", owner)
+	}
+	return f.updateChunkReferences(ctx, fileIds, release)
+}
+
+func (f *Filer) changeChunkReferences(ctx context.Context, fileIds []string, release bool) (unreferenced []string, err error) {
+	owner, isOwner := f.chunkReferenceOwner()
+	if isOwner {
+		return f.updateChunkReferences(ctx, fileIds, release)
+	}
+	forward := f.chunkReferences.forward
+	if forward == nil {
+		forward = f.forwardChunkReferences
+	}
+	return forward(ctx, owner, fileIds, release)
+}
+
+// chunkReferenceOwner is the owner filer if the peer filers share the filer store, or else this filer
+func (f *Filer) chunkReferenceOwner() (owner pb.ServerAddress, isOwner bool) {
+	if f.MetaAggregator == nil || f.MetaAggregator.HasPeersOnSeparateStores() {
+		return "", true
+	}
+	owner = f.MetaAggregator.Owner()
+	return owner, owner == f.MetaAggregator.self
+}
+
+func (f *Filer) forwardChunkReferences(ctx context.Context, owner pb.ServerAddress, fileIds []string, release bool) (unreferenced []string, err error) {
+	err = pb.WithFilerClient(false, owner, f.GrpcDialOption, func(client filer_pb.SeaweedFilerClient) error {
+		resp, updateErr := client.UpdateChunkReferences(ctx, &filer_pb.UpdateChunkReferencesRequest{
+			FileIds: fileIds,
+			Release: release,
+		})
+		if updateErr != nil {
+			return updateErr
+		}
+		unreferenced = resp.UnreferencedFileIds
+		return nil
+	})
+	return
+}
+
+// updateChunkReferences adds or releases one reference of each file id in the filer store.
+// The released file ids without any reference are returned.
+func (f *Filer) updateChunkReferences(ctx context.Context, fileIds []string, release bool) (unreferenced []string, err error) {
+	f.chunkReferences.Lock()
+	defer f.chunkReferences.Unlock()
+	for _, fileId := range fileIds {
+		count, getErr := f.getUint64(ctx, chunkReferencePrefix+fileId)
+		if getErr != nil {
+			if !release {
+				return nil, getErr
+			}
+			// keep the chunk if not sure
+			glog.Errorf("get chunk references of %s: %v", fileId, getErr)
+			continue
+		}
+		if !release {
+			if err = f.putUint64(ctx, chunkReferencePrefix+fileId, count+1); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if count == 0 {
+			unreferenced = append(unreferenced, fileId)
+			continue
+		}
+		if putErr := f.putUint64(ctx, chunkReferencePrefix+fileId, count-1); putErr != nil {
+			glog.Errorf("release chunk reference of %s: %v", fileId, putErr)
+		}
+	}
+	return
+}
+
+func (f *Filer) getUint64(ctx context.Context, key string) (uint64, error) {
+	value, err := f.Store.KvGet(ctx, []byte(key))
+	if err == ErrKvNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(value) != 8 {
+		return 0, nil
+	}
+	return binary.BigEndian.Uint64(value), nil
+}
+
+func (f *Filer) putUint64(ctx context.Context, key string, value uint64) error {
+	if value == 0 {
+		if err := f.Store.KvDelete(ctx, []byte(key)); err != nil && err != ErrKvNotFound {
+			return err
+		}
+		return nil
+	}
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, value)
+	return f.Store.KvPut(ctx, []byte(key), buf)
+}
@@ -0,0 +1,152 @@
+package filer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb"
+)
+
+// kvStore keeps only the key values in memory
+type kvStore struct {
+	VirtualFilerStore
+	kv map[string][]byte
+}
+
+func (store *kvStore) KvPut(ctx context.Context, key []byte, value []byte) error {
+	store.kv[string(key)] = value
+	return nil
+}
+
+func (store *kvStore) KvGet(ctx context.Context, key []byte) ([]byte, error) {
+	value, found := store.kv[string(key)]
+	if !found {
+		return nil, ErrKvNotFound
+	}
+	return value, nil
+}
+
+func (store *kvStore) KvDelete(ctx context.Context, key []byte) error {
+	delete(store.kv, string(key))
+	return nil
+}
+
+func TestChunkReferences(t *testing.T) {
+	store := &kvStore{kv: make(map[string][]byte)}
+	f := &Filer{Store: store}
+
+	// without shared chunks, all chunks are deleted
+	if toDelete, _ := f.releaseChunkReferences([]string{"1,a"}); len(toDelete) != 1 {
+		t.Errorf("expected to delete 1,a, got %v", toDelete)
+	}
+
+	// 1,a is shared by 3 entries, and 1,b by 2 entries
+	if err := f.ReferenceChunks(context.Background(), []string{"1,a", "1,b"}); err != nil {
+		t.Fatalf("reference chunks: %v", err)
+	}
+	if err := f.ReferenceChunks(context.Background(), []string{"1,a"}); err != nil {
+		t.Fatalf("reference chunks: %v", err)
+	}
+
+	toDelete, _ := f.releaseChunkReferences([]string{"1,a", "1,b", "1,c"})
+	if len(toDelete) != 1 || toDelete[0] != "1,c" {
+		t.Errorf("expected to delete 1,c, got %v", toDelete)
+	}
+	toDelete, _ = f.releaseChunkReferences([]string{"1,a", "1,b"})
+	if len(toDelete) != 1 || toDelete[0] != "1,b" {
+		t.Errorf("expected to delete 1,b, got %v", toDelete)
+	}
+	toDelete, _ = f.releaseChunkReferences([]string{"1,a"})
+	if len(toDelete) != 1 || toDelete[0] != "1,a" {
+		t.Errorf("expected to delete 1,a, got %v", toDelete)
+	}
+
+	// all the references are released
+	if len(store.kv) != 0 {
+		t.Errorf("expected no chunk references left, got %d keys", len(store.kv))
+	}
+}
+
+func TestChunkReferencesOnTwoFilers(t *testing.T) {
+	store := &kvStore{kv: make(map[string][]byte)}
+	newPeer := func(self pb.ServerAddress) *Filer {
+		f := &Filer{Store: store, Signature: 1}
+		f.MetaAggregator = NewMetaAggregator(f, self, nil)
+		for _, peer := range []pb.ServerAddress{"a:8888", "b:8888"} {
+			f.MetaAggregator.setActive(peer, true)
+			f.MetaAggregator.setPeerSignature(peer, 1)
+		}
+		return f
+	}
+	owner, peer := newPeer("a:8888"), newPeer("b:8888")
+	forwarded := 0
+	peer.chunkReferences.forward = func(ctx context.Context, address pb.ServerAddress, fileIds []string, release bool) ([]string, error) {
+		if address != "a:8888" {
+			return nil, fmt.Errorf("forwarded to %s", address)
+		}
+		forwarded++
+		return owner.UpdateChunkReferences(ctx, fileIds, release)
+	}
+	if _, err := peer.UpdateChunkReferences(context.Background(), []string{"1,a"}, false); err == nil {
+		t.Errorf("expected the peer to refuse changing the counts")
+	}
+
+	// the peer deletes an unshared chunk before the owner shares another one
+	if toDelete, err := peer.releaseChunkReferences([]string{"1,x"}); err != nil || len(toDelete) != 1 {
+		t.Errorf("expected to delete 1,x, got %v: %v", toDelete, err)
+	}
+	if err := owner.ReferenceChunks(context.Background(), []string{"1,a"}); err != nil {
+		t.Fatalf("reference chunks: %v", err)
+	}
+	if toDelete, err := peer.releaseChunkReferences([]string{"1,a"}); err != nil || len(toDelete) != 0 {
+		t.Errorf("expected to keep the shared 1,a, got %v: %v", toDelete, err)
+	}
+	if toDelete, err := peer.releaseChunkReferences([]string{"1,a"}); err != nil || len(toDelete) != 1 {
+		t.Errorf("expected to delete 1,a, got %v: %v", toDelete, err)
+	}
+	if forwarded != 3 {
+		t.Errorf("expected 3 forwarded changes, got %d", forwarded)
+	}
+
+	// concurrent changes on both filers are all counted
+	var wg sync.WaitGroup
+	var forwardLock sync.Mutex
+	peer.chunkReferences.forward = func(ctx context.Context, address pb.ServerAddress, fileIds []string, release bool) ([]string, error) {
+		forwardLock.Lock()
+		forwarded++
+		forwardLock.Unlock()
+		return owner.UpdateChunkReferences(ctx, fileIds, release)
+	}
+	for _, f := range []*Filer{owner, peer} {
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(f *Filer) {
+				defer wg.Done()
+				if err := f.ReferenceChunks(context.Background(), []string{"1,b"}); err != nil {
+					t.Errorf("reference chunks: %v", err)
+				}
+			}(f)
+		}
+	}
+	wg.Wait()
+	deleted := 0
+	for i := 0; i <= 100; i++ {
+		f := owner
+		if i%2 == 1 {
+			f = peer
+		}
+		toDelete, err := f.releaseChunkReferences([]string{"1,b"})
+		if err != nil {
+			t.Fatalf("release chunk references: %v", err)
+		}
+		deleted += len(toDelete)
+		if len(toDelete) > 0 && i != 100 {
+			t.Fatalf("deleted 1,b after %d of 101 releases", i+1)
+		}
+	}
+	if deleted != 1 || len(store.kv) != 0 {
+		t.Errorf("expected to delete 1,b once and release all references, got %d deletions and %d keys", deleted, len(store.kv))
+	}
+}
@@ -24,6 +24,7 @@ const (
 	IamConfigDirecotry    = "/etc/iam"
 	IamIdentityFile       = "identity.json"
 	IamPoliciesFile       = "policies.json"
+	IamRateLimitFile      = "rate_limit.json"
 )
 
 type FilerConf struct {
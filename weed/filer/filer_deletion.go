@@ -1,6 +1,7 @@
 package filer
 
 import (
+	"math"
 	"strings"
 	"time"
 
@@ -41,6 +42,7 @@ func (f *Filer) loopProcessingDeletion() {
 	for {
 		deletionCount = 0
 		f.fileIdDeletionQueue.Consume(func(fileIds []string) {
+			fileIds = f.releaseChunkReferencesOrRetry(fileIds)
 			for len(fileIds) > 0 {
 				var toDeleteFileIds []string
 				if len(fileIds) > DeletionBatchSize {
@@ -68,11 +70,24 @@ func (f *Filer) loopProcessingDeletion() {
 	}
 }
 
+// releaseChunkReferencesOrRetry returns the file ids to delete. If the references can not be released,
+// e.g., the owner filer is not reachable, the file ids are kept in the deletion queue to be retried later.
+func (f *Filer) releaseChunkReferencesOrRetry(fileIds []string) (toDelete []string) {
+	toDelete, err := f.releaseChunkReferences(fileIds)
+	if err != nil {
+		glog.Errorf("release chunk references of %d file ids: %v", len(fileIds), err)
+		f.fileIdDeletionQueue.EnQueue(fileIds...)
+		return nil
+	}
+	return toDelete
+}
+
 func (f *Filer) doDeleteFileIds(fileIds []string) {
 
 	lookupFunc := LookupByMasterClientFn(f.MasterClient)
 	DeletionBatchSize := 100000 // roughly 20 bytes cost per file id.
 
+	fileIds = f.releaseChunkReferencesOrRetry(fileIds)
 	for len(fileIds) > 0 {
 		var toDeleteFileIds []string
 		if len(fileIds) > DeletionBatchSize {
@@ -150,5 +165,38 @@ func (f *Filer) deleteChunksIfNotNew(oldEntry, newEntry *Entry) {
 			toDelete = append(toDelete, oldChunk)
 		}
 	}
+	if len(toDelete) > 0 && (HasChunkManifest(toDelete) || HasChunkManifest(newEntry.Chunks)) {
+		// the old chunks may be folded into new manifests, and the chunks of a dropped manifest
+		// may be listed directly in the new entry, e.g., after punching a hole
+		for _, fileId := range f.unfoldManifests(toDelete, newEntry.Chunks, newChunkIds) {
+			f.fileIdDeletionQueue.EnQueue(fileId)
+		}
+		return
+	}
 	f.DeleteChunks(toDelete)
 }
+
+// unfoldManifests resolves the manifests of both the deleted and the new chunks, and returns the deleted file ids not used by the new chunks
+func (f *Filer) unfoldManifests(toDelete, newChunks []*filer_pb.FileChunk, newChunkIds map[string]bool) (fileIds []string) {
+	newDataChunks, newManifestChunks, err := ResolveChunkManifest(f.MasterClient.LookupFileId, newChunks, 0, math.MaxInt64)
+	if err != nil {
+		// keep the old chunks if not sure
+		glog.Errorf("resolve new chunk manifests: %v", err)
+		return nil
+	}
+	for _, chunk := range append(newDataChunks, newManifestChunks...) {
+		newChunkIds[chunk.GetFileIdString()] = true
+	}
+
+	oldDataChunks, oldManifestChunks, err := ResolveChunkManifest(f.MasterClient.LookupFileId, toDelete, 0, math.MaxInt64)
+	if err != nil {
+		glog.Errorf("resolve old chunk manifests: %v", err)
+		return nil
+	}
+	for _, chunk := range append(oldDataChunks, oldManifestChunks...) {
+		if !newChunkIds[chunk.GetFileIdString()] {
+			fileIds = append(fileIds, chunk.GetFileIdString())
+		}
+	}
+	return
+}
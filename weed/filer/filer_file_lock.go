@@ -0,0 +1,449 @@
+package filer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	DefaultFileLockLeaseSeconds = 30
+
+	// the locks of each file and the lease of each client are saved in their own keys,
+	// listed in the index key to be loaded
+	fileLockIndexKey        = "filer.file_locks/index"
+	fileLockFileKeyPrefix   = "filer.file_locks/file/"
+	fileLockClientKeyPrefix = "filer.file_locks/client/"
+	// the index keeps the removed keys until it is this much larger than needed, to be rewritten rarely
+	fileLockIndexSlack = 64
+)
+
+var ErrFileLockTableMoved = errors.New("file locks moved to another filer")
+
+// FileLockStore persists the lock table, usually the filer store
+type FileLockStore interface {
+	KvPut(ctx context.Context, key []byte, value []byte) (err error)
+	KvGet(ctx context.Context, key []byte) (value []byte, err error)
+	KvDelete(ctx context.Context, key []byte) (err error)
+}
+
+// FileLockTable holds the POSIX advisory locks and flock locks placed by the mount clients.
+// The locks are keyed by the file, and locks of the same file are coordinated across all clients.
+// Each client holds a lease, and all its locks are released when the lease is not renewed in time.
+// Once activated with a store, every change is saved to the store, and a table activated later,
+// e.g., after a restart or on another filer, continues with the saved locks.
+// Only the changed files and leases are saved, so a change costs the same however many files are locked.
+type FileLockTable struct {
+	tableLock    sync.Mutex
+	files        map[string][]*filer_pb.FileLock
+	clients      map[string]time.Time // lease expiration of each client
+	changed      chan struct{}
+	store        FileLockStore
+	dirtyFiles   map[string]bool
+	dirtyClients map[string]bool
+	index        *fileLockIndex // the keys saved in the store
+	indexDirty   bool
+	generation   int // increased on deactivation, to fail the waiting lock requests
+}
+
+// fileLockIndex lists the files and clients saved in the store.
+// It may list the keys removed since, which are skipped when loading.
+type fileLockIndex struct {
+	Files   map[string]bool `json:"files"`
+	Clients map[string]bool `json:"clients"`
+}
+
+func newFileLockIndex() *fileLockIndex {
+	return &fileLockIndex{
+		Files:   make(map[string]bool),
+		Clients: make(map[string]bool),
+	}
+}
+
+func NewFileLockTable() *FileLockTable {
+	return &FileLockTable{
+		files:        make(map[string][]*filer_pb.FileLock),
+		clients:      make(map[string]time.Time),
+		changed:      make(chan struct{}),
+		dirtyFiles:   make(map[string]bool),
+		dirtyClients: make(map[string]bool),
+		index:        newFileLockIndex(),
+	}
+}
+
+// Activate loads the locks saved in the store, and saves the later changes to it.
+// The clients get a new lease, to renew it with this table.
+func (t *FileLockTable) Activate(store FileLockStore) error {
+	ctx := context.Background()
+	index := newFileLockIndex()
+	if err := getFileLockValue(ctx, store, fileLockIndexKey, index); err != nil {
+		return fmt.Errorf("load file lock index: %v", err)
+	}
+	files := make(map[string][]*filer_pb.FileLock)
+	for key := range index.Files {
+		var locks []*filer_pb.FileLock
+		if err := getFileLockValue(ctx, store, fileLockFileKeyPrefix+key, &locks); err != nil {
+			return fmt.Errorf("load file locks of %s: %v", key, err)
+		}
+		if len(locks) > 0 {
+			files[key] = locks
+		}
+	}
+	clients := make(map[string]time.Time)
+	minExpiration := time.Now().Add(leaseDuration(0))
+	for clientId := range index.Clients {
+		var expiration time.Time
+		if err := getFileLockValue(ctx, store, fileLockClientKeyPrefix+clientId, &expiration); err != nil {
+			return fmt.Errorf("load file lock lease of %s: %v", clientId, err)
+		}
+		if expiration.IsZero() {
+			continue
+		}
+		if expiration.Before(minExpiration) {
+			expiration = minExpiration
+		}
+		clients[clientId] = expiration
+	}
+
+	t.tableLock.Lock()
+	defer t.tableLock.Unlock()
+	t.files = files
+	t.clients = clients
+	t.store = store
+	t.dirtyFiles = make(map[string]bool)
+	t.dirtyClients = make(map[string]bool)
+	t.index = index
+	t.pruneIndex()
+	t.notifyChanged()
+	t.saveChanges()
+	return nil
+}
+
+// Deactivate drops the locks kept in memory, and stops saving the changes.
+// The waiting lock requests fail with ErrFileLockTableMoved.
+func (t *FileLockTable) Deactivate() {
+	t.tableLock.Lock()
+	defer t.tableLock.Unlock()
+	t.files = make(map[string][]*filer_pb.FileLock)
+	t.clients = make(map[string]time.Time)
+	t.store = nil
+	t.dirtyFiles = make(map[string]bool)
+	t.dirtyClients = make(map[string]bool)
+	t.index = newFileLockIndex()
+	t.indexDirty = false
+	t.generation++
+	t.notifyChanged()
+}
+
+// Test returns the first lock conflicting with the lock, or nil if the lock could be placed
+func (t *FileLockTable) Test(key string, lock *filer_pb.FileLock) *filer_pb.FileLock {
+	t.tableLock.Lock()
+	defer t.tableLock.Unlock()
+	defer t.saveChanges()
+	t.expireLeases(time.Now())
+	return t.findConflict(key, lock)
+}
+
+// Lock places, changes or removes the lock on the range of the file.
+// If the range is locked by another owner, it returns the conflicting lock,
+// or waits until the lock can be placed if wait is true.
+func (t *FileLockTable) Lock(ctx context.Context, key string, lock *filer_pb.FileLock, wait bool, leaseSeconds int32) (conflict *filer_pb.FileLock, err error) {
+	t.tableLock.Lock()
+	generation := t.generation
+	t.tableLock.Unlock()
+	for {
+		t.tableLock.Lock()
+		if t.generation != generation {
+			t.tableLock.Unlock()
+			return nil, ErrFileLockTableMoved
+		}
+		now := time.Now()
+		t.expireLeases(now)
+		if conflict = t.findConflict(key, lock); conflict == nil {
+			t.applyLock(key, lock)
+			if lock.Type != filer_pb.FileLock_UNLOCK {
+				t.setLease(lock.ClientId, now.Add(leaseDuration(leaseSeconds)))
+			}
+			t.notifyChanged()
+			t.saveChanges()
+			t.tableLock.Unlock()
+			return nil, nil
+		}
+		t.saveChanges()
+		changed := t.changed
+		t.tableLock.Unlock()
+
+		if !wait {
+			return conflict, nil
+		}
+		// wake up periodically to expire the leases of crashed clients
+		select {
+		case <-changed:
+		case <-time.After(time.Second):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Renew extends the lease of the client, and returns the number of locks held by the client.
+// An unknown client has no locks, possibly because its lease has expired already.
+func (t *FileLockTable) Renew(clientId string, leaseSeconds int32) (lockCount int) {
+	t.tableLock.Lock()
+	defer t.tableLock.Unlock()
+	defer t.saveChanges()
+	now := time.Now()
+	t.expireLeases(now)
+	if _, found := t.clients[clientId]; !found {
+		return 0
+	}
+	t.setLease(clientId, now.Add(leaseDuration(leaseSeconds)))
+	for _, locks := range t.files {
+		for _, held := range locks {
+			if held.ClientId == clientId {
+				lockCount++
+			}
+		}
+	}
+	return
+}
+
+// Release removes all locks of the owner on the file, e.g., when the file is closed
+func (t *FileLockTable) Release(key string, clientId string, owner uint64, isFlock bool) {
+	t.tableLock.Lock()
+	defer t.tableLock.Unlock()
+	defer t.saveChanges()
+	t.applyLock(key, &filer_pb.FileLock{
+		Type:     filer_pb.FileLock_UNLOCK,
+		Start:    0,
+		End:      math.MaxUint64,
+		ClientId: clientId,
+		Owner:    owner,
+		IsFlock:  isFlock,
+	})
+	t.notifyChanged()
+}
+
+// ReleaseClient removes all locks of the client, e.g., when the client unmounts
+func (t *FileLockTable) ReleaseClient(clientId string) {
+	t.tableLock.Lock()
+	defer t.tableLock.Unlock()
+	defer t.saveChanges()
+	t.releaseClient(clientId)
+	t.notifyChanged()
+}
+
+func (t *FileLockTable) expireLeases(now time.Time) {
+	expired := false
+	for clientId, expiration := range t.clients {
+		if now.After(expiration) {
+			t.releaseClient(clientId)
+			expired = true
+		}
+	}
+	if expired {
+		t.notifyChanged()
+	}
+}
+
+func (t *FileLockTable) releaseClient(clientId string) {
+	delete(t.clients, clientId)
+	t.dirtyClients[clientId] = true
+	for key, locks := range t.files {
+		var kept []*filer_pb.FileLock
+		for _, held := range locks {
+			if held.ClientId != clientId {
+				kept = append(kept, held)
+			}
+		}
+		if len(kept) != len(locks) {
+			t.setLocks(key, kept)
+		}
+	}
+}
+
+func (t *FileLockTable) setLease(clientId string, expiration time.Time) {
+	t.clients[clientId] = expiration
+	t.dirtyClients[clientId] = true
+}
+
+func (t *FileLockTable) notifyChanged() {
+	close(t.changed)
+	t.changed = make(chan struct{})
+}
+
+// saveChanges writes the changed files and leases to the store. The failure is only logged,
+// since the locks are still coordinated in memory.
+func (t *FileLockTable) saveChanges() {
+	if t.store == nil {
+		t.dirtyFiles = make(map[string]bool)
+		t.dirtyClients = make(map[string]bool)
+		return
+	}
+	ctx := context.Background()
+	for key := range t.dirtyFiles {
+		if locks, found := t.files[key]; found {
+			t.saveValue(ctx, fileLockFileKeyPrefix+key, locks)
+			if !t.index.Files[key] {
+				t.index.Files[key] = true
+				t.indexDirty = true
+			}
+		} else if t.index.Files[key] {
+			t.deleteValue(ctx, fileLockFileKeyPrefix+key)
+		}
+	}
+	for clientId := range t.dirtyClients {
+		if expiration, found := t.clients[clientId]; found {
+			t.saveValue(ctx, fileLockClientKeyPrefix+clientId, expiration)
+			if !t.index.Clients[clientId] {
+				t.index.Clients[clientId] = true
+				t.indexDirty = true
+			}
+		} else if t.index.Clients[clientId] {
+			t.deleteValue(ctx, fileLockClientKeyPrefix+clientId)
+		}
+	}
+	t.dirtyFiles = make(map[string]bool)
+	t.dirtyClients = make(map[string]bool)
+	t.pruneIndex()
+	if t.indexDirty {
+		t.indexDirty = false
+		t.saveValue(ctx, fileLockIndexKey, t.index)
+	}
+}
+
+// pruneIndex drops the removed keys from the index once there are too many of them
+func (t *FileLockTable) pruneIndex() {
+	if len(t.index.Files) > 2*len(t.files)+fileLockIndexSlack {
+		t.index.Files = make(map[string]bool)
+		for key := range t.files {
+			t.index.Files[key] = true
+		}
+		t.indexDirty = true
+	}
+	if len(t.index.Clients) > 2*len(t.clients)+fileLockIndexSlack {
+		t.index.Clients = make(map[string]bool)
+		for clientId := range t.clients {
+			t.index.Clients[clientId] = true
+		}
+		t.indexDirty = true
+	}
+}
+
+func (t *FileLockTable) saveValue(ctx context.Context, key string, v interface{}) {
+	value, err := json.Marshal(v)
+	if err == nil {
+		err = t.store.KvPut(ctx, []byte(key), value)
+	}
+	if err != nil {
+		glog.Errorf("save %s: %v", key, err)
+	}
+}
+
+func (t *FileLockTable) deleteValue(ctx context.Context, key string) {
+	if err := t.store.KvDelete(ctx, []byte(key)); err != nil && err != ErrKvNotFound {
+		glog.Errorf("delete %s: %v", key, err)
+	}
+}
+
+// getFileLockValue decodes the saved value into v, leaving v unchanged if not found
+func getFileLockValue(ctx context.Context, store FileLockStore, key string, v interface{}) error {
+	value, err := store.KvGet(ctx, []byte(key))
+	if err == ErrKvNotFound || (err == nil && len(value) == 0) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(value, v)
+}
+
+func (t *FileLockTable) findConflict(key string, lock *filer_pb.FileLock) *filer_pb.FileLock {
+	if lock.Type == filer_pb.FileLock_UNLOCK {
+		return nil
+	}
+	for _, held := range t.files[key] {
+		if held.IsFlock != lock.IsFlock || isSameLockOwner(held, lock) || !isOverlapping(held, lock) {
+			continue
+		}
+		if held.Type == filer_pb.FileLock_WRITE || lock.Type == filer_pb.FileLock_WRITE {
+			return held
+		}
+	}
+	return nil
+}
+
+// applyLock replaces the locks of the owner within the range with the new lock,
+// splitting the locks partially covered, and merging the adjacent locks of the same type
+func (t *FileLockTable) applyLock(key string, lock *filer_pb.FileLock) {
+	var locks []*filer_pb.FileLock
+	for _, held := range t.files[key] {
+		if !isSameLockOwner(held, lock) || !isOverlapping(held, lock) {
+			locks = append(locks, held)
+			continue
+		}
+		if held.Start < lock.Start {
+			left := proto.Clone(held).(*filer_pb.FileLock)
+			left.End = lock.Start - 1
+			locks = append(locks, left)
+		}
+		if held.End > lock.End {
+			right := proto.Clone(held).(*filer_pb.FileLock)
+			right.Start = lock.End + 1
+			locks = append(locks, right)
+		}
+	}
+
+	if lock.Type != filer_pb.FileLock_UNLOCK {
+		merged := proto.Clone(lock).(*filer_pb.FileLock)
+		var kept []*filer_pb.FileLock
+		for _, held := range locks {
+			if isSameLockOwner(held, merged) && held.Type == merged.Type {
+				if held.End != math.MaxUint64 && held.End+1 == merged.Start {
+					merged.Start = held.Start
+					continue
+				}
+				if merged.End != math.MaxUint64 && merged.End+1 == held.Start {
+					merged.End = held.End
+					continue
+				}
+			}
+			kept = append(kept, held)
+		}
+		locks = append(kept, merged)
+	}
+
+	t.setLocks(key, locks)
+}
+
+func (t *FileLockTable) setLocks(key string, locks []*filer_pb.FileLock) {
+	t.dirtyFiles[key] = true
+	if len(locks) == 0 {
+		delete(t.files, key)
+		return
+	}
+	t.files[key] = locks
+}
+
+func isSameLockOwner(a, b *filer_pb.FileLock) bool {
+	return a.ClientId == b.ClientId && a.Owner == b.Owner && a.IsFlock == b.IsFlock
+}
+
+func isOverlapping(a, b *filer_pb.FileLock) bool {
+	return a.Start <= b.End && b.Start <= a.End
+}
+
+func leaseDuration(leaseSeconds int32) time.Duration {
+	if leaseSeconds <= 0 {
+		leaseSeconds = DefaultFileLockLeaseSeconds
+	}
+	return time.Duration(leaseSeconds) * time.Second
+}
@@ -0,0 +1,240 @@
+package filer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func newTestLock(clientId string, owner uint64, lockType filer_pb.FileLock_Type, start, end uint64) *filer_pb.FileLock {
+	return &filer_pb.FileLock{ClientId: clientId, Owner: owner, Type: lockType, Start: start, End: end}
+}
+
+func TestFileLockRanges(t *testing.T) {
+	table := NewFileLockTable()
+	ctx := context.Background()
+
+	if conflict, _ := table.Lock(ctx, "/a", newTestLock("c1", 1, filer_pb.FileLock_WRITE, 0, 99), false, 0); conflict != nil {
+		t.Fatalf("unexpected conflict %+v", conflict)
+	}
+	// shared locks of another owner conflict with the write lock, but not outside of its range
+	if conflict := table.Test("/a", newTestLock("c2", 1, filer_pb.FileLock_READ, 50, 50)); conflict == nil {
+		t.Errorf("expected conflict")
+	}
+	if conflict, _ := table.Lock(ctx, "/a", newTestLock("c2", 1, filer_pb.FileLock_READ, 100, math.MaxUint64), false, 0); conflict != nil {
+		t.Errorf("unexpected conflict %+v", conflict)
+	}
+	// the same owner can change its own lock
+	if conflict, _ := table.Lock(ctx, "/a", newTestLock("c1", 1, filer_pb.FileLock_READ, 10, 19), false, 0); conflict != nil {
+		t.Errorf("unexpected conflict %+v", conflict)
+	}
+	if len(table.files["/a"]) != 4 {
+		t.Errorf("expected split locks: %+v", table.files["/a"])
+	}
+	if conflict := table.Test("/a", newTestLock("c3", 1, filer_pb.FileLock_READ, 10, 19)); conflict != nil {
+		t.Errorf("unexpected conflict %+v", conflict)
+	}
+	// unlocking the middle leaves two write locks
+	table.Lock(ctx, "/a", newTestLock("c1", 1, filer_pb.FileLock_UNLOCK, 10, 19), false, 0)
+	if conflict := table.Test("/a", newTestLock("c3", 1, filer_pb.FileLock_WRITE, 10, 19)); conflict != nil {
+		t.Errorf("unexpected conflict %+v", conflict)
+	}
+	// relocking the middle merges them again
+	table.Lock(ctx, "/a", newTestLock("c1", 1, filer_pb.FileLock_WRITE, 10, 19), false, 0)
+	if table.Renew("c1", 0) != 1 {
+		t.Errorf("expected merged locks: %+v", table.files["/a"])
+	}
+	// flock locks do not interact with posix locks
+	flock := newTestLock("c3", 7, filer_pb.FileLock_WRITE, 0, math.MaxUint64)
+	flock.IsFlock = true
+	if conflict, _ := table.Lock(ctx, "/a", flock, false, 0); conflict != nil {
+		t.Errorf("unexpected conflict %+v", conflict)
+	}
+
+	table.Release("/a", "c1", 1, false)
+	table.ReleaseClient("c2")
+	table.Release("/a", "c3", 7, true)
+	if len(table.files) != 0 {
+		t.Errorf("expected no locks: %+v", table.files)
+	}
+}
+
+func TestFileLockWait(t *testing.T) {
+	table := NewFileLockTable()
+	ctx := context.Background()
+	table.Lock(ctx, "/a", newTestLock("c1", 1, filer_pb.FileLock_WRITE, 0, math.MaxUint64), false, 0)
+
+	acquired := make(chan struct{})
+	go func() {
+		table.Lock(ctx, "/a", newTestLock("c2", 1, filer_pb.FileLock_WRITE, 0, 10), true, 0)
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatalf("acquired a locked range")
+	case <-time.After(100 * time.Millisecond):
+	}
+	table.Release("/a", "c1", 1, false)
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatalf("lock not acquired after release")
+	}
+
+	// waiting can be cancelled
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := table.Lock(cancelCtx, "/a", newTestLock("c3", 1, filer_pb.FileLock_READ, 0, 0), true, 0); err == nil {
+		t.Errorf("expected cancelled wait")
+	}
+}
+
+func TestFileLockLeaseExpiry(t *testing.T) {
+	table := NewFileLockTable()
+	ctx := context.Background()
+	table.Lock(ctx, "/a", newTestLock("c1", 1, filer_pb.FileLock_WRITE, 0, math.MaxUint64), false, 1)
+
+	if conflict := table.Test("/a", newTestLock("c2", 1, filer_pb.FileLock_WRITE, 0, 0)); conflict == nil {
+		t.Fatalf("expected conflict")
+	}
+	table.tableLock.Lock()
+	table.expireLeases(time.Now().Add(2 * time.Second))
+	table.tableLock.Unlock()
+	if conflict := table.Test("/a", newTestLock("c2", 1, filer_pb.FileLock_WRITE, 0, 0)); conflict != nil {
+		t.Errorf("lock of expired client %+v", conflict)
+	}
+	if table.Renew("c1", 1) != 0 {
+		t.Errorf("expired client still holds locks")
+	}
+}
+
+// memFileLockStore keeps the saved lock table in memory
+type memFileLockStore struct {
+	values map[string][]byte
+	writes int
+}
+
+func (s *memFileLockStore) KvPut(ctx context.Context, key []byte, value []byte) error {
+	s.values[string(key)] = value
+	s.writes++
+	return nil
+}
+
+func (s *memFileLockStore) KvDelete(ctx context.Context, key []byte) error {
+	delete(s.values, string(key))
+	s.writes++
+	return nil
+}
+
+func (s *memFileLockStore) KvGet(ctx context.Context, key []byte) ([]byte, error) {
+	value, found := s.values[string(key)]
+	if !found {
+		return nil, ErrKvNotFound
+	}
+	return value, nil
+}
+
+func TestFileLockPersistence(t *testing.T) {
+	store := &memFileLockStore{values: make(map[string][]byte)}
+	ctx := context.Background()
+
+	table := NewFileLockTable()
+	if err := table.Activate(store); err != nil {
+		t.Fatalf("activate: %v", err)
+	}
+	table.Lock(ctx, "inode:16", newTestLock("c1", 1, filer_pb.FileLock_WRITE, 0, 99), false, 0)
+	table.Lock(ctx, "inode:32", newTestLock("c2", 1, filer_pb.FileLock_READ, 0, 0), false, 0)
+	table.Release("inode:32", "c2", 1, false)
+
+	// another table, e.g., after a restart or on another filer, continues with the saved locks
+	restored := NewFileLockTable()
+	if err := restored.Activate(store); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if conflict := restored.Test("inode:16", newTestLock("c3", 1, filer_pb.FileLock_READ, 50, 50)); conflict == nil || conflict.ClientId != "c1" {
+		t.Errorf("expected the restored lock to conflict, got %+v", conflict)
+	}
+	if len(restored.files) != 1 {
+		t.Errorf("unexpected restored locks %+v", restored.files)
+	}
+	if restored.Renew("c1", 0) != 1 {
+		t.Errorf("the restored client should keep its lock")
+	}
+
+	// the deactivated table drops its locks, fails the waiting requests, and stops saving
+	failed := make(chan error)
+	go func() {
+		_, err := table.Lock(ctx, "inode:16", newTestLock("c3", 1, filer_pb.FileLock_WRITE, 0, 0), true, 0)
+		failed <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+	table.Deactivate()
+	select {
+	case err := <-failed:
+		if err != ErrFileLockTableMoved {
+			t.Errorf("unexpected wait error %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("the waiting lock request is not failed")
+	}
+	if len(table.files) != 0 {
+		t.Errorf("expected no locks after deactivation: %+v", table.files)
+	}
+	table.Lock(ctx, "inode:48", newTestLock("c4", 1, filer_pb.FileLock_WRITE, 0, 0), false, 0)
+	if conflict := restored.Test("inode:48", newTestLock("c5", 1, filer_pb.FileLock_WRITE, 0, 0)); conflict != nil {
+		t.Errorf("unexpected conflict %+v", conflict)
+	}
+	restored.ReleaseClient("c1")
+	if err := table.Activate(store); err != nil {
+		t.Fatalf("reactivate: %v", err)
+	}
+	if len(table.files) != 0 {
+		t.Errorf("the locks saved after deactivation: %+v", table.files)
+	}
+}
+
+func TestFileLockPersistencePerFile(t *testing.T) {
+	store := &memFileLockStore{values: make(map[string][]byte)}
+	ctx := context.Background()
+
+	table := NewFileLockTable()
+	if err := table.Activate(store); err != nil {
+		t.Fatalf("activate: %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		table.Lock(ctx, fmt.Sprintf("inode:%d", i), newTestLock("c1", 1, filer_pb.FileLock_WRITE, 0, 0), false, 0)
+	}
+
+	// locking and unlocking a file saves only the file and the lease, however many files are locked
+	table.Lock(ctx, "inode:100", newTestLock("c1", 1, filer_pb.FileLock_WRITE, 0, 0), false, 0)
+	table.Lock(ctx, "inode:100", newTestLock("c1", 1, filer_pb.FileLock_UNLOCK, 0, 0), false, 0)
+	writes := store.writes
+	for i := 0; i < 10; i++ {
+		table.Lock(ctx, "inode:100", newTestLock("c1", 1, filer_pb.FileLock_WRITE, 0, 0), false, 0)
+		table.Lock(ctx, "inode:100", newTestLock("c1", 1, filer_pb.FileLock_UNLOCK, 0, 0), false, 0)
+		table.Renew("c1", 0)
+	}
+	if writes = store.writes - writes; writes != 40 {
+		t.Errorf("expected 4 writes per lock, unlock and renew, got %d", writes)
+	}
+
+	// the released locks are removed from the store
+	for i := 0; i < 50; i++ {
+		table.Release(fmt.Sprintf("inode:%d", i), "c1", 1, false)
+	}
+	restored := NewFileLockTable()
+	if err := restored.Activate(store); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if len(restored.files) != 50 || restored.Renew("c1", 0) != 50 {
+		t.Errorf("unexpected restored locks %+v", restored.files)
+	}
+	restored.ReleaseClient("c1")
+	if len(store.values) != 1 {
+		t.Errorf("only the index should be left, got %d keys", len(store.values))
+	}
+}
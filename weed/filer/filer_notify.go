@@ -93,6 +93,16 @@ func (f *Filer) logFlushFunc(startTime, stopTime time.Time, buf []byte) {
 
 	startTime, stopTime = startTime.UTC(), stopTime.UTC()
 
+	for {
+		wrappedBuf, err := f.wrapLogCipherKeys(buf)
+		if err == nil {
+			buf = wrappedBuf
+			break
+		}
+		glog.Errorf("wrap cipher keys of the meta log: %v", err)
+		time.Sleep(737 * time.Millisecond)
+	}
+
 	targetFile := fmt.Sprintf("%s/%04d-%02d-%02d/%02d-%02d.%08x", SystemLogDir,
 		startTime.Year(), startTime.Month(), startTime.Day(), startTime.Hour(), startTime.Minute(), f.UniqueFileId,
 		// startTime.Second(), startTime.Nanosecond(),
@@ -116,6 +126,12 @@ func (f *Filer) ReadPersistedLogBuffer(startTime time.Time, eachLogEntryFn func(
 
 	sizeBuf := make([]byte, 4)
 	startTsNs := startTime.UnixNano()
+	eachUnwrappedLogEntryFn := func(logEntry *filer_pb.LogEntry) error {
+		if err := f.unwrapLogCipherKeys(logEntry); err != nil {
+			return fmt.Errorf("unwrap cipher keys of the meta log: %v", err)
+		}
+		return eachLogEntryFn(logEntry)
+	}
 
 	dayEntries, _, listDayErr := f.ListDirectoryEntries(context.Background(), SystemLogDir, startDate, true, 366, "", "", "")
 	if listDayErr != nil {
@@ -137,7 +153,7 @@ func (f *Filer) ReadPersistedLogBuffer(startTime time.Time, eachLogEntryFn func(
 			}
 			// println("processing", hourMinuteEntry.FullPath)
 			chunkedFileReader := NewChunkStreamReaderFromFiler(f.MasterClient, hourMinuteEntry.Chunks)
-			if lastTsNs, err = ReadEachLogEntry(chunkedFileReader, sizeBuf, startTsNs, eachLogEntryFn); err != nil {
+			if lastTsNs, err = ReadEachLogEntry(chunkedFileReader, sizeBuf, startTsNs, eachUnwrappedLogEntryFn); err != nil {
 				chunkedFileReader.Close()
 				if err == io.EOF {
 					continue
@@ -0,0 +1,181 @@
+package filer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+const (
+	MsgObjectLocked = "object is locked"
+
+	ObjectLockGovernance = "GOVERNANCE"
+	ObjectLockCompliance = "COMPLIANCE"
+	LegalHoldOn          = "ON"
+	LegalHoldOff         = "OFF"
+)
+
+// ObjectRetainUntil returns the S3 object lock retention, zero time if not retained
+func ObjectRetainUntil(extended map[string][]byte) (mode string, retainUntil time.Time) {
+	mode = string(extended[xhttp.AmzObjectLockMode])
+	if mode != ObjectLockGovernance && mode != ObjectLockCompliance {
+		return "", time.Time{}
+	}
+	retainUntil, err := time.Parse(time.RFC3339, string(extended[xhttp.AmzObjectLockRetainUntilDate]))
+	if err != nil {
+		return "", time.Time{}
+	}
+	return mode, retainUntil
+}
+
+// IsObjectRetained checks whether the S3 object lock retention is still in effect
+func IsObjectRetained(extended map[string][]byte, now time.Time) bool {
+	mode, retainUntil := ObjectRetainUntil(extended)
+	return mode != "" && now.Before(retainUntil)
+}
+
+// IsObjectLegalHold checks whether the S3 object lock legal hold is on
+func IsObjectLegalHold(extended map[string][]byte) bool {
+	return string(extended[xhttp.AmzObjectLockLegalHold]) == LegalHoldOn
+}
+
+// IsObjectLocked checks whether the file can not be deleted or overwritten because of its retention or legal hold
+func IsObjectLocked(extended map[string][]byte, now time.Time) bool {
+	return IsObjectLegalHold(extended) || IsObjectRetained(extended, now)
+}
+
+// CanDelete checks the entry, and all its children when deleting recursively, against S3 object lock,
+// so that the locked objects can not be deleted through any protocol.
+func (f *Filer) CanDelete(ctx context.Context, p util.FullPath, isRecursive bool) error {
+	entry, err := f.FindEntry(ctx, p)
+	if err != nil {
+		return nil
+	}
+	now := time.Now()
+	if !entry.IsDirectory() {
+		if IsObjectLocked(entry.Extended, now) {
+			return fmt.Errorf("%s: %s", MsgObjectLocked, p)
+		}
+		return nil
+	}
+	if !isRecursive {
+		return nil
+	}
+
+	// only the buckets with object lock enabled can have locked objects
+	if bucket := f.DetectBucket(p); bucket != "" {
+		if !f.isObjectLockEnabled(ctx, bucket) {
+			return nil
+		}
+		return f.checkObjectLockInFolder(ctx, p, now)
+	}
+	if p != "/" && !strings.HasPrefix(f.DirBucketsPath, string(p)+"/") {
+		return nil
+	}
+	lastBucketName := ""
+	for {
+		buckets, _, err := f.ListDirectoryEntries(ctx, util.FullPath(f.DirBucketsPath), lastBucketName, false, PaginationSize, "", "", "")
+		if err != nil {
+			return fmt.Errorf("list buckets: %v", err)
+		}
+		for _, bucket := range buckets {
+			lastBucketName = bucket.Name()
+			if bucket.IsDirectory() && len(bucket.Extended[xhttp.AmzBucketObjectLock]) > 0 {
+				if err := f.checkObjectLockInFolder(ctx, bucket.FullPath, now); err != nil {
+					return err
+				}
+			}
+		}
+		if len(buckets) < PaginationSize {
+			return nil
+		}
+	}
+}
+
+func (f *Filer) isObjectLockEnabled(ctx context.Context, bucket string) bool {
+	entry, err := f.FindEntry(ctx, util.NewFullPath(f.DirBucketsPath, bucket))
+	if err != nil {
+		return false
+	}
+	return len(entry.Extended[xhttp.AmzBucketObjectLock]) > 0
+}
+
+func (f *Filer) checkObjectLockInFolder(ctx context.Context, dir util.FullPath, now time.Time) error {
+	lastFileName := ""
+	for {
+		entries, _, err := f.ListDirectoryEntries(ctx, dir, lastFileName, false, PaginationSize, "", "", "")
+		if err != nil {
+			return fmt.Errorf("list folder %s: %v", dir, err)
+		}
+		for _, sub := range entries {
+			lastFileName = sub.Name()
+			if sub.IsDirectory() {
+				if err := f.checkObjectLockInFolder(ctx, sub.FullPath, now); err != nil {
+					return err
+				}
+			} else if IsObjectLocked(sub.Extended, now) {
+				return fmt.Errorf("%s: %s", MsgObjectLocked, sub.FullPath)
+			}
+		}
+		if len(entries) < PaginationSize {
+			return nil
+		}
+	}
+}
+
+// ObjectLockRelease is what the s3 gateway has authorized, with a signed filer jwt, to release from an object lock
+type ObjectLockRelease struct {
+	RemoveLegalHold  bool
+	BypassGovernance bool
+}
+
+// checkObjectLockUpdate keeps the content of a locked file from being overwritten,
+// and the compliance retention from being shortened or removed. The legal hold and the governance retention
+// can only be removed or shortened when the s3 gateway has authorized each of them.
+func checkObjectLockUpdate(oldEntry, entry *Entry, release ObjectLockRelease) error {
+	if oldEntry.IsDirectory() {
+		return nil
+	}
+	now := time.Now()
+	if !IsObjectLocked(oldEntry.Extended, now) {
+		return nil
+	}
+	if isContentChanged(oldEntry, entry) {
+		glog.V(1).Infof("overwrite locked %s", oldEntry.FullPath)
+		return fmt.Errorf("%s: %s", MsgObjectLocked, oldEntry.FullPath)
+	}
+	if IsObjectLegalHold(oldEntry.Extended) && !IsObjectLegalHold(entry.Extended) && !release.RemoveLegalHold {
+		glog.V(1).Infof("remove legal hold of %s", oldEntry.FullPath)
+		return fmt.Errorf("%s: %s", MsgObjectLocked, oldEntry.FullPath)
+	}
+	mode, retainUntil := ObjectRetainUntil(oldEntry.Extended)
+	if mode == "" || !now.Before(retainUntil) {
+		return nil
+	}
+	newMode, newRetainUntil := ObjectRetainUntil(entry.Extended)
+	if newMode == "" || newRetainUntil.Before(retainUntil) || mode == ObjectLockCompliance && newMode != ObjectLockCompliance {
+		if mode == ObjectLockCompliance || !release.BypassGovernance {
+			glog.V(1).Infof("shorten %s retention of %s", mode, oldEntry.FullPath)
+			return fmt.Errorf("%s: %s", MsgObjectLocked, oldEntry.FullPath)
+		}
+	}
+	return nil
+}
+
+func isContentChanged(oldEntry, entry *Entry) bool {
+	if len(oldEntry.Chunks) != len(entry.Chunks) || !bytes.Equal(oldEntry.Content, entry.Content) {
+		return true
+	}
+	for i, chunk := range oldEntry.Chunks {
+		if chunk.GetFileIdString() != entry.Chunks[i].GetFileIdString() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package filer
+
+import (
+	"testing"
+	"time"
+
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+)
+
+func newObjectLockEntry(extended map[string]string) *Entry {
+	entry := &Entry{FullPath: "/buckets/b/key", Extended: make(map[string][]byte)}
+	for k, v := range extended {
+		entry.Extended[k] = []byte(v)
+	}
+	return entry
+}
+
+func TestCheckObjectLockUpdate(t *testing.T) {
+	later := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	sooner := time.Now().Add(time.Minute).UTC().Format(time.RFC3339)
+
+	legalHold := map[string]string{xhttp.AmzObjectLockLegalHold: LegalHoldOn}
+	governance := map[string]string{xhttp.AmzObjectLockMode: ObjectLockGovernance, xhttp.AmzObjectLockRetainUntilDate: later}
+	compliance := map[string]string{xhttp.AmzObjectLockMode: ObjectLockCompliance, xhttp.AmzObjectLockRetainUntilDate: later}
+
+	tests := []struct {
+		name     string
+		old, new map[string]string
+		release  ObjectLockRelease
+		allowed  bool
+	}{
+		{"remove legal hold", legalHold, map[string]string{xhttp.AmzObjectLockLegalHold: LegalHoldOff}, ObjectLockRelease{}, false},
+		{"remove legal hold bypassing governance", legalHold, map[string]string{xhttp.AmzObjectLockLegalHold: LegalHoldOff}, ObjectLockRelease{BypassGovernance: true}, false},
+		{"remove legal hold authorized", legalHold, map[string]string{xhttp.AmzObjectLockLegalHold: LegalHoldOff}, ObjectLockRelease{RemoveLegalHold: true}, true},
+		{"remove governance", governance, nil, ObjectLockRelease{}, false},
+		{"shorten governance", governance, map[string]string{xhttp.AmzObjectLockMode: ObjectLockGovernance, xhttp.AmzObjectLockRetainUntilDate: sooner}, ObjectLockRelease{}, false},
+		{"remove governance authorized", governance, nil, ObjectLockRelease{BypassGovernance: true}, true},
+		{"remove governance releasing legal hold", governance, nil, ObjectLockRelease{RemoveLegalHold: true}, false},
+		{"governance to compliance", governance, compliance, ObjectLockRelease{}, true},
+		{"remove compliance", compliance, nil, ObjectLockRelease{BypassGovernance: true}, false},
+		{"compliance to governance", compliance, governance, ObjectLockRelease{BypassGovernance: true}, false},
+		{"shorten compliance", compliance, map[string]string{xhttp.AmzObjectLockMode: ObjectLockCompliance, xhttp.AmzObjectLockRetainUntilDate: sooner}, ObjectLockRelease{BypassGovernance: true}, false},
+		{"keep compliance", compliance, compliance, ObjectLockRelease{}, true},
+	}
+	for _, tt := range tests {
+		err := checkObjectLockUpdate(newObjectLockEntry(tt.old), newObjectLockEntry(tt.new), tt.release)
+		if tt.allowed && err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+		if !tt.allowed && err == nil {
+			t.Errorf("%s: expected to be refused", tt.name)
+		}
+	}
+
+	// the content of a locked object can not be changed even if authorized
+	changed := newObjectLockEntry(governance)
+	changed.Content = []byte("new")
+	if err := checkObjectLockUpdate(newObjectLockEntry(governance), changed, ObjectLockRelease{RemoveLegalHold: true, BypassGovernance: true}); err == nil {
+		t.Errorf("expected to refuse overwriting locked content")
+	}
+}
@@ -0,0 +1,168 @@
+package filer
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/chrislusf/seaweedfs/weed/kms"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// SetKeyManager makes the filer store keep the chunk cipher keys wrapped by the key manager
+func (f *Filer) SetKeyManager(keyManager kms.KeyManager) {
+	f.KeyManager = keyManager
+	if fsw, ok := f.Store.(*FilerStoreWrapper); ok {
+		fsw.keyManager = keyManager
+	}
+}
+
+// wrapCipherKeys returns a copy of the entry to save, with the chunk cipher keys wrapped.
+// The entry itself is left alone, since it is also used for the metadata events.
+// Without a key manager, the cipher keys are saved as they are.
+func (fsw *FilerStoreWrapper) wrapCipherKeys(entry *Entry) (*Entry, error) {
+	chunks, err := wrapChunkCipherKeys(fsw.keyManager, entry.Chunks)
+	if err != nil {
+		return nil, err
+	}
+	if chunks == nil {
+		return entry, nil
+	}
+	wrappedEntry := *entry
+	wrappedEntry.Chunks = chunks
+	return &wrappedEntry, nil
+}
+
+// unwrapCipherKeys restores the chunk cipher keys of an entry read from the store.
+// The key id is kept, to tell which master key protects the cipher key in the store.
+func (fsw *FilerStoreWrapper) unwrapCipherKeys(entry *Entry) error {
+	return unwrapChunkCipherKeys(fsw.keyManager, entry.Chunks)
+}
+
+// wrapChunkCipherKeys returns a copy of the chunks with the cipher keys wrapped, or nil if no chunk has a cipher key
+func wrapChunkCipherKeys(keyManager kms.KeyManager, chunks []*filer_pb.FileChunk) ([]*filer_pb.FileChunk, error) {
+	var wrappedChunks []*filer_pb.FileChunk
+	for i, chunk := range chunks {
+		if len(chunk.CipherKey) == 0 && chunk.CipherKeyId == "" {
+			continue
+		}
+		if wrappedChunks == nil {
+			wrappedChunks = make([]*filer_pb.FileChunk, len(chunks))
+			copy(wrappedChunks, chunks)
+		}
+		wrapped := proto.Clone(chunk).(*filer_pb.FileChunk)
+		wrapped.CipherKeyId = ""
+		if keyManager != nil && len(chunk.CipherKey) > 0 {
+			var err error
+			if wrapped.CipherKeyId, wrapped.CipherKey, err = keyManager.WrapKey(chunk.CipherKey); err != nil {
+				return nil, fmt.Errorf("wrap cipher key of %s: %v", chunk.GetFileIdString(), err)
+			}
+		}
+		wrappedChunks[i] = wrapped
+	}
+	return wrappedChunks, nil
+}
+
+func unwrapChunkCipherKeys(keyManager kms.KeyManager, chunks []*filer_pb.FileChunk) error {
+	for _, chunk := range chunks {
+		if chunk.CipherKeyId == "" {
+			continue
+		}
+		if keyManager == nil {
+			return fmt.Errorf("cipher key of %s is wrapped by %s, but no key manager is configured", chunk.GetFileIdString(), chunk.CipherKeyId)
+		}
+		cipherKey, err := keyManager.UnwrapKey(chunk.CipherKeyId, chunk.CipherKey)
+		if err != nil {
+			return fmt.Errorf("unwrap cipher key of %s by %s: %v", chunk.GetFileIdString(), chunk.CipherKeyId, err)
+		}
+		chunk.CipherKey = cipherKey
+	}
+	return nil
+}
+
+// wrapLogCipherKeys wraps the chunk cipher keys in the metadata events of the log before it is persisted.
+// The live metadata events and the notification queues still carry the plain cipher keys,
+// which the subscribers need to read the data.
+func (f *Filer) wrapLogCipherKeys(buf []byte) ([]byte, error) {
+	if f.KeyManager == nil {
+		return buf, nil
+	}
+	var wrappedBuf []byte
+	for pos := 0; pos+4 <= len(buf); {
+		size := int(util.BytesToUint32(buf[pos : pos+4]))
+		if pos+4+size > len(buf) {
+			return nil, fmt.Errorf("log entry of %d bytes at %d exceeds %d bytes", size, pos, len(buf))
+		}
+		logEntry := &filer_pb.LogEntry{}
+		if err := proto.Unmarshal(buf[pos+4:pos+4+size], logEntry); err != nil {
+			return nil, err
+		}
+		pos += 4 + size
+		event := &filer_pb.SubscribeMetadataResponse{}
+		if err := proto.Unmarshal(logEntry.Data, event); err != nil {
+			return nil, err
+		}
+		if notification := event.EventNotification; notification != nil {
+			for _, entry := range []*filer_pb.Entry{notification.OldEntry, notification.NewEntry} {
+				if entry == nil {
+					continue
+				}
+				chunks, err := wrapChunkCipherKeys(f.KeyManager, entry.Chunks)
+				if err != nil {
+					return nil, err
+				}
+				if chunks != nil {
+					entry.Chunks = chunks
+				}
+			}
+		}
+		var err error
+		if logEntry.Data, err = proto.Marshal(event); err != nil {
+			return nil, err
+		}
+		logEntryData, err := proto.Marshal(logEntry)
+		if err != nil {
+			return nil, err
+		}
+		sizeBuf := make([]byte, 4)
+		util.Uint32toBytes(sizeBuf, uint32(len(logEntryData)))
+		wrappedBuf = append(append(wrappedBuf, sizeBuf...), logEntryData...)
+	}
+	return wrappedBuf, nil
+}
+
+// unwrapLogCipherKeys restores the chunk cipher keys in the metadata event of a persisted log entry
+func (f *Filer) unwrapLogCipherKeys(logEntry *filer_pb.LogEntry) error {
+	event := &filer_pb.SubscribeMetadataResponse{}
+	if err := proto.Unmarshal(logEntry.Data, event); err != nil {
+		return err
+	}
+	notification := event.EventNotification
+	if notification == nil {
+		return nil
+	}
+	wrapped := false
+	for _, entry := range []*filer_pb.Entry{notification.OldEntry, notification.NewEntry} {
+		if entry == nil {
+			continue
+		}
+		for _, chunk := range entry.Chunks {
+			if chunk.CipherKeyId != "" {
+				wrapped = true
+			}
+		}
+		if err := unwrapChunkCipherKeys(f.KeyManager, entry.Chunks); err != nil {
+			return err
+		}
+	}
+	if !wrapped {
+		return nil
+	}
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	logEntry.Data = data
+	return nil
+}
@@ -0,0 +1,78 @@
+package filer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/golang/protobuf/proto"
+)
+
+// upperKeyManager "wraps" the cipher keys by changing them to upper case
+type upperKeyManager struct{}
+
+func (m *upperKeyManager) GetName() string { return "upper" }
+func (m *upperKeyManager) Initialize(configuration *util.ViperProxy, prefix string) error {
+	return nil
+}
+func (m *upperKeyManager) CurrentKeyId() (string, error) { return "upper", nil }
+func (m *upperKeyManager) WrapKey(cipherKey []byte) (string, []byte, error) {
+	return "upper", bytes.ToUpper(cipherKey), nil
+}
+func (m *upperKeyManager) UnwrapKey(keyId string, wrappedKey []byte) ([]byte, error) {
+	return bytes.ToLower(wrappedKey), nil
+}
+
+func TestWrapLogCipherKeys(t *testing.T) {
+	f := &Filer{KeyManager: &upperKeyManager{}}
+
+	var buf []byte
+	for i, entry := range []*filer_pb.Entry{
+		{Name: "a", Chunks: []*filer_pb.FileChunk{{FileId: "1,a", CipherKey: []byte("secret")}}},
+		{Name: "b", Chunks: []*filer_pb.FileChunk{{FileId: "1,b"}}},
+	} {
+		data, _ := proto.Marshal(&filer_pb.SubscribeMetadataResponse{
+			Directory:         "/",
+			EventNotification: &filer_pb.EventNotification{NewEntry: entry},
+		})
+		logEntryData, _ := proto.Marshal(&filer_pb.LogEntry{TsNs: int64(i + 1), Data: data})
+		sizeBuf := make([]byte, 4)
+		util.Uint32toBytes(sizeBuf, uint32(len(logEntryData)))
+		buf = append(append(buf, sizeBuf...), logEntryData...)
+	}
+
+	wrapped, err := f.wrapLogCipherKeys(buf)
+	if err != nil {
+		t.Fatalf("wrap: %v", err)
+	}
+	if bytes.Contains(wrapped, []byte("secret")) || !bytes.Contains(wrapped, []byte("SECRET")) {
+		t.Errorf("the persisted log should only have the wrapped cipher key")
+	}
+
+	var names, cipherKeys []string
+	_, err = ReadEachLogEntry(bytes.NewReader(wrapped), make([]byte, 4), 0, func(logEntry *filer_pb.LogEntry) error {
+		if err := f.unwrapLogCipherKeys(logEntry); err != nil {
+			return err
+		}
+		event := &filer_pb.SubscribeMetadataResponse{}
+		if err := proto.Unmarshal(logEntry.Data, event); err != nil {
+			return err
+		}
+		names = append(names, event.EventNotification.NewEntry.Name)
+		cipherKeys = append(cipherKeys, string(event.EventNotification.NewEntry.Chunks[0].CipherKey))
+		return nil
+	})
+	if len(names) != 2 || names[0] != "a" || names[1] != "b" {
+		t.Errorf("unexpected events %v: %v", names, err)
+	}
+	if len(cipherKeys) != 2 || cipherKeys[0] != "secret" || cipherKeys[1] != "" {
+		t.Errorf("unexpected cipher keys %v", cipherKeys)
+	}
+
+	// without a key manager, the log is persisted as it is
+	f.KeyManager = nil
+	if unwrapped, _ := f.wrapLogCipherKeys(buf); !bytes.Equal(unwrapped, buf) {
+		t.Errorf("the log should not change without a key manager")
+	}
+}
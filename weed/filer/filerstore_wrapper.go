@@ -2,11 +2,14 @@ package filer
 
 import (
 	"context"
+	"fmt"
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/kms"
 	"github.com/viant/ptrie"
 	"io"
 	"math"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
@@ -28,14 +31,22 @@ type VirtualFilerStore interface {
 	OnBucketCreation(bucket string)
 	OnBucketDeletion(bucket string)
 	CanDropWholeBucket() bool
+	UpdateEntryAtomically(ctx context.Context, fp util.FullPath, updateFn func(entry *Entry) (changed bool, err error)) error
 }
 
 type FilerStoreWrapper struct {
 	defaultStore   FilerStore
 	pathToStore    ptrie.Trie
 	storeIdToStore map[string]FilerStore
+	keyManager     kms.KeyManager
+	// entryLocks serialize the writes to the same entry, and folderLock keeps folders from being emptied,
+	// while UpdateEntryAtomically reads and saves an entry
+	entryLocks [entryLockCount]sync.Mutex
+	folderLock sync.RWMutex
 }
 
+const entryLockCount = 64
+
 func NewFilerStoreWrapper(store FilerStore) *FilerStoreWrapper {
 	if innerStore, ok := store.(*FilerStoreWrapper); ok {
 		return innerStore
@@ -112,6 +123,7 @@ func (fsw *FilerStoreWrapper) Initialize(configuration util.Configuration, prefi
 }
 
 func (fsw *FilerStoreWrapper) InsertEntry(ctx context.Context, entry *Entry) error {
+	defer fsw.lockEntry(entry.FullPath)()
 	actualStore := fsw.getActualStore(entry.FullPath)
 	stats.FilerStoreCounter.WithLabelValues(actualStore.GetName(), "insert").Inc()
 	start := time.Now()
@@ -124,6 +136,11 @@ func (fsw *FilerStoreWrapper) InsertEntry(ctx context.Context, entry *Entry) err
 		entry.Mime = ""
 	}
 
+	entry, err := fsw.wrapCipherKeys(entry)
+	if err != nil {
+		return err
+	}
+
 	if err := fsw.handleUpdateToHardLinks(ctx, entry); err != nil {
 		return err
 	}
@@ -133,6 +150,11 @@ func (fsw *FilerStoreWrapper) InsertEntry(ctx context.Context, entry *Entry) err
 }
 
 func (fsw *FilerStoreWrapper) UpdateEntry(ctx context.Context, entry *Entry) error {
+	defer fsw.lockEntry(entry.FullPath)()
+	return fsw.updateEntry(ctx, entry)
+}
+
+func (fsw *FilerStoreWrapper) updateEntry(ctx context.Context, entry *Entry) error {
 	actualStore := fsw.getActualStore(entry.FullPath)
 	stats.FilerStoreCounter.WithLabelValues(actualStore.GetName(), "update").Inc()
 	start := time.Now()
@@ -145,6 +167,11 @@ func (fsw *FilerStoreWrapper) UpdateEntry(ctx context.Context, entry *Entry) err
 		entry.Mime = ""
 	}
 
+	entry, err := fsw.wrapCipherKeys(entry)
+	if err != nil {
+		return err
+	}
+
 	if err := fsw.handleUpdateToHardLinks(ctx, entry); err != nil {
 		return err
 	}
@@ -170,10 +197,14 @@ func (fsw *FilerStoreWrapper) FindEntry(ctx context.Context, fp util.FullPath) (
 	fsw.maybeReadHardLink(ctx, entry)
 
 	filer_pb.AfterEntryDeserialization(entry.Chunks)
+	if err = fsw.unwrapCipherKeys(entry); err != nil {
+		return nil, err
+	}
 	return
 }
 
 func (fsw *FilerStoreWrapper) DeleteEntry(ctx context.Context, fp util.FullPath) (err error) {
+	defer fsw.lockEntry(fp)()
 	actualStore := fsw.getActualStore(fp)
 	stats.FilerStoreCounter.WithLabelValues(actualStore.GetName(), "delete").Inc()
 	start := time.Now()
@@ -185,7 +216,7 @@ func (fsw *FilerStoreWrapper) DeleteEntry(ctx context.Context, fp util.FullPath)
 	if findErr == filer_pb.ErrNotFound {
 		return nil
 	}
-	if len(existingEntry.HardLinkId) != 0 {
+	if existingEntry != nil && len(existingEntry.HardLinkId) != 0 {
 		// remove hard link
 		glog.V(4).Infof("DeleteHardLink %s", existingEntry.FullPath)
 		if err = fsw.DeleteHardLink(ctx, existingEntry.HardLinkId); err != nil {
@@ -198,6 +229,7 @@ func (fsw *FilerStoreWrapper) DeleteEntry(ctx context.Context, fp util.FullPath)
 }
 
 func (fsw *FilerStoreWrapper) DeleteOneEntry(ctx context.Context, existingEntry *Entry) (err error) {
+	defer fsw.lockEntry(existingEntry.FullPath)()
 	actualStore := fsw.getActualStore(existingEntry.FullPath)
 	stats.FilerStoreCounter.WithLabelValues(actualStore.GetName(), "delete").Inc()
 	start := time.Now()
@@ -218,6 +250,7 @@ func (fsw *FilerStoreWrapper) DeleteOneEntry(ctx context.Context, existingEntry
 }
 
 func (fsw *FilerStoreWrapper) DeleteOneEntrySkipHardlink(ctx context.Context, fullpath util.FullPath) (err error) {
+	defer fsw.lockEntry(fullpath)()
 	actualStore := fsw.getActualStore(fullpath)
 	stats.FilerStoreCounter.WithLabelValues(actualStore.GetName(), "delete").Inc()
 	start := time.Now()
@@ -230,6 +263,8 @@ func (fsw *FilerStoreWrapper) DeleteOneEntrySkipHardlink(ctx context.Context, fu
 }
 
 func (fsw *FilerStoreWrapper) DeleteFolderChildren(ctx context.Context, fp util.FullPath) (err error) {
+	fsw.folderLock.RLock()
+	defer fsw.folderLock.RUnlock()
 	actualStore := fsw.getActualStore(fp + "/")
 	stats.FilerStoreCounter.WithLabelValues(actualStore.GetName(), "deleteFolderChildren").Inc()
 	start := time.Now()
@@ -241,6 +276,28 @@ func (fsw *FilerStoreWrapper) DeleteFolderChildren(ctx context.Context, fp util.
 	return actualStore.DeleteFolderChildren(ctx, fp)
 }
 
+// UpdateEntryAtomically saves the entry changed by updateFn, without any other write to the entry in between
+func (fsw *FilerStoreWrapper) UpdateEntryAtomically(ctx context.Context, fp util.FullPath, updateFn func(entry *Entry) (changed bool, err error)) error {
+	fsw.folderLock.Lock()
+	defer fsw.folderLock.Unlock()
+	defer fsw.lockEntry(fp)()
+	entry, err := fsw.FindEntry(ctx, fp)
+	if err != nil {
+		return err
+	}
+	changed, err := updateFn(entry)
+	if err != nil || !changed {
+		return err
+	}
+	return fsw.updateEntry(ctx, entry)
+}
+
+func (fsw *FilerStoreWrapper) lockEntry(fp util.FullPath) (unlock func()) {
+	lock := &fsw.entryLocks[uint32(util.HashToInt32([]byte(fp)))%entryLockCount]
+	lock.Lock()
+	return lock.Unlock
+}
+
 func (fsw *FilerStoreWrapper) ListDirectoryEntries(ctx context.Context, dirPath util.FullPath, startFileName string, includeStartFile bool, limit int64, eachEntryFunc ListEachEntryFunc) (string, error) {
 	actualStore := fsw.getActualStore(dirPath + "/")
 	stats.FilerStoreCounter.WithLabelValues(actualStore.GetName(), "list").Inc()
@@ -250,11 +307,20 @@ func (fsw *FilerStoreWrapper) ListDirectoryEntries(ctx context.Context, dirPath
 	}()
 
 	// glog.V(4).Infof("ListDirectoryEntries %s from %s limit %d", dirPath, startFileName, limit)
-	return actualStore.ListDirectoryEntries(ctx, dirPath, startFileName, includeStartFile, limit, func(entry *Entry) bool {
+	var unwrapErr error
+	lastFileName, err := actualStore.ListDirectoryEntries(ctx, dirPath, startFileName, includeStartFile, limit, func(entry *Entry) bool {
 		fsw.maybeReadHardLink(ctx, entry)
 		filer_pb.AfterEntryDeserialization(entry.Chunks)
+		if unwrapErr = fsw.unwrapCipherKeys(entry); unwrapErr != nil {
+			unwrapErr = fmt.Errorf("list %s: %v", entry.FullPath, unwrapErr)
+			return false
+		}
 		return eachEntryFunc(entry)
 	})
+	if err == nil {
+		err = unwrapErr
+	}
+	return lastFileName, err
 }
 
 func (fsw *FilerStoreWrapper) ListDirectoryPrefixedEntries(ctx context.Context, dirPath util.FullPath, startFileName string, includeStartFile bool, limit int64, prefix string, eachEntryFunc ListEachEntryFunc) (lastFileName string, err error) {
@@ -268,15 +334,23 @@ func (fsw *FilerStoreWrapper) ListDirectoryPrefixedEntries(ctx context.Context,
 		limit = math.MaxInt32 - 1
 	}
 	// glog.V(4).Infof("ListDirectoryPrefixedEntries %s from %s prefix %s limit %d", dirPath, startFileName, prefix, limit)
+	var unwrapErr error
 	adjustedEntryFunc := func(entry *Entry) bool {
 		fsw.maybeReadHardLink(ctx, entry)
 		filer_pb.AfterEntryDeserialization(entry.Chunks)
+		if unwrapErr = fsw.unwrapCipherKeys(entry); unwrapErr != nil {
+			unwrapErr = fmt.Errorf("list %s: %v", entry.FullPath, unwrapErr)
+			return false
+		}
 		return eachEntryFunc(entry)
 	}
 	lastFileName, err = actualStore.ListDirectoryPrefixedEntries(ctx, dirPath, startFileName, includeStartFile, limit, prefix, adjustedEntryFunc)
 	if err == ErrUnsupportedListDirectoryPrefixed {
 		lastFileName, err = fsw.prefixFilterEntries(ctx, dirPath, startFileName, includeStartFile, limit, prefix, adjustedEntryFunc)
 	}
+	if err == nil {
+		err = unwrapErr
+	}
 	return lastFileName, err
 }
 
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
@@ -86,6 +87,103 @@ func TestEmptyRoot(t *testing.T) {
 
 }
 
+// reversedKeyManager "wraps" the cipher keys by reversing them
+type reversedKeyManager struct {
+	keyId string
+}
+
+func (m *reversedKeyManager) GetName() string { return "reversed" }
+func (m *reversedKeyManager) Initialize(configuration *util.ViperProxy, prefix string) error {
+	return nil
+}
+func (m *reversedKeyManager) CurrentKeyId() (string, error) { return m.keyId, nil }
+func (m *reversedKeyManager) WrapKey(cipherKey []byte) (string, []byte, error) {
+	return m.keyId, reversed(cipherKey), nil
+}
+func (m *reversedKeyManager) UnwrapKey(keyId string, wrappedKey []byte) ([]byte, error) {
+	return reversed(wrappedKey), nil
+}
+
+func reversed(data []byte) []byte {
+	t := make([]byte, len(data))
+	for i, b := range data {
+		t[len(data)-1-i] = b
+	}
+	return t
+}
+
+func TestWrappedCipherKeys(t *testing.T) {
+	testFiler := filer.NewFiler(nil, nil, "", "", "", "", nil)
+	dir := t.TempDir()
+	store := &LevelDBStore{}
+	store.initialize(dir)
+	testFiler.SetStore(store)
+	testFiler.SetKeyManager(&reversedKeyManager{keyId: "key1"})
+
+	ctx := context.Background()
+	fullpath := util.FullPath("/encrypted.txt")
+	entry1 := &filer.Entry{
+		FullPath: fullpath,
+		Attr:     filer.Attr{Mode: 0644},
+		Chunks: []*filer_pb.FileChunk{
+			{FileId: "1,01637037d6", Size: 100, CipherKey: []byte("0123456789")},
+			{FileId: "2,01637037d7", Offset: 100, Size: 100},
+		},
+	}
+	if err := testFiler.CreateEntry(ctx, entry1, false, false, nil); err != nil {
+		t.Fatalf("create entry %v: %v", entry1.FullPath, err)
+	}
+	if string(entry1.Chunks[0].CipherKey) != "0123456789" || entry1.Chunks[0].CipherKeyId != "" {
+		t.Errorf("the created entry is changed: %v", entry1.Chunks[0])
+	}
+
+	// the store only has the wrapped key
+	stored, err := store.FindEntry(ctx, fullpath)
+	if err != nil {
+		t.Fatalf("find stored entry: %v", err)
+	}
+	if string(stored.Chunks[0].CipherKey) != "9876543210" || stored.Chunks[0].CipherKeyId != "key1" {
+		t.Errorf("unexpected stored chunk: %v", stored.Chunks[0])
+	}
+	if len(stored.Chunks[1].CipherKey) != 0 || stored.Chunks[1].CipherKeyId != "" {
+		t.Errorf("unexpected stored chunk: %v", stored.Chunks[1])
+	}
+
+	entry, err := testFiler.FindEntry(ctx, fullpath)
+	if err != nil {
+		t.Fatalf("find entry: %v", err)
+	}
+	if string(entry.Chunks[0].CipherKey) != "0123456789" || entry.Chunks[0].CipherKeyId != "key1" {
+		t.Errorf("unexpected chunk: %v", entry.Chunks[0])
+	}
+
+	entries, _, _ := testFiler.ListDirectoryEntries(ctx, util.FullPath("/"), "", false, 100, "", "", "")
+	if len(entries) != 1 || string(entries[0].Chunks[0].CipherKey) != "0123456789" {
+		t.Errorf("unexpected listed entries: %v", entries)
+	}
+
+	// without the key manager, the wrapped keys can not be read
+	testFiler.SetKeyManager(nil)
+	if _, err = testFiler.FindEntry(ctx, fullpath); err == nil {
+		t.Errorf("expecting an error to find wrapped cipher keys without the key manager")
+	}
+	if _, _, err = testFiler.ListDirectoryEntries(ctx, util.FullPath("/"), "", false, 100, "", "", ""); err == nil {
+		t.Errorf("expecting an error to list wrapped cipher keys without the key manager")
+	}
+
+	// rewrap the cipher keys with the current key
+	testFiler.SetKeyManager(&reversedKeyManager{keyId: "key2"})
+	err = testFiler.Store.UpdateEntryAtomically(ctx, fullpath, func(entry *filer.Entry) (bool, error) {
+		return entry.Chunks[0].CipherKeyId != "key2", nil
+	})
+	if err != nil {
+		t.Fatalf("rewrap entry: %v", err)
+	}
+	if stored, err = store.FindEntry(ctx, fullpath); err != nil || stored.Chunks[0].CipherKeyId != "key2" {
+		t.Errorf("unexpected rewrapped chunk: %v, %v", stored, err)
+	}
+}
+
 func BenchmarkInsertEntry(b *testing.B) {
 	testFiler := filer.NewFiler(nil, nil, "", "", "", "", nil)
 	dir := b.TempDir()
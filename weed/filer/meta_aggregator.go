@@ -26,6 +26,7 @@ type MetaAggregator struct {
 	grpcDialOption  grpc.DialOption
 	MetaLogBuffer   *log_buffer.LogBuffer
 	peerStatues     map[pb.ServerAddress]int
+	peerSignatures  map[pb.ServerAddress]int32
 	peerStatuesLock sync.Mutex
 	// notifying clients
 	ListenersLock sync.Mutex
@@ -40,6 +41,7 @@ func NewMetaAggregator(filer *Filer, self pb.ServerAddress, grpcDialOption grpc.
 		self:           self,
 		grpcDialOption: grpcDialOption,
 		peerStatues:    make(map[pb.ServerAddress]int),
+		peerSignatures: make(map[pb.ServerAddress]int32),
 	}
 	t.ListenersCond = sync.NewCond(&t.ListenersLock)
 	t.MetaLogBuffer = log_buffer.NewLogBuffer("aggr", LogFlushInterval, nil, func() {
@@ -80,10 +82,56 @@ func (ma *MetaAggregator) setActive(address pb.ServerAddress, isActive bool) (no
 		}
 		if ma.peerStatues[address] <= 0 {
 			delete(ma.peerStatues, address)
+			delete(ma.peerSignatures, address)
 		}
 	}
 	return
 }
+
+func (ma *MetaAggregator) setPeerSignature(address pb.ServerAddress, signature int32) {
+	ma.peerStatuesLock.Lock()
+	defer ma.peerStatuesLock.Unlock()
+	if _, found := ma.peerStatues[address]; found {
+		ma.peerSignatures[address] = signature
+	}
+}
+
+// HasPeersOnSeparateStores tells whether any active peer filer uses another filer store, or is not known yet
+func (ma *MetaAggregator) HasPeersOnSeparateStores() bool {
+	ma.peerStatuesLock.Lock()
+	defer ma.peerStatuesLock.Unlock()
+	for address := range ma.peerStatues {
+		if signature, found := ma.peerSignatures[address]; !found || signature != ma.filer.Signature {
+			return true
+		}
+	}
+	return false
+}
+
+// ActivePeers lists the active filers, including this filer once it is known to the master
+func (ma *MetaAggregator) ActivePeers() (peers []pb.ServerAddress) {
+	ma.peerStatuesLock.Lock()
+	defer ma.peerStatuesLock.Unlock()
+	for address, count := range ma.peerStatues {
+		if count > 0 {
+			peers = append(peers, address)
+		}
+	}
+	return
+}
+
+// Owner is the active filer with the smallest address, serving what one filer does for all the filers,
+// e.g., the file locks and the chunk references
+func (ma *MetaAggregator) Owner() pb.ServerAddress {
+	owner := ma.self
+	for _, peer := range ma.ActivePeers() {
+		if peer < owner {
+			owner = peer
+		}
+	}
+	return owner
+}
+
 func (ma *MetaAggregator) isActive(address pb.ServerAddress) (isActive bool) {
 	ma.peerStatuesLock.Lock()
 	defer ma.peerStatuesLock.Unlock()
@@ -114,6 +162,7 @@ func (ma *MetaAggregator) subscribeToOneFiler(f *Filer, self pb.ServerAddress, p
 		time.Sleep(1357 * time.Millisecond)
 		peerSignature, err = ma.readFilerStoreSignature(peer)
 	}
+	ma.setPeerSignature(peer, peerSignature)
 
 	// when filer store is not shared by multiple filers
 	if peerSignature != f.Signature {
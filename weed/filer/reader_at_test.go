@@ -1,6 +1,7 @@
 package filer
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"math"
@@ -134,6 +135,37 @@ func TestReaderAt0(t *testing.T) {
 
 }
 
+func TestReaderAtHoles(t *testing.T) {
+
+	visibles := []VisibleInterval{
+		{
+			start:     2,
+			stop:      5,
+			fileId:    "1",
+			chunkSize: 9,
+		},
+	}
+
+	readerAt := &ChunkReadAt{
+		chunkViews:    ViewFromVisibleIntervals(visibles, 0, math.MaxInt64),
+		readerLock:    sync.Mutex{},
+		fileSize:      8,
+		readerCache:   newReaderCache(3, &mockChunkCache{}, nil),
+		readerPattern: NewReaderPattern(),
+	}
+
+	// the buffer may have been used before
+	data := []byte{9, 9, 9, 9, 9, 9, 9, 9}
+	n, err := readerAt.ReadAt(data, 0)
+	if n != 8 || err != io.EOF {
+		t.Errorf("unexpected read: %d, %v", n, err)
+	}
+	if !bytes.Equal(data, []byte{0, 0, 1, 1, 1, 0, 0, 0}) {
+		t.Errorf("unexpected data: %v", data)
+	}
+
+}
+
 func TestReaderAt1(t *testing.T) {
 
 	visibles := []VisibleInterval{
@@ -0,0 +1,31 @@
+package filetransfer
+
+import (
+	"os"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+// FileInfo presents the filer entry as os.FileInfo
+type FileInfo struct {
+	entry *filer_pb.Entry
+}
+
+func NewFileInfo(entry *filer_pb.Entry) *FileInfo {
+	return &FileInfo{entry: entry}
+}
+
+func (fi *FileInfo) Name() string { return fi.entry.Name }
+func (fi *FileInfo) Size() int64  { return int64(filer.FileSize(fi.entry)) }
+func (fi *FileInfo) Mode() os.FileMode {
+	mode := os.FileMode(fi.entry.Attributes.FileMode)
+	if fi.entry.IsDirectory {
+		mode |= os.ModeDir
+	}
+	return mode
+}
+func (fi *FileInfo) ModTime() time.Time { return time.Unix(fi.entry.Attributes.Mtime, 0) }
+func (fi *FileInfo) IsDir() bool        { return fi.entry.IsDirectory }
+func (fi *FileInfo) Sys() interface{}   { return nil }
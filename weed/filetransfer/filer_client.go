@@ -0,0 +1,229 @@
+package filetransfer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc"
+)
+
+// FilerClient reads and writes the filer entries for the gateway file systems.
+// The new files are stored with the collection, replication and disk type of the gateway.
+type FilerClient struct {
+	Filer          pb.ServerAddress
+	GrpcDialOption grpc.DialOption
+	Collection     string
+	Replication    string
+	DiskType       string
+	Cipher         bool
+	Uid            uint32
+	Gid            uint32
+	// Signature marks the metadata changes made through the gateway
+	Signature int32
+}
+
+var _ = filer_pb.FilerClient(&FilerClient{})
+
+func (fc *FilerClient) WithFilerClient(streamingMode bool, fn func(filer_pb.SeaweedFilerClient) error) error {
+	return pb.WithGrpcClient(streamingMode, func(grpcConnection *grpc.ClientConn) error {
+		client := filer_pb.NewSeaweedFilerClient(grpcConnection)
+		return fn(client)
+	}, fc.Filer.ToGrpcAddress(), fc.GrpcDialOption)
+}
+
+func (fc *FilerClient) AdjustedUrl(location *filer_pb.Location) string {
+	return location.Url
+}
+
+// Lookup returns the entry of the full path, or os.ErrNotExist
+func (fc *FilerClient) Lookup(fullpath util.FullPath) (*filer_pb.Entry, error) {
+	if fullpath == "/" {
+		return &filer_pb.Entry{
+			Name:        "/",
+			IsDirectory: true,
+			Attributes: &filer_pb.FuseAttributes{
+				Mtime:    time.Now().Unix(),
+				FileMode: uint32(0755 | os.ModeDir),
+			},
+		}, nil
+	}
+	entry, err := filer_pb.GetEntry(fc, fullpath)
+	if err == filer_pb.ErrNotFound || err == nil && entry == nil {
+		return nil, os.ErrNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	if entry.Attributes == nil {
+		entry.Attributes = &filer_pb.FuseAttributes{}
+	}
+	return entry, nil
+}
+
+func (fc *FilerClient) NewEntry(name string, isDirectory bool, perm os.FileMode) *filer_pb.Entry {
+	now := time.Now().Unix()
+	entry := &filer_pb.Entry{
+		Name:        name,
+		IsDirectory: isDirectory,
+		Attributes: &filer_pb.FuseAttributes{
+			Mtime:    now,
+			Crtime:   now,
+			FileMode: uint32(perm),
+			Uid:      fc.Uid,
+			Gid:      fc.Gid,
+		},
+	}
+	if isDirectory {
+		entry.Attributes.FileMode |= uint32(os.ModeDir)
+	} else {
+		entry.Attributes.Collection = fc.Collection
+		entry.Attributes.Replication = fc.Replication
+		entry.Attributes.DiskType = fc.DiskType
+	}
+	return entry
+}
+
+// CreateDirectory creates the directory, or returns os.ErrExist
+func (fc *FilerClient) CreateDirectory(fullpath util.FullPath, perm os.FileMode) error {
+	if _, err := fc.Lookup(fullpath); err == nil {
+		return os.ErrExist
+	}
+	dir, dirName := fullpath.DirAndName()
+	return fc.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		return filer_pb.CreateEntry(client, &filer_pb.CreateEntryRequest{
+			Directory:  dir,
+			Entry:      fc.NewEntry(dirName, true, perm),
+			OExcl:      true,
+			Signatures: []int32{fc.Signature},
+		})
+	})
+}
+
+// EnsureDirectory creates the directory and its parents if missing
+func (fc *FilerClient) EnsureDirectory(fullpath util.FullPath, perm os.FileMode) error {
+	entry, err := fc.Lookup(fullpath)
+	if err == nil {
+		if !entry.IsDirectory {
+			return syscall.ENOTDIR
+		}
+		return nil
+	}
+	if err != os.ErrNotExist {
+		return err
+	}
+	dir, _ := fullpath.DirAndName()
+	if err = fc.EnsureDirectory(util.FullPath(dir), perm); err != nil {
+		return err
+	}
+	if err = fc.CreateDirectory(fullpath, perm); err != nil && err != os.ErrExist {
+		return err
+	}
+	return nil
+}
+
+// SaveEntry creates the new entry, or updates the existing one
+func (fc *FilerClient) SaveEntry(fullpath util.FullPath, entry *filer_pb.Entry, isNew bool) error {
+	dir, _ := fullpath.DirAndName()
+	return fc.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		if isNew {
+			return filer_pb.CreateEntry(client, &filer_pb.CreateEntryRequest{
+				Directory:  dir,
+				Entry:      entry,
+				Signatures: []int32{fc.Signature},
+			})
+		}
+		return filer_pb.UpdateEntry(client, &filer_pb.UpdateEntryRequest{
+			Directory:  dir,
+			Entry:      entry,
+			Signatures: []int32{fc.Signature},
+		})
+	})
+}
+
+func (fc *FilerClient) RenameEntry(oldPath, newPath util.FullPath) error {
+	oldDir, oldBaseName := oldPath.DirAndName()
+	newDir, newBaseName := newPath.DirAndName()
+
+	return fc.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		request := &filer_pb.AtomicRenameEntryRequest{
+			OldDirectory: oldDir,
+			OldName:      oldBaseName,
+			NewDirectory: newDir,
+			NewName:      newBaseName,
+			Signatures:   []int32{fc.Signature},
+		}
+		if _, err := client.AtomicRenameEntry(context.Background(), request); err != nil {
+			return fmt.Errorf("renaming %s => %s: %v", oldPath, newPath, err)
+		}
+		return nil
+	})
+}
+
+func (fc *FilerClient) RemoveEntry(fullpath util.FullPath, isRecursive bool) error {
+	dir, name := fullpath.DirAndName()
+	return filer_pb.Remove(fc, dir, name, true, isRecursive, false, false, []int32{fc.Signature})
+}
+
+func (fc *FilerClient) SaveDataAsChunk(reader io.Reader, name string, offset int64) (chunk *filer_pb.FileChunk, collection, replication string, err error) {
+
+	var fileId, host string
+	var auth security.EncodedJwt
+
+	if err = fc.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		return util.Retry("assignVolume", func() error {
+			request := &filer_pb.AssignVolumeRequest{
+				Count:       1,
+				Replication: fc.Replication,
+				Collection:  fc.Collection,
+				DiskType:    fc.DiskType,
+				Path:        name,
+			}
+
+			resp, err := client.AssignVolume(context.Background(), request)
+			if err != nil {
+				glog.V(0).Infof("assign volume failure %v: %v", request, err)
+				return err
+			}
+			if resp.Error != "" {
+				return fmt.Errorf("assign volume failure %v: %v", request, resp.Error)
+			}
+
+			fileId, host, auth = resp.FileId, resp.Location.Url, security.EncodedJwt(resp.Auth)
+			collection, replication = resp.Collection, resp.Replication
+			return nil
+		})
+	}); err != nil {
+		return nil, "", "", fmt.Errorf("filerGrpcAddress assign volume: %v", err)
+	}
+
+	fileUrl := fmt.Sprintf("http://%s/%s", host, fileId)
+	uploadOption := &operation.UploadOption{
+		UploadUrl:         fileUrl,
+		Filename:          name,
+		Cipher:            fc.Cipher,
+		IsInputCompressed: false,
+		MimeType:          "",
+		PairMap:           nil,
+		Jwt:               auth,
+	}
+	uploadResult, err, _ := operation.Upload(reader, uploadOption)
+	if err != nil {
+		glog.V(0).Infof("upload data %v to %s: %v", name, fileUrl, err)
+		return nil, "", "", fmt.Errorf("upload data: %v", err)
+	}
+	if uploadResult.Error != "" {
+		glog.V(0).Infof("upload failure %v to %s: %v", name, fileUrl, uploadResult.Error)
+		return nil, "", "", fmt.Errorf("upload result: %v", uploadResult.Error)
+	}
+	return uploadResult.ToPbFileChunk(fileId, offset), collection, replication, nil
+}
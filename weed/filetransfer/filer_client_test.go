@@ -0,0 +1,87 @@
+package filetransfer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filetransfer/filertest"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc"
+)
+
+// newTestFilerClient connects to the in memory filer
+func newTestFilerClient(t *testing.T) (*FilerClient, *filertest.Filer) {
+	f := filertest.NewFiler(t)
+	fc := &FilerClient{
+		Filer:          f.Address(),
+		GrpcDialOption: grpc.WithInsecure(),
+		Collection:     "c1",
+		Replication:    "001",
+		Uid:            1000,
+		Gid:            1000,
+		Signature:      123,
+	}
+	return fc, f
+}
+
+func TestSaveDataAsChunk(t *testing.T) {
+	fc, f := newTestFilerClient(t)
+
+	data := []byte{0, 1, 2, 3, 4, 5, 6, 7}
+	chunk, collection, replication, err := fc.SaveDataAsChunk(bytes.NewReader(data), "/home/a.bin", 100)
+	if err != nil {
+		t.Fatalf("save data: %v", err)
+	}
+	if chunk.FileId != "1,101637037" || chunk.Offset != 100 || chunk.Size != uint64(len(data)) {
+		t.Errorf("unexpected chunk %v", chunk)
+	}
+	if collection != "c1" || replication != "001" {
+		t.Errorf("unexpected collection %s replication %s", collection, replication)
+	}
+	if upload := f.Upload(chunk.FileId); !bytes.Equal(upload, data) {
+		t.Errorf("unexpected upload %v", upload)
+	}
+
+	entry := fc.NewEntry("a.bin", false, 0644)
+	entry.Chunks = append(entry.Chunks, chunk)
+	if err = fc.SaveEntry("/home/a.bin", entry, true); err != nil {
+		t.Fatalf("save entry: %v", err)
+	}
+	saved, err := fc.Lookup("/home/a.bin")
+	if err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	if len(saved.Chunks) != 1 || saved.Attributes.Collection != "c1" || saved.Attributes.Uid != 1000 {
+		t.Errorf("unexpected entry %v", saved)
+	}
+	if signatures := f.Signatures(); len(signatures) != 1 || signatures[0] != fc.Signature {
+		t.Errorf("the changes should be signed, got %v", signatures)
+	}
+}
+
+func TestEnsureDirectory(t *testing.T) {
+	fc, f := newTestFilerClient(t)
+
+	if err := fc.EnsureDirectory("/home/alice", 0755); err != nil {
+		t.Fatalf("ensure directory: %v", err)
+	}
+	for _, p := range []util.FullPath{"/home", "/home/alice"} {
+		if entry := f.Get(p); entry == nil || !entry.IsDirectory {
+			t.Errorf("expected directory %s, got %v", p, entry)
+		}
+	}
+	// existing directories are kept
+	if err := fc.EnsureDirectory("/home/alice", 0755); err != nil {
+		t.Errorf("ensure existing directory: %v", err)
+	}
+	if err := fc.CreateDirectory("/home/alice", 0755); err == nil {
+		t.Errorf("expected the directory to exist")
+	}
+
+	if err := fc.SaveEntry("/home/file", fc.NewEntry("file", false, 0644), true); err != nil {
+		t.Fatalf("save entry: %v", err)
+	}
+	if err := fc.EnsureDirectory("/home/file/sub", 0755); err == nil {
+		t.Errorf("expected an error creating a directory under a file")
+	}
+}
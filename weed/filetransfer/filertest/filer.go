@@ -0,0 +1,158 @@
+// Package filertest runs an in memory filer, with a volume server keeping the uploads,
+// for the tests of the ftp and sftp servers and of their filer client.
+package filertest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// Filer keeps the entries in memory, and assigns the uploads to its volume server
+type Filer struct {
+	filer_pb.UnimplementedSeaweedFilerServer
+	sync.Mutex
+	address     pb.ServerAddress
+	volumeUrl   string
+	entries     map[util.FullPath]*filer_pb.Entry
+	signatures  []int32
+	fileId      int
+	uploads     map[string][]byte
+	failUploads bool
+}
+
+// NewFiler starts the filer and its volume server, both stopped when the test ends
+func NewFiler(t testing.TB) *Filer {
+	f := &Filer{
+		entries: make(map[util.FullPath]*filer_pb.Entry),
+		uploads: make(map[string][]byte),
+	}
+
+	volumeServer := httptest.NewServer(http.HandlerFunc(f.serveUpload))
+	t.Cleanup(volumeServer.Close)
+	f.volumeUrl = strings.TrimPrefix(volumeServer.URL, "http://")
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	grpcServer := grpc.NewServer()
+	filer_pb.RegisterSeaweedFilerServer(grpcServer, f)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+	f.address = pb.ServerAddress(fmt.Sprintf("127.0.0.1:0.%d", listener.Addr().(*net.TCPAddr).Port))
+
+	return f
+}
+
+// Address is the filer address, to connect with grpc.WithInsecure()
+func (f *Filer) Address() pb.ServerAddress {
+	return f.address
+}
+
+// Get returns the entry, or nil if not found
+func (f *Filer) Get(fullpath util.FullPath) *filer_pb.Entry {
+	f.Lock()
+	defer f.Unlock()
+	return f.entries[fullpath]
+}
+
+// Put saves the entry as is, e.g., to prepare the test
+func (f *Filer) Put(fullpath util.FullPath, entry *filer_pb.Entry) {
+	f.Lock()
+	defer f.Unlock()
+	f.entries[fullpath] = entry
+}
+
+// Signatures are the signatures of the entry changes, in order
+func (f *Filer) Signatures() []int32 {
+	f.Lock()
+	defer f.Unlock()
+	return append([]int32(nil), f.signatures...)
+}
+
+// Upload returns the data uploaded to the file id
+func (f *Filer) Upload(fileId string) []byte {
+	f.Lock()
+	defer f.Unlock()
+	return f.uploads[fileId]
+}
+
+// FailUploads makes the volume server reject the uploads, as a read only volume
+func (f *Filer) FailUploads(fail bool) {
+	f.Lock()
+	defer f.Unlock()
+	f.failUploads = fail
+}
+
+func (f *Filer) serveUpload(w http.ResponseWriter, r *http.Request) {
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	data, _ := io.ReadAll(file)
+	f.Lock()
+	defer f.Unlock()
+	if f.failUploads {
+		json.NewEncoder(w).Encode(map[string]interface{}{"error": "volume is read only"})
+		return
+	}
+	f.uploads[strings.TrimPrefix(r.URL.Path, "/")] = data
+	json.NewEncoder(w).Encode(map[string]interface{}{"size": len(data)})
+}
+
+func (f *Filer) LookupDirectoryEntry(ctx context.Context, req *filer_pb.LookupDirectoryEntryRequest) (*filer_pb.LookupDirectoryEntryResponse, error) {
+	f.Lock()
+	defer f.Unlock()
+	entry, found := f.entries[util.NewFullPath(req.Directory, req.Name)]
+	if !found {
+		return nil, filer_pb.ErrNotFound
+	}
+	return &filer_pb.LookupDirectoryEntryResponse{Entry: proto.Clone(entry).(*filer_pb.Entry)}, nil
+}
+
+func (f *Filer) CreateEntry(ctx context.Context, req *filer_pb.CreateEntryRequest) (*filer_pb.CreateEntryResponse, error) {
+	f.Lock()
+	defer f.Unlock()
+	p := util.NewFullPath(req.Directory, req.Entry.Name)
+	if _, found := f.entries[p]; found && req.OExcl {
+		return &filer_pb.CreateEntryResponse{Error: fmt.Sprintf("%s already exists", p)}, nil
+	}
+	f.entries[p] = proto.Clone(req.Entry).(*filer_pb.Entry)
+	f.signatures = append(f.signatures, req.Signatures...)
+	return &filer_pb.CreateEntryResponse{}, nil
+}
+
+func (f *Filer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntryRequest) (*filer_pb.UpdateEntryResponse, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.entries[util.NewFullPath(req.Directory, req.Entry.Name)] = proto.Clone(req.Entry).(*filer_pb.Entry)
+	f.signatures = append(f.signatures, req.Signatures...)
+	return &filer_pb.UpdateEntryResponse{}, nil
+}
+
+func (f *Filer) AssignVolume(ctx context.Context, req *filer_pb.AssignVolumeRequest) (*filer_pb.AssignVolumeResponse, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.fileId++
+	return &filer_pb.AssignVolumeResponse{
+		FileId:      fmt.Sprintf("1,%x01637037", f.fileId),
+		Location:    &filer_pb.Location{Url: f.volumeUrl},
+		Collection:  req.Collection,
+		Replication: req.Replication,
+	}, nil
+}
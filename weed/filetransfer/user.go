@@ -0,0 +1,73 @@
+// Package filetransfer has the parts shared by the FTP and SFTP gateways:
+// the users loaded from the S3 identities, and the filer access of the file systems.
+package filetransfer
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"google.golang.org/grpc"
+)
+
+// User is the S3 identity logged in over FTP or SFTP.
+// Only the actions not limited to buckets are applied.
+type User struct {
+	Name     string
+	IsAdmin  bool
+	CanRead  bool
+	CanWrite bool
+}
+
+func NewUser(identity *iam_pb.Identity) *User {
+	user := &User{
+		Name: identity.Name,
+	}
+	for _, action := range identity.Actions {
+		switch action {
+		case s3_constants.ACTION_ADMIN:
+			user.IsAdmin, user.CanRead, user.CanWrite = true, true, true
+		case s3_constants.ACTION_READ, s3_constants.ACTION_LIST:
+			user.CanRead = true
+		case s3_constants.ACTION_WRITE:
+			user.CanWrite = true
+		}
+	}
+	return user
+}
+
+// HomeDir is the root for admin users, and a sub directory of the root named after the user for the others
+func (user *User) HomeDir(root string) string {
+	if user.IsAdmin {
+		return root
+	}
+	// cleaning the name as an absolute path keeps the home inside the root
+	return path.Join(root, path.Clean("/"+user.Name))
+}
+
+// LoadIdentities reads the S3 identities from the config file, or else from the filer
+func LoadIdentities(identityConfig string, filerAddress pb.ServerAddress, grpcDialOption grpc.DialOption) (*iam_pb.S3ApiConfiguration, error) {
+	var content []byte
+	var err error
+	if identityConfig != "" {
+		content, err = os.ReadFile(identityConfig)
+	} else {
+		err = pb.WithFilerClient(false, filerAddress, grpcDialOption, func(client filer_pb.SeaweedFilerClient) error {
+			content, err = filer.ReadInsideFiler(client, filer.IamConfigDirecotry, filer.IamIdentityFile)
+			return err
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read identities: %v", err)
+	}
+	config := &iam_pb.S3ApiConfiguration{}
+	if err = filer.ParseS3ConfigurationFromBytes(content, config); err != nil {
+		return nil, fmt.Errorf("parse identities: %v", err)
+	}
+	return config, nil
+}
@@ -0,0 +1,75 @@
+package filetransfer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+)
+
+func TestNewUser(t *testing.T) {
+	tests := []struct {
+		actions                    []string
+		isAdmin, canRead, canWrite bool
+	}{
+		{[]string{s3_constants.ACTION_ADMIN}, true, true, true},
+		{[]string{s3_constants.ACTION_READ}, false, true, false},
+		{[]string{s3_constants.ACTION_LIST}, false, true, false},
+		{[]string{s3_constants.ACTION_WRITE}, false, false, true},
+		// the actions limited to buckets are not applied
+		{[]string{s3_constants.ACTION_READ + ":bucket1", s3_constants.ACTION_WRITE + ":bucket1"}, false, false, false},
+		{[]string{s3_constants.ACTION_TAGGING}, false, false, false},
+	}
+	for _, tt := range tests {
+		user := NewUser(&iam_pb.Identity{Name: "u", Actions: tt.actions})
+		if user.IsAdmin != tt.isAdmin || user.CanRead != tt.canRead || user.CanWrite != tt.canWrite {
+			t.Errorf("%v: unexpected user %+v", tt.actions, user)
+		}
+	}
+}
+
+func TestHomeDir(t *testing.T) {
+	tests := []struct {
+		user     User
+		root     string
+		expected string
+	}{
+		{User{Name: "admin", IsAdmin: true}, "/ftp", "/ftp"},
+		{User{Name: "alice"}, "/ftp", "/ftp/alice"},
+		{User{Name: "alice"}, "/", "/alice"},
+		// the home directory can not leave the root
+		{User{Name: "../../etc"}, "/ftp", "/ftp/etc"},
+		{User{Name: ".."}, "/ftp", "/ftp"},
+		{User{Name: "a/../../b"}, "/ftp", "/ftp/b"},
+	}
+	for _, tt := range tests {
+		if actual := tt.user.HomeDir(tt.root); actual != tt.expected {
+			t.Errorf("user %s root %s: expected %s, got %s", tt.user.Name, tt.root, tt.expected, actual)
+		}
+	}
+}
+
+func TestLoadIdentities(t *testing.T) {
+	identityConfig := filepath.Join(t.TempDir(), "identity.json")
+	if err := os.WriteFile(identityConfig, []byte(`{
+		"identities": [
+			{"name": "alice", "credentials": [{"accessKey": "ak", "secretKey": "sk"}], "actions": ["Read"]}
+		]
+	}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := LoadIdentities(identityConfig, "", nil)
+	if err != nil {
+		t.Fatalf("load identities: %v", err)
+	}
+	if len(config.Identities) != 1 || config.Identities[0].Name != "alice" || config.Identities[0].Credentials[0].AccessKey != "ak" {
+		t.Errorf("unexpected identities %v", config.Identities)
+	}
+
+	if _, err = LoadIdentities(filepath.Join(t.TempDir(), "missing.json"), "", nil); err == nil {
+		t.Errorf("expected an error for a missing identity config")
+	}
+}
@@ -0,0 +1,33 @@
+package ftpd
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/filetransfer"
+)
+
+// authenticate accepts either the identity name or the access key as the user name, and the secret key as the password
+func (s *FtpServer) authenticate(username, password string) (*filetransfer.User, error) {
+	config, err := filetransfer.LoadIdentities(s.option.IdentityConfig, s.option.Filer, s.option.GrpcDialOption)
+	if err != nil {
+		return nil, err
+	}
+	for _, identity := range config.Identities {
+		for _, cred := range identity.Credentials {
+			if username != identity.Name && username != cred.AccessKey {
+				continue
+			}
+			if subtle.ConstantTimeCompare([]byte(password), []byte(cred.SecretKey)) != 1 {
+				continue
+			}
+			user := filetransfer.NewUser(identity)
+			if !user.CanRead && !user.CanWrite {
+				return nil, fmt.Errorf("identity %s has no ftp permission", identity.Name)
+			}
+			return user, nil
+		}
+	}
+	return nil, errors.New("no matching identity")
+}
@@ -0,0 +1,51 @@
+package ftpd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAuthenticate(t *testing.T) {
+	identityConfig := filepath.Join(t.TempDir(), "identity.json")
+	if err := os.WriteFile(identityConfig, []byte(`{
+		"identities": [
+			{"name": "admin", "credentials": [{"accessKey": "admin_ak", "secretKey": "admin_sk"}], "actions": ["Admin"]},
+			{"name": "alice", "credentials": [{"accessKey": "alice_ak", "secretKey": "alice_sk"}], "actions": ["Read"]},
+			{"name": "carol", "credentials": [{"accessKey": "carol_ak", "secretKey": "carol_sk"}], "actions": ["Write:bucket1"]}
+		]
+	}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	s := &FtpServer{option: &FtpServerOption{FtpRoot: "/ftp", IdentityConfig: identityConfig}}
+
+	tests := []struct {
+		username, password string
+		home               string
+		canWrite           bool
+	}{
+		{"admin", "admin_sk", "/ftp", true},
+		{"alice", "alice_sk", "/ftp/alice", false},
+		{"alice_ak", "alice_sk", "/ftp/alice", false},
+		{"alice", "admin_sk", "", false},
+		{"alice", "", "", false},
+		// the identities with only bucket actions can not log in
+		{"carol", "carol_sk", "", false},
+	}
+	for _, tt := range tests {
+		user, err := s.authenticate(tt.username, tt.password)
+		if tt.home == "" {
+			if err == nil {
+				t.Errorf("%s with %q: expected to fail", tt.username, tt.password)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s with %q: %v", tt.username, tt.password, err)
+			continue
+		}
+		if home := user.HomeDir(s.option.FtpRoot); home != tt.home || user.CanWrite != tt.canWrite {
+			t.Errorf("%s: unexpected home %s, user %+v", tt.username, home, user)
+		}
+	}
+}
@@ -0,0 +1,347 @@
+package ftpd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/filetransfer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/chrislusf/seaweedfs/weed/util/buffered_writer"
+	"github.com/chrislusf/seaweedfs/weed/util/chunk_cache"
+	"github.com/spf13/afero"
+)
+
+var errRandomWrite = errors.New("random write is not supported")
+
+// FtpFile is one opened file or directory. Files are written sequentially,
+// with the data uploaded as chunks and the entry saved to the filer on Close.
+// A failed transfer is not saved, so that it does not replace the existing file.
+type FtpFile struct {
+	fs     *FtpFileSystem
+	name   string
+	entry  *filer_pb.Entry
+	off    int64
+	reader *filer.ChunkReadAt
+
+	dirEntries []os.FileInfo
+	dirLoaded  bool
+	dirOff     int
+
+	isWrite     bool
+	isNew       bool
+	truncate    bool
+	writer      *buffered_writer.BufferedWriteCloser
+	chunks      []*filer_pb.FileChunk
+	collection  string
+	replication string
+	err         error
+}
+
+var _ = afero.File(&FtpFile{})
+var _ = io.ReaderFrom(&FtpFile{})
+
+func newFtpFile(fs *FtpFileSystem, name string, entry *filer_pb.Entry) *FtpFile {
+	return &FtpFile{
+		fs:    fs,
+		name:  name,
+		entry: entry,
+	}
+}
+
+// newFtpFileForWrite prepares to replace the file, or to append to it
+func newFtpFileForWrite(fs *FtpFileSystem, name string, entry *filer_pb.Entry, perm os.FileMode, isAppend bool) (*FtpFile, error) {
+	f := &FtpFile{
+		fs:      fs,
+		name:    name,
+		entry:   entry,
+		isWrite: true,
+	}
+	if entry == nil {
+		_, fileName := util.FullPath(name).DirAndName()
+		f.entry = fs.NewEntry(fileName, false, perm)
+		f.isNew = true
+		return f, nil
+	}
+	if !isAppend {
+		f.truncate = true
+		return f, nil
+	}
+	if err := f.moveContentToChunk(); err != nil {
+		return nil, err
+	}
+	f.off = int64(filer.FileSize(entry))
+	return f, nil
+}
+
+// moveContentToChunk uploads the inlined content, so that the new chunks can be added after it
+func (f *FtpFile) moveContentToChunk() error {
+	if len(f.entry.Content) == 0 {
+		return nil
+	}
+	chunk, _, _, err := f.fs.SaveDataAsChunk(bytes.NewReader(f.entry.Content), f.name, 0)
+	if err != nil {
+		return err
+	}
+	f.entry.Chunks = append(f.entry.Chunks, chunk)
+	f.entry.Content = nil
+	return nil
+}
+
+func (f *FtpFile) Name() string {
+	return f.name
+}
+
+func (f *FtpFile) Stat() (os.FileInfo, error) {
+	return filetransfer.NewFileInfo(f.entry), nil
+}
+
+func (f *FtpFile) Sync() error {
+	return nil
+}
+
+func (f *FtpFile) Close() error {
+	glog.V(2).Infof("FtpFile.Close %v", f.name)
+
+	if f.reader != nil {
+		f.reader.Close()
+		f.reader = nil
+	}
+	if !f.isWrite {
+		return nil
+	}
+	if f.err != nil {
+		glog.V(0).Infof("file %s is not saved: %v", f.name, f.err)
+		return f.err
+	}
+	if f.writer != nil {
+		if err := f.writer.Close(); err != nil {
+			return err
+		}
+	}
+	return f.save()
+}
+
+// save writes the entry with the uploaded chunks to the filer
+func (f *FtpFile) save() error {
+	entry := f.entry
+	if f.truncate {
+		entry.Chunks, entry.Content = nil, nil
+	}
+	entry.Chunks = append(entry.Chunks, f.chunks...)
+
+	manifestedChunks, manifestErr := filer.MaybeManifestize(f.fs.SaveDataAsChunk, entry.Chunks)
+	if manifestErr != nil {
+		// not good, but should be ok
+		glog.V(0).Infof("file %s close MaybeManifestize: %v", f.name, manifestErr)
+	} else {
+		entry.Chunks = manifestedChunks
+	}
+
+	entry.Attributes.Mtime = time.Now().Unix()
+	entry.Attributes.FileSize = filer.TotalSize(entry.Chunks)
+	entry.Attributes.Md5 = nil
+	if f.collection != "" {
+		entry.Attributes.Collection = f.collection
+		entry.Attributes.Replication = f.replication
+	}
+
+	return f.fs.SaveEntry(util.FullPath(f.name), entry, f.isNew)
+}
+
+func (f *FtpFile) Read(p []byte) (n int, err error) {
+	n, err = f.ReadAt(p, f.off)
+	f.off += int64(n)
+	return
+}
+
+func (f *FtpFile) ReadAt(p []byte, off int64) (n int, err error) {
+	if f.entry.IsDirectory {
+		return 0, syscall.EISDIR
+	}
+	if f.isWrite {
+		return 0, os.ErrPermission
+	}
+
+	fileSize := int64(filer.FileSize(f.entry))
+	if off >= fileSize {
+		return 0, io.EOF
+	}
+	if len(f.entry.Content) > 0 {
+		n = copy(p, f.entry.Content[off:])
+		if off+int64(n) >= int64(len(f.entry.Content)) {
+			err = io.EOF
+		}
+		return
+	}
+
+	if f.reader == nil {
+		lookupFn := filer.LookupFn(f.fs)
+		visibles, err := filer.NonOverlappingVisibleIntervals(lookupFn, f.entry.Chunks, 0, fileSize)
+		if err != nil {
+			return 0, err
+		}
+		// the transfers are sequential, so the chunks are not cached
+		var chunkCache *chunk_cache.TieredChunkCache
+		chunkViews := filer.ViewFromVisibleIntervals(visibles, 0, fileSize)
+		f.reader = filer.NewChunkReaderAtFromClient(lookupFn, chunkViews, chunkCache, fileSize)
+	}
+
+	n, err = f.reader.ReadAt(p, off)
+	if err != nil && err != io.EOF {
+		glog.Errorf("file read %s: %v", f.name, err)
+	}
+	return
+}
+
+func (f *FtpFile) Seek(offset int64, whence int) (int64, error) {
+	glog.V(2).Infof("FtpFile.Seek %v %v %v", f.name, offset, whence)
+
+	if f.writer != nil {
+		return 0, errRandomWrite
+	}
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.off
+	case io.SeekEnd:
+		offset += int64(filer.FileSize(f.entry))
+	}
+	if offset < 0 {
+		return 0, os.ErrInvalid
+	}
+	if f.isWrite && f.truncate && offset > 0 {
+		// resume the upload, keeping the data before the offset
+		if err := f.moveContentToChunk(); err != nil {
+			return 0, err
+		}
+		f.truncate = false
+	}
+	f.off = offset
+	return f.off, nil
+}
+
+func (f *FtpFile) Write(p []byte) (n int, err error) {
+	if !f.isWrite {
+		return 0, os.ErrPermission
+	}
+	if f.err != nil {
+		return 0, f.err
+	}
+
+	if f.writer == nil {
+		startOffset := f.off
+		f.writer = buffered_writer.NewBufferedWriteCloser(4 * 1024 * 1024)
+		f.writer.FlushFunc = func(data []byte, offset int64) error {
+			chunk, collection, replication, err := f.fs.SaveDataAsChunk(bytes.NewReader(data), f.name, startOffset+offset)
+			if err != nil {
+				return fmt.Errorf("%s upload result: %v", f.name, err)
+			}
+			f.chunks = append(f.chunks, chunk)
+			f.collection, f.replication = collection, replication
+			return nil
+		}
+	}
+
+	n, err = f.writer.Write(p)
+	f.off += int64(n)
+	if err != nil {
+		f.err = err
+	}
+	return
+}
+
+// ReadFrom receives the data of the upload. The transfer fails if the data connection
+// breaks before the end of the data, and the partial file is not saved on Close.
+func (f *FtpFile) ReadFrom(r io.Reader) (n int64, err error) {
+	buf := make([]byte, 64*1024)
+	for {
+		nr, readErr := r.Read(buf)
+		if nr > 0 {
+			nw, writeErr := f.Write(buf[:nr])
+			n += int64(nw)
+			if writeErr != nil {
+				return n, writeErr
+			}
+		}
+		if readErr == io.EOF {
+			return n, nil
+		}
+		if readErr != nil {
+			f.err = fmt.Errorf("%s transfer: %v", f.name, readErr)
+			return n, readErr
+		}
+	}
+}
+
+func (f *FtpFile) WriteAt(p []byte, off int64) (n int, err error) {
+	if off != f.off {
+		return 0, errRandomWrite
+	}
+	return f.Write(p)
+}
+
+func (f *FtpFile) WriteString(s string) (n int, err error) {
+	return f.Write([]byte(s))
+}
+
+func (f *FtpFile) Truncate(size int64) error {
+	if !f.isWrite {
+		return os.ErrPermission
+	}
+	if size != 0 || f.writer != nil {
+		return errRandomWrite
+	}
+	f.truncate = true
+	return nil
+}
+
+func (f *FtpFile) Readdir(count int) ([]os.FileInfo, error) {
+	glog.V(2).Infof("FtpFile.Readdir %v count %d", f.name, count)
+
+	if !f.entry.IsDirectory {
+		return nil, syscall.ENOTDIR
+	}
+	if !f.dirLoaded {
+		err := filer_pb.ReadDirAllEntries(f.fs, util.FullPath(f.name), "", func(entry *filer_pb.Entry, isLast bool) error {
+			if entry.Attributes == nil {
+				entry.Attributes = &filer_pb.FuseAttributes{}
+			}
+			f.dirEntries = append(f.dirEntries, filetransfer.NewFileInfo(entry))
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		f.dirLoaded = true
+	}
+
+	remaining := f.dirEntries[f.dirOff:]
+	if count <= 0 {
+		f.dirOff = len(f.dirEntries)
+		return remaining, nil
+	}
+	if len(remaining) == 0 {
+		return nil, io.EOF
+	}
+	if count > len(remaining) {
+		count = len(remaining)
+	}
+	f.dirOff += count
+	return remaining[:count], nil
+}
+
+func (f *FtpFile) Readdirnames(n int) (names []string, err error) {
+	infos, err := f.Readdir(n)
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+	return names, err
+}
@@ -0,0 +1,175 @@
+package ftpd
+
+import (
+	"os"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filetransfer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/spf13/afero"
+)
+
+// FtpFileSystem maps the file operations of the ftp clients onto the filer
+type FtpFileSystem struct {
+	*filetransfer.FilerClient
+}
+
+var _ = afero.Fs(&FtpFileSystem{})
+var _ = filer_pb.FilerClient(&FtpFileSystem{})
+
+func NewFtpFileSystem(option *FtpServerOption) *FtpFileSystem {
+	return &FtpFileSystem{
+		FilerClient: &filetransfer.FilerClient{
+			Filer:          option.Filer,
+			GrpcDialOption: option.GrpcDialOption,
+			Collection:     option.Collection,
+			Replication:    option.Replication,
+			DiskType:       option.DiskType,
+			Cipher:         option.Cipher,
+			Uid:            option.Uid,
+			Gid:            option.Gid,
+			Signature:      util.RandomInt32(),
+		},
+	}
+}
+
+func (fs *FtpFileSystem) Name() string {
+	return "SeaweedFS"
+}
+
+func cleanPath(name string) string {
+	return path.Clean("/" + strings.TrimPrefix(name, "/"))
+}
+
+// lookup returns the entry of the path, or os.ErrNotExist
+func (fs *FtpFileSystem) lookup(name string) (*filer_pb.Entry, error) {
+	return fs.Lookup(util.FullPath(cleanPath(name)))
+}
+
+func (fs *FtpFileSystem) Create(name string) (afero.File, error) {
+	return fs.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+}
+
+func (fs *FtpFileSystem) Mkdir(name string, perm os.FileMode) error {
+	glog.V(2).Infof("FtpFileSystem.Mkdir %v", name)
+
+	return fs.CreateDirectory(util.FullPath(cleanPath(name)), perm)
+}
+
+func (fs *FtpFileSystem) MkdirAll(name string, perm os.FileMode) error {
+	return fs.EnsureDirectory(util.FullPath(cleanPath(name)), perm)
+}
+
+func (fs *FtpFileSystem) Open(name string) (afero.File, error) {
+	return fs.OpenFile(name, os.O_RDONLY, 0)
+}
+
+// OpenFile opens the file to read, or to write from the beginning or at the end.
+// Writing from the beginning replaces the file, unless the upload is resumed by seeking to an offset.
+func (fs *FtpFileSystem) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	glog.V(2).Infof("FtpFileSystem.OpenFile %v %x", name, flag)
+
+	name = cleanPath(name)
+	entry, err := fs.lookup(name)
+	if err != nil && err != os.ErrNotExist {
+		return nil, err
+	}
+
+	if flag&(os.O_WRONLY|os.O_RDWR) == 0 {
+		if entry == nil {
+			return nil, os.ErrNotExist
+		}
+		return newFtpFile(fs, name, entry), nil
+	}
+
+	if entry != nil {
+		if entry.IsDirectory {
+			return nil, syscall.EISDIR
+		}
+		if flag&os.O_EXCL != 0 {
+			return nil, os.ErrExist
+		}
+	} else if flag&(os.O_CREATE|os.O_APPEND) == 0 {
+		return nil, os.ErrNotExist
+	}
+	return newFtpFileForWrite(fs, name, entry, perm, flag&os.O_APPEND != 0)
+}
+
+func (fs *FtpFileSystem) Remove(name string) error {
+	glog.V(2).Infof("FtpFileSystem.Remove %v", name)
+
+	return fs.remove(name, false)
+}
+
+func (fs *FtpFileSystem) RemoveAll(name string) error {
+	glog.V(2).Infof("FtpFileSystem.RemoveAll %v", name)
+
+	return fs.remove(name, true)
+}
+
+func (fs *FtpFileSystem) remove(name string, isRecursive bool) error {
+	name = cleanPath(name)
+	if name == "/" {
+		return os.ErrPermission
+	}
+	if _, err := fs.lookup(name); err != nil {
+		return err
+	}
+	return fs.RemoveEntry(util.FullPath(name), isRecursive)
+}
+
+func (fs *FtpFileSystem) Rename(oldName, newName string) error {
+	glog.V(2).Infof("FtpFileSystem.Rename %v to %v", oldName, newName)
+
+	oldName, newName = cleanPath(oldName), cleanPath(newName)
+	if _, err := fs.lookup(oldName); err != nil {
+		return err
+	}
+	return fs.RenameEntry(util.FullPath(oldName), util.FullPath(newName))
+}
+
+func (fs *FtpFileSystem) Stat(name string) (os.FileInfo, error) {
+	glog.V(2).Infof("FtpFileSystem.Stat %v", name)
+
+	entry, err := fs.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	return filetransfer.NewFileInfo(entry), nil
+}
+
+func (fs *FtpFileSystem) Chmod(name string, mode os.FileMode) error {
+	return fs.updateAttributes(name, func(attr *filer_pb.FuseAttributes) {
+		attr.FileMode = uint32(os.FileMode(attr.FileMode)&^os.ModePerm | mode&os.ModePerm)
+	})
+}
+
+func (fs *FtpFileSystem) Chown(name string, uid, gid int) error {
+	return fs.updateAttributes(name, func(attr *filer_pb.FuseAttributes) {
+		attr.Uid, attr.Gid = uint32(uid), uint32(gid)
+	})
+}
+
+func (fs *FtpFileSystem) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	return fs.updateAttributes(name, func(attr *filer_pb.FuseAttributes) {
+		attr.Mtime = mtime.Unix()
+	})
+}
+
+func (fs *FtpFileSystem) updateAttributes(name string, fn func(attr *filer_pb.FuseAttributes)) error {
+	name = cleanPath(name)
+	if name == "/" {
+		return os.ErrPermission
+	}
+	entry, err := fs.lookup(name)
+	if err != nil {
+		return err
+	}
+	fn(entry.Attributes)
+	return fs.SaveEntry(util.FullPath(name), entry, false)
+}
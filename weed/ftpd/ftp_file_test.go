@@ -0,0 +1,112 @@
+package ftpd
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filetransfer/filertest"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"google.golang.org/grpc"
+)
+
+// newTestFtpFileSystem serves the files from the in memory filer
+func newTestFtpFileSystem(t *testing.T) (*FtpFileSystem, *filertest.Filer) {
+	f := filertest.NewFiler(t)
+	f.Put("/a.txt", &filer_pb.Entry{
+		Name:       "a.txt",
+		Content:    []byte("old"),
+		Attributes: &filer_pb.FuseAttributes{FileMode: 0644, FileSize: 3},
+	})
+	fs := NewFtpFileSystem(&FtpServerOption{
+		Filer:          f.Address(),
+		GrpcDialOption: grpc.WithInsecure(),
+	})
+	return fs, f
+}
+
+// brokenReader returns the data, and then fails as a broken data connection
+type brokenReader struct {
+	data []byte
+}
+
+func (r *brokenReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, errors.New("connection reset by peer")
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestStore(t *testing.T) {
+	fs, f := newTestFtpFileSystem(t)
+
+	file, err := fs.OpenFile("/a.txt", os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		t.Fatalf("open to write: %v", err)
+	}
+	if _, err = io.Copy(file, bytes.NewReader([]byte("new content"))); err != nil {
+		t.Fatalf("copy: %v", err)
+	}
+	if err = file.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	entry := f.Get("/a.txt")
+	if len(entry.Content) != 0 || len(entry.Chunks) != 1 || entry.Attributes.FileSize != uint64(len("new content")) {
+		t.Errorf("the file should be replaced, got %v", entry)
+	}
+}
+
+func TestStoreBrokenTransfer(t *testing.T) {
+	fs, f := newTestFtpFileSystem(t)
+
+	file, err := fs.OpenFile("/a.txt", os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		t.Fatalf("open to write: %v", err)
+	}
+	if _, err = io.Copy(file, &brokenReader{data: []byte("partial")}); err == nil {
+		t.Fatalf("expected the transfer to fail")
+	}
+	if err = file.Close(); err == nil {
+		t.Errorf("expected the close to report the failed transfer")
+	}
+
+	if entry := f.Get("/a.txt"); string(entry.Content) != "old" || len(entry.Chunks) != 0 {
+		t.Errorf("the partial upload should not replace the file, got %v", entry)
+	}
+}
+
+func TestStoreFailedUpload(t *testing.T) {
+	fs, f := newTestFtpFileSystem(t)
+
+	file, err := fs.OpenFile("/a.txt", os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		t.Fatalf("open to write: %v", err)
+	}
+	// the buffer is uploaded when full, and the upload fails
+	f.FailUploads(true)
+	if _, err = file.Write([]byte("partial")); err != nil {
+		t.Fatalf("first write: %v", err)
+	}
+	data := make([]byte, 4*1024*1024)
+	if _, err = file.Write(data); err == nil {
+		t.Fatalf("expected the upload to fail")
+	}
+
+	// the later writes and the close do not save the file, even if the volume recovers
+	f.FailUploads(false)
+	if _, err = file.Write(data[:10]); err == nil {
+		t.Errorf("expected the writes after a failed upload to fail")
+	}
+	if err = file.Close(); err == nil {
+		t.Errorf("expected the close to report the failed upload")
+	}
+
+	if entry := f.Get("/a.txt"); string(entry.Content) != "old" || len(entry.Chunks) != 0 {
+		t.Errorf("the failed upload should not replace the file, got %v", entry)
+	}
+}
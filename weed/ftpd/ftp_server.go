@@ -3,44 +3,68 @@ package ftpd
 import (
 	"crypto/tls"
 	"errors"
-	"github.com/chrislusf/seaweedfs/weed/util"
+	"fmt"
 	"net"
 
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
 	ftpserver "github.com/fclairamb/ftpserverlib"
+	"github.com/spf13/afero"
 	"google.golang.org/grpc"
 )
 
 type FtpServerOption struct {
-	Filer            string
+	Filer            pb.ServerAddress
 	IP               string
 	IpBind           string
 	Port             int
-	FilerGrpcAddress string
 	FtpRoot          string
 	GrpcDialOption   grpc.DialOption
 	PassivePortStart int
 	PassivePortStop  int
+	IdentityConfig   string
+	TlsCertificate   string
+	TlsPrivateKey    string
+	Collection       string
+	Replication      string
+	DiskType         string
+	Cipher           bool
+	Uid              uint32
+	Gid              uint32
 }
 
-type SftpServer struct {
+type FtpServer struct {
 	option      *FtpServerOption
 	ftpListener net.Listener
+	tlsConfig   *tls.Config
+	fs          *FtpFileSystem
 }
 
-var _ = ftpserver.MainDriver(&SftpServer{})
+var _ = ftpserver.MainDriver(&FtpServer{})
 
-// NewServer returns a new FTP server driver
-func NewFtpServer(ftpListener net.Listener, option *FtpServerOption) (*SftpServer, error) {
-	var err error
-	server := &SftpServer{
+// NewFtpServer returns a new FTP server driver
+func NewFtpServer(ftpListener net.Listener, option *FtpServerOption) (*FtpServer, error) {
+	server := &FtpServer{
 		option:      option,
 		ftpListener: ftpListener,
+		fs:          NewFtpFileSystem(option),
+	}
+	if option.TlsCertificate != "" || option.TlsPrivateKey != "" {
+		cert, err := tls.LoadX509KeyPair(option.TlsCertificate, option.TlsPrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("load tls certificate %s key %s: %v", option.TlsCertificate, option.TlsPrivateKey, err)
+		}
+		server.tlsConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
 	}
-	return server, err
+	return server, nil
 }
 
 // GetSettings returns some general settings around the server setup
-func (s *SftpServer) GetSettings() (*ftpserver.Settings, error) {
+func (s *FtpServer) GetSettings() (*ftpserver.Settings, error) {
 	var portRange *ftpserver.PortRange
 	if s.option.PassivePortStart > 0 && s.option.PassivePortStop > s.option.PassivePortStart {
 		portRange = &ftpserver.PortRange{
@@ -61,21 +85,47 @@ func (s *SftpServer) GetSettings() (*ftpserver.Settings, error) {
 }
 
 // ClientConnected is called to send the very first welcome message
-func (s *SftpServer) ClientConnected(cc ftpserver.ClientContext) (string, error) {
+func (s *FtpServer) ClientConnected(cc ftpserver.ClientContext) (string, error) {
+	glog.V(2).Infof("ftp client %d connected from %s", cc.ID(), cc.RemoteAddr())
 	return "Welcome to SeaweedFS FTP Server", nil
 }
 
 // ClientDisconnected is called when the user disconnects, even if he never authenticated
-func (s *SftpServer) ClientDisconnected(cc ftpserver.ClientContext) {
+func (s *FtpServer) ClientDisconnected(cc ftpserver.ClientContext) {
+	glog.V(2).Infof("ftp client %d disconnected from %s", cc.ID(), cc.RemoteAddr())
 }
 
-// AuthUser authenticates the user and selects an handling driver
-func (s *SftpServer) AuthUser(cc ftpserver.ClientContext, username, password string) (ftpserver.ClientDriver, error) {
-	return nil, nil
+// AuthUser authenticates the user and selects an handling driver.
+// The user is confined to the home directory, and read only users can not change anything.
+func (s *FtpServer) AuthUser(cc ftpserver.ClientContext, username, password string) (ftpserver.ClientDriver, error) {
+	user, err := s.authenticate(username, password)
+	if err != nil {
+		glog.V(0).Infof("ftp login %s from %s: %v", username, cc.RemoteAddr(), err)
+		return nil, errors.New("invalid user or password")
+	}
+
+	home := user.HomeDir(s.option.FtpRoot)
+	if err = s.fs.MkdirAll(home, 0755); err != nil {
+		glog.Errorf("ftp user %s home %s: %v", user.Name, home, err)
+		return nil, fmt.Errorf("prepare home directory: %v", err)
+	}
+	glog.V(1).Infof("ftp user %s logged in from %s, home %s", user.Name, cc.RemoteAddr(), home)
+
+	var fs afero.Fs = s.fs
+	if home != "/" {
+		fs = afero.NewBasePathFs(fs, home)
+	}
+	if !user.CanWrite {
+		fs = afero.NewReadOnlyFs(fs)
+	}
+	return fs, nil
 }
 
 // GetTLSConfig returns a TLS Certificate to use
 // The certificate could frequently change if we use something like "let's encrypt"
-func (s *SftpServer) GetTLSConfig() (*tls.Config, error) {
-	return nil, errors.New("no TLS certificate configured")
+func (s *FtpServer) GetTLSConfig() (*tls.Config, error) {
+	if s.tlsConfig == nil {
+		return nil, errors.New("no TLS certificate configured")
+	}
+	return s.tlsConfig, nil
 }
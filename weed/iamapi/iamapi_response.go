@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
 )
 
 type CommonResponse struct {
@@ -89,6 +90,35 @@ type GetUserPolicyResponse struct {
 	} `xml:"GetUserPolicyResult"`
 }
 
+type AssumeRoleResponse struct {
+	CommonResponse
+	XMLName          xml.Name `xml:"https://sts.amazonaws.com/doc/2011-06-15/ AssumeRoleResponse"`
+	AssumeRoleResult struct {
+		Credentials     sts.Credentials     `xml:"Credentials"`
+		AssumedRoleUser sts.AssumedRoleUser `xml:"AssumedRoleUser"`
+	} `xml:"AssumeRoleResult"`
+}
+
+type AssumeRoleWithWebIdentityResponse struct {
+	CommonResponse
+	XMLName                         xml.Name `xml:"https://sts.amazonaws.com/doc/2011-06-15/ AssumeRoleWithWebIdentityResponse"`
+	AssumeRoleWithWebIdentityResult struct {
+		Credentials                 sts.Credentials     `xml:"Credentials"`
+		AssumedRoleUser             sts.AssumedRoleUser `xml:"AssumedRoleUser"`
+		SubjectFromWebIdentityToken string              `xml:"SubjectFromWebIdentityToken"`
+		Audience                    string              `xml:"Audience"`
+		Provider                    string              `xml:"Provider"`
+	} `xml:"AssumeRoleWithWebIdentityResult"`
+}
+
+type GetSessionTokenResponse struct {
+	CommonResponse
+	XMLName               xml.Name `xml:"https://sts.amazonaws.com/doc/2011-06-15/ GetSessionTokenResponse"`
+	GetSessionTokenResult struct {
+		Credentials sts.Credentials `xml:"Credentials"`
+	} `xml:"GetSessionTokenResult"`
+}
+
 type ErrorResponse struct {
 	CommonResponse
 	XMLName xml.Name `xml:"https://iam.amazonaws.com/doc/2010-05-08/ ErrorResponse"`
@@ -13,6 +13,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/s3api"
 	. "github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/chrislusf/seaweedfs/weed/wdclient"
 	"github.com/gorilla/mux"
@@ -42,6 +43,8 @@ type IamServerOption struct {
 type IamApiServer struct {
 	s3ApiConfig IamS3ApiConfig
 	iam         *s3api.IdentityAccessManagement
+	// oidcVerifier validates the ID tokens of AssumeRoleWithWebIdentity, nil if not configured
+	oidcVerifier *security.OidcVerifier
 }
 
 var s3ApiConfigure IamS3ApiConfig
@@ -56,6 +59,7 @@ func NewIamApiServer(router *mux.Router, option *IamServerOption) (iamApiServer
 		s3ApiConfig: s3ApiConfigure,
 		iam:         s3api.NewIdentityAccessManagement(&s3Option),
 	}
+	iamApiServer.oidcVerifier = security.LoadOidcVerifier(util.GetViper())
 
 	iamApiServer.registerRouter(router)
 
@@ -68,6 +72,10 @@ func (iama *IamApiServer) registerRouter(router *mux.Router) {
 	// ListBuckets
 
 	// apiRouter.Methods("GET").Path("/").HandlerFunc(track(s3a.iam.Auth(s3a.ListBucketsHandler, ACTION_ADMIN), "LIST"))
+	// STS AssumeRole and GetSessionToken, authorized by the actions
+	apiRouter.Methods("POST").Path("/").MatcherFunc(isStsRequest).HandlerFunc(iama.iam.Authenticate(iama.DoStsActions))
+	// STS AssumeRoleWithWebIdentity, authenticated by the ID token instead of a signature
+	apiRouter.Methods("POST").Path("/").MatcherFunc(isUnsignedRequest).HandlerFunc(iama.DoWebIdentityActions)
 	apiRouter.Methods("POST").Path("/").HandlerFunc(iama.iam.Auth(iama.DoActions, ACTION_ADMIN))
 	//
 	// NotFound
@@ -0,0 +1,298 @@
+package iamapi
+
+// https://docs.aws.amazon.com/STS/latest/APIReference/API_AssumeRole.html
+// https://docs.aws.amazon.com/STS/latest/APIReference/API_AssumeRoleWithWebIdentity.html
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
+	"github.com/chrislusf/seaweedfs/weed/s3api"
+	. "github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/golang-jwt/jwt"
+	"github.com/gorilla/mux"
+)
+
+const (
+	stsDefaultDurationSeconds = 3600
+	stsMinDurationSeconds     = 900
+	stsMaxDurationSeconds     = 43200
+	stsErrCodeValidation      = "ValidationError"
+	stsErrCodeAccessDenied    = "AccessDenied"
+)
+
+// isStsRequest tells the STS requests apart from the IAM requests by the service of the signature scope
+func isStsRequest(r *http.Request, rm *mux.RouteMatch) bool {
+	const stsScope = "/sts/aws4_request"
+	return strings.Contains(r.Header.Get("Authorization"), stsScope) ||
+		strings.HasSuffix(r.URL.Query().Get("X-Amz-Credential"), stsScope)
+}
+
+// isUnsignedRequest matches the requests authenticated by their parameters, like AssumeRoleWithWebIdentity
+func isUnsignedRequest(r *http.Request, rm *mux.RouteMatch) bool {
+	return r.Header.Get("Authorization") == "" && r.URL.Query().Get("X-Amz-Credential") == ""
+}
+
+func writeStsErrorResponse(w http.ResponseWriter, r *http.Request, statusCode int, errCode string, msg string) {
+	errorResp := ErrorResponse{}
+	errorResp.Error.Type = "Sender"
+	errorResp.Error.Code = &errCode
+	errorResp.Error.Message = &msg
+	glog.V(1).Infof("sts %s: %s", errCode, msg)
+	s3err.WriteXMLResponse(w, r, statusCode, errorResp)
+}
+
+func parseStsDuration(values url.Values) (time.Duration, error) {
+	durationSeconds := stsDefaultDurationSeconds
+	if v := values.Get("DurationSeconds"); v != "" {
+		var err error
+		if durationSeconds, err = strconv.Atoi(v); err != nil {
+			return 0, fmt.Errorf("invalid DurationSeconds %s", v)
+		}
+	}
+	if durationSeconds < stsMinDurationSeconds || durationSeconds > stsMaxDurationSeconds {
+		return 0, fmt.Errorf("DurationSeconds must be between %d and %d", stsMinDurationSeconds, stsMaxDurationSeconds)
+	}
+	return time.Duration(durationSeconds) * time.Second, nil
+}
+
+// parseStsPolicy returns the actions of the inline session policy, nil if there is no policy
+func parseStsPolicy(values url.Values) ([]s3api.Action, error) {
+	policy := values.Get("Policy")
+	if policy == "" {
+		return nil, nil
+	}
+	policyDocument, err := GetPolicyDocument(&policy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Policy: %v", err)
+	}
+	actions := []s3api.Action{}
+	for _, action := range GetActions(&policyDocument) {
+		actions = append(actions, s3api.Action(action))
+	}
+	return actions, nil
+}
+
+// parseRoleArn gets the role name from "arn:aws:iam::<account>:role/<name>"
+func parseRoleArn(roleArn string) (string, error) {
+	parts := strings.SplitN(roleArn, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" || parts[2] != "iam" || !strings.HasPrefix(parts[5], "role/") {
+		return "", fmt.Errorf("invalid RoleArn %s", roleArn)
+	}
+	name := parts[5][strings.LastIndex(parts[5], "/")+1:]
+	if name == "" {
+		return "", fmt.Errorf("invalid RoleArn %s", roleArn)
+	}
+	return name, nil
+}
+
+func toStsCredentials(creds *s3api.SessionCredentials) sts.Credentials {
+	return sts.Credentials{
+		AccessKeyId:     &creds.AccessKeyId,
+		SecretAccessKey: &creds.SecretAccessKey,
+		SessionToken:    &creds.SessionToken,
+		Expiration:      &creds.Expiration,
+	}
+}
+
+func toAssumedRoleUser(roleName, sessionName string) sts.AssumedRoleUser {
+	assumedRoleId := fmt.Sprintf("%s:%s", roleName, sessionName)
+	assumedRoleArn := fmt.Sprintf("arn:aws:sts:::assumed-role/%s/%s", roleName, sessionName)
+	return sts.AssumedRoleUser{AssumedRoleId: &assumedRoleId, Arn: &assumedRoleArn}
+}
+
+func (iama *IamApiServer) AssumeRole(caller *s3api.Identity, values url.Values) (resp AssumeRoleResponse, statusCode int, errCode string, err error) {
+	roleName, err := parseRoleArn(values.Get("RoleArn"))
+	if err != nil {
+		return resp, http.StatusBadRequest, stsErrCodeValidation, err
+	}
+	sessionName := values.Get("RoleSessionName")
+	if sessionName == "" {
+		return resp, http.StatusBadRequest, stsErrCodeValidation, fmt.Errorf("RoleSessionName is required")
+	}
+	duration, err := parseStsDuration(values)
+	if err != nil {
+		return resp, http.StatusBadRequest, stsErrCodeValidation, err
+	}
+	actions, err := parseStsPolicy(values)
+	if err != nil {
+		return resp, http.StatusBadRequest, stsErrCodeValidation, err
+	}
+	if !caller.CanAssumeRole(roleName) {
+		return resp, http.StatusForbidden, stsErrCodeAccessDenied, fmt.Errorf("%s is not authorized to assume role %s", caller.Name, roleName)
+	}
+
+	if statusCode, errCode, err = iama.checkRoleExists(roleName); err != nil {
+		return resp, statusCode, errCode, err
+	}
+
+	creds, err := iama.iam.NewSessionCredentials(roleName, sessionName, actions, duration)
+	if err != nil {
+		return resp, http.StatusInternalServerError, iam.ErrCodeServiceFailureException, err
+	}
+	resp.AssumeRoleResult.Credentials = toStsCredentials(creds)
+	resp.AssumeRoleResult.AssumedRoleUser = toAssumedRoleUser(roleName, sessionName)
+	return resp, http.StatusOK, "", nil
+}
+
+func (iama *IamApiServer) AssumeRoleWithWebIdentity(values url.Values) (resp AssumeRoleWithWebIdentityResponse, statusCode int, errCode string, err error) {
+	if iama.oidcVerifier == nil {
+		return resp, http.StatusBadRequest, sts.ErrCodeInvalidIdentityTokenException, fmt.Errorf("jwt.oidc is not configured")
+	}
+	claims, err := iama.oidcVerifier.Verify(values.Get("WebIdentityToken"))
+	if err != nil {
+		if ve, ok := err.(*jwt.ValidationError); ok && ve.Errors == jwt.ValidationErrorExpired {
+			return resp, http.StatusBadRequest, sts.ErrCodeExpiredTokenException, err
+		}
+		return resp, http.StatusBadRequest, sts.ErrCodeInvalidIdentityTokenException, err
+	}
+	roleName, err := parseRoleArn(values.Get("RoleArn"))
+	if err != nil {
+		return resp, http.StatusBadRequest, stsErrCodeValidation, err
+	}
+	sessionName := values.Get("RoleSessionName")
+	if sessionName == "" {
+		return resp, http.StatusBadRequest, stsErrCodeValidation, fmt.Errorf("RoleSessionName is required")
+	}
+	duration, err := parseStsDuration(values)
+	if err != nil {
+		return resp, http.StatusBadRequest, stsErrCodeValidation, err
+	}
+	actions, err := parseStsPolicy(values)
+	if err != nil {
+		return resp, http.StatusBadRequest, stsErrCodeValidation, err
+	}
+
+	// the first mapping of the role decides the actions
+	var mapping *security.OidcMapping
+	for _, m := range iama.oidcVerifier.MatchingMappings(claims) {
+		if m.Identity == roleName {
+			mapping = m
+			break
+		}
+	}
+	if mapping == nil {
+		return resp, http.StatusForbidden, stsErrCodeAccessDenied, fmt.Errorf("%v is not authorized to assume role %s", claims["sub"], roleName)
+	}
+	if len(mapping.Actions) > 0 {
+		var mappingActions []s3api.Action
+		for _, action := range mapping.Actions {
+			mappingActions = append(mappingActions, s3api.Action(action))
+		}
+		actions = s3api.IntersectActions(mappingActions, actions)
+	}
+	if statusCode, errCode, err = iama.checkRoleExists(roleName); err != nil {
+		return resp, statusCode, errCode, err
+	}
+
+	creds, err := iama.iam.NewSessionCredentials(roleName, sessionName, actions, duration)
+	if err != nil {
+		return resp, http.StatusInternalServerError, iam.ErrCodeServiceFailureException, err
+	}
+	result := &resp.AssumeRoleWithWebIdentityResult
+	result.Credentials = toStsCredentials(creds)
+	result.AssumedRoleUser = toAssumedRoleUser(roleName, sessionName)
+	result.SubjectFromWebIdentityToken, _ = claims["sub"].(string)
+	result.Provider = iama.oidcVerifier.Issuer
+	result.Audience = iama.oidcVerifier.Audience
+	return resp, http.StatusOK, "", nil
+}
+
+func (iama *IamApiServer) checkRoleExists(roleName string) (statusCode int, errCode string, err error) {
+	s3cfg := &iam_pb.S3ApiConfiguration{}
+	if err = iama.s3ApiConfig.GetS3ApiConfiguration(s3cfg); err != nil {
+		return http.StatusInternalServerError, iam.ErrCodeServiceFailureException, err
+	}
+	for _, ident := range s3cfg.Identities {
+		if ident.Name == roleName {
+			return http.StatusOK, "", nil
+		}
+	}
+	return http.StatusNotFound, iam.ErrCodeNoSuchEntityException, fmt.Errorf("The role with name %s cannot be found.", roleName)
+}
+
+func (iama *IamApiServer) GetSessionToken(caller *s3api.Identity, values url.Values) (resp GetSessionTokenResponse, statusCode int, errCode string, err error) {
+	if caller.Session != nil {
+		return resp, http.StatusForbidden, stsErrCodeAccessDenied, fmt.Errorf("cannot call GetSessionToken with temporary credentials")
+	}
+	duration, err := parseStsDuration(values)
+	if err != nil {
+		return resp, http.StatusBadRequest, stsErrCodeValidation, err
+	}
+	actions, err := parseStsPolicy(values)
+	if err != nil {
+		return resp, http.StatusBadRequest, stsErrCodeValidation, err
+	}
+	creds, err := iama.iam.NewSessionCredentials(caller.Name, "", actions, duration)
+	if err != nil {
+		return resp, http.StatusInternalServerError, iam.ErrCodeServiceFailureException, err
+	}
+	resp.GetSessionTokenResult.Credentials = toStsCredentials(creds)
+	return resp, http.StatusOK, "", nil
+}
+
+func (iama *IamApiServer) DoStsActions(w http.ResponseWriter, r *http.Request, caller *s3api.Identity) {
+	if err := r.ParseForm(); err != nil {
+		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidRequest)
+		return
+	}
+	values := r.Form
+	glog.V(4).Infof("DoStsActions %s: %+v", r.Header.Get(xhttp.AmzAuthType), values)
+
+	var response interface{}
+	var statusCode int
+	var errCode string
+	var err error
+	switch values.Get("Action") {
+	case "AssumeRole":
+		var resp AssumeRoleResponse
+		resp, statusCode, errCode, err = iama.AssumeRole(caller, values)
+		resp.SetRequestId()
+		response = resp
+	case "GetSessionToken":
+		var resp GetSessionTokenResponse
+		resp, statusCode, errCode, err = iama.GetSessionToken(caller, values)
+		resp.SetRequestId()
+		response = resp
+	default:
+		errNotImplemented := s3err.GetAPIError(s3err.ErrNotImplemented)
+		writeStsErrorResponse(w, r, errNotImplemented.HTTPStatusCode, errNotImplemented.Code, errNotImplemented.Description)
+		return
+	}
+	if err != nil {
+		writeStsErrorResponse(w, r, statusCode, errCode, err.Error())
+		return
+	}
+	s3err.WriteXMLResponse(w, r, http.StatusOK, response)
+}
+
+func (iama *IamApiServer) DoWebIdentityActions(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidRequest)
+		return
+	}
+	values := r.Form
+	if values.Get("Action") != "AssumeRoleWithWebIdentity" {
+		// other unsigned requests are handled as before, the parsed form is kept in the request
+		iama.iam.Auth(iama.DoActions, ACTION_ADMIN)(w, r)
+		return
+	}
+	resp, statusCode, errCode, err := iama.AssumeRoleWithWebIdentity(values)
+	if err != nil {
+		writeStsErrorResponse(w, r, statusCode, errCode, err.Error())
+		return
+	}
+	resp.SetRequestId()
+	s3err.WriteXMLResponse(w, r, http.StatusOK, resp)
+}
@@ -0,0 +1,53 @@
+package kms
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// KeyManager wraps the chunk cipher keys with a master key, so the filer store only keeps the wrapped keys.
+// The key id returned with each wrapped key is saved along with it, to unwrap it after the master key is rotated.
+type KeyManager interface {
+	// GetName gets the name to locate the configuration in security.toml file
+	GetName() string
+	// Initialize initializes the key manager
+	Initialize(configuration *util.ViperProxy, prefix string) error
+	// CurrentKeyId is the id of the master key wrapping the new cipher keys
+	CurrentKeyId() (string, error)
+	// WrapKey encrypts a cipher key with the current master key
+	WrapKey(cipherKey []byte) (keyId string, wrappedKey []byte, err error)
+	// UnwrapKey decrypts a cipher key wrapped by the master key of the key id
+	UnwrapKey(keyId string, wrappedKey []byte) (cipherKey []byte, err error)
+}
+
+var (
+	KeyManagers []KeyManager
+)
+
+// LoadConfiguration returns the enabled key manager, or nil if none is enabled
+func LoadConfiguration(config *util.ViperProxy, prefix string) (KeyManager, error) {
+
+	var enabled KeyManager
+	for _, keyManager := range KeyManagers {
+		if !config.GetBool(prefix + keyManager.GetName() + ".enabled") {
+			continue
+		}
+		if enabled != nil {
+			return nil, fmt.Errorf("key manager is enabled for both %s and %s", enabled.GetName(), keyManager.GetName())
+		}
+		enabled = keyManager
+	}
+	if enabled == nil {
+		return nil, nil
+	}
+
+	keyManager := reflect.New(reflect.ValueOf(enabled).Elem().Type()).Interface().(KeyManager)
+	if err := keyManager.Initialize(config, prefix+keyManager.GetName()+"."); err != nil {
+		return nil, fmt.Errorf("initialize key manager %s: %v", keyManager.GetName(), err)
+	}
+	glog.V(0).Infof("configured key manager %s", keyManager.GetName())
+	return keyManager, nil
+}
@@ -0,0 +1,102 @@
+package local
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/chrislusf/seaweedfs/weed/kms"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func init() {
+	kms.KeyManagers = append(kms.KeyManagers, &LocalKeyManager{})
+}
+
+// LocalKeyManager keeps the master keys in local files, or derives them from passphrases.
+type LocalKeyManager struct {
+	keyId string
+	keys  map[string]util.CipherKey
+}
+
+func (m *LocalKeyManager) GetName() string {
+	return "local"
+}
+
+func (m *LocalKeyManager) Initialize(configuration *util.ViperProxy, prefix string) (err error) {
+	m.keys = make(map[string]util.CipherKey)
+	for keyId := range configuration.GetStringMap(prefix + "keys") {
+		keyPrefix := prefix + "keys." + keyId + "."
+		keyFile, passphrase := configuration.GetString(keyPrefix+"key_file"), configuration.GetString(keyPrefix+"passphrase")
+		if m.keys[keyId], err = loadKey(keyId, util.ResolvePath(keyFile), passphrase); err != nil {
+			return fmt.Errorf("%skeys.%s: %v", prefix, keyId, err)
+		}
+	}
+	return m.initialize(configuration.GetString(prefix + "key_id"))
+}
+
+func (m *LocalKeyManager) initialize(keyId string) error {
+	if len(m.keys) == 0 {
+		return fmt.Errorf("no master key is configured")
+	}
+	if keyId == "" {
+		var keyIds []string
+		for id := range m.keys {
+			keyIds = append(keyIds, id)
+		}
+		sort.Strings(keyIds)
+		keyId = keyIds[0]
+	}
+	if _, found := m.keys[keyId]; !found {
+		return fmt.Errorf("key_id %s is not in the configured keys", keyId)
+	}
+	m.keyId = keyId
+	return nil
+}
+
+// loadKey reads a base64 encoded 256-bit key, or derives it from the passphrase
+func loadKey(keyId, keyFile, passphrase string) (util.CipherKey, error) {
+	if keyFile != "" && passphrase != "" {
+		return nil, fmt.Errorf("only one of key_file and passphrase can be set")
+	}
+	if passphrase != "" {
+		// the key id salts the passphrase, so different keys can share a passphrase
+		return scrypt.Key([]byte(passphrase), []byte("seaweedfs-kms-"+keyId), 1<<15, 8, 1, 32)
+	}
+	if keyFile == "" {
+		return nil, fmt.Errorf("missing key_file or passphrase")
+	}
+	data, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %v", keyFile, err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("%s: expecting 32 bytes, but found %d", keyFile, len(key))
+	}
+	return key, nil
+}
+
+func (m *LocalKeyManager) CurrentKeyId() (string, error) {
+	return m.keyId, nil
+}
+
+func (m *LocalKeyManager) WrapKey(cipherKey []byte) (keyId string, wrappedKey []byte, err error) {
+	wrappedKey, err = util.Encrypt(cipherKey, m.keys[m.keyId])
+	return m.keyId, wrappedKey, err
+}
+
+func (m *LocalKeyManager) UnwrapKey(keyId string, wrappedKey []byte) (cipherKey []byte, err error) {
+	key, found := m.keys[keyId]
+	if !found {
+		return nil, fmt.Errorf("master key %s is not configured", keyId)
+	}
+	return util.Decrypt(wrappedKey, key)
+}
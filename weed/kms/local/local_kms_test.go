@@ -0,0 +1,55 @@
+package local
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func TestLocalKeyRotation(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key1")
+	assert.NoError(t, ioutil.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(util.GenCipherKey())+"\n"), 0600))
+	key1, err := loadKey("key1", keyFile, "")
+	assert.NoError(t, err)
+	key2, err := loadKey("key2", "", "correct horse battery staple")
+	assert.NoError(t, err)
+
+	m := &LocalKeyManager{keys: map[string]util.CipherKey{"key1": key1}}
+	assert.NoError(t, m.initialize(""))
+	cipherKey := util.GenCipherKey()
+	keyId, wrappedKey, err := m.WrapKey(cipherKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "key1", keyId)
+	assert.False(t, bytes.Contains(wrappedKey, cipherKey))
+
+	// rotate to key2, and the cipher keys wrapped by key1 can still be unwrapped
+	m.keys["key2"] = key2
+	assert.NoError(t, m.initialize("key2"))
+	currentKeyId, _ := m.CurrentKeyId()
+	assert.Equal(t, "key2", currentKeyId)
+	unwrapped, err := m.UnwrapKey(keyId, wrappedKey)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(cipherKey), unwrapped)
+
+	keyId, rewrappedKey, err := m.WrapKey(unwrapped)
+	assert.NoError(t, err)
+	assert.Equal(t, "key2", keyId)
+	_, err = m.UnwrapKey("key1", rewrappedKey)
+	assert.Error(t, err)
+
+	// the same passphrase derives the same key for the same key id only
+	sameKey2, _ := loadKey("key2", "", "correct horse battery staple")
+	assert.Equal(t, key2, sameKey2)
+	key3, _ := loadKey("key3", "", "correct horse battery staple")
+	assert.NotEqual(t, key2, key3)
+
+	assert.Error(t, m.initialize("key4"))
+	_, err = m.UnwrapKey("key4", rewrappedKey)
+	assert.Error(t, err)
+}
@@ -0,0 +1,176 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/karlseguin/ccache/v2"
+
+	"github.com/chrislusf/seaweedfs/weed/kms"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func init() {
+	kms.KeyManagers = append(kms.KeyManagers, &VaultKeyManager{})
+}
+
+// VaultKeyManager wraps the cipher keys with the transit secrets engine of HashiCorp Vault, or compatible servers.
+// The key id is the transit key name and its version, e.g. "seaweedfs:v2".
+type VaultKeyManager struct {
+	address   string
+	token     string
+	namespace string
+	mount     string
+	keyName   string
+	client    *http.Client
+	// the unwrapped keys, to avoid a round trip for each chunk read
+	unwrapped *ccache.Cache
+}
+
+func (m *VaultKeyManager) GetName() string {
+	return "vault"
+}
+
+func (m *VaultKeyManager) Initialize(configuration *util.ViperProxy, prefix string) (err error) {
+	configuration.SetDefault(prefix+"address", "http://127.0.0.1:8200")
+	configuration.SetDefault(prefix+"mount", "transit")
+	configuration.SetDefault(prefix+"key_name", "seaweedfs")
+	configuration.SetDefault(prefix+"timeout_seconds", 10)
+	configuration.SetDefault(prefix+"cache_size", 100000)
+	token := configuration.GetString(prefix + "token")
+	if token == "" {
+		token = os.Getenv("VAULT_TOKEN")
+	}
+	return m.initialize(
+		configuration.GetString(prefix+"address"),
+		token,
+		configuration.GetString(prefix+"namespace"),
+		configuration.GetString(prefix+"mount"),
+		configuration.GetString(prefix+"key_name"),
+		time.Duration(configuration.GetInt(prefix+"timeout_seconds"))*time.Second,
+		int64(configuration.GetInt(prefix+"cache_size")),
+	)
+}
+
+func (m *VaultKeyManager) initialize(address, token, namespace, mount, keyName string, timeout time.Duration, cacheSize int64) error {
+	if token == "" {
+		return fmt.Errorf("missing vault token")
+	}
+	m.address = strings.TrimSuffix(address, "/")
+	m.token = token
+	m.namespace = namespace
+	m.mount = strings.Trim(mount, "/")
+	m.keyName = keyName
+	m.client = &http.Client{Timeout: timeout}
+	m.unwrapped = ccache.New(ccache.Configure().MaxSize(cacheSize))
+	_, err := m.CurrentKeyId()
+	return err
+}
+
+func (m *VaultKeyManager) CurrentKeyId() (string, error) {
+	var resp struct {
+		Data struct {
+			LatestVersion int `json:"latest_version"`
+		} `json:"data"`
+	}
+	if err := m.call(http.MethodGet, "keys/"+m.keyName, nil, &resp); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s:v%d", m.keyName, resp.Data.LatestVersion), nil
+}
+
+func (m *VaultKeyManager) WrapKey(cipherKey []byte) (keyId string, wrappedKey []byte, err error) {
+	var resp struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	request := map[string]string{"plaintext": base64.StdEncoding.EncodeToString(cipherKey)}
+	if err = m.call(http.MethodPost, "encrypt/"+m.keyName, request, &resp); err != nil {
+		return "", nil, err
+	}
+	// the ciphertext looks like "vault:v2:base64..."
+	parts := strings.SplitN(resp.Data.Ciphertext, ":", 3)
+	if len(parts) != 3 {
+		return "", nil, fmt.Errorf("unexpected ciphertext %q", resp.Data.Ciphertext)
+	}
+	return m.keyName + ":" + parts[1], []byte(resp.Data.Ciphertext), nil
+}
+
+func (m *VaultKeyManager) UnwrapKey(keyId string, wrappedKey []byte) (cipherKey []byte, err error) {
+	cacheKey := keyId + "/" + string(wrappedKey)
+	if item := m.unwrapped.Get(cacheKey); item != nil {
+		return item.Value().([]byte), nil
+	}
+	keyName, _, err := parseKeyId(keyId)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	if err = m.call(http.MethodPost, "decrypt/"+keyName, map[string]string{"ciphertext": string(wrappedKey)}, &resp); err != nil {
+		return nil, err
+	}
+	if cipherKey, err = base64.StdEncoding.DecodeString(resp.Data.Plaintext); err != nil {
+		return nil, fmt.Errorf("decode plaintext: %v", err)
+	}
+	m.unwrapped.Set(cacheKey, cipherKey, time.Hour)
+	return cipherKey, nil
+}
+
+func parseKeyId(keyId string) (keyName string, version int, err error) {
+	i := strings.LastIndex(keyId, ":v")
+	if i <= 0 {
+		return "", 0, fmt.Errorf("invalid vault key id %q", keyId)
+	}
+	if version, err = strconv.Atoi(keyId[i+2:]); err != nil {
+		return "", 0, fmt.Errorf("invalid vault key id %q", keyId)
+	}
+	return keyId[:i], version, nil
+}
+
+func (m *VaultKeyManager) call(method, path string, request, response interface{}) error {
+	var body []byte
+	if request != nil {
+		var err error
+		if body, err = json.Marshal(request); err != nil {
+			return err
+		}
+	}
+	url := fmt.Sprintf("%s/v1/%s/%s", m.address, m.mount, path)
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", m.token)
+	if m.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", m.namespace)
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %v", method, url, err)
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%s %s: %v", method, url, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: %s %s", method, url, resp.Status, strings.TrimSpace(string(data)))
+	}
+	return json.Unmarshal(data, response)
+}
@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// fakeTransit "encrypts" by prefixing the key version, enough to follow the rotation
+type fakeTransit struct {
+	version      int
+	decryptCount int
+}
+
+func (f *fakeTransit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Vault-Token") != "s.token" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	var req map[string]string
+	json.NewDecoder(r.Body).Decode(&req)
+	var data interface{}
+	switch r.URL.Path {
+	case "/v1/transit/keys/seaweedfs":
+		data = map[string]int{"latest_version": f.version}
+	case "/v1/transit/encrypt/seaweedfs":
+		data = map[string]string{"ciphertext": fmt.Sprintf("vault:v%d:%s", f.version, req["plaintext"])}
+	case "/v1/transit/decrypt/seaweedfs":
+		f.decryptCount++
+		parts := strings.SplitN(req["ciphertext"], ":", 3)
+		data = map[string]string{"plaintext": parts[2]}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+}
+
+func TestVaultTransit(t *testing.T) {
+	transit := &fakeTransit{version: 1}
+	server := httptest.NewServer(transit)
+	defer server.Close()
+
+	m := &VaultKeyManager{}
+	assert.Error(t, m.initialize(server.URL, "s.wrong", "", "transit", "seaweedfs", time.Second, 100))
+	assert.NoError(t, m.initialize(server.URL, "s.token", "", "/transit/", "seaweedfs", time.Second, 100))
+
+	cipherKey := util.GenCipherKey()
+	keyId, wrappedKey, err := m.WrapKey(cipherKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "seaweedfs:v1", keyId)
+	assert.Equal(t, "vault:v1:"+base64.StdEncoding.EncodeToString(cipherKey), string(wrappedKey))
+
+	transit.version = 2
+	currentKeyId, err := m.CurrentKeyId()
+	assert.NoError(t, err)
+	assert.Equal(t, "seaweedfs:v2", currentKeyId)
+
+	for i := 0; i < 3; i++ {
+		unwrapped, err := m.UnwrapKey(keyId, wrappedKey)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(cipherKey), unwrapped)
+	}
+	assert.Equal(t, 1, transit.decryptCount)
+
+	_, err = m.UnwrapKey("seaweedfs", wrappedKey)
+	assert.Error(t, err)
+}
@@ -66,6 +66,7 @@ type WFS struct {
 	fhmap             *FileHandleToInode
 	dhmap             *DirectoryHandleToInode
 	fuseServer        *fuse.Server
+	fileLocks         *fileLocks
 }
 
 func NewSeaweedFileSystem(option *Option) *WFS {
@@ -78,6 +79,8 @@ func NewSeaweedFileSystem(option *Option) *WFS {
 		dhmap:         NewDirectoryHandleToInode(),
 	}
 
+	wfs.fileLocks = newFileLocks(option, wfs.signature)
+
 	wfs.option.filerIndex = rand.Intn(len(option.FilerAddresses))
 	wfs.option.setupUniqueCacheDirectory()
 	if option.CacheSizeMB > 0 {
@@ -93,6 +96,7 @@ func NewSeaweedFileSystem(option *Option) *WFS {
 		}, func(filePath util.FullPath, entry *filer_pb.Entry) {
 		})
 	grace.OnInterrupt(func() {
+		wfs.releaseAllFileLocks()
 		wfs.metaCache.Shutdown()
 		os.RemoveAll(option.getUniqueCacheDir())
 	})
@@ -106,6 +110,7 @@ func NewSeaweedFileSystem(option *Option) *WFS {
 func (wfs *WFS) StartBackgroundTasks() {
 	startTime := time.Now()
 	go meta_cache.SubscribeMetaEvents(wfs.metaCache, wfs.signature, wfs, wfs.option.FilerMountRootPath, startTime.UnixNano())
+	go wfs.loopRenewFileLockLease()
 }
 
 func (wfs *WFS) String() string {
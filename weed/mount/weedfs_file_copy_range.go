@@ -0,0 +1,102 @@
+package mount
+
+import (
+	"context"
+	"math"
+	"syscall"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/hanwen/go-fuse/v2/fuse"
+)
+
+// https://github.com/libfuse/libfuse/blob/48ae2e72b39b6a31cb2194f6f11786b7ca06aac6/include/fuse.h#L778
+
+/**
+ * Copy a range of data from one file to another
+ *
+ * Performs an optimized copy between two file descriptors without the
+ * additional cost of transferring data through the FUSE kernel module
+ * to user space (glibc) and then back into the FUSE filesystem again.
+ *
+ * In case this method is not implemented, applications are expected to
+ * fall back to a regular file copy.   (Some glibc versions did this
+ * emulation automatically, but the emulation has been removed from all
+ * glibc release branches.)
+ */
+func (wfs *WFS) CopyFileRange(cancel <-chan struct{}, in *fuse.CopyFileRangeIn) (written uint32, code fuse.Status) {
+
+	fhIn := wfs.GetHandle(FileHandleId(in.FhIn))
+	fhOut := wfs.GetHandle(FileHandleId(in.FhOut))
+	if fhIn == nil || fhOut == nil {
+		return 0, fuse.EBADF
+	}
+
+	// the filer copies the chunks, so the pending writes of both files need to be flushed first
+	var srcSize uint64
+	if fhIn != fhOut {
+		fhIn.Lock()
+		code = wfs.doFlush(fhIn, in.Uid, in.Gid)
+		srcSize = filer.FileSize(fhIn.entry)
+		fhIn.Unlock()
+		if code != fuse.OK {
+			return 0, code
+		}
+	}
+	fhOut.Lock()
+	defer fhOut.Unlock()
+	if code = wfs.doFlush(fhOut, in.Uid, in.Gid); code != fuse.OK {
+		return 0, code
+	}
+	if fhIn == fhOut {
+		srcSize = filer.FileSize(fhIn.entry)
+	}
+
+	// copy up to the end of the source file, and no more than the copied size returned as uint32
+	if in.OffIn >= srcSize {
+		return 0, fuse.OK
+	}
+	length := in.Len
+	if length > srcSize-in.OffIn {
+		length = srcSize - in.OffIn
+	}
+	if length > math.MaxUint32 {
+		length = math.MaxUint32
+	}
+
+	srcDir, srcName := fhIn.FullPath().DirAndName()
+	dstPath := fhOut.FullPath()
+	dstDir, dstName := dstPath.DirAndName()
+	err := wfs.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.CopyFileRange(context.Background(), &filer_pb.CopyFileRangeRequest{
+			SrcDirectory: srcDir,
+			SrcName:      srcName,
+			DstDirectory: dstDir,
+			DstName:      dstName,
+			SrcOffset:    int64(in.OffIn),
+			DstOffset:    int64(in.OffOut),
+			Length:       int64(length),
+			Signatures:   []int32{wfs.signature},
+		})
+		if err != nil {
+			return err
+		}
+
+		wfs.mapPbIdFromFilerToLocal(resp.Entry)
+		fhOut.entry = resp.Entry
+		fhOut.entryViewCache = nil
+		fhOut.reader = nil
+		wfs.metaCache.InsertEntry(context.Background(), filer.FromPbEntry(dstDir, resp.Entry))
+
+		written = uint32(resp.Copied)
+		return nil
+	})
+	if err != nil {
+		// the kernel falls back to copying the data through this mount
+		glog.V(1).Infof("copy %s/%s to %s: %v", srcDir, srcName, dstPath, err)
+		return 0, fuse.Status(syscall.EOPNOTSUPP)
+	}
+
+	return written, fuse.OK
+}
@@ -95,5 +95,8 @@ func (wfs *WFS) Open(cancel <-chan struct{}, in *fuse.OpenIn, out *fuse.OpenOut)
  * @param fi file information
  */
 func (wfs *WFS) Release(cancel <-chan struct{}, in *fuse.ReleaseIn) {
+	if in.ReleaseFlags&fuseReleaseFlockUnlock != 0 {
+		wfs.releaseFileLocks(in.NodeId, in.LockOwner, true)
+	}
 	wfs.ReleaseHandle(FileHandleId(in.Fh))
 }
@@ -0,0 +1,280 @@
+package mount
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/hanwen/go-fuse/v2/fuse"
+)
+
+// FUSE_RELEASE_FLOCK_UNLOCK in the release flags asks to remove the flock locks of the lock owner
+const fuseReleaseFlockUnlock = 1 << 1
+
+// fileLocks tracks the files that may have locks placed through this mount.
+// The locks themselves are held by one filer of the cluster, so that they are shared by all mounts.
+type fileLocks struct {
+	clientId string
+	sync.Mutex
+	inodes map[uint64]struct{}
+}
+
+func newFileLocks(option *Option, signature int32) *fileLocks {
+	hostname, _ := os.Hostname()
+	return &fileLocks{
+		clientId: fmt.Sprintf("%s:%s:%x", hostname, option.MountDirectory, uint32(signature)),
+		inodes:   make(map[uint64]struct{}),
+	}
+}
+
+func (fl *fileLocks) markLocked(inode uint64) {
+	fl.Lock()
+	defer fl.Unlock()
+	fl.inodes[inode] = struct{}{}
+}
+
+func (fl *fileLocks) isLocked(inode uint64) bool {
+	fl.Lock()
+	defer fl.Unlock()
+	_, found := fl.inodes[inode]
+	return found
+}
+
+func (fl *fileLocks) hasLocks() bool {
+	fl.Lock()
+	defer fl.Unlock()
+	return len(fl.inodes) > 0
+}
+
+func (fl *fileLocks) reset() {
+	fl.Lock()
+	defer fl.Unlock()
+	fl.inodes = make(map[uint64]struct{})
+}
+
+/**
+ * Test for a POSIX file lock
+ *
+ * Valid replies:
+ *   fuse_reply_lock
+ *   fuse_reply_err
+ *
+ * @param req request handle
+ * @param ino the inode number
+ * @param fi file information
+ * @param lock the region/type to test
+ */
+func (wfs *WFS) GetLk(cancel <-chan struct{}, in *fuse.LkIn, out *fuse.LkOut) (code fuse.Status) {
+	path, lock, status := wfs.toFileLock(in)
+	if status != fuse.OK {
+		return status
+	}
+
+	var conflict *filer_pb.FileLock
+	err := wfs.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.GetFileLock(context.Background(), &filer_pb.GetFileLockRequest{
+			Path: string(path),
+			Lock: lock,
+		})
+		if err != nil {
+			return err
+		}
+		conflict = resp.Conflict
+		return nil
+	})
+	if err != nil {
+		glog.Errorf("get lock %s: %v", path, err)
+		return fuse.EIO
+	}
+
+	if conflict == nil {
+		out.Lk = in.Lk
+		out.Lk.Typ = syscall.F_UNLCK
+		return fuse.OK
+	}
+	out.Lk = fuse.FileLock{
+		Start: conflict.Start,
+		End:   conflict.End,
+		Typ:   syscall.F_RDLCK,
+		Pid:   conflict.Pid,
+	}
+	if conflict.Type == filer_pb.FileLock_WRITE {
+		out.Lk.Typ = syscall.F_WRLCK
+	}
+	return fuse.OK
+}
+
+/**
+ * Acquire, modify or release a POSIX file lock
+ *
+ * For POSIX threads (NPTL) there's a 1-1 relation between pid and
+ * owner, but otherwise this is not always the case.  For checking
+ * lock ownership, 'fi->owner' must be used.  The l_pid field in
+ * 'struct flock' should only be used to fill in this field in
+ * getlk().
+ *
+ * Note: if the locking methods are not implemented, the kernel
+ * will still allow file locking to work locally.  Hence these are
+ * only interesting for network filesystems and similar.
+ *
+ * Valid replies:
+ *   fuse_reply_err
+ *
+ * @param req request handle
+ * @param ino the inode number
+ * @param fi file information
+ * @param lock the region/type to set
+ * @param sleep locking operation may sleep
+ */
+func (wfs *WFS) SetLk(cancel <-chan struct{}, in *fuse.LkIn) (code fuse.Status) {
+	return wfs.setLk(cancel, in, false)
+}
+
+func (wfs *WFS) SetLkw(cancel <-chan struct{}, in *fuse.LkIn) (code fuse.Status) {
+	return wfs.setLk(cancel, in, true)
+}
+
+func (wfs *WFS) setLk(cancel <-chan struct{}, in *fuse.LkIn, wait bool) (code fuse.Status) {
+	path, lock, status := wfs.toFileLock(in)
+	if status != fuse.OK {
+		return status
+	}
+
+	// the filer keeps waiting for the lock until the request is interrupted
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+	go func() {
+		select {
+		case <-cancel:
+			cancelFn()
+		case <-ctx.Done():
+		}
+	}()
+
+	var acquired bool
+	err := wfs.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.SetFileLock(ctx, &filer_pb.SetFileLockRequest{
+			Path:         string(path),
+			Lock:         lock,
+			Wait:         wait,
+			LeaseSeconds: filer.DefaultFileLockLeaseSeconds,
+		})
+		if err != nil {
+			return err
+		}
+		acquired = resp.Acquired
+		return nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return fuse.EINTR
+		}
+		glog.Errorf("set lock %s: %v", path, err)
+		return fuse.EIO
+	}
+	if !acquired {
+		return fuse.EAGAIN
+	}
+
+	if lock.Type != filer_pb.FileLock_UNLOCK {
+		wfs.fileLocks.markLocked(in.NodeId)
+	}
+	return fuse.OK
+}
+
+func (wfs *WFS) toFileLock(in *fuse.LkIn) (path util.FullPath, lock *filer_pb.FileLock, code fuse.Status) {
+	path, code = wfs.inodeToPath.GetPath(in.NodeId)
+	if code != fuse.OK {
+		return
+	}
+	lock = &filer_pb.FileLock{
+		Start:    in.Lk.Start,
+		End:      in.Lk.End,
+		Pid:      in.Lk.Pid,
+		ClientId: wfs.fileLocks.clientId,
+		Owner:    in.Owner,
+		IsFlock:  in.LkFlags&fuse.FUSE_LK_FLOCK != 0,
+	}
+	switch in.Lk.Typ {
+	case syscall.F_RDLCK:
+		lock.Type = filer_pb.FileLock_READ
+	case syscall.F_WRLCK:
+		lock.Type = filer_pb.FileLock_WRITE
+	case syscall.F_UNLCK:
+		lock.Type = filer_pb.FileLock_UNLOCK
+	default:
+		return path, nil, fuse.EINVAL
+	}
+	if lock.Start > lock.End {
+		return path, nil, fuse.EINVAL
+	}
+	return path, lock, fuse.OK
+}
+
+// releaseFileLocks removes the posix locks or flock locks of the owner on the file
+func (wfs *WFS) releaseFileLocks(inode uint64, owner uint64, isFlock bool) {
+	if !wfs.fileLocks.isLocked(inode) {
+		return
+	}
+	path, status := wfs.inodeToPath.GetPath(inode)
+	if status != fuse.OK {
+		return
+	}
+	err := wfs.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.ReleaseFileLocks(context.Background(), &filer_pb.ReleaseFileLocksRequest{
+			ClientId: wfs.fileLocks.clientId,
+			Path:     string(path),
+			Owner:    owner,
+			IsFlock:  isFlock,
+		})
+		return err
+	})
+	if err != nil {
+		glog.Errorf("release locks on %s: %v", path, err)
+	}
+}
+
+// releaseAllFileLocks removes all locks placed through this mount
+func (wfs *WFS) releaseAllFileLocks() {
+	if !wfs.fileLocks.hasLocks() {
+		return
+	}
+	err := wfs.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.ReleaseFileLocks(context.Background(), &filer_pb.ReleaseFileLocksRequest{
+			ClientId: wfs.fileLocks.clientId,
+		})
+		return err
+	})
+	if err != nil {
+		glog.Errorf("release locks of %s: %v", wfs.fileLocks.clientId, err)
+	}
+	wfs.fileLocks.reset()
+}
+
+// loopRenewFileLockLease keeps the locks of this mount alive on the filer.
+// If this mount goes away without releasing its locks, the filer releases them after the lease expires.
+func (wfs *WFS) loopRenewFileLockLease() {
+	for {
+		time.Sleep(filer.DefaultFileLockLeaseSeconds * time.Second / 3)
+		if !wfs.fileLocks.hasLocks() {
+			continue
+		}
+		err := wfs.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+			_, err := client.RenewFileLockLease(context.Background(), &filer_pb.RenewFileLockLeaseRequest{
+				ClientId:     wfs.fileLocks.clientId,
+				LeaseSeconds: filer.DefaultFileLockLeaseSeconds,
+			})
+			return err
+		})
+		if err != nil {
+			glog.Warningf("renew lock lease of %s: %v", wfs.fileLocks.clientId, err)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package mount
+
+import (
+	"math"
+	"syscall"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/hanwen/go-fuse/v2/fuse"
+)
+
+const (
+	// whence values of lseek, not defined by the syscall package
+	seekData = 3
+	seekHole = 4
+
+	// mode flags of fallocate, not defined by the syscall package
+	fallocFlKeepSize  = 0x01
+	fallocFlPunchHole = 0x02
+)
+
+/**
+ * Find next data or hole after the specified offset
+ */
+func (wfs *WFS) Lseek(cancel <-chan struct{}, in *fuse.LseekIn, out *fuse.LseekOut) fuse.Status {
+
+	// the kernel only asks for SEEK_DATA and SEEK_HOLE
+	if in.Whence != seekData && in.Whence != seekHole {
+		return fuse.EINVAL
+	}
+
+	fh := wfs.GetHandle(FileHandleId(in.Fh))
+	if fh == nil {
+		return fuse.EBADF
+	}
+
+	fh.Lock()
+	defer fh.Unlock()
+
+	// the dirty pages become chunks of the entry
+	if err := fh.dirtyPages.FlushData(); err != nil {
+		glog.Errorf("%v lseek flush: %v", fh.FullPath(), err)
+		return fuse.EIO
+	}
+
+	entry := fh.entry
+	if entry == nil {
+		return fuse.ENOENT
+	}
+	fileSize := int64(filer.FileSize(entry))
+	offset := int64(in.Offset)
+	if offset >= fileSize {
+		return fuse.Status(syscall.ENXIO)
+	}
+
+	// the inline content and the files not cached from the remote storage are all data
+	if len(entry.Content) > 0 || entry.IsInRemoteOnly() {
+		if in.Whence == seekData {
+			out.Offset = uint64(offset)
+		} else {
+			out.Offset = uint64(fileSize)
+		}
+		return fuse.OK
+	}
+
+	if fh.entryViewCache == nil {
+		var err error
+		fh.entryViewCache, err = filer.NonOverlappingVisibleIntervals(wfs.LookupFn(), entry.Chunks, 0, fileSize)
+		if err != nil {
+			glog.Errorf("%v lseek resolve chunks: %v", fh.FullPath(), err)
+			return fuse.EIO
+		}
+		fh.reader = nil
+	}
+	seekOffset, found := seekDataOrHole(fh.entryViewCache, offset, fileSize, in.Whence)
+	if !found {
+		return fuse.Status(syscall.ENXIO)
+	}
+	out.Offset = uint64(seekOffset)
+	return fuse.OK
+}
+
+// seekDataOrHole finds the data or the hole at or after the offset, which is inside the file.
+// There is always a hole at the end of the file, but there may be no data after the offset.
+func seekDataOrHole(visibles []filer.VisibleInterval, offset, fileSize int64, whence uint32) (seekOffset int64, found bool) {
+	chunkViews := filer.ViewFromVisibleIntervals(visibles, offset, fileSize-offset)
+
+	if whence == seekData {
+		if len(chunkViews) == 0 {
+			return 0, false
+		}
+		return max(offset, chunkViews[0].LogicOffset), true
+	}
+
+	// the hole starts after the data adjacent to the offset, or at the end of the file
+	holeStart := offset
+	for _, chunkView := range chunkViews {
+		if chunkView.LogicOffset > holeStart {
+			break
+		}
+		holeStart = chunkView.LogicOffset + int64(chunkView.Size)
+	}
+	return min(holeStart, fileSize), true
+}
+
+/**
+ * Allocates space for an open file
+ *
+ * This function ensures that required space is allocated for specified
+ * file.  If this function returns success then any subsequent write
+ * request to specified range is guaranteed not to fail because of lack
+ * of space on the file system media.
+ */
+func (wfs *WFS) Fallocate(cancel <-chan struct{}, in *fuse.FallocateIn) (code fuse.Status) {
+
+	fh := wfs.GetHandle(FileHandleId(in.Fh))
+	if fh == nil {
+		return fuse.EBADF
+	}
+
+	if in.Length > math.MaxInt64 || in.Offset > math.MaxInt64-in.Length {
+		return fuse.Status(syscall.EFBIG)
+	}
+
+	fh.Lock()
+	defer fh.Unlock()
+
+	entry := fh.entry
+	if entry == nil {
+		return fuse.ENOENT
+	}
+
+	switch in.Mode {
+	case 0:
+		// the volumes only store written data, so the preallocated space is a hole
+		if newSize := in.Offset + in.Length; newSize > filer.FileSize(entry) {
+			entry.Attributes.FileSize = newSize
+			entry.Attributes.Mtime = time.Now().Unix()
+			fh.dirtyMetadata = true
+		}
+		return fuse.OK
+	case fallocFlKeepSize:
+		return fuse.OK
+	case fallocFlKeepSize | fallocFlPunchHole:
+		return wfs.punchHole(fh, int64(in.Offset), int64(in.Offset+in.Length), in.Uid, in.Gid)
+	}
+
+	return fuse.Status(syscall.EOPNOTSUPP)
+}
+
+// punchHole drops the chunks inside the range, and trims the chunks ending inside the range.
+// The chunks still visible inside the range are overwritten with zeros.
+func (wfs *WFS) punchHole(fh *FileHandle, start, stop int64, uid, gid uint32) fuse.Status {
+
+	fileFullPath := fh.FullPath()
+	if err := fh.dirtyPages.FlushData(); err != nil {
+		glog.Errorf("%v punch hole flush: %v", fileFullPath, err)
+		return fuse.EIO
+	}
+
+	entry := fh.entry
+	stop = min(stop, int64(filer.FileSize(entry)))
+	if start >= stop {
+		return fuse.OK
+	}
+	glog.V(4).Infof("%v punch hole [%d,%d) chunks=%d", fileFullPath, start, stop, len(entry.Chunks))
+
+	if len(entry.Content) > 0 {
+		for i := start; i < stop && i < int64(len(entry.Content)); i++ {
+			entry.Content[i] = 0
+		}
+		entry.Attributes.Mtime = time.Now().Unix()
+		fh.dirtyMetadata = true
+		return wfs.doFlush(fh, uid, gid)
+	}
+
+	chunks := entry.Chunks
+	for _, chunk := range entry.Chunks {
+		if chunk.IsChunkManifest && isOverlapping(chunk, start, stop) {
+			// the filer keeps the chunks of the dropped manifests that are still listed in the entry
+			dataChunks, _, err := filer.ResolveChunkManifest(wfs.LookupFn(), entry.Chunks, 0, math.MaxInt64)
+			if err != nil {
+				glog.Errorf("%v punch hole resolve chunks: %v", fileFullPath, err)
+				return fuse.EIO
+			}
+			chunks = dataChunks
+			break
+		}
+	}
+
+	keptChunks, zeroChunks := punchHoleChunks(chunks, start, stop)
+	glog.V(4).Infof("%v punch hole keeps %d of %d chunks, zeroing %d", fileFullPath, len(keptChunks), len(chunks), len(zeroChunks))
+	entry.Chunks = keptChunks
+	fh.entryViewCache = nil
+	fh.reader = nil
+
+	if len(zeroChunks) > 0 {
+		// only zero the parts still visible after the other chunks are dropped
+		visibles, err := filer.NonOverlappingVisibleIntervals(wfs.LookupFn(), keptChunks, start, stop)
+		if err != nil {
+			glog.Errorf("%v punch hole resolve chunks: %v", fileFullPath, err)
+			return fuse.EIO
+		}
+		for _, chunkView := range filer.ViewFromVisibleIntervals(visibles, start, stop-start) {
+			fh.dirtyPages.AddPage(chunkView.LogicOffset, make([]byte, chunkView.Size))
+		}
+	}
+
+	entry.Attributes.Mtime = time.Now().Unix()
+	fh.dirtyMetadata = true
+	return wfs.doFlush(fh, uid, gid)
+}
+
+// punchHoleChunks drops the chunks inside the hole [start, stop), and trims the chunks ending inside it.
+// The kept chunks still overlapping the hole are also returned as zeroChunks, to be overwritten with zeros.
+// The trimmed chunks are copies, leaving the chunks of the entry unchanged.
+func punchHoleChunks(chunks []*filer_pb.FileChunk, start, stop int64) (keptChunks, zeroChunks []*filer_pb.FileChunk) {
+	for _, chunk := range chunks {
+		chunkStop := chunk.Offset + int64(chunk.Size)
+		switch {
+		case !isOverlapping(chunk, start, stop):
+			keptChunks = append(keptChunks, chunk)
+		case start <= chunk.Offset && chunkStop <= stop:
+			// dropped
+		case chunk.Offset < start && chunkStop <= stop:
+			trimmed := proto.Clone(chunk).(*filer_pb.FileChunk)
+			trimmed.Size = uint64(start - chunk.Offset)
+			keptChunks = append(keptChunks, trimmed)
+		default:
+			// the chunks have no offset into the stored data, so the head of a chunk can not be cut off
+			keptChunks = append(keptChunks, chunk)
+			zeroChunks = append(zeroChunks, chunk)
+		}
+	}
+	return
+}
+
+func isOverlapping(chunk *filer_pb.FileChunk, start, stop int64) bool {
+	return max(chunk.Offset, start) < min(chunk.Offset+int64(chunk.Size), stop)
+}
@@ -0,0 +1,114 @@
+package mount
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPunchHoleChunks(t *testing.T) {
+	newChunks := func() []*filer_pb.FileChunk {
+		return []*filer_pb.FileChunk{
+			{FileId: "a", Offset: 0, Size: 100, Mtime: 1},
+			{FileId: "b", Offset: 100, Size: 100, Mtime: 2},
+			{FileId: "c", Offset: 200, Size: 100, Mtime: 3},
+		}
+	}
+	type chunk struct {
+		fileId string
+		size   uint64
+	}
+	tests := []struct {
+		name        string
+		start, stop int64
+		kept        []chunk
+		zeroed      []string
+	}{
+		{
+			name:   "hole inside one chunk",
+			start:  110,
+			stop:   150,
+			kept:   []chunk{{"a", 100}, {"b", 100}, {"c", 100}},
+			zeroed: []string{"b"},
+		},
+		{
+			name:   "hole across chunks",
+			start:  50,
+			stop:   250,
+			kept:   []chunk{{"a", 50}, {"c", 100}},
+			zeroed: []string{"c"},
+		},
+		{
+			name:  "hole at the end of the file",
+			start: 150,
+			stop:  300,
+			kept:  []chunk{{"a", 100}, {"b", 50}},
+		},
+		{
+			name:  "hole covering whole chunks",
+			start: 100,
+			stop:  200,
+			kept:  []chunk{{"a", 100}, {"c", 100}},
+		},
+	}
+	for _, tt := range tests {
+		chunks := newChunks()
+		kept, zeroed := punchHoleChunks(chunks, tt.start, tt.stop)
+		var actualKept []chunk
+		for _, c := range kept {
+			actualKept = append(actualKept, chunk{c.FileId, c.Size})
+		}
+		var actualZeroed []string
+		for _, c := range zeroed {
+			actualZeroed = append(actualZeroed, c.FileId)
+		}
+		assert.Equal(t, tt.kept, actualKept, tt.name)
+		assert.Equal(t, tt.zeroed, actualZeroed, tt.name)
+		// the chunks of the entry are not changed in place
+		for i, c := range newChunks() {
+			assert.Equal(t, c.Size, chunks[i].Size, tt.name)
+		}
+	}
+}
+
+func TestSeekDataOrHole(t *testing.T) {
+	// data at [0,100) and [200,300), holes at [100,200) and [300,400)
+	chunks := []*filer_pb.FileChunk{
+		{FileId: "a", Offset: 0, Size: 60, Mtime: 1},
+		{FileId: "b", Offset: 60, Size: 40, Mtime: 2},
+		{FileId: "c", Offset: 200, Size: 100, Mtime: 3},
+	}
+	const fileSize = 400
+	visibles, err := filer.NonOverlappingVisibleIntervals(nil, chunks, 0, fileSize)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		offset   int64
+		whence   uint32
+		expected int64
+		found    bool
+	}{
+		{"data at the offset", 10, seekData, 10, true},
+		{"data after a hole", 150, seekData, 200, true},
+		{"data at the start of a chunk", 200, seekData, 200, true},
+		{"no data after the offset", 350, seekData, 0, false},
+		{"hole after adjacent chunks", 10, seekHole, 100, true},
+		{"hole at the offset", 150, seekHole, 150, true},
+		{"hole at the start of a hole", 100, seekHole, 100, true},
+		{"hole at the end of the data", 250, seekHole, 300, true},
+		{"hole inside the last hole", 350, seekHole, 350, true},
+	}
+	for _, tt := range tests {
+		offset, found := seekDataOrHole(visibles, tt.offset, fileSize, tt.whence)
+		assert.Equal(t, tt.found, found, tt.name)
+		assert.Equal(t, tt.expected, offset, tt.name)
+	}
+
+	// a file ending in data has the hole at its end
+	offset, found := seekDataOrHole(visibles, 250, 300, seekHole)
+	assert.True(t, found)
+	assert.Equal(t, int64(300), offset)
+}
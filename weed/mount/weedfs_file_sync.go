@@ -49,6 +49,8 @@ import (
  * [close]: http://pubs.opengroup.org/onlinepubs/9699919799/functions/close.html
  */
 func (wfs *WFS) Flush(cancel <-chan struct{}, in *fuse.FlushIn) fuse.Status {
+	wfs.releaseFileLocks(in.NodeId, in.LockOwner, false)
+
 	fh := wfs.GetHandle(FileHandleId(in.Fh))
 	if fh == nil {
 		return fuse.ENOENT
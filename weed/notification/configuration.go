@@ -1,6 +1,9 @@
 package notification
 
 import (
+	"fmt"
+	"reflect"
+
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/golang/protobuf/proto"
@@ -12,6 +15,8 @@ type MessageQueue interface {
 	// Initialize initializes the file store
 	Initialize(configuration util.Configuration, prefix string) error
 	SendMessage(key string, message proto.Message) error
+	// SendRawMessage sends an already encoded message, e.g., the json of S3 event notifications
+	SendRawMessage(key string, data []byte) error
 }
 
 var (
@@ -54,3 +59,18 @@ func validateOneEnabledQueue(config *util.ViperProxy) {
 		}
 	}
 }
+
+// NewMessageQueue creates a message queue of the named type, separate from the filer notification queue
+func NewMessageQueue(name string, configuration util.Configuration, prefix string) (MessageQueue, error) {
+	for _, queue := range MessageQueues {
+		if queue.GetName() != name {
+			continue
+		}
+		newQueue := reflect.New(reflect.TypeOf(queue).Elem()).Interface().(MessageQueue)
+		if err := newQueue.Initialize(configuration, prefix); err != nil {
+			return nil, err
+		}
+		return newQueue, nil
+	}
+	return nil, fmt.Errorf("unknown message queue %s", name)
+}
@@ -93,8 +93,12 @@ func (k *GoCDKPubSub) SendMessage(key string, message proto.Message) error {
 	if err != nil {
 		return err
 	}
-	err = k.topic.Send(context.Background(), &pubsub.Message{
-		Body:     bytes,
+	return k.SendRawMessage(key, bytes)
+}
+
+func (k *GoCDKPubSub) SendRawMessage(key string, data []byte) error {
+	err := k.topic.Send(context.Background(), &pubsub.Message{
+		Body:     data,
 		Metadata: map[string]string{"key": key},
 	})
 	if err != nil {
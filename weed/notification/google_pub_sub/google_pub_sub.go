@@ -74,9 +74,14 @@ func (k *GooglePubSub) SendMessage(key string, message proto.Message) (err error
 		return
 	}
 
+	return k.SendRawMessage(key, bytes)
+}
+
+func (k *GooglePubSub) SendRawMessage(key string, data []byte) (err error) {
+
 	ctx := context.Background()
 	result := k.topic.Publish(ctx, &pubsub.Message{
-		Data:       bytes,
+		Data:       data,
 		Attributes: map[string]string{"key": key},
 	})
 
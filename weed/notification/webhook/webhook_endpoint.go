@@ -0,0 +1,313 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+const (
+	minRetryWait        = time.Second
+	maxRetryWait        = 30 * time.Second
+	spoolReplayInterval = 10 * time.Second
+)
+
+type endpointOption struct {
+	secret     []byte
+	timeout    time.Duration
+	batchSize  int
+	batchWait  time.Duration
+	maxRetries int
+	queueSize  int
+	spoolDir   string
+}
+
+type message struct {
+	key  string
+	data []byte
+}
+
+// batchMessage is one message in the body of a batched request
+type batchMessage struct {
+	Key     string          `json:"key"`
+	Message json.RawMessage `json:"message"`
+}
+
+type batchBody struct {
+	Messages []batchMessage `json:"messages"`
+}
+
+// request is an encoded batch waiting to be posted again
+type request struct {
+	key  string
+	body []byte
+}
+
+// endpoint delivers the messages to one url.
+// A failed request is retried with exponential backoff, bounded by maxRetryWait, and the later messages
+// are held behind it to keep their order, while the queue keeps being drained.
+// With a spool, the held requests are kept on disk until the endpoint is back, and the messages
+// are spilled to the spool when the queue is full. Without a spool, a request is dropped after
+// maxRetries retries, and at most queueSize requests are held in memory.
+type endpoint struct {
+	url      string
+	option   endpointOption
+	client   *http.Client
+	messages chan *message
+	spool    *spool
+	held     []*request // the failed requests without a spool
+	// the held requests are not retried again until the endpoint may be back
+	nextRetry time.Time
+	retryWait time.Duration
+	retries   int
+}
+
+func newEndpoint(url string, option endpointOption) (*endpoint, error) {
+	e := &endpoint{
+		url:       url,
+		option:    option,
+		client:    &http.Client{Timeout: option.timeout},
+		messages:  make(chan *message, option.queueSize),
+		retryWait: minRetryWait,
+	}
+	if option.spoolDir != "" {
+		// each endpoint has its own spool, named after its url
+		spool, err := newSpool(filepath.Join(option.spoolDir, fmt.Sprintf("%x", util.Md5([]byte(url)))[:16]))
+		if err != nil {
+			return nil, fmt.Errorf("webhook %s spool: %v", url, err)
+		}
+		e.spool = spool
+	}
+	return e, nil
+}
+
+// enqueue never blocks the sender. When the queue is full, the message is spilled to the spool,
+// and may be delivered before the messages still in the queue.
+func (e *endpoint) enqueue(key string, data []byte) error {
+	m := &message{key: key, data: data}
+	select {
+	case e.messages <- m:
+		return nil
+	default:
+	}
+	if e.spool == nil {
+		return fmt.Errorf("webhook %s queue is full", e.url)
+	}
+	key, body, err := e.encode([]*message{m})
+	if err == nil {
+		err = e.spool.write(key, body)
+	}
+	if err != nil {
+		return fmt.Errorf("webhook %s queue is full, spool: %v", e.url, err)
+	}
+	return nil
+}
+
+func (e *endpoint) loop() {
+	for {
+		batch := e.nextBatch(e.nextWakeUp())
+		if !e.retryHeld() {
+			// keep the order of the messages while the endpoint is still unavailable
+			if batch != nil {
+				e.holdBatch(batch)
+			}
+			continue
+		}
+		if batch == nil {
+			continue
+		}
+		key, body, err := e.encode(batch)
+		if err != nil {
+			glog.Errorf("webhook %s drops %d messages: %v", e.url, len(batch), err)
+			continue
+		}
+		if err = e.post(key, body); err != nil {
+			glog.V(1).Infof("webhook %s retry in %v: %v", e.url, e.retryWait, err)
+			e.hold(key, body)
+			e.backoff()
+			continue
+		}
+		e.retryWait = minRetryWait
+	}
+}
+
+// nextWakeUp ticks when the held requests should be retried, or periodically to check the spool
+func (e *endpoint) nextWakeUp() <-chan time.Time {
+	if wait := time.Until(e.nextRetry); wait > 0 {
+		return time.After(wait)
+	}
+	return time.After(spoolReplayInterval)
+}
+
+// nextBatch collects up to batchSize messages, waiting at most batchWait after the first one.
+// It returns nil if there is no message before the tick.
+func (e *endpoint) nextBatch(tick <-chan time.Time) (batch []*message) {
+	select {
+	case m := <-e.messages:
+		batch = append(batch, m)
+	case <-tick:
+		return nil
+	}
+	if e.option.batchSize <= 1 {
+		return
+	}
+	timer := time.NewTimer(e.option.batchWait)
+	defer timer.Stop()
+	for len(batch) < e.option.batchSize {
+		select {
+		case m := <-e.messages:
+			batch = append(batch, m)
+		case <-timer.C:
+			return
+		}
+	}
+	return
+}
+
+// encode posts a single message as is, and the batched messages as {"messages":[{"key":..., "message":...}]}
+func (e *endpoint) encode(batch []*message) (key string, body []byte, err error) {
+	if e.option.batchSize <= 1 {
+		return batch[0].key, batch[0].data, nil
+	}
+	b := batchBody{}
+	for _, m := range batch {
+		b.Messages = append(b.Messages, batchMessage{Key: m.key, Message: m.data})
+	}
+	body, err = json.Marshal(b)
+	return "", body, err
+}
+
+func (e *endpoint) holdBatch(batch []*message) {
+	key, body, err := e.encode(batch)
+	if err != nil {
+		glog.Errorf("webhook %s drops %d messages: %v", e.url, len(batch), err)
+		return
+	}
+	e.hold(key, body)
+}
+
+// hold keeps the request behind the earlier failed ones
+func (e *endpoint) hold(key string, body []byte) {
+	if e.spool != nil {
+		if err := e.spool.write(key, body); err != nil {
+			glog.Errorf("webhook %s drops a request: spool: %v", e.url, err)
+		}
+		return
+	}
+	if len(e.held) >= e.option.queueSize {
+		glog.Errorf("webhook %s drops a request: too many failed requests", e.url)
+		e.held = e.held[1:]
+		e.retries = 0
+	}
+	e.held = append(e.held, &request{key: key, body: body})
+}
+
+// retryHeld posts the held requests in order, and returns false if some are still left
+func (e *endpoint) retryHeld() bool {
+	if e.spool == nil && len(e.held) == 0 {
+		return true
+	}
+	if time.Now().Before(e.nextRetry) {
+		return false
+	}
+	for {
+		name, key, body, err := e.oldestHeld()
+		if err != nil {
+			glog.Errorf("webhook %s read spool: %v", e.url, err)
+			e.backoff()
+			return false
+		}
+		if name == "" {
+			return true
+		}
+		if err = e.post(key, body); err != nil {
+			glog.V(1).Infof("webhook %s retry in %v: %v", e.url, e.retryWait, err)
+			if e.retries++; e.spool == nil && e.retries > e.option.maxRetries {
+				glog.Errorf("webhook %s drops a request after %d retries: %v", e.url, e.option.maxRetries, err)
+				e.held = e.held[1:]
+				e.retries = 0
+			}
+			e.backoff()
+			return false
+		}
+		if err = e.removeHeld(name); err != nil {
+			glog.Errorf("webhook %s remove spooled %s: %v", e.url, name, err)
+			e.backoff()
+			return false
+		}
+		e.retries = 0
+		e.retryWait = minRetryWait
+	}
+}
+
+func (e *endpoint) oldestHeld() (name, key string, body []byte, err error) {
+	if e.spool != nil {
+		return e.spool.oldest()
+	}
+	if len(e.held) == 0 {
+		return
+	}
+	return "memory", e.held[0].key, e.held[0].body, nil
+}
+
+func (e *endpoint) removeHeld(name string) error {
+	if e.spool != nil {
+		return e.spool.remove(name)
+	}
+	e.held = e.held[1:]
+	return nil
+}
+
+// backoff delays the next retry, doubling the wait up to maxRetryWait
+func (e *endpoint) backoff() {
+	e.nextRetry = time.Now().Add(e.retryWait)
+	if e.retryWait *= 2; e.retryWait > maxRetryWait {
+		e.retryWait = maxRetryWait
+	}
+}
+
+// post sends the body. With a secret, the X-Seaweedfs-Signature header is "sha256=" followed by
+// the hex of hmac_sha256(secret, timestamp + "." + body), where timestamp is the X-Seaweedfs-Timestamp header.
+func (e *endpoint) post(key string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set("X-Seaweedfs-Key", key)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("X-Seaweedfs-Timestamp", timestamp)
+	if len(e.option.secret) > 0 {
+		req.Header.Set("X-Seaweedfs-Signature", "sha256="+sign(e.option.secret, timestamp, body))
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post to %s: %v", e.url, err)
+	}
+	util.CloseResponse(resp)
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("post to %s: %s", e.url, resp.Status)
+	}
+	return nil
+}
+
+func sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
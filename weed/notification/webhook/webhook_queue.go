@@ -0,0 +1,109 @@
+package webhook
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/notification"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+func init() {
+	notification.MessageQueues = append(notification.MessageQueues, &WebhookQueue{})
+}
+
+// WebhookQueue posts the messages as json to http endpoints.
+// Each endpoint has its own delivery queue, so a slow or failing endpoint does not hold back the others.
+type WebhookQueue struct {
+	pathPrefixes []string
+	endpoints    []*endpoint
+}
+
+func (w *WebhookQueue) GetName() string {
+	return "webhook"
+}
+
+func (w *WebhookQueue) Initialize(configuration util.Configuration, prefix string) (err error) {
+	glog.V(0).Infof("notification.webhook.endpoints: %v", configuration.GetStringSlice(prefix+"endpoints"))
+	glog.V(0).Infof("notification.webhook.path_prefixes: %v", configuration.GetStringSlice(prefix+"path_prefixes"))
+	configuration.SetDefault(prefix+"timeout_seconds", 10)
+	configuration.SetDefault(prefix+"batch_size", 1)
+	configuration.SetDefault(prefix+"batch_wait_ms", 1000)
+	configuration.SetDefault(prefix+"max_retries", 5)
+	configuration.SetDefault(prefix+"queue_size", 10000)
+	return w.initialize(
+		configuration.GetStringSlice(prefix+"endpoints"),
+		configuration.GetStringSlice(prefix+"path_prefixes"),
+		endpointOption{
+			secret:     []byte(configuration.GetString(prefix + "secret")),
+			timeout:    time.Duration(configuration.GetInt(prefix+"timeout_seconds")) * time.Second,
+			batchSize:  configuration.GetInt(prefix + "batch_size"),
+			batchWait:  time.Duration(configuration.GetInt(prefix+"batch_wait_ms")) * time.Millisecond,
+			maxRetries: configuration.GetInt(prefix + "max_retries"),
+			queueSize:  configuration.GetInt(prefix + "queue_size"),
+			spoolDir:   util.ResolvePath(configuration.GetString(prefix + "spool_dir")),
+		},
+	)
+}
+
+func (w *WebhookQueue) initialize(urls []string, pathPrefixes []string, option endpointOption) error {
+	if len(urls) == 0 {
+		return fmt.Errorf("missing webhook endpoints")
+	}
+	w.pathPrefixes = pathPrefixes
+	for _, url := range urls {
+		e, err := newEndpoint(url, option)
+		if err != nil {
+			return err
+		}
+		w.endpoints = append(w.endpoints, e)
+		go e.loop()
+	}
+	return nil
+}
+
+func (w *WebhookQueue) SendMessage(key string, message proto.Message) (err error) {
+	if !w.isIncluded(key) {
+		return nil
+	}
+	m := jsonpb.Marshaler{}
+	text, err := m.MarshalToString(message)
+	if err != nil {
+		return err
+	}
+	return w.SendRawMessage(key, []byte(text))
+}
+
+// SendRawMessage queues the message to all endpoints, the delivery is asynchronous
+func (w *WebhookQueue) SendRawMessage(key string, data []byte) (err error) {
+	if !w.isIncluded(key) {
+		return nil
+	}
+	var errs []string
+	for _, e := range w.endpoints {
+		if err := e.enqueue(key, data); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// isIncluded checks the key, which is the full path of the filer events, against the path prefixes
+func (w *WebhookQueue) isIncluded(key string) bool {
+	if len(w.pathPrefixes) == 0 {
+		return true
+	}
+	for _, prefix := range w.pathPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
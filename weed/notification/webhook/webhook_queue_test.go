@@ -0,0 +1,165 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookBatchAndSign(t *testing.T) {
+	bodies := make(chan *batchBody, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "sha256="+sign([]byte("secret"), r.Header.Get("X-Seaweedfs-Timestamp"), body), r.Header.Get("X-Seaweedfs-Signature"))
+		b := &batchBody{}
+		assert.NoError(t, json.Unmarshal(body, b))
+		bodies <- b
+	}))
+	defer server.Close()
+
+	w := &WebhookQueue{}
+	assert.NoError(t, w.initialize([]string{server.URL}, []string{"/buckets/"}, endpointOption{
+		secret:    []byte("secret"),
+		timeout:   time.Second,
+		batchSize: 2,
+		batchWait: time.Minute,
+		queueSize: 10,
+	}))
+
+	assert.NoError(t, w.SendRawMessage("/buckets/a", []byte(`{"n":1}`)))
+	assert.NoError(t, w.SendRawMessage("/tmp/b", []byte(`{"n":2}`)))
+	assert.NoError(t, w.SendRawMessage("/buckets/c", []byte(`{"n":3}`)))
+
+	select {
+	case b := <-bodies:
+		if assert.Len(t, b.Messages, 2) {
+			assert.Equal(t, "/buckets/a", b.Messages[0].Key)
+			assert.Equal(t, `{"n":3}`, string(b.Messages[1].Message))
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no batch is posted")
+	}
+}
+
+func TestWebhookSpoolReplay(t *testing.T) {
+	available := false
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !available {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		keys = append(keys, r.Header.Get("X-Seaweedfs-Key"))
+	}))
+	defer server.Close()
+
+	e, err := newEndpoint(server.URL, endpointOption{timeout: time.Second, spoolDir: t.TempDir()})
+	assert.NoError(t, err)
+
+	assert.Error(t, e.post("a", []byte(`{}`)))
+	e.hold("a", []byte(`{}`))
+	e.hold("b", []byte(`{}`))
+	assert.False(t, e.retryHeld())
+	assert.Equal(t, 2*minRetryWait, e.retryWait)
+
+	available = true
+	e.nextRetry = time.Time{}
+	assert.True(t, e.retryHeld())
+	assert.Equal(t, minRetryWait, e.retryWait)
+	assert.Equal(t, []string{"a", "b"}, keys)
+
+	name, _, _, err := e.spool.oldest()
+	assert.NoError(t, err)
+	assert.Empty(t, name)
+}
+
+// keyServer records the keys of the posted messages, and fails them while unavailable
+type keyServer struct {
+	sync.Mutex
+	available bool
+	keys      []string
+}
+
+func (s *keyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	if !s.available {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	s.keys = append(s.keys, r.Header.Get("X-Seaweedfs-Key"))
+}
+
+func (s *keyServer) setAvailable(available bool) {
+	s.Lock()
+	defer s.Unlock()
+	s.available = available
+}
+
+func (s *keyServer) waitForKeys(t *testing.T, count int) []string {
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); time.Sleep(50 * time.Millisecond) {
+		s.Lock()
+		keys := append([]string(nil), s.keys...)
+		s.Unlock()
+		if len(keys) >= count {
+			return keys
+		}
+	}
+	t.Fatalf("expected %d delivered messages", count)
+	return nil
+}
+
+func TestWebhookQueueFullSpills(t *testing.T) {
+	ks := &keyServer{}
+	server := httptest.NewServer(ks)
+	defer server.Close()
+
+	// the endpoint is not consuming the queue, so the later messages overflow
+	e, err := newEndpoint(server.URL, endpointOption{timeout: time.Second, queueSize: 2, spoolDir: t.TempDir()})
+	assert.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, e.enqueue(fmt.Sprintf("/m%d", i), []byte(`{}`)))
+	}
+	_, key, _, err := e.spool.oldest()
+	assert.NoError(t, err)
+	assert.Equal(t, "/m2", key)
+
+	ks.setAvailable(true)
+	go e.loop()
+	assert.ElementsMatch(t, []string{"/m0", "/m1", "/m2", "/m3", "/m4"}, ks.waitForKeys(t, 5))
+
+	// without a spool, the messages are dropped when the queue is full
+	e, err = newEndpoint(server.URL, endpointOption{timeout: time.Second, queueSize: 1})
+	assert.NoError(t, err)
+	assert.NoError(t, e.enqueue("/a", []byte(`{}`)))
+	assert.Error(t, e.enqueue("/b", []byte(`{}`)))
+}
+
+func TestWebhookRetryDoesNotHoldQueue(t *testing.T) {
+	ks := &keyServer{}
+	server := httptest.NewServer(ks)
+	defer server.Close()
+
+	e, err := newEndpoint(server.URL, endpointOption{timeout: time.Second, queueSize: 3, maxRetries: 100})
+	assert.NoError(t, err)
+	go e.loop()
+
+	// the queue keeps being drained while the failed requests wait for their retries
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		assert.NoError(t, e.enqueue(fmt.Sprintf("/m%d", i), []byte(`{}`)))
+		time.Sleep(20 * time.Millisecond)
+	}
+	assert.Less(t, int64(time.Since(start)), int64(minRetryWait))
+
+	// the oldest held requests are dropped beyond the queue size, and the others are delivered in order
+	ks.setAvailable(true)
+	assert.Equal(t, []string{"/m3", "/m4", "/m5"}, ks.waitForKeys(t, 3))
+}
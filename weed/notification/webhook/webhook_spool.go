@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+)
+
+// spool keeps the undelivered requests on disk, one file per request, named by the spooling time.
+// The requests are written by the delivery loop, and by the senders when the queue is full.
+type spool struct {
+	dir       string
+	writeLock sync.Mutex
+	lastTsNs  int64
+}
+
+type spooledRequest struct {
+	Key  string          `json:"key,omitempty"`
+	Body json.RawMessage `json:"body"`
+}
+
+func newSpool(dir string) (*spool, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &spool{dir: dir}, nil
+}
+
+func (s *spool) write(key string, body []byte) error {
+	data, err := json.Marshal(&spooledRequest{Key: key, Body: body})
+	if err != nil {
+		return err
+	}
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	// the names keep the order of the writes
+	tsNs := time.Now().UnixNano()
+	if tsNs <= s.lastTsNs {
+		tsNs = s.lastTsNs + 1
+	}
+	s.lastTsNs = tsNs
+	name := fmt.Sprintf("%019d.json", tsNs)
+	tmpPath := filepath.Join(s.dir, name+".tmp")
+	if err = os.WriteFile(tmpPath, data, 0644); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, filepath.Join(s.dir, name))
+}
+
+// oldest returns the earliest spooled request, or an empty name if the spool is empty
+func (s *spool) oldest() (name, key string, body []byte, err error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		data, readErr := os.ReadFile(filepath.Join(s.dir, entry.Name()))
+		if readErr != nil {
+			return "", "", nil, readErr
+		}
+		request := &spooledRequest{}
+		if err = json.Unmarshal(data, request); err != nil {
+			// set the broken request aside, so that it does not block the later ones
+			glog.Errorf("webhook spool %s/%s: %v", s.dir, entry.Name(), err)
+			if err = os.Rename(filepath.Join(s.dir, entry.Name()), filepath.Join(s.dir, entry.Name()+".bad")); err != nil {
+				return "", "", nil, err
+			}
+			continue
+		}
+		return entry.Name(), request.Key, request.Body, nil
+	}
+	return
+}
+
+func (s *spool) remove(name string) error {
+	return os.Remove(filepath.Join(s.dir, name))
+}
@@ -25,6 +25,8 @@ type SeaweedFilerClient interface {
 	AppendToEntry(ctx context.Context, in *AppendToEntryRequest, opts ...grpc.CallOption) (*AppendToEntryResponse, error)
 	DeleteEntry(ctx context.Context, in *DeleteEntryRequest, opts ...grpc.CallOption) (*DeleteEntryResponse, error)
 	AtomicRenameEntry(ctx context.Context, in *AtomicRenameEntryRequest, opts ...grpc.CallOption) (*AtomicRenameEntryResponse, error)
+	CopyFileRange(ctx context.Context, in *CopyFileRangeRequest, opts ...grpc.CallOption) (*CopyFileRangeResponse, error)
+	UpdateChunkReferences(ctx context.Context, in *UpdateChunkReferencesRequest, opts ...grpc.CallOption) (*UpdateChunkReferencesResponse, error)
 	StreamRenameEntry(ctx context.Context, in *StreamRenameEntryRequest, opts ...grpc.CallOption) (SeaweedFiler_StreamRenameEntryClient, error)
 	AssignVolume(ctx context.Context, in *AssignVolumeRequest, opts ...grpc.CallOption) (*AssignVolumeResponse, error)
 	LookupVolume(ctx context.Context, in *LookupVolumeRequest, opts ...grpc.CallOption) (*LookupVolumeResponse, error)
@@ -39,6 +41,11 @@ type SeaweedFilerClient interface {
 	KvGet(ctx context.Context, in *KvGetRequest, opts ...grpc.CallOption) (*KvGetResponse, error)
 	KvPut(ctx context.Context, in *KvPutRequest, opts ...grpc.CallOption) (*KvPutResponse, error)
 	CacheRemoteObjectToLocalCluster(ctx context.Context, in *CacheRemoteObjectToLocalClusterRequest, opts ...grpc.CallOption) (*CacheRemoteObjectToLocalClusterResponse, error)
+	GetFileLock(ctx context.Context, in *GetFileLockRequest, opts ...grpc.CallOption) (*GetFileLockResponse, error)
+	SetFileLock(ctx context.Context, in *SetFileLockRequest, opts ...grpc.CallOption) (*SetFileLockResponse, error)
+	RenewFileLockLease(ctx context.Context, in *RenewFileLockLeaseRequest, opts ...grpc.CallOption) (*RenewFileLockLeaseResponse, error)
+	ReleaseFileLocks(ctx context.Context, in *ReleaseFileLocksRequest, opts ...grpc.CallOption) (*ReleaseFileLocksResponse, error)
+	RewrapCipherKeys(ctx context.Context, in *RewrapCipherKeysRequest, opts ...grpc.CallOption) (*RewrapCipherKeysResponse, error)
 }
 
 type seaweedFilerClient struct {
@@ -135,6 +142,24 @@ func (c *seaweedFilerClient) AtomicRenameEntry(ctx context.Context, in *AtomicRe
 	return out, nil
 }
 
+func (c *seaweedFilerClient) CopyFileRange(ctx context.Context, in *CopyFileRangeRequest, opts ...grpc.CallOption) (*CopyFileRangeResponse, error) {
+	out := new(CopyFileRangeResponse)
+	err := c.cc.Invoke(ctx, "/filer_pb.SeaweedFiler/CopyFileRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) UpdateChunkReferences(ctx context.Context, in *UpdateChunkReferencesRequest, opts ...grpc.CallOption) (*UpdateChunkReferencesResponse, error) {
+	out := new(UpdateChunkReferencesResponse)
+	err := c.cc.Invoke(ctx, "/filer_pb.SeaweedFiler/UpdateChunkReferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *seaweedFilerClient) StreamRenameEntry(ctx context.Context, in *StreamRenameEntryRequest, opts ...grpc.CallOption) (SeaweedFiler_StreamRenameEntryClient, error) {
 	stream, err := c.cc.NewStream(ctx, &SeaweedFiler_ServiceDesc.Streams[1], "/filer_pb.SeaweedFiler/StreamRenameEntry", opts...)
 	if err != nil {
@@ -352,6 +377,51 @@ func (c *seaweedFilerClient) CacheRemoteObjectToLocalCluster(ctx context.Context
 	return out, nil
 }
 
+func (c *seaweedFilerClient) GetFileLock(ctx context.Context, in *GetFileLockRequest, opts ...grpc.CallOption) (*GetFileLockResponse, error) {
+	out := new(GetFileLockResponse)
+	err := c.cc.Invoke(ctx, "/filer_pb.SeaweedFiler/GetFileLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) SetFileLock(ctx context.Context, in *SetFileLockRequest, opts ...grpc.CallOption) (*SetFileLockResponse, error) {
+	out := new(SetFileLockResponse)
+	err := c.cc.Invoke(ctx, "/filer_pb.SeaweedFiler/SetFileLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) RenewFileLockLease(ctx context.Context, in *RenewFileLockLeaseRequest, opts ...grpc.CallOption) (*RenewFileLockLeaseResponse, error) {
+	out := new(RenewFileLockLeaseResponse)
+	err := c.cc.Invoke(ctx, "/filer_pb.SeaweedFiler/RenewFileLockLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) ReleaseFileLocks(ctx context.Context, in *ReleaseFileLocksRequest, opts ...grpc.CallOption) (*ReleaseFileLocksResponse, error) {
+	out := new(ReleaseFileLocksResponse)
+	err := c.cc.Invoke(ctx, "/filer_pb.SeaweedFiler/ReleaseFileLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) RewrapCipherKeys(ctx context.Context, in *RewrapCipherKeysRequest, opts ...grpc.CallOption) (*RewrapCipherKeysResponse, error) {
+	out := new(RewrapCipherKeysResponse)
+	err := c.cc.Invoke(ctx, "/filer_pb.SeaweedFiler/RewrapCipherKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SeaweedFilerServer is the server API for SeaweedFiler service.
 // All implementations must embed UnimplementedSeaweedFilerServer
 // for forward compatibility
@@ -363,6 +433,8 @@ type SeaweedFilerServer interface {
 	AppendToEntry(context.Context, *AppendToEntryRequest) (*AppendToEntryResponse, error)
 	DeleteEntry(context.Context, *DeleteEntryRequest) (*DeleteEntryResponse, error)
 	AtomicRenameEntry(context.Context, *AtomicRenameEntryRequest) (*AtomicRenameEntryResponse, error)
+	CopyFileRange(context.Context, *CopyFileRangeRequest) (*CopyFileRangeResponse, error)
+	UpdateChunkReferences(context.Context, *UpdateChunkReferencesRequest) (*UpdateChunkReferencesResponse, error)
 	StreamRenameEntry(*StreamRenameEntryRequest, SeaweedFiler_StreamRenameEntryServer) error
 	AssignVolume(context.Context, *AssignVolumeRequest) (*AssignVolumeResponse, error)
 	LookupVolume(context.Context, *LookupVolumeRequest) (*LookupVolumeResponse, error)
@@ -377,6 +449,11 @@ type SeaweedFilerServer interface {
 	KvGet(context.Context, *KvGetRequest) (*KvGetResponse, error)
 	KvPut(context.Context, *KvPutRequest) (*KvPutResponse, error)
 	CacheRemoteObjectToLocalCluster(context.Context, *CacheRemoteObjectToLocalClusterRequest) (*CacheRemoteObjectToLocalClusterResponse, error)
+	GetFileLock(context.Context, *GetFileLockRequest) (*GetFileLockResponse, error)
+	SetFileLock(context.Context, *SetFileLockRequest) (*SetFileLockResponse, error)
+	RenewFileLockLease(context.Context, *RenewFileLockLeaseRequest) (*RenewFileLockLeaseResponse, error)
+	ReleaseFileLocks(context.Context, *ReleaseFileLocksRequest) (*ReleaseFileLocksResponse, error)
+	RewrapCipherKeys(context.Context, *RewrapCipherKeysRequest) (*RewrapCipherKeysResponse, error)
 	mustEmbedUnimplementedSeaweedFilerServer()
 }
 
@@ -405,6 +482,12 @@ func (UnimplementedSeaweedFilerServer) DeleteEntry(context.Context, *DeleteEntry
 func (UnimplementedSeaweedFilerServer) AtomicRenameEntry(context.Context, *AtomicRenameEntryRequest) (*AtomicRenameEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AtomicRenameEntry not implemented")
 }
+func (UnimplementedSeaweedFilerServer) CopyFileRange(context.Context, *CopyFileRangeRequest) (*CopyFileRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyFileRange not implemented")
+}
+func (UnimplementedSeaweedFilerServer) UpdateChunkReferences(context.Context, *UpdateChunkReferencesRequest) (*UpdateChunkReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChunkReferences not implemented")
+}
 func (UnimplementedSeaweedFilerServer) StreamRenameEntry(*StreamRenameEntryRequest, SeaweedFiler_StreamRenameEntryServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamRenameEntry not implemented")
 }
@@ -447,6 +530,21 @@ func (UnimplementedSeaweedFilerServer) KvPut(context.Context, *KvPutRequest) (*K
 func (UnimplementedSeaweedFilerServer) CacheRemoteObjectToLocalCluster(context.Context, *CacheRemoteObjectToLocalClusterRequest) (*CacheRemoteObjectToLocalClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CacheRemoteObjectToLocalCluster not implemented")
 }
+func (UnimplementedSeaweedFilerServer) GetFileLock(context.Context, *GetFileLockRequest) (*GetFileLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileLock not implemented")
+}
+func (UnimplementedSeaweedFilerServer) SetFileLock(context.Context, *SetFileLockRequest) (*SetFileLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFileLock not implemented")
+}
+func (UnimplementedSeaweedFilerServer) RenewFileLockLease(context.Context, *RenewFileLockLeaseRequest) (*RenewFileLockLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewFileLockLease not implemented")
+}
+func (UnimplementedSeaweedFilerServer) ReleaseFileLocks(context.Context, *ReleaseFileLocksRequest) (*ReleaseFileLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseFileLocks not implemented")
+}
+func (UnimplementedSeaweedFilerServer) RewrapCipherKeys(context.Context, *RewrapCipherKeysRequest) (*RewrapCipherKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewrapCipherKeys not implemented")
+}
 func (UnimplementedSeaweedFilerServer) mustEmbedUnimplementedSeaweedFilerServer() {}
 
 // UnsafeSeaweedFilerServer may be embedded to opt out of forward compatibility for this service.
@@ -589,6 +687,42 @@ func _SeaweedFiler_AtomicRenameEntry_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SeaweedFiler_CopyFileRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyFileRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).CopyFileRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/CopyFileRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).CopyFileRange(ctx, req.(*CopyFileRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_UpdateChunkReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChunkReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).UpdateChunkReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/UpdateChunkReferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).UpdateChunkReferences(ctx, req.(*UpdateChunkReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SeaweedFiler_StreamRenameEntry_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamRenameEntryRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -858,6 +992,96 @@ func _SeaweedFiler_CacheRemoteObjectToLocalCluster_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SeaweedFiler_GetFileLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).GetFileLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/GetFileLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).GetFileLock(ctx, req.(*GetFileLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_SetFileLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFileLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).SetFileLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/SetFileLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).SetFileLock(ctx, req.(*SetFileLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_RenewFileLockLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewFileLockLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).RenewFileLockLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/RenewFileLockLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).RenewFileLockLease(ctx, req.(*RenewFileLockLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_ReleaseFileLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseFileLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).ReleaseFileLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/ReleaseFileLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).ReleaseFileLocks(ctx, req.(*ReleaseFileLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_RewrapCipherKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewrapCipherKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).RewrapCipherKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/RewrapCipherKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).RewrapCipherKeys(ctx, req.(*RewrapCipherKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SeaweedFiler_ServiceDesc is the grpc.ServiceDesc for SeaweedFiler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -889,6 +1113,14 @@ var SeaweedFiler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AtomicRenameEntry",
 			Handler:    _SeaweedFiler_AtomicRenameEntry_Handler,
 		},
+		{
+			MethodName: "CopyFileRange",
+			Handler:    _SeaweedFiler_CopyFileRange_Handler,
+		},
+		{
+			MethodName: "UpdateChunkReferences",
+			Handler:    _SeaweedFiler_UpdateChunkReferences_Handler,
+		},
 		{
 			MethodName: "AssignVolume",
 			Handler:    _SeaweedFiler_AssignVolume_Handler,
@@ -929,6 +1161,26 @@ var SeaweedFiler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CacheRemoteObjectToLocalCluster",
 			Handler:    _SeaweedFiler_CacheRemoteObjectToLocalCluster_Handler,
 		},
+		{
+			MethodName: "GetFileLock",
+			Handler:    _SeaweedFiler_GetFileLock_Handler,
+		},
+		{
+			MethodName: "SetFileLock",
+			Handler:    _SeaweedFiler_SetFileLock_Handler,
+		},
+		{
+			MethodName: "RenewFileLockLease",
+			Handler:    _SeaweedFiler_RenewFileLockLease_Handler,
+		},
+		{
+			MethodName: "ReleaseFileLocks",
+			Handler:    _SeaweedFiler_ReleaseFileLocks_Handler,
+		},
+		{
+			MethodName: "RewrapCipherKeys",
+			Handler:    _SeaweedFiler_RewrapCipherKeys_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
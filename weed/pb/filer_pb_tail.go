@@ -2,6 +2,7 @@ package pb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
@@ -12,24 +13,28 @@ import (
 
 type ProcessMetadataFunc func(resp *filer_pb.SubscribeMetadataResponse) error
 
+// ErrStopFollowing is returned by a ProcessMetadataFunc to stop following, without moving past the current event
+var ErrStopFollowing = errors.New("stop following metadata")
+
 func FollowMetadata(filerAddress ServerAddress, grpcDialOption grpc.DialOption, clientName string, clientId int32,
 	pathPrefix string, additionalPathPrefixes []string, lastTsNs int64, selfSignature int32,
 	processEventFn ProcessMetadataFunc, fatalOnError bool) error {
 
 	err := WithFilerClient(true, filerAddress, grpcDialOption, makeFunc(clientName, clientId,
-		pathPrefix, additionalPathPrefixes, &lastTsNs, selfSignature, processEventFn, fatalOnError))
+		pathPrefix, additionalPathPrefixes, nil, &lastTsNs, selfSignature, processEventFn, fatalOnError))
 	if err != nil {
 		return fmt.Errorf("subscribing filer meta change: %v", err)
 	}
 	return err
 }
 
+// WithFilerClientFollowMetadata follows the changes under the path prefix, and of the entries directly in the directories
 func WithFilerClientFollowMetadata(filerClient filer_pb.FilerClient,
-	clientName string, clientId int32, pathPrefix string, lastTsNs *int64, selfSignature int32,
+	clientName string, clientId int32, pathPrefix string, directories []string, lastTsNs *int64, selfSignature int32,
 	processEventFn ProcessMetadataFunc, fatalOnError bool) error {
 
 	err := filerClient.WithFilerClient(true, makeFunc(clientName, clientId,
-		pathPrefix, nil, lastTsNs, selfSignature, processEventFn, fatalOnError))
+		pathPrefix, nil, directories, lastTsNs, selfSignature, processEventFn, fatalOnError))
 	if err != nil {
 		return fmt.Errorf("subscribing filer meta change: %v", err)
 	}
@@ -37,7 +42,7 @@ func WithFilerClientFollowMetadata(filerClient filer_pb.FilerClient,
 	return nil
 }
 
-func makeFunc(clientName string, clientId int32, pathPrefix string, additionalPathPrefixes []string, lastTsNs *int64, selfSignature int32,
+func makeFunc(clientName string, clientId int32, pathPrefix string, additionalPathPrefixes []string, directories []string, lastTsNs *int64, selfSignature int32,
 	processEventFn ProcessMetadataFunc, fatalOnError bool) func(client filer_pb.SeaweedFilerClient) error {
 	return func(client filer_pb.SeaweedFilerClient) error {
 		ctx, cancel := context.WithCancel(context.Background())
@@ -46,6 +51,7 @@ func makeFunc(clientName string, clientId int32, pathPrefix string, additionalPa
 			ClientName:   clientName,
 			PathPrefix:   pathPrefix,
 			PathPrefixes: additionalPathPrefixes,
+			Directories:  directories,
 			SinceNs:      *lastTsNs,
 			Signature:    selfSignature,
 			ClientId:     clientId,
@@ -64,6 +70,9 @@ func makeFunc(clientName string, clientId int32, pathPrefix string, additionalPa
 			}
 
 			if err := processEventFn(resp); err != nil {
+				if errors.Is(err, ErrStopFollowing) {
+					return err
+				}
 				if fatalOnError {
 					glog.Fatalf("process %v: %v", resp, err)
 				} else {
@@ -0,0 +1,108 @@
+package csv
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/match"
+)
+
+type Query struct {
+	Field string
+	Op    string
+	Value string
+}
+
+// QueryCsv filters the record, and returns the projected fields, or all the fields without projections.
+// The fields are named by the header, or by the position as _1, _2, ...
+func QueryCsv(header, record []string, projections []string, query Query) (passedFilter bool, values []string) {
+	if query.Field != "" && !filterCsv(header, record, query) {
+		return false, nil
+	}
+	if len(projections) == 0 {
+		return true, record
+	}
+	for _, projection := range projections {
+		value, _ := fieldValue(header, record, projection)
+		values = append(values, value)
+	}
+	return true, values
+}
+
+// fieldValue looks up the field by the header name, falling back to a case insensitive match, or by the position
+func fieldValue(header, record []string, name string) (string, bool) {
+	index := -1
+	for i, h := range header {
+		if h == name {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		for i, h := range header {
+			if strings.EqualFold(h, name) {
+				index = i
+				break
+			}
+		}
+	}
+	if index < 0 && strings.HasPrefix(name, "_") {
+		if position, err := strconv.Atoi(name[1:]); err == nil {
+			index = position - 1
+		}
+	}
+	if index < 0 || index >= len(record) {
+		return "", false
+	}
+	return record[index], true
+}
+
+// filterCsv compares as numbers if both sides are numbers, and as strings otherwise
+func filterCsv(header, record []string, query Query) bool {
+
+	value, found := fieldValue(header, record, query.Field)
+	if !found {
+		return false
+	}
+	if query.Op == "" {
+		return true
+	}
+	rpv := query.Value
+
+	switch query.Op {
+	case "%":
+		return match.Match(value, rpv)
+	case "!%":
+		return !match.Match(value, rpv)
+	}
+
+	var cmp int
+	vn, verr := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	rpvn, rerr := strconv.ParseFloat(strings.TrimSpace(rpv), 64)
+	switch {
+	case verr == nil && rerr == nil && vn < rpvn:
+		cmp = -1
+	case verr == nil && rerr == nil && vn > rpvn:
+		cmp = 1
+	case verr == nil && rerr == nil:
+		cmp = 0
+	default:
+		cmp = strings.Compare(value, rpv)
+	}
+
+	switch query.Op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package csv
+
+import (
+	"io"
+	"reflect"
+	"testing"
+)
+
+func readAll(t *testing.T, r *Reader) (records [][]string) {
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			t.Fatalf("read: %v", err)
+		}
+		records = append(records, record)
+	}
+}
+
+func TestReader(t *testing.T) {
+	data := "# comment\r\nname,city,age\r\n\"Smith, John\",\"New \"\"York\"\"\",42\r\n\r\nDoe,Paris,7"
+
+	r := NewReader([]byte(data), Input{FileHeaderInfo: "USE"})
+	records := readAll(t, r)
+	if !reflect.DeepEqual(r.Header, []string{"name", "city", "age"}) {
+		t.Errorf("header: %v", r.Header)
+	}
+	expected := [][]string{
+		{"Smith, John", `New "York"`, "42"},
+		{"Doe", "Paris", "7"},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("records: %q", records)
+	}
+}
+
+func TestReaderCustomFormat(t *testing.T) {
+	data := "a|'b\\'c'|'d;e';x|y|z;"
+
+	records := readAll(t, NewReader([]byte(data), Input{
+		RecordDelimiter:            ";",
+		FieldDelimiter:             "|",
+		QuoteCharacter:             "'",
+		QuoteEscapeCharacter:       "\\",
+		AllowQuotedRecordDelimiter: true,
+	}))
+	expected := [][]string{
+		{"a", "b'c", "d;e"},
+		{"x", "y", "z"},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("records: %q", records)
+	}
+
+	records = readAll(t, NewReader([]byte("\"a\nb\"\nc\n"), Input{}))
+	expected = [][]string{{"a"}, {"b"}, {"c"}}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("quoted record delimiter not allowed: %q", records)
+	}
+}
+
+func TestQueryCsv(t *testing.T) {
+	header := []string{"name", "age"}
+	record := []string{"John", "42"}
+
+	tests := []struct {
+		query    Query
+		expected bool
+	}{
+		{Query{}, true},
+		{Query{Field: "age", Op: ">", Value: "9"}, true},
+		{Query{Field: "age", Op: "<", Value: "100"}, true},
+		{Query{Field: "_2", Op: "=", Value: "42.0"}, true},
+		{Query{Field: "NAME", Op: "%", Value: "J*"}, true},
+		{Query{Field: "name", Op: "!=", Value: "John"}, false},
+		{Query{Field: "missing", Op: "", Value: ""}, false},
+	}
+	for _, tt := range tests {
+		if passed, _ := QueryCsv(header, record, nil, tt.query); passed != tt.expected {
+			t.Errorf("%+v: expected %v", tt.query, tt.expected)
+		}
+	}
+
+	_, values := QueryCsv(header, record, []string{"age", "_1", "_3"}, Query{})
+	if !reflect.DeepEqual(values, []string{"42", "John", ""}) {
+		t.Errorf("projection: %q", values)
+	}
+}
+
+func TestToCsv(t *testing.T) {
+	buf := ToCsv(nil, []string{"a", "b,c", `d"e`}, Output{})
+	if string(buf) != "a,\"b,c\",\"d\"\"e\"\n" {
+		t.Errorf("as needed: %q", buf)
+	}
+	buf = ToCsv(nil, []string{"a", "b"}, Output{QuoteFields: "ALWAYS", FieldDelimiter: "\t", RecordDelimiter: "\r\n"})
+	if string(buf) != "\"a\"\t\"b\"\r\n" {
+		t.Errorf("always: %q", buf)
+	}
+}
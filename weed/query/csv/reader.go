@@ -0,0 +1,175 @@
+package csv
+
+import (
+	"bytes"
+	"io"
+	"strings"
+)
+
+// Input describes the csv format, the same as the S3 Select CSVInput
+type Input struct {
+	FileHeaderInfo             string // NONE | USE | IGNORE
+	RecordDelimiter            string // Default: \n
+	FieldDelimiter             string // Default: ,
+	QuoteCharacter             string // Default: "
+	QuoteEscapeCharacter       string // Default: "
+	Comments                   string // Default: #
+	AllowQuotedRecordDelimiter bool
+}
+
+// Reader splits the csv data into records.
+// The quote and delimiter settings can be any strings, which encoding/csv does not support.
+type Reader struct {
+	data []byte
+	pos  int
+
+	recordDelimiter            []byte
+	fieldDelimiter             []byte
+	quote                      []byte
+	quoteEscape                []byte
+	comments                   []byte
+	allowQuotedRecordDelimiter bool
+
+	fileHeaderInfo string
+	headerRead     bool
+	Header         []string
+}
+
+func NewReader(data []byte, input Input) *Reader {
+	r := &Reader{
+		data:                       data,
+		recordDelimiter:            []byte(withDefault(input.RecordDelimiter, "\n")),
+		fieldDelimiter:             []byte(withDefault(input.FieldDelimiter, ",")),
+		quote:                      []byte(withDefault(input.QuoteCharacter, `"`)),
+		comments:                   []byte(withDefault(input.Comments, "#")),
+		allowQuotedRecordDelimiter: input.AllowQuotedRecordDelimiter,
+		fileHeaderInfo:             strings.ToUpper(input.FileHeaderInfo),
+	}
+	r.quoteEscape = []byte(withDefault(input.QuoteEscapeCharacter, string(r.quote)))
+	return r
+}
+
+func withDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+// Read returns the next record, skipping the comments, the empty lines, and the header line.
+// It returns io.EOF at the end of the data.
+func (r *Reader) Read() (fields []string, err error) {
+	for {
+		fields, err = r.readRecord()
+		if err != nil {
+			return nil, err
+		}
+		if r.headerRead || r.fileHeaderInfo != "USE" && r.fileHeaderInfo != "IGNORE" {
+			return fields, nil
+		}
+		r.headerRead = true
+		if r.fileHeaderInfo == "USE" {
+			r.Header = fields
+		}
+	}
+}
+
+// HeaderDone is true once the header line, if the input has one, has been read
+func (r *Reader) HeaderDone() bool {
+	return r.headerRead || r.fileHeaderInfo != "USE" && r.fileHeaderInfo != "IGNORE"
+}
+
+func (r *Reader) hasPrefix(prefix []byte) bool {
+	return len(prefix) > 0 && bytes.HasPrefix(r.data[r.pos:], prefix)
+}
+
+// atRecordDelimiter also accepts "\r\n" for the default "\n" delimiter, and returns the delimiter length
+func (r *Reader) atRecordDelimiter() int {
+	if r.hasPrefix(r.recordDelimiter) {
+		return len(r.recordDelimiter)
+	}
+	if string(r.recordDelimiter) == "\n" && bytes.HasPrefix(r.data[r.pos:], []byte("\r\n")) {
+		return 2
+	}
+	return 0
+}
+
+func (r *Reader) readRecord() ([]string, error) {
+	for {
+		if r.pos >= len(r.data) {
+			return nil, io.EOF
+		}
+		if r.hasPrefix(r.comments) {
+			r.skipRecord()
+			continue
+		}
+		if n := r.atRecordDelimiter(); n > 0 {
+			// empty line
+			r.pos += n
+			continue
+		}
+		return r.readFields(), nil
+	}
+}
+
+func (r *Reader) skipRecord() {
+	for r.pos < len(r.data) {
+		if n := r.atRecordDelimiter(); n > 0 {
+			r.pos += n
+			return
+		}
+		r.pos++
+	}
+}
+
+func (r *Reader) readFields() (fields []string) {
+	var field []byte
+	inQuote := false
+	for r.pos < len(r.data) {
+		if inQuote {
+			if !bytes.Equal(r.quoteEscape, r.quote) && r.hasPrefix(r.quoteEscape) && bytes.HasPrefix(r.data[r.pos+len(r.quoteEscape):], r.quote) {
+				field = append(field, r.quote...)
+				r.pos += len(r.quoteEscape) + len(r.quote)
+				continue
+			}
+			if r.hasPrefix(r.quote) {
+				r.pos += len(r.quote)
+				if bytes.Equal(r.quoteEscape, r.quote) && r.hasPrefix(r.quote) {
+					// doubled quote
+					field = append(field, r.quote...)
+					r.pos += len(r.quote)
+					continue
+				}
+				inQuote = false
+				continue
+			}
+			if !r.allowQuotedRecordDelimiter {
+				if n := r.atRecordDelimiter(); n > 0 {
+					r.pos += n
+					return append(fields, string(field))
+				}
+			}
+			field = append(field, r.data[r.pos])
+			r.pos++
+			continue
+		}
+		if n := r.atRecordDelimiter(); n > 0 {
+			r.pos += n
+			return append(fields, string(field))
+		}
+		if r.hasPrefix(r.fieldDelimiter) {
+			fields = append(fields, string(field))
+			field = nil
+			r.pos += len(r.fieldDelimiter)
+			continue
+		}
+		if r.hasPrefix(r.quote) {
+			inQuote = true
+			r.pos += len(r.quote)
+			continue
+		}
+		field = append(field, r.data[r.pos])
+		r.pos++
+	}
+	return append(fields, string(field))
+}
@@ -0,0 +1,46 @@
+package csv
+
+import (
+	"strings"
+)
+
+// Output describes the csv format, the same as the S3 Select CSVOutput
+type Output struct {
+	QuoteFields          string // ALWAYS | ASNEEDED
+	RecordDelimiter      string // Default: \n
+	FieldDelimiter       string // Default: ,
+	QuoteCharacter       string // Default: "
+	QuoteEscapeCharacter string // Default: "
+}
+
+// ToCsv appends one record, ending with the record delimiter
+func ToCsv(buf []byte, values []string, output Output) []byte {
+	recordDelimiter := withDefault(output.RecordDelimiter, "\n")
+	fieldDelimiter := withDefault(output.FieldDelimiter, ",")
+	quote := withDefault(output.QuoteCharacter, `"`)
+	quoteEscape := withDefault(output.QuoteEscapeCharacter, quote)
+	always := strings.ToUpper(output.QuoteFields) == "ALWAYS"
+
+	for i, value := range values {
+		if i > 0 {
+			buf = append(buf, fieldDelimiter...)
+		}
+		if !always && !needsQuote(value, fieldDelimiter, recordDelimiter, quote) {
+			buf = append(buf, value...)
+			continue
+		}
+		buf = append(buf, quote...)
+		buf = append(buf, strings.ReplaceAll(value, quote, quoteEscape+quote)...)
+		buf = append(buf, quote...)
+	}
+	buf = append(buf, recordDelimiter...)
+	return buf
+}
+
+func needsQuote(value, fieldDelimiter, recordDelimiter, quote string) bool {
+	return strings.Contains(value, fieldDelimiter) ||
+		strings.Contains(value, recordDelimiter) ||
+		strings.Contains(value, quote) ||
+		strings.ContainsAny(value, "\r\n") ||
+		strings.TrimSpace(value) != value
+}
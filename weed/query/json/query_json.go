@@ -14,8 +14,9 @@ type Query struct {
 	Value string
 }
 
+// QueryJson filters the json line, and returns the projected fields. A query without a field passes all lines.
 func QueryJson(jsonLine string, projections []string, query Query) (passedFilter bool, values []sqltypes.Value) {
-	if filterJson(jsonLine, query) {
+	if query.Field == "" || filterJson(jsonLine, query) {
 		passedFilter = true
 		fields := gjson.GetMany(jsonLine, projections...)
 		for _, f := range fields {
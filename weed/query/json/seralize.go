@@ -1,6 +1,10 @@
 package json
 
-import "github.com/chrislusf/seaweedfs/weed/query/sqltypes"
+import (
+	stdjson "encoding/json"
+
+	"github.com/chrislusf/seaweedfs/weed/query/sqltypes"
+)
 
 func ToJson(buf []byte, selections []string, values []sqltypes.Value) []byte {
 	buf = append(buf, '{')
@@ -8,10 +12,16 @@ func ToJson(buf []byte, selections []string, values []sqltypes.Value) []byte {
 		if i > 0 {
 			buf = append(buf, ',')
 		}
-		buf = append(buf, selections[i]...)
+		buf = ToJsonString(buf, selections[i])
 		buf = append(buf, ':')
 		buf = append(buf, value.Raw()...)
 	}
 	buf = append(buf, '}')
 	return buf
 }
+
+// ToJsonString appends the text as a quoted json string
+func ToJsonString(buf []byte, s string) []byte {
+	quoted, _ := stdjson.Marshal(s)
+	return append(buf, quoted...)
+}
@@ -0,0 +1,81 @@
+package s3api
+
+import (
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+)
+
+func (iam *IdentityAccessManagement) setBucketAcl(bucket string, acp *AccessControlPolicy) {
+	iam.m.Lock()
+	defer iam.m.Unlock()
+	if acp == nil {
+		delete(iam.bucketAcls, bucket)
+		return
+	}
+	if iam.bucketAcls == nil {
+		iam.bucketAcls = make(map[string]*AccessControlPolicy)
+	}
+	iam.bucketAcls[bucket] = acp
+}
+
+func (iam *IdentityAccessManagement) getBucketAcl(bucket string) *AccessControlPolicy {
+	iam.m.RLock()
+	defer iam.m.RUnlock()
+	return iam.bucketAcls[bucket]
+}
+
+// loadBucketAcl refreshes the cached ACL from the bucket entry, nil entry means the bucket is gone
+func (iam *IdentityAccessManagement) loadBucketAcl(bucket string, entry *filer_pb.Entry) {
+	var data []byte
+	if entry != nil {
+		data = entry.Extended[xhttp.AmzAcl]
+	}
+	if len(data) == 0 {
+		iam.setBucketAcl(bucket, nil)
+		return
+	}
+	acp, err := parseAccessControlPolicy(data)
+	if err != nil {
+		glog.Warningf("bucket %s has invalid acl: %v", bucket, err)
+		iam.setBucketAcl(bucket, nil)
+		return
+	}
+	iam.setBucketAcl(bucket, acp)
+}
+
+// isAclGranted checks the request against the object ACL for object reads and object ACL operations,
+// and against the bucket ACL for the others. Requests without signatures only match the AllUsers group.
+func (iam *IdentityAccessManagement) isAclGranted(r *http.Request, identity *Identity, bucket, object, s3Action string) bool {
+	if bucket == "" {
+		return false
+	}
+	permission, onObject := aclPermissionOf(s3Action)
+	if permission == "" {
+		return false
+	}
+
+	var identityId string
+	isAuthenticated := r.Header.Get(xhttp.AmzAuthType) != "Anonymous"
+	if identity != nil && isAuthenticated {
+		identityId = identity.Name
+	}
+
+	var acp *AccessControlPolicy
+	if onObject {
+		if iam.objectAclLoader != nil {
+			acp = iam.objectAclLoader(bucket, object, r.URL.Query().Get("versionId"))
+		}
+	} else {
+		acp = iam.getBucketAcl(bucket)
+	}
+	if acp == nil {
+		return false
+	}
+
+	granted := acp.isGranted(identityId, isAuthenticated, permission)
+	glog.V(3).Infof("acl %s %s%s by %q: %v", permission, bucket, object, identityId, granted)
+	return granted
+}
@@ -0,0 +1,300 @@
+package s3api
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/policy"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+func (iam *IdentityAccessManagement) setBucketPolicy(bucket string, bucketPolicy *policy.BucketPolicy) {
+	iam.m.Lock()
+	defer iam.m.Unlock()
+	if bucketPolicy == nil {
+		delete(iam.bucketPolicies, bucket)
+		return
+	}
+	if iam.bucketPolicies == nil {
+		iam.bucketPolicies = make(map[string]*policy.BucketPolicy)
+	}
+	iam.bucketPolicies[bucket] = bucketPolicy
+}
+
+func (iam *IdentityAccessManagement) getBucketPolicy(bucket string) *policy.BucketPolicy {
+	iam.m.RLock()
+	defer iam.m.RUnlock()
+	return iam.bucketPolicies[bucket]
+}
+
+// loadBucketPolicy refreshes the cached policy from the bucket entry, nil entry means the bucket is gone
+func (iam *IdentityAccessManagement) loadBucketPolicy(bucket string, entry *filer_pb.Entry) {
+	var data []byte
+	if entry != nil {
+		data = entry.Extended[xhttp.AmzBucketPolicy]
+	}
+	if len(data) == 0 {
+		iam.setBucketPolicy(bucket, nil)
+		return
+	}
+	bucketPolicy, err := policy.ParseBucketPolicy(data, bucket)
+	if err != nil {
+		glog.Warningf("bucket %s has invalid policy: %v", bucket, err)
+		iam.setBucketPolicy(bucket, nil)
+		return
+	}
+	iam.setBucketPolicy(bucket, bucketPolicy)
+}
+
+// evalBucketPolicy checks the request against the bucket policy.
+// Admin identities are not restricted by bucket policies, so they can always fix a bad policy.
+func (iam *IdentityAccessManagement) evalBucketPolicy(r *http.Request, identity *Identity, bucket, object, s3Action string) policy.Effect {
+	if bucket == "" {
+		return policy.NoMatch
+	}
+	if identity != nil && identity.isAdmin() {
+		return policy.NoMatch
+	}
+	return iam.evalBucketPolicyAction(r, identity, bucket, object, s3Action)
+}
+
+// checkObjectPolicy evaluates the bucket policy for one object of a request authorized on the bucket,
+// e.g. each key of DeleteObjects. An object level Deny refuses the object, and when only the bucket policy
+// allowed the request, the policy has to allow the object as well.
+func (iam *IdentityAccessManagement) checkObjectPolicy(r *http.Request, bucket, object, s3Action string) s3err.ErrorCode {
+	if !iam.isEnabled() || r.Header.Get(xhttp.AmzIsAdmin) != "" {
+		return s3err.ErrNone
+	}
+	var identity *Identity
+	if identityId := r.Header.Get(xhttp.AmzIdentityId); identityId != "" {
+		identity = &Identity{Name: identityId}
+	}
+	switch iam.evalBucketPolicyAction(r, identity, bucket, object, s3Action) {
+	case policy.Deny:
+		return s3err.ErrAccessDenied
+	case policy.Allow:
+		return s3err.ErrNone
+	}
+	if r.Header.Get(xhttp.AmzIsPolicyAllowed) != "" {
+		return s3err.ErrAccessDenied
+	}
+	return s3err.ErrNone
+}
+
+func (iam *IdentityAccessManagement) evalBucketPolicyAction(r *http.Request, identity *Identity, bucket, object, action string) policy.Effect {
+	bucketPolicy := iam.getBucketPolicy(bucket)
+	if bucketPolicy == nil {
+		return policy.NoMatch
+	}
+	args := &policy.Args{
+		Action:     action,
+		Resource:   policy.ResourceArnPrefix + bucket + object,
+		Conditions: policyConditions(r, identity),
+	}
+	if identity != nil {
+		args.Principal = identity.Name
+	}
+	effect := bucketPolicy.Evaluate(args)
+	glog.V(3).Infof("bucket policy %s %s by %q: %q", args.Action, args.Resource, args.Principal, effect)
+	return effect
+}
+
+// canBypassGovernance checks the permission to bypass the governance retention, when the request asks for it
+func (iam *IdentityAccessManagement) canBypassGovernance(r *http.Request, identity *Identity) bool {
+	if !strings.EqualFold(r.Header.Get(xhttp.AmzBypassGovernanceRetention), "true") {
+		return false
+	}
+	if identity != nil && identity.isAdmin() {
+		return true
+	}
+	bucket, object := xhttp.GetBucketAndObject(r)
+	if bucket == "" {
+		return false
+	}
+	switch iam.evalBucketPolicyAction(r, identity, bucket, object, "s3:BypassGovernanceRetention") {
+	case policy.Deny:
+		return false
+	case policy.Allow:
+		return true
+	}
+	return identity != nil && identity.canDo(s3_constants.ACTION_ADMIN, bucket, object)
+}
+
+// s3ActionOf maps the request to the action name used in bucket policies
+func s3ActionOf(r *http.Request, object string) string {
+	query := r.URL.Query()
+	has := func(key string) bool {
+		_, found := query[key]
+		return found
+	}
+	isObject := object != "" && object != "/"
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		switch {
+		case has("uploadId"):
+			return "s3:ListMultipartUploadParts"
+		case has("uploads"):
+			return "s3:ListBucketMultipartUploads"
+		case has("tagging") && isObject:
+			if has("versionId") {
+				return "s3:GetObjectVersionTagging"
+			}
+			return "s3:GetObjectTagging"
+		case has("acl"):
+			if !isObject {
+				return "s3:GetBucketAcl"
+			}
+			if has("versionId") {
+				return "s3:GetObjectVersionAcl"
+			}
+			return "s3:GetObjectAcl"
+		case has("retention"):
+			return "s3:GetObjectRetention"
+		case has("legal-hold"):
+			return "s3:GetObjectLegalHold"
+		case has("object-lock"):
+			return "s3:GetBucketObjectLockConfiguration"
+		case isObject:
+			if has("versionId") {
+				return "s3:GetObjectVersion"
+			}
+			return "s3:GetObject"
+		case has("policy"):
+			return "s3:GetBucketPolicy"
+		case has("cors"):
+			return "s3:GetBucketCORS"
+		case has("lifecycle"):
+			return "s3:GetLifecycleConfiguration"
+		case has("versioning"):
+			return "s3:GetBucketVersioning"
+		case has("versions"):
+			return "s3:ListBucketVersions"
+		case has("location"):
+			return "s3:GetBucketLocation"
+		case has("tagging"):
+			return "s3:GetBucketTagging"
+		case has("requestPayment"):
+			return "s3:GetBucketRequestPayment"
+		}
+		return "s3:ListBucket"
+	case http.MethodPut:
+		switch {
+		case has("tagging") && isObject:
+			if has("versionId") {
+				return "s3:PutObjectVersionTagging"
+			}
+			return "s3:PutObjectTagging"
+		case has("acl"):
+			if !isObject {
+				return "s3:PutBucketAcl"
+			}
+			if has("versionId") {
+				return "s3:PutObjectVersionAcl"
+			}
+			return "s3:PutObjectAcl"
+		case has("retention"):
+			return "s3:PutObjectRetention"
+		case has("legal-hold"):
+			return "s3:PutObjectLegalHold"
+		case has("object-lock"):
+			return "s3:PutBucketObjectLockConfiguration"
+		case isObject:
+			return "s3:PutObject"
+		case has("policy"):
+			return "s3:PutBucketPolicy"
+		case has("cors"):
+			return "s3:PutBucketCORS"
+		case has("lifecycle"):
+			return "s3:PutLifecycleConfiguration"
+		case has("versioning"):
+			return "s3:PutBucketVersioning"
+		case has("tagging"):
+			return "s3:PutBucketTagging"
+		}
+		return "s3:CreateBucket"
+	case http.MethodDelete:
+		switch {
+		case has("uploadId"):
+			return "s3:AbortMultipartUpload"
+		case has("tagging") && isObject:
+			if has("versionId") {
+				return "s3:DeleteObjectVersionTagging"
+			}
+			return "s3:DeleteObjectTagging"
+		case isObject:
+			if has("versionId") {
+				return "s3:DeleteObjectVersion"
+			}
+			return "s3:DeleteObject"
+		case has("policy"):
+			return "s3:DeleteBucketPolicy"
+		case has("cors"):
+			return "s3:PutBucketCORS"
+		case has("lifecycle"):
+			return "s3:PutLifecycleConfiguration"
+		case has("tagging"):
+			return "s3:PutBucketTagging"
+		}
+		return "s3:DeleteBucket"
+	case http.MethodPost:
+		if has("delete") {
+			return "s3:DeleteObject"
+		}
+		if has("select") && isObject {
+			// SelectObjectContent is authorized as reading the object
+			return "s3:GetObject"
+		}
+		return "s3:PutObject"
+	}
+	return "s3:" + r.Method
+}
+
+// policyConditions collects the condition keys supported in bucket policies
+func policyConditions(r *http.Request, identity *Identity) map[string][]string {
+	conditions := make(map[string][]string)
+	set := func(key, value string) {
+		if value != "" {
+			conditions[strings.ToLower(key)] = []string{value}
+		}
+	}
+
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		set("aws:SourceIp", host)
+	} else {
+		set("aws:SourceIp", r.RemoteAddr)
+	}
+	if r.TLS != nil {
+		set("aws:SecureTransport", "true")
+	} else {
+		set("aws:SecureTransport", "false")
+	}
+	set("aws:UserAgent", r.UserAgent())
+	set("aws:Referer", r.Referer())
+	if identity != nil {
+		set("aws:username", identity.Name)
+		set("aws:userid", identity.Name)
+		set("aws:PrincipalType", "User")
+	} else {
+		set("aws:PrincipalType", "Anonymous")
+	}
+
+	query := r.URL.Query()
+	for _, key := range []string{"prefix", "delimiter", "max-keys", "versionId"} {
+		if values, found := query[key]; found {
+			// an empty prefix is still a prefix
+			conditions[strings.ToLower("s3:"+key)] = values
+		}
+	}
+	for _, header := range []string{"x-amz-acl", "x-amz-copy-source", "x-amz-metadata-directive",
+		"x-amz-server-side-encryption", "x-amz-storage-class"} {
+		set("s3:"+header, r.Header.Get(header))
+	}
+
+	return conditions
+}
@@ -0,0 +1,60 @@
+package s3api
+
+import (
+	"testing"
+
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/policy"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckObjectPolicy(t *testing.T) {
+	iam := &IdentityAccessManagement{
+		identities: []*Identity{
+			{
+				Name:    "writer",
+				Actions: []Action{"Write:bucket1"},
+			},
+		},
+	}
+	bucketPolicy, err := policy.ParseBucketPolicy([]byte(`{"Version":"2012-10-17","Statement":[
+		{"Effect":"Deny","Principal":"*","Action":"s3:DeleteObject","Resource":"arn:aws:s3:::bucket1/keep/*"},
+		{"Effect":"Allow","Principal":"*","Action":"s3:DeleteObject","Resource":"arn:aws:s3:::bucket1/tmp/*"}
+	]}`), "bucket1")
+	assert.NoError(t, err)
+	iam.setBucketPolicy("bucket1", bucketPolicy)
+
+	req := mustNewRequest("POST", "http://127.0.0.1:9000/bucket1?delete", 0, nil, t)
+	req.Header.Set(xhttp.AmzIdentityId, "writer")
+	assert.Equal(t, s3err.ErrAccessDenied, iam.checkObjectPolicy(req, "bucket1", "/keep/a", "s3:DeleteObject"))
+	assert.Equal(t, s3err.ErrNone, iam.checkObjectPolicy(req, "bucket1", "/tmp/a", "s3:DeleteObject"))
+	assert.Equal(t, s3err.ErrNone, iam.checkObjectPolicy(req, "bucket1", "/other", "s3:DeleteObject"))
+
+	// when only the bucket policy allowed the request, the policy has to allow each object
+	req.Header.Set(xhttp.AmzIsPolicyAllowed, "true")
+	assert.Equal(t, s3err.ErrNone, iam.checkObjectPolicy(req, "bucket1", "/tmp/a", "s3:DeleteObject"))
+	assert.Equal(t, s3err.ErrAccessDenied, iam.checkObjectPolicy(req, "bucket1", "/other", "s3:DeleteObject"))
+}
+
+func TestAuthorizeObjectKey(t *testing.T) {
+	writer := &Identity{
+		Name:    "writer",
+		Actions: []Action{"Write:bucket1"},
+	}
+	iam := &IdentityAccessManagement{
+		identities: []*Identity{writer},
+	}
+	bucketPolicy, err := policy.ParseBucketPolicy([]byte(`{"Version":"2012-10-17","Statement":[
+		{"Effect":"Deny","Principal":"*","Action":"s3:PutObject","Resource":"arn:aws:s3:::bucket1/readonly/*"}
+	]}`), "bucket1")
+	assert.NoError(t, err)
+	iam.setBucketPolicy("bucket1", bucketPolicy)
+
+	// a post policy upload is authorized with the key of the form
+	req := mustNewRequest("POST", "http://127.0.0.1:9000/bucket1", 0, nil, t)
+	assert.Equal(t, s3err.ErrAccessDenied, iam.authorize(req, writer, s3_constants.ACTION_WRITE, "bucket1", "/readonly/a", "s3:PutObject"))
+	assert.Equal(t, s3err.ErrNone, iam.authorize(req, writer, s3_constants.ACTION_WRITE, "bucket1", "/a", "s3:PutObject"))
+	assert.Equal(t, s3err.ErrAccessDenied, iam.authorize(req, writer, s3_constants.ACTION_WRITE, "bucket2", "/a", "s3:PutObject"))
+}
@@ -1,11 +1,13 @@
 package s3api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/filer"
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -13,8 +15,11 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
 	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/policy"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
 type Action string
@@ -26,14 +31,29 @@ type Iam interface {
 type IdentityAccessManagement struct {
 	m sync.RWMutex
 
-	identities []*Identity
-	domain     string
+	identities     []*Identity
+	domain         string
+	bucketPolicies map[string]*policy.BucketPolicy
+	bucketAcls     map[string]*AccessControlPolicy
+	// sessionSigningKey signs the session tokens of the temporary credentials
+	sessionSigningKey security.SigningKey
+	// objectAclLoader reads the ACL of one object version, nil if the object has no ACL
+	objectAclLoader func(bucket, object, versionId string) *AccessControlPolicy
 }
 
 type Identity struct {
 	Name        string
 	Credentials []*Credential
 	Actions     []Action
+	// Session is set when the identity is authenticated by temporary credentials
+	Session *IdentitySession
+}
+
+type IdentitySession struct {
+	Name       string
+	Expiration time.Time
+	// Actions scope down the actions of the identity, nil for no scoping
+	Actions []Action
 }
 
 type Credential struct {
@@ -45,30 +65,10 @@ func (action Action) isAdmin() bool {
 	return strings.HasPrefix(string(action), s3_constants.ACTION_ADMIN)
 }
 
-func (action Action) isOwner(bucket string) bool {
-	return string(action) == s3_constants.ACTION_ADMIN+":"+bucket
-}
-
-func (action Action) overBucket(bucket string) bool {
-	return strings.HasSuffix(string(action), ":"+bucket) || strings.HasSuffix(string(action), ":*")
-}
-
-func (action Action) getPermission() Permission {
-	switch act := strings.Split(string(action), ":")[0]; act {
-	case s3_constants.ACTION_ADMIN:
-		return Permission("FULL_CONTROL")
-	case s3_constants.ACTION_WRITE:
-		return Permission("WRITE")
-	case s3_constants.ACTION_READ:
-		return Permission("READ")
-	default:
-		return Permission("")
-	}
-}
-
 func NewIdentityAccessManagement(option *S3ApiServerOption) *IdentityAccessManagement {
 	iam := &IdentityAccessManagement{
-		domain: option.DomainName,
+		domain:            option.DomainName,
+		sessionSigningKey: security.SigningKey(util.GetViper().GetString("jwt.s3_sts.key")),
 	}
 	if option.Config != "" {
 		if err := iam.loadS3ApiConfigurationFromFile(option.Config); err != nil {
@@ -181,9 +181,19 @@ func (iam *IdentityAccessManagement) Auth(f http.HandlerFunc, action Action) htt
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) {
+		r.Header.Del(xhttp.AmzIsPolicyAllowed)
+		r.Header.Del(xhttp.AmzCanBypassGovernance)
+		r.Header.Del(xhttp.AmzIsAclAllowed)
+		r.Header.Del(xhttp.AmzAcl)
+		r.Header.Del(xhttp.AmzIdentityId)
+		r.Header.Del(xhttp.AmzIsAdmin)
 		identity, errCode := iam.authRequest(r, action)
 		if errCode == s3err.ErrNone {
+			if iam.canBypassGovernance(r, identity) {
+				r.Header.Set(xhttp.AmzCanBypassGovernance, "true")
+			}
 			if identity != nil && identity.Name != "" {
+				r = withAuthenticatedIdentity(r, identity)
 				r.Header.Set(xhttp.AmzIdentityId, identity.Name)
 				if identity.isAdmin() {
 					r.Header.Set(xhttp.AmzIsAdmin, "true")
@@ -198,11 +208,39 @@ func (iam *IdentityAccessManagement) Auth(f http.HandlerFunc, action Action) htt
 	}
 }
 
+// Identify authenticates the request for the handlers checking the access by themselves,
+// so that the rate limits apply to the identity. Failing requests are passed on to be rejected by f.
+func (iam *IdentityAccessManagement) Identify(f http.HandlerFunc) http.HandlerFunc {
+
+	if !iam.isEnabled() {
+		return f
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if identity, errCode := iam.authUser(r); errCode == s3err.ErrNone && identity != nil && identity.Name != "" {
+			r = withAuthenticatedIdentity(r, identity)
+		}
+		f(w, r)
+	}
+}
+
+type authenticatedIdentityKey struct{}
+
+// withAuthenticatedIdentity keeps the identity in the request context, which unlike the headers can not be set by the clients
+func withAuthenticatedIdentity(r *http.Request, identity *Identity) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), authenticatedIdentityKey{}, identity.Name))
+}
+
+// authenticatedIdentityName is the name of the identity authenticated by Auth or Identify, or empty
+func authenticatedIdentityName(r *http.Request) string {
+	name, _ := r.Context().Value(authenticatedIdentityKey{}).(string)
+	return name
+}
+
 // check whether the request has valid access keys
 func (iam *IdentityAccessManagement) authRequest(r *http.Request, action Action) (*Identity, s3err.ErrorCode) {
 	var identity *Identity
 	var s3Err s3err.ErrorCode
-	var found bool
 	var authType string
 	switch getRequestAuthType(r) {
 	case authTypeStreamingSigned:
@@ -229,11 +267,8 @@ func (iam *IdentityAccessManagement) authRequest(r *http.Request, action Action)
 		return identity, s3err.ErrNotImplemented
 	case authTypeAnonymous:
 		authType = "Anonymous"
-		identity, found = iam.lookupAnonymous()
-		if !found {
-			r.Header.Set(xhttp.AmzAuthType, authType)
-			return identity, s3err.ErrAccessDenied
-		}
+		// without the anonymous identity, only the bucket policy can grant access
+		identity, _ = iam.lookupAnonymous()
 	default:
 		return identity, s3err.ErrNotImplemented
 	}
@@ -245,16 +280,40 @@ func (iam *IdentityAccessManagement) authRequest(r *http.Request, action Action)
 		return identity, s3Err
 	}
 
-	glog.V(3).Infof("user name: %v actions: %v, action: %v", identity.Name, identity.Actions, action)
-
 	bucket, object := xhttp.GetBucketAndObject(r)
 
-	if !identity.canDo(action, bucket, object) {
-		return identity, s3err.ErrAccessDenied
+	return identity, iam.authorize(r, identity, action, bucket, object, s3ActionOf(r, object))
+}
+
+// authorize checks the s3 action on the bucket or object for the authenticated identity,
+// against the session scope, the bucket policy, the identity actions and the acls.
+func (iam *IdentityAccessManagement) authorize(r *http.Request, identity *Identity, action Action, bucket, object, s3Action string) s3err.ErrorCode {
+	// the session scope limits whatever the bucket policies or acls allow
+	if identity != nil && !identity.inSessionScope(action, bucket, object) {
+		return s3err.ErrAccessDenied
 	}
 
-	return identity, s3err.ErrNone
+	switch iam.evalBucketPolicy(r, identity, bucket, object, s3Action) {
+	case policy.Deny:
+		return s3err.ErrAccessDenied
+	case policy.Allow:
+		r.Header.Set(xhttp.AmzIsPolicyAllowed, "true")
+		return s3err.ErrNone
+	}
+
+	if identity != nil {
+		glog.V(3).Infof("user name: %v actions: %v, action: %v", identity.Name, identity.Actions, action)
+		if identity.canDo(action, bucket, object) {
+			return s3err.ErrNone
+		}
+	}
+
+	if iam.isAclGranted(r, identity, bucket, object, s3Action) {
+		r.Header.Set(xhttp.AmzIsAclAllowed, "true")
+		return s3err.ErrNone
+	}
 
+	return s3err.ErrAccessDenied
 }
 
 func (iam *IdentityAccessManagement) authUser(r *http.Request) (*Identity, s3err.ErrorCode) {
@@ -308,10 +367,30 @@ func (iam *IdentityAccessManagement) authUser(r *http.Request) (*Identity, s3err
 }
 
 func (identity *Identity) canDo(action Action, bucket string, objectKey string) bool {
-	if identity.isAdmin() {
+	if !identity.inSessionScope(action, bucket, objectKey) {
+		return false
+	}
+	return canDoActions(identity.Actions, action, bucket, objectKey)
+}
+
+// inSessionScope checks the action against the scope-down of temporary session credentials
+func (identity *Identity) inSessionScope(action Action, bucket string, objectKey string) bool {
+	if identity.Session == nil || identity.Session.Actions == nil {
+		return true
+	}
+	return canDoActions(identity.Session.Actions, action, bucket, objectKey)
+}
+
+// CanAssumeRole checks whether the identity can get temporary credentials of the role identity
+func (identity *Identity) CanAssumeRole(role string) bool {
+	return identity.Session == nil && identity.canDo(s3_constants.ACTION_ASSUME_ROLE, role, "")
+}
+
+func canDoActions(actions []Action, action Action, bucket string, objectKey string) bool {
+	if isAdminActions(actions) {
 		return true
 	}
-	for _, a := range identity.Actions {
+	for _, a := range actions {
 		if a == action {
 			return true
 		}
@@ -323,7 +402,7 @@ func (identity *Identity) canDo(action Action, bucket string, objectKey string)
 	adminTarget := s3_constants.ACTION_ADMIN + ":" + bucket + objectKey
 	limitedByBucket := string(action) + ":" + bucket
 	adminLimitedByBucket := s3_constants.ACTION_ADMIN + ":" + bucket
-	for _, a := range identity.Actions {
+	for _, a := range actions {
 		act := string(a)
 		if strings.HasSuffix(act, "*") {
 			if strings.HasPrefix(target, act[:len(act)-1]) {
@@ -345,7 +424,14 @@ func (identity *Identity) canDo(action Action, bucket string, objectKey string)
 }
 
 func (identity *Identity) isAdmin() bool {
-	for _, a := range identity.Actions {
+	if identity.Session != nil && identity.Session.Actions != nil && !isAdminActions(identity.Session.Actions) {
+		return false
+	}
+	return isAdminActions(identity.Actions)
+}
+
+func isAdminActions(actions []Action) bool {
+	for _, a := range actions {
 		if a == "Admin" {
 			return true
 		}
@@ -8,11 +8,19 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
-func (s3a *S3ApiServer) subscribeMetaEvents(clientName string, prefix string, lastTsNs int64) {
+func (s3a *S3ApiServer) subscribeMetaEvents(clientName string, prefix string, directories []string, lastTsNs int64) {
 
 	processEventFn := func(resp *filer_pb.SubscribeMetadataResponse) error {
 
 		message := resp.EventNotification
+
+		// bucket entries carry the bucket level configurations
+		if resp.Directory == s3a.option.BucketsPath && message.OldEntry != nil {
+			if message.NewEntry == nil || message.NewParentPath != resp.Directory || message.NewEntry.Name != message.OldEntry.Name {
+				s3a.onBucketMetadataChange(message.OldEntry.Name, nil)
+			}
+		}
+
 		if message.NewEntry == nil {
 			return nil
 		}
@@ -22,21 +30,47 @@ func (s3a *S3ApiServer) subscribeMetaEvents(clientName string, prefix string, la
 		if message.NewParentPath != "" {
 			dir = message.NewParentPath
 		}
+		if dir == s3a.option.BucketsPath {
+			s3a.onBucketMetadataChange(message.NewEntry.Name, message.NewEntry)
+		}
 		if dir == filer.IamConfigDirecotry && message.NewEntry.Name == filer.IamIdentityFile {
 			if err := s3a.iam.loadS3ApiConfigurationFromBytes(message.NewEntry.Content); err != nil {
 				return err
 			}
 			glog.V(0).Infof("updated %s/%s", filer.IamConfigDirecotry, filer.IamIdentityFile)
 		}
+		if dir == filer.IamConfigDirecotry && message.NewEntry.Name == filer.IamRateLimitFile && s3a.option.RateLimitConfig == "" {
+			if err := s3a.rateLimiter.loadConfigurationFromBytes(message.NewEntry.Content); err != nil {
+				return err
+			}
+			glog.V(0).Infof("updated %s/%s", filer.IamConfigDirecotry, filer.IamRateLimitFile)
+		}
 
 		return nil
 	}
 
 	util.RetryForever("followIamChanges", func() error {
-		return pb.WithFilerClientFollowMetadata(s3a, clientName, s3a.randomClientId, prefix, &lastTsNs, 0, processEventFn, true)
+		return pb.WithFilerClientFollowMetadata(s3a, clientName, s3a.randomClientId, prefix, directories, &lastTsNs, 0, processEventFn, true)
 	}, func(err error) bool {
 		glog.V(0).Infof("iam follow metadata changes: %v", err)
 		return true
 	})
 
 }
+
+func (s3a *S3ApiServer) onBucketMetadataChange(bucket string, entry *filer_pb.Entry) {
+	if entry != nil && !entry.IsDirectory {
+		return
+	}
+	s3a.iam.loadBucketPolicy(bucket, entry)
+	s3a.iam.loadBucketAcl(bucket, entry)
+	s3a.bucketConfigs.update(bucket, entry)
+}
+
+// loadBucketMetadata reads the bucket level configurations of all existing buckets
+func (s3a *S3ApiServer) loadBucketMetadata() error {
+	return filer_pb.ReadDirAllEntries(s3a, util.FullPath(s3a.option.BucketsPath), "", func(entry *filer_pb.Entry, isLast bool) error {
+		s3a.onBucketMetadataChange(entry.Name, entry)
+		return nil
+	})
+}
@@ -0,0 +1,192 @@
+package s3api
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	sessionAccessKeyPrefix  = "ASIA"
+	sessionAccessKeyCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+)
+
+// SessionCredentials are temporary credentials, verified by the session token without being stored anywhere
+type SessionCredentials struct {
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      time.Time
+}
+
+// NewSessionCredentials issues temporary credentials of the identity, scoped down to the actions if not nil
+func (iam *IdentityAccessManagement) NewSessionCredentials(identity, sessionName string, actions []Action, duration time.Duration) (*SessionCredentials, error) {
+	if len(iam.sessionSigningKey) == 0 {
+		return nil, fmt.Errorf("jwt.s3_sts.key is not configured")
+	}
+	accessKey, err := newSessionAccessKey()
+	if err != nil {
+		return nil, err
+	}
+	var scope []string
+	if actions != nil {
+		scope = make([]string, 0, len(actions))
+		for _, action := range actions {
+			scope = append(scope, string(action))
+		}
+	}
+	expiration := time.Now().Add(duration).Truncate(time.Second)
+	sessionToken := security.GenJwtForS3Session(iam.sessionSigningKey, expiration, accessKey, identity, sessionName, scope)
+	if sessionToken == "" {
+		return nil, fmt.Errorf("fail to sign session token")
+	}
+	return &SessionCredentials{
+		AccessKeyId:     accessKey,
+		SecretAccessKey: sessionSecretKey(iam.sessionSigningKey, accessKey),
+		SessionToken:    string(sessionToken),
+		Expiration:      expiration,
+	}, nil
+}
+
+// IntersectActions keeps the actions granted by both lists, nil meaning no restriction
+func IntersectActions(a, b []Action) []Action {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	actions := []Action{}
+	keepGranted := func(candidates, granted []Action) {
+		for _, action := range candidates {
+			name, bucket := string(action), ""
+			if i := strings.Index(name, ":"); i > 0 {
+				name, bucket = name[:i], name[i+1:]
+			}
+			if canDoActions(granted, Action(name), bucket, "") && !containsAction(actions, action) {
+				actions = append(actions, action)
+			}
+		}
+	}
+	keepGranted(a, b)
+	keepGranted(b, a)
+	return actions
+}
+
+func containsAction(actions []Action, action Action) bool {
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+func newSessionAccessKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate access key: %v", err)
+	}
+	for i := range b {
+		b[i] = sessionAccessKeyCharset[int(b[i])%len(sessionAccessKeyCharset)]
+	}
+	return sessionAccessKeyPrefix + string(b), nil
+}
+
+// sessionSecretKey derives the secret key from the access key, so only the session token needs to be kept by the client
+func sessionSecretKey(signingKey security.SigningKey, accessKey string) string {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(accessKey))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))[:40]
+}
+
+// lookupCredential finds the credential of the access key, from the session token if present
+func (iam *IdentityAccessManagement) lookupCredential(accessKey, sessionToken string) (*Identity, *Credential, s3err.ErrorCode) {
+	if sessionToken != "" {
+		return iam.lookupBySessionToken(accessKey, sessionToken)
+	}
+	identity, cred, found := iam.lookupByAccessKey(accessKey)
+	if !found {
+		return nil, nil, s3err.ErrInvalidAccessKeyID
+	}
+	return identity, cred, s3err.ErrNone
+}
+
+func (iam *IdentityAccessManagement) lookupBySessionToken(accessKey, sessionToken string) (*Identity, *Credential, s3err.ErrorCode) {
+	if len(iam.sessionSigningKey) == 0 {
+		return nil, nil, s3err.ErrInvalidToken
+	}
+	claims := &security.SeaweedS3SessionClaims{}
+	if _, err := security.DecodeJwt(iam.sessionSigningKey, security.EncodedJwt(sessionToken), claims); err != nil {
+		if ve, ok := err.(*jwt.ValidationError); ok && ve.Errors == jwt.ValidationErrorExpired {
+			return nil, nil, s3err.ErrExpiredToken
+		}
+		glog.V(1).Infof("invalid session token of %s: %v", accessKey, err)
+		return nil, nil, s3err.ErrInvalidToken
+	}
+	if claims.AccessKey != accessKey {
+		return nil, nil, s3err.ErrInvalidAccessKeyID
+	}
+	// the identity is looked up again, so removing it also revokes its temporary credentials
+	parent, found := iam.lookupByName(claims.Identity)
+	if !found {
+		glog.V(1).Infof("session token of removed identity %s", claims.Identity)
+		return nil, nil, s3err.ErrInvalidToken
+	}
+	cred := &Credential{
+		AccessKey: accessKey,
+		SecretKey: sessionSecretKey(iam.sessionSigningKey, accessKey),
+	}
+	session := &IdentitySession{
+		Name:       claims.SessionName,
+		Expiration: time.Unix(claims.ExpiresAt, 0),
+	}
+	if claims.Actions != nil {
+		session.Actions = make([]Action, 0, len(claims.Actions))
+		for _, action := range claims.Actions {
+			session.Actions = append(session.Actions, Action(action))
+		}
+	}
+	return &Identity{
+		Name:        parent.Name,
+		Credentials: []*Credential{cred},
+		Actions:     parent.Actions,
+		Session:     session,
+	}, cred, s3err.ErrNone
+}
+
+func (iam *IdentityAccessManagement) lookupByName(name string) (identity *Identity, found bool) {
+	iam.m.RLock()
+	defer iam.m.RUnlock()
+	for _, ident := range iam.identities {
+		if ident.Name == name {
+			return ident, true
+		}
+	}
+	return nil, false
+}
+
+// Authenticate passes the identity of the request signature to f, leaving the authorization to f
+func (iam *IdentityAccessManagement) Authenticate(f func(w http.ResponseWriter, r *http.Request, identity *Identity)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		identity, errCode := iam.authUser(r)
+		if errCode == s3err.ErrNone && identity == nil {
+			errCode = s3err.ErrAccessDenied
+		}
+		if errCode != s3err.ErrNone {
+			s3err.WriteErrorResponse(w, r, errCode)
+			return
+		}
+		f(w, r, identity)
+	}
+}
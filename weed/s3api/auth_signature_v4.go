@@ -33,6 +33,7 @@ import (
 	"time"
 	"unicode/utf8"
 
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
 )
 
@@ -113,9 +114,9 @@ func (iam *IdentityAccessManagement) doesSignatureMatch(hashedPayload string, r
 	}
 
 	// Verify if the access key id matches.
-	identity, cred, found := iam.lookupByAccessKey(signV4Values.Credential.accessKey)
-	if !found {
-		return nil, s3err.ErrInvalidAccessKeyID
+	identity, cred, errCode := iam.lookupCredential(signV4Values.Credential.accessKey, req.Header.Get(xhttp.AmzSecurityToken))
+	if errCode != s3err.ErrNone {
+		return nil, errCode
 	}
 
 	// Extract date, if not present throw error.
@@ -312,17 +313,17 @@ func parseSignature(signElement string) (string, s3err.ErrorCode) {
 // doesPolicySignatureMatch - Verify query headers with post policy
 //     - http://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-HTTPPOSTConstructPolicy.html
 // returns ErrNone if the signature matches.
-func (iam *IdentityAccessManagement) doesPolicySignatureV4Match(formValues http.Header) s3err.ErrorCode {
+func (iam *IdentityAccessManagement) doesPolicySignatureV4Match(formValues http.Header) (*Identity, s3err.ErrorCode) {
 
 	// Parse credential tag.
 	credHeader, err := parseCredentialHeader("Credential=" + formValues.Get("X-Amz-Credential"))
 	if err != s3err.ErrNone {
-		return s3err.ErrMissingFields
+		return nil, s3err.ErrMissingFields
 	}
 
-	_, cred, found := iam.lookupByAccessKey(credHeader.accessKey)
-	if !found {
-		return s3err.ErrInvalidAccessKeyID
+	identity, cred, errCode := iam.lookupCredential(credHeader.accessKey, formValues.Get(xhttp.AmzSecurityToken))
+	if errCode != s3err.ErrNone {
+		return nil, errCode
 	}
 
 	// Get signing key.
@@ -333,11 +334,11 @@ func (iam *IdentityAccessManagement) doesPolicySignatureV4Match(formValues http.
 
 	// Verify signature.
 	if !compareSignatureV4(newSignature, formValues.Get("X-Amz-Signature")) {
-		return s3err.ErrSignatureDoesNotMatch
+		return nil, s3err.ErrSignatureDoesNotMatch
 	}
 
 	// Success.
-	return s3err.ErrNone
+	return identity, s3err.ErrNone
 }
 
 // check query headers with presigned signature
@@ -354,9 +355,10 @@ func (iam *IdentityAccessManagement) doesPresignedSignatureMatch(hashedPayload s
 	}
 
 	// Verify if the access key id matches.
-	identity, cred, found := iam.lookupByAccessKey(pSignValues.Credential.accessKey)
-	if !found {
-		return nil, s3err.ErrInvalidAccessKeyID
+	sessionToken := req.URL.Query().Get(xhttp.AmzSecurityToken)
+	identity, cred, errCode := iam.lookupCredential(pSignValues.Credential.accessKey, sessionToken)
+	if errCode != s3err.ErrNone {
+		return nil, errCode
 	}
 
 	// Extract all the signed headers along with its values.
@@ -393,6 +395,9 @@ func (iam *IdentityAccessManagement) doesPresignedSignatureMatch(hashedPayload s
 	query.Set("X-Amz-Expires", strconv.Itoa(expireSeconds))
 	query.Set("X-Amz-SignedHeaders", getSignedHeaders(extractedSignedHeaders))
 	query.Set("X-Amz-Credential", cred.AccessKey+"/"+getScope(t, pSignValues.Credential.scope.region))
+	if sessionToken != "" {
+		query.Set(xhttp.AmzSecurityToken, sessionToken)
+	}
 
 	// Save other headers available in the request parameters.
 	for k, v := range req.URL.Query() {
@@ -87,9 +87,9 @@ func (iam *IdentityAccessManagement) calculateSeedSignature(r *http.Request) (cr
 		return nil, "", "", time.Time{}, errCode
 	}
 	// Verify if the access key id matches.
-	identity, cred, found := iam.lookupByAccessKey(signV4Values.Credential.accessKey)
-	if !found {
-		return nil, "", "", time.Time{}, s3err.ErrInvalidAccessKeyID
+	identity, cred, errCode := iam.lookupCredential(signV4Values.Credential.accessKey, req.Header.Get(xhttp.AmzSecurityToken))
+	if errCode != s3err.ErrNone {
+		return nil, "", "", time.Time{}, errCode
 	}
 
 	bucket, object := xhttp.GetBucketAndObject(r)
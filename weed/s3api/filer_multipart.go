@@ -3,6 +3,7 @@ package s3api
 import (
 	"encoding/xml"
 	"fmt"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
 	"path/filepath"
 	"strconv"
@@ -83,12 +84,16 @@ func (s3a *S3ApiServer) completeMultipartUpload(input *s3.CompleteMultipartUploa
 	var finalParts []*filer_pb.FileChunk
 	var offset int64
 	var mime string
+	// the parts encrypted by the gateway have their own iv
+	_, isSseEncrypted := pentry.Extended[xhttp.AmzSseIv]
+	var sseParts []string
 
 	for _, entry := range entries {
 		if strings.HasSuffix(entry.Name, ".part") && !entry.IsDirectory {
 			if entry.Name == "0001.part" && entry.Attributes.Mime != "" {
 				mime = entry.Attributes.Mime
 			}
+			partStart := offset
 			for _, chunk := range entry.Chunks {
 				p := &filer_pb.FileChunk{
 					FileId:    chunk.GetFileIdString(),
@@ -101,6 +106,9 @@ func (s3a *S3ApiServer) completeMultipartUpload(input *s3.CompleteMultipartUploa
 				finalParts = append(finalParts, p)
 				offset += int64(chunk.Size)
 			}
+			if isSseEncrypted {
+				sseParts = append(sseParts, formatSsePart(offset-partStart, string(entry.Extended[xhttp.AmzSseIv])))
+			}
 		}
 	}
 
@@ -119,15 +127,28 @@ func (s3a *S3ApiServer) completeMultipartUpload(input *s3.CompleteMultipartUploa
 		dirName = dirName[:len(dirName)-1]
 	}
 
-	err = s3a.mkFile(dirName, entryName, finalParts, func(entry *filer_pb.Entry) {
+	versioned, err := s3a.prepareVersionedWrite(*input.Bucket, "/"+strings.TrimPrefix(*input.Key, "/"))
+	if err != nil {
+		glog.Errorf("completeMultipartUpload %s/%s prepare version: %v", dirName, entryName, err)
+		return nil, s3err.ErrInternalError
+	}
+	versionId := versioned.versionId
+
+	err = s3a.mkFile(versioned.dir, versioned.name, finalParts, func(entry *filer_pb.Entry) {
 		if entry.Extended == nil {
 			entry.Extended = make(map[string][]byte)
 		}
 		for k, v := range pentry.Extended {
-			if k != "key" {
+			if k != "key" && k != xhttp.AmzVersionId {
 				entry.Extended[k] = v
 			}
 		}
+		if versionId != "" {
+			entry.Extended[xhttp.AmzVersionId] = []byte(versionId)
+		}
+		if isSseEncrypted {
+			entry.Extended[xhttp.AmzSseParts] = []byte(strings.Join(sseParts, ","))
+		}
 		if pentry.Attributes.Mime != "" {
 			entry.Attributes.Mime = pentry.Attributes.Mime
 		} else if mime != "" {
@@ -135,8 +156,12 @@ func (s3a *S3ApiServer) completeMultipartUpload(input *s3.CompleteMultipartUploa
 		}
 	})
 
+	if err == nil {
+		err = s3a.commitVersionedWrite(versioned)
+	}
 	if err != nil {
 		glog.Errorf("completeMultipartUpload %s/%s error: %v", dirName, entryName, err)
+		s3a.abortVersionedWrite(versioned, false)
 		return nil, s3err.ErrInternalError
 	}
 
@@ -148,6 +173,9 @@ func (s3a *S3ApiServer) completeMultipartUpload(input *s3.CompleteMultipartUploa
 			Key:      objectKey(input.Key),
 		},
 	}
+	if versionId != "" {
+		output.VersionId = aws.String(versionId)
+	}
 
 	if err = s3a.rm(s3a.genUploadsFolder(*input.Bucket), *input.UploadId, false, true); err != nil {
 		glog.V(1).Infof("completeMultipartUpload cleanup %s upload %s: %v", *input.Bucket, *input.UploadId, err)
@@ -0,0 +1,85 @@
+package s3api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+// withFilerLock runs fn while holding the filer lock on lockPath, renewing its lease until fn returns.
+// The lost channel is closed if the lease can not be renewed, after which another gateway may hold the lock.
+func (s3a *S3ApiServer) withFilerLock(lockPath, clientId string, fn func(lost <-chan struct{})) (acquired bool, err error) {
+	err = s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.SetFileLock(context.Background(), &filer_pb.SetFileLockRequest{
+			Path: lockPath,
+			Lock: &filer_pb.FileLock{
+				Type:     filer_pb.FileLock_WRITE,
+				End:      math.MaxUint64,
+				ClientId: clientId,
+				IsFlock:  true,
+			},
+			LeaseSeconds: filer.DefaultFileLockLeaseSeconds,
+		})
+		if err != nil {
+			return err
+		}
+		acquired = resp.Acquired
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("lock %s: %v", lockPath, err)
+	}
+	if !acquired {
+		return false, nil
+	}
+
+	done := make(chan struct{})
+	lost := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(filer.DefaultFileLockLeaseSeconds * time.Second / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				var lockCount int32
+				if err := s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+					resp, err := client.RenewFileLockLease(context.Background(), &filer_pb.RenewFileLockLeaseRequest{
+						ClientId:     clientId,
+						LeaseSeconds: filer.DefaultFileLockLeaseSeconds,
+					})
+					if err != nil {
+						return err
+					}
+					lockCount = resp.LockCount
+					return nil
+				}); err != nil {
+					glog.Warningf("renew lock %s: %v", lockPath, err)
+					close(lost)
+					return
+				}
+				if lockCount == 0 {
+					glog.Warningf("lost lock %s", lockPath)
+					close(lost)
+					return
+				}
+			}
+		}
+	}()
+
+	fn(lost)
+
+	close(done)
+	return true, s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.ReleaseFileLocks(context.Background(), &filer_pb.ReleaseFileLocksRequest{
+			ClientId: clientId,
+		})
+		return err
+	})
+}
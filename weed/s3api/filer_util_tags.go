@@ -22,12 +22,7 @@ func (s3a *S3ApiServer) getTags(parentDirectoryPath string, entryName string) (t
 		if err != nil {
 			return err
 		}
-		tags = make(map[string]string)
-		for k, v := range resp.Entry.Extended {
-			if strings.HasPrefix(k, S3TAG_PREFIX) {
-				tags[k[len(S3TAG_PREFIX):]] = string(v)
-			}
-		}
+		tags = getEntryTags(resp.Entry)
 		return nil
 	})
 	return
@@ -103,3 +98,13 @@ func (s3a *S3ApiServer) rmTags(parentDirectoryPath string, entryName string) (er
 	})
 
 }
+
+func getEntryTags(entry *filer_pb.Entry) map[string]string {
+	tags := make(map[string]string)
+	for k, v := range entry.Extended {
+		if strings.HasPrefix(k, S3TAG_PREFIX) {
+			tags[k[len(S3TAG_PREFIX):]] = string(v)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,428 @@
+package s3api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+const (
+	versionsFolder = ".versions"
+	nullVersionId  = "null"
+	// the new objects being written are staged in the versions folder under this prefix,
+	// hidden from the version listing and never promoted
+	stagedVersionPrefix = ".staged."
+
+	VersioningEnabled   = "Enabled"
+	VersioningSuspended = "Suspended"
+)
+
+// The current version of an object always lives at its normal path, so that
+// filer, mount and webdav clients keep seeing the latest content.
+// Noncurrent versions and delete markers are kept under
+//   <bucket>/.versions/<escaped object key>/<version id>
+// The changes of the current object and its versions are serialized per object key,
+// by this gateway only, so one key should be written through one gateway at a time.
+
+// versionLocks are the locks of the object keys being changed, with the zero value ready to use
+type versionLocks struct {
+	sync.Mutex
+	locks map[string]*versionLock
+}
+
+type versionLock struct {
+	sync.Mutex
+	users int
+}
+
+// lock locks the object key until the returned unlock is called
+func (l *versionLocks) lock(bucket, object string) (unlock func()) {
+	key := bucket + object
+	l.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*versionLock)
+	}
+	keyLock, found := l.locks[key]
+	if !found {
+		keyLock = &versionLock{}
+		l.locks[key] = keyLock
+	}
+	keyLock.users++
+	l.Unlock()
+
+	keyLock.Lock()
+	return func() {
+		keyLock.Unlock()
+		l.Lock()
+		if keyLock.users--; keyLock.users == 0 {
+			delete(l.locks, key)
+		}
+		l.Unlock()
+	}
+}
+
+func (s3a *S3ApiServer) genVersionsFolder(bucket string) string {
+	return fmt.Sprintf("%s/%s/%s", s3a.option.BucketsPath, bucket, versionsFolder)
+}
+
+func (s3a *S3ApiServer) genObjectVersionsFolder(bucket, object string) string {
+	return s3a.genVersionsFolder(bucket) + "/" + url.PathEscape(strings.TrimPrefix(object, "/"))
+}
+
+// isReservedObject tells the keys in the folders kept by the gateway in each bucket.
+// They are not objects, and accessing them directly would bypass versioning and object lock.
+func isReservedObject(object string) bool {
+	top := strings.SplitN(strings.TrimPrefix(object, "/"), "/", 2)[0]
+	return top == versionsFolder || top == lifecycleFolder
+}
+
+// reservedObjectMiddleware refuses the object requests on the reserved folders
+func (s3a *S3ApiServer) reservedObjectMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, object := xhttp.GetBucketAndObject(r); isReservedObject(object) {
+			s3err.WriteErrorResponse(w, r, s3err.ErrAccessDenied)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s3a *S3ApiServer) objectDirAndName(bucket, object string) (dir, name string) {
+	return util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, bucket, object)).DirAndName()
+}
+
+// newVersionId returns ids sorting newest first in the filer listing
+func newVersionId() string {
+	return fmt.Sprintf("%016x%08x", math.MaxInt64-time.Now().UnixNano(), rand.Uint32())
+}
+
+func getVersionId(entry *filer_pb.Entry) string {
+	if v, ok := entry.Extended[xhttp.AmzVersionId]; ok && len(v) > 0 {
+		return string(v)
+	}
+	return nullVersionId
+}
+
+func isDeleteMarker(entry *filer_pb.Entry) bool {
+	_, ok := entry.Extended[xhttp.AmzDeleteMarker]
+	return ok
+}
+
+// getBucketVersioning returns "", Enabled or Suspended. A missing bucket is treated as unversioned.
+func (s3a *S3ApiServer) getBucketVersioning(bucket string) (versioning string, err error) {
+	entry, err := s3a.getEntry(s3a.option.BucketsPath, bucket)
+	if err == filer_pb.ErrNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if entry == nil {
+		return "", nil
+	}
+	return string(entry.Extended[xhttp.AmzBucketVersioning]), nil
+}
+
+func (s3a *S3ApiServer) setBucketVersioning(bucket string, versioning string) error {
+	return s3a.updateBucketEntry(bucket, func(entry *filer_pb.Entry) {
+		entry.Extended[xhttp.AmzBucketVersioning] = []byte(versioning)
+	})
+}
+
+// versionedWrite is a new object being written into a bucket, staged in the versions folder when the bucket is versioned
+type versionedWrite struct {
+	bucket     string
+	object     string
+	versioning string
+	// versionId is empty for the "null" version
+	versionId string
+	// dir and name locate where the new object is written to
+	dir  string
+	name string
+}
+
+// prepareVersionedWrite locates where to write a new object, with the version id of the object.
+// In a versioned bucket, the new object is first written into the versions folder,
+// and only replaces the current object in commitVersionedWrite, after it is completely written.
+func (s3a *S3ApiServer) prepareVersionedWrite(bucket, object string) (*versionedWrite, error) {
+	versioning, err := s3a.getBucketVersioning(bucket)
+	if err != nil {
+		return nil, err
+	}
+	write := &versionedWrite{
+		bucket:     bucket,
+		object:     object,
+		versioning: versioning,
+	}
+	if versioning == "" {
+		write.dir, write.name = s3a.objectDirAndName(bucket, object)
+		return write, nil
+	}
+
+	// staged under a name that is not a version id, so it is not listed or promoted before it is committed
+	versionId := newVersionId()
+	write.dir, write.name = s3a.genObjectVersionsFolder(bucket, object), stagedVersionPrefix+versionId
+	if versioning == VersioningEnabled {
+		write.versionId = versionId
+	}
+	return write, nil
+}
+
+// isStaged tells whether the new object is written into the versions folder
+func (write *versionedWrite) isStaged() bool {
+	return write.versioning != ""
+}
+
+// commitVersionedWrite moves the completely written object in place of the current object,
+// which is kept as a noncurrent version, unless both are the null version.
+// The two renames are not atomic: if the second one fails, the newest version is promoted again.
+func (s3a *S3ApiServer) commitVersionedWrite(write *versionedWrite) error {
+	if !write.isStaged() {
+		return nil
+	}
+	defer s3a.versionLocks.lock(write.bucket, write.object)()
+	return s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		if err := s3a.archiveCurrentVersion(client, write.bucket, write.object, write.versioning); err != nil {
+			return err
+		}
+		dir, name := s3a.objectDirAndName(write.bucket, write.object)
+		if err := renameEntry(client, write.dir, write.name, dir, name); err != nil {
+			if promoteErr := s3a.promoteLatestVersion(client, write.bucket, write.object); promoteErr != nil {
+				glog.Errorf("restore latest version %s%s: %v", write.bucket, write.object, promoteErr)
+			}
+			return err
+		}
+		if write.versioning == VersioningSuspended {
+			// the new object replaces the noncurrent null version
+			return filer_pb.DoRemove(client, write.dir, nullVersionId, true, false, false, false, nil)
+		}
+		return nil
+	})
+}
+
+// abortVersionedWrite removes the staged object after a failed write, leaving the current object as is.
+// The data is kept if it is still used elsewhere, e.g. by the parts of a multipart upload.
+func (s3a *S3ApiServer) abortVersionedWrite(write *versionedWrite, isDeleteData bool) {
+	if !write.isStaged() {
+		return
+	}
+	defer s3a.versionLocks.lock(write.bucket, write.object)()
+	if err := s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		if err := filer_pb.DoRemove(client, write.dir, write.name, isDeleteData, false, false, false, nil); err != nil {
+			return err
+		}
+		return s3a.promoteLatestVersion(client, write.bucket, write.object)
+	}); err != nil {
+		glog.Errorf("abort writing %s%s: %v", write.bucket, write.object, err)
+	}
+}
+
+// archiveCurrentVersion moves the current object into the versions folder.
+// When versioning is suspended, the null version is not kept. The object key must be locked by the caller.
+func (s3a *S3ApiServer) archiveCurrentVersion(client filer_pb.SeaweedFilerClient, bucket, object string, versioning string) error {
+	dir, name := s3a.objectDirAndName(bucket, object)
+	resp, err := filer_pb.LookupEntry(client, &filer_pb.LookupDirectoryEntryRequest{
+		Directory: dir,
+		Name:      name,
+	})
+	if err == filer_pb.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if resp.Entry.IsDirectory {
+		return nil
+	}
+
+	versionId := getVersionId(resp.Entry)
+	if versionId == nullVersionId && versioning == VersioningSuspended {
+		return nil
+	}
+
+	return renameEntry(client, dir, name, s3a.genObjectVersionsFolder(bucket, object), versionId)
+}
+
+// promoteLatestVersion makes the newest noncurrent version the current object,
+// unless the object still exists or the newest version is a delete marker.
+// The object key must be locked by the caller.
+func (s3a *S3ApiServer) promoteLatestVersion(client filer_pb.SeaweedFilerClient, bucket, object string) error {
+	dir, name := s3a.objectDirAndName(bucket, object)
+	if _, err := filer_pb.LookupEntry(client, &filer_pb.LookupDirectoryEntryRequest{
+		Directory: dir,
+		Name:      name,
+	}); err != filer_pb.ErrNotFound {
+		return err
+	}
+
+	versions, err := s3a.listNoncurrentVersions(bucket, object)
+	if err != nil {
+		return err
+	}
+	versionsDir := s3a.genObjectVersionsFolder(bucket, object)
+	if len(versions) > 0 && !isDeleteMarker(versions[0]) {
+		if err := markPromotedVersion(client, versionsDir, versions[0]); err != nil {
+			return err
+		}
+		if err := renameEntry(client, versionsDir, versions[0].Name, dir, name); err != nil {
+			return err
+		}
+		versions = versions[1:]
+	}
+	if len(versions) == 0 {
+		versionsParentDir, versionsDirName := util.FullPath(versionsDir).DirAndName()
+		if err := doDeleteEntry(client, versionsParentDir, versionsDirName, false, false); err != nil {
+			glog.V(1).Infof("remove empty versions folder %s: %v", versionsDir, err)
+		}
+	}
+	return nil
+}
+
+// markPromotedVersion tells the bucket notifications that the object becoming current again is not newly created
+func markPromotedVersion(client filer_pb.SeaweedFilerClient, versionsDir string, entry *filer_pb.Entry) error {
+	if _, found := entry.Extended[xhttp.AmzPromotedVersion]; found {
+		return nil
+	}
+	if entry.Extended == nil {
+		entry.Extended = make(map[string][]byte)
+	}
+	entry.Extended[xhttp.AmzPromotedVersion] = []byte("true")
+	return filer_pb.UpdateEntry(client, &filer_pb.UpdateEntryRequest{
+		Directory: versionsDir,
+		Entry:     entry,
+	})
+}
+
+// createDeleteMarker records a delete marker as the newest version of the object
+func (s3a *S3ApiServer) createDeleteMarker(client filer_pb.SeaweedFilerClient, bucket, object string, versioning string) (versionId string, err error) {
+	versionId = nullVersionId
+	extended := map[string][]byte{
+		xhttp.AmzDeleteMarker: []byte("true"),
+	}
+	if versioning == VersioningEnabled {
+		versionId = newVersionId()
+		extended[xhttp.AmzVersionId] = []byte(versionId)
+	}
+	now := time.Now().Unix()
+	err = filer_pb.CreateEntry(client, &filer_pb.CreateEntryRequest{
+		Directory: s3a.genObjectVersionsFolder(bucket, object),
+		Entry: &filer_pb.Entry{
+			Name: versionId,
+			Attributes: &filer_pb.FuseAttributes{
+				Mtime:    now,
+				Crtime:   now,
+				FileMode: uint32(0660),
+				Uid:      filer_pb.OS_UID,
+				Gid:      filer_pb.OS_GID,
+			},
+			Extended: extended,
+		},
+	})
+	return
+}
+
+// listNoncurrentVersions returns the noncurrent versions of one object, newest first
+func (s3a *S3ApiServer) listNoncurrentVersions(bucket, object string) (versions []*filer_pb.Entry, err error) {
+	err = filer_pb.ReadDirAllEntries(s3a, util.FullPath(s3a.genObjectVersionsFolder(bucket, object)), "", func(entry *filer_pb.Entry, isLast bool) error {
+		// skip the new objects still being written
+		if !entry.IsDirectory && !strings.HasPrefix(entry.Name, stagedVersionPrefix) && entry.Name == getVersionId(entry) {
+			versions = append(versions, entry)
+		}
+		return nil
+	})
+	if err == filer_pb.ErrNotFound {
+		err = nil
+	}
+	// generated version ids already sort newest first, the null version is placed by its modification time
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].Attributes.Mtime > versions[j].Attributes.Mtime
+	})
+	return
+}
+
+// lookupVersion finds one version of an object, either the current one or a noncurrent one
+func (s3a *S3ApiServer) lookupVersion(bucket, object, versionId string) (dir string, entry *filer_pb.Entry, isCurrent bool, err error) {
+	dir, name := s3a.objectDirAndName(bucket, object)
+	entry, err = s3a.getEntry(dir, name)
+	if err == nil && entry != nil && !entry.IsDirectory && getVersionId(entry) == versionId {
+		return dir, entry, true, nil
+	}
+	if err != nil && err != filer_pb.ErrNotFound {
+		return "", nil, false, err
+	}
+	if strings.HasPrefix(versionId, stagedVersionPrefix) {
+		return "", nil, false, filer_pb.ErrNotFound
+	}
+	dir = s3a.genObjectVersionsFolder(bucket, object)
+	entry, err = s3a.getEntry(dir, versionId)
+	if err == nil && entry == nil {
+		err = filer_pb.ErrNotFound
+	}
+	return dir, entry, false, err
+}
+
+// deleteVersion permanently removes one version, and returns whether it was a delete marker
+func (s3a *S3ApiServer) deleteVersion(bucket, object, versionId string) (deletedMarker bool, err error) {
+	defer s3a.versionLocks.lock(bucket, object)()
+	dir, entry, _, err := s3a.lookupVersion(bucket, object, versionId)
+	if err == filer_pb.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	deletedMarker = isDeleteMarker(entry)
+	err = s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		if err := doDeleteEntry(client, dir, entry.Name, true, false); err != nil {
+			return err
+		}
+		return s3a.promoteLatestVersion(client, bucket, object)
+	})
+	return
+}
+
+// deleteCurrentVersion hides the object behind a delete marker, keeping the existing data as a noncurrent version
+func (s3a *S3ApiServer) deleteCurrentVersion(bucket, object string, versioning string) (markerVersionId string, err error) {
+	defer s3a.versionLocks.lock(bucket, object)()
+	err = s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		if err := s3a.archiveCurrentVersion(client, bucket, object, versioning); err != nil {
+			return err
+		}
+		if versioning == VersioningSuspended {
+			dir, name := s3a.objectDirAndName(bucket, object)
+			if err := filer_pb.DoRemove(client, dir, name, true, false, false, false, nil); err != nil {
+				return err
+			}
+		}
+		markerVersionId, err = s3a.createDeleteMarker(client, bucket, object, versioning)
+		return err
+	})
+	return
+}
+
+func renameEntry(client filer_pb.SeaweedFilerClient, oldDir, oldName, newDir, newName string) error {
+	request := &filer_pb.AtomicRenameEntryRequest{
+		OldDirectory: oldDir,
+		OldName:      oldName,
+		NewDirectory: newDir,
+		NewName:      newName,
+	}
+	glog.V(1).Infof("rename entry %s/%s => %s/%s", oldDir, oldName, newDir, newName)
+	if _, err := client.AtomicRenameEntry(context.Background(), request); err != nil {
+		return fmt.Errorf("rename %s/%s => %s/%s: %v", oldDir, oldName, newDir, newName, err)
+	}
+	return nil
+}
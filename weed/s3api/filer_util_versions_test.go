@@ -0,0 +1,308 @@
+package s3api
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// memFiler keeps the entries in memory, with just enough of the filer grpc API for the versioned writes
+type memFiler struct {
+	filer_pb.UnimplementedSeaweedFilerServer
+	sync.Mutex
+	entries map[util.FullPath]*filer_pb.Entry
+}
+
+func (f *memFiler) LookupDirectoryEntry(ctx context.Context, req *filer_pb.LookupDirectoryEntryRequest) (*filer_pb.LookupDirectoryEntryResponse, error) {
+	f.Lock()
+	defer f.Unlock()
+	entry, found := f.entries[util.NewFullPath(req.Directory, req.Name)]
+	if !found {
+		return nil, filer_pb.ErrNotFound
+	}
+	return &filer_pb.LookupDirectoryEntryResponse{Entry: proto.Clone(entry).(*filer_pb.Entry)}, nil
+}
+
+func (f *memFiler) ListEntries(req *filer_pb.ListEntriesRequest, stream filer_pb.SeaweedFiler_ListEntriesServer) error {
+	f.Lock()
+	var entries []*filer_pb.Entry
+	for p, entry := range f.entries {
+		dir, name := p.DirAndName()
+		if dir != req.Directory || !strings.HasPrefix(name, req.Prefix) {
+			continue
+		}
+		if name < req.StartFromFileName || name == req.StartFromFileName && !req.InclusiveStartFrom {
+			continue
+		}
+		entries = append(entries, proto.Clone(entry).(*filer_pb.Entry))
+	}
+	f.Unlock()
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+	for i, entry := range entries {
+		if req.Limit > 0 && uint32(i) >= req.Limit {
+			break
+		}
+		if err := stream.Send(&filer_pb.ListEntriesResponse{Entry: entry}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *memFiler) CreateEntry(ctx context.Context, req *filer_pb.CreateEntryRequest) (*filer_pb.CreateEntryResponse, error) {
+	f.put(req.Directory, req.Entry)
+	return &filer_pb.CreateEntryResponse{}, nil
+}
+
+func (f *memFiler) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntryRequest) (*filer_pb.UpdateEntryResponse, error) {
+	f.put(req.Directory, req.Entry)
+	return &filer_pb.UpdateEntryResponse{}, nil
+}
+
+func (f *memFiler) DeleteEntry(ctx context.Context, req *filer_pb.DeleteEntryRequest) (*filer_pb.DeleteEntryResponse, error) {
+	f.Lock()
+	defer f.Unlock()
+	p := util.NewFullPath(req.Directory, req.Name)
+	if _, found := f.entries[p]; !found {
+		return &filer_pb.DeleteEntryResponse{Error: filer_pb.ErrNotFound.Error()}, nil
+	}
+	for child := range f.entries {
+		if strings.HasPrefix(string(child), string(p)+"/") {
+			if !req.IsRecursive {
+				return &filer_pb.DeleteEntryResponse{Error: fmt.Sprintf("%s is not empty", p)}, nil
+			}
+			delete(f.entries, child)
+		}
+	}
+	delete(f.entries, p)
+	return &filer_pb.DeleteEntryResponse{}, nil
+}
+
+func (f *memFiler) AtomicRenameEntry(ctx context.Context, req *filer_pb.AtomicRenameEntryRequest) (*filer_pb.AtomicRenameEntryResponse, error) {
+	f.Lock()
+	oldPath := util.NewFullPath(req.OldDirectory, req.OldName)
+	entry, found := f.entries[oldPath]
+	if !found {
+		f.Unlock()
+		return nil, filer_pb.ErrNotFound
+	}
+	delete(f.entries, oldPath)
+	f.Unlock()
+	entry.Name = req.NewName
+	f.put(req.NewDirectory, entry)
+	return &filer_pb.AtomicRenameEntryResponse{}, nil
+}
+
+func (f *memFiler) put(dir string, entry *filer_pb.Entry) {
+	f.Lock()
+	defer f.Unlock()
+	f.entries[util.NewFullPath(dir, entry.Name)] = proto.Clone(entry).(*filer_pb.Entry)
+	for p := util.FullPath(dir); p != "/"; {
+		parent, name := p.DirAndName()
+		if _, found := f.entries[p]; !found {
+			f.entries[p] = &filer_pb.Entry{Name: name, IsDirectory: true}
+		}
+		p = util.FullPath(parent)
+	}
+}
+
+func (f *memFiler) get(dir, name string) *filer_pb.Entry {
+	f.Lock()
+	defer f.Unlock()
+	return f.entries[util.NewFullPath(dir, name)]
+}
+
+func newMemFilerS3ApiServer(t *testing.T) (*S3ApiServer, *memFiler) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	f := &memFiler{entries: make(map[util.FullPath]*filer_pb.Entry)}
+	grpcServer := grpc.NewServer()
+	filer_pb.RegisterSeaweedFilerServer(grpcServer, f)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	s3a := &S3ApiServer{
+		option: &S3ApiServerOption{
+			Filer:          pb.ServerAddress(fmt.Sprintf("127.0.0.1:0.%d", listener.Addr().(*net.TCPAddr).Port)),
+			BucketsPath:    "/buckets",
+			GrpcDialOption: grpc.WithInsecure(),
+		},
+	}
+	return s3a, f
+}
+
+func setTestBucketVersioning(f *memFiler, bucket, versioning string) {
+	f.put("/buckets", &filer_pb.Entry{
+		Name:        bucket,
+		IsDirectory: true,
+		Extended:    map[string][]byte{xhttp.AmzBucketVersioning: []byte(versioning)},
+	})
+}
+
+// writeTestObject writes the object as uploaded through the filer, into the prepared location
+func writeTestObject(t *testing.T, s3a *S3ApiServer, f *memFiler, bucket, object, content string) *versionedWrite {
+	write, err := s3a.prepareVersionedWrite(bucket, object)
+	if err != nil {
+		t.Fatalf("prepare %s%s: %v", bucket, object, err)
+	}
+	entry := &filer_pb.Entry{
+		Name:       write.name,
+		Content:    []byte(content),
+		Attributes: &filer_pb.FuseAttributes{},
+		Extended:   map[string][]byte{},
+	}
+	if write.versionId != "" {
+		entry.Extended[xhttp.AmzVersionId] = []byte(write.versionId)
+	}
+	f.put(write.dir, entry)
+	return write
+}
+
+func TestVersionedWriteSuspended(t *testing.T) {
+	s3a, f := newMemFilerS3ApiServer(t)
+	setTestBucketVersioning(f, "b1", VersioningEnabled)
+
+	first := writeTestObject(t, s3a, f, "b1", "/a", "v1")
+	assert.NoError(t, s3a.commitVersionedWrite(first))
+	assert.NotEmpty(t, first.versionId)
+
+	// with versioning suspended, the new null version does not replace the versioned object
+	setTestBucketVersioning(f, "b1", VersioningSuspended)
+	second := writeTestObject(t, s3a, f, "b1", "/a", "v2")
+	assert.Empty(t, second.versionId)
+	assert.Equal(t, "v1", string(f.get("/buckets/b1", "a").Content), "current object changed before commit")
+	assert.NoError(t, s3a.commitVersionedWrite(second))
+	assert.Equal(t, "v2", string(f.get("/buckets/b1", "a").Content))
+	assert.Equal(t, "v1", string(f.get(s3a.genObjectVersionsFolder("b1", "/a"), first.versionId).Content))
+
+	// the next null version replaces the current null version
+	third := writeTestObject(t, s3a, f, "b1", "/a", "v3")
+	assert.NoError(t, s3a.commitVersionedWrite(third))
+	assert.Equal(t, "v3", string(f.get("/buckets/b1", "a").Content))
+	assert.Nil(t, f.get(s3a.genObjectVersionsFolder("b1", "/a"), nullVersionId))
+	assert.Nil(t, f.get(third.dir, third.name))
+	versions, err := s3a.listNoncurrentVersions("b1", "/a")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(versions))
+}
+
+func TestVersionedWriteFailed(t *testing.T) {
+	s3a, f := newMemFilerS3ApiServer(t)
+	setTestBucketVersioning(f, "b1", VersioningSuspended)
+
+	first := writeTestObject(t, s3a, f, "b1", "/a", "v1")
+	assert.NoError(t, s3a.commitVersionedWrite(first))
+
+	// a partially written null version is hidden, and removed without touching the current object
+	failed := writeTestObject(t, s3a, f, "b1", "/a", "partial")
+	versions, err := s3a.listNoncurrentVersions("b1", "/a")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(versions))
+	s3a.abortVersionedWrite(failed, true)
+	assert.Equal(t, "v1", string(f.get("/buckets/b1", "a").Content))
+	assert.Nil(t, f.get(failed.dir, failed.name))
+
+	setTestBucketVersioning(f, "b1", VersioningEnabled)
+	failed = writeTestObject(t, s3a, f, "b1", "/a", "partial")
+	s3a.abortVersionedWrite(failed, true)
+	assert.Equal(t, "v1", string(f.get("/buckets/b1", "a").Content))
+	assert.Nil(t, f.get(failed.dir, failed.name))
+}
+
+func TestReservedObjects(t *testing.T) {
+	assert.True(t, isReservedObject("/.versions/a/null"))
+	assert.True(t, isReservedObject(".versions"))
+	assert.True(t, isReservedObject("/.lifecycle/x"))
+	assert.False(t, isReservedObject("/a/.versions/b"))
+	assert.False(t, isReservedObject("/.versionsx"))
+
+	s3a := &S3ApiServer{}
+	handler := s3a.reservedObjectMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	for object, expected := range map[string]int{
+		".versions/a/null": http.StatusForbidden,
+		"a/b":              http.StatusOK,
+	} {
+		req := mux.SetURLVars(httptest.NewRequest("GET", "/b1/"+object, nil), map[string]string{"bucket": "b1", "object": object})
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, expected, rec.Code, object)
+	}
+}
+
+func TestVersionedWriteStaged(t *testing.T) {
+	s3a, f := newMemFilerS3ApiServer(t)
+	setTestBucketVersioning(f, "b1", VersioningEnabled)
+
+	first := writeTestObject(t, s3a, f, "b1", "/a", "v1")
+	assert.NoError(t, s3a.commitVersionedWrite(first))
+
+	// the staged object is neither listed, looked up nor promoted before it is committed
+	staged := writeTestObject(t, s3a, f, "b1", "/a", "v2")
+	versions, err := s3a.listNoncurrentVersions("b1", "/a")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(versions))
+	_, _, _, err = s3a.lookupVersion("b1", "/a", staged.name)
+	assert.Equal(t, filer_pb.ErrNotFound, err)
+
+	markerVersionId, err := s3a.deleteCurrentVersion("b1", "/a", VersioningEnabled)
+	assert.NoError(t, err)
+	_, err = s3a.deleteVersion("b1", "/a", markerVersionId)
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", string(f.get("/buckets/b1", "a").Content))
+
+	assert.NoError(t, s3a.commitVersionedWrite(staged))
+	assert.Equal(t, "v2", string(f.get("/buckets/b1", "a").Content))
+	versions, err = s3a.listNoncurrentVersions("b1", "/a")
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(versions)) {
+		assert.Equal(t, first.versionId, versions[0].Name)
+	}
+}
+
+func TestVersionedWriteConcurrent(t *testing.T) {
+	s3a, f := newMemFilerS3ApiServer(t)
+	setTestBucketVersioning(f, "b1", VersioningEnabled)
+
+	// every committed object is kept, either as the current object or as a noncurrent version
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		write := writeTestObject(t, s3a, f, "b1", "/a", fmt.Sprintf("v%d", i))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, s3a.commitVersionedWrite(write))
+		}()
+	}
+	wg.Wait()
+
+	contents := map[string]bool{string(f.get("/buckets/b1", "a").Content): true}
+	versions, err := s3a.listNoncurrentVersions("b1", "/a")
+	assert.NoError(t, err)
+	for _, version := range versions {
+		contents[string(version.Content)] = true
+	}
+	assert.Equal(t, 10, len(contents))
+	assert.Equal(t, 9, len(versions))
+	assert.Empty(t, s3a.versionLocks.locks)
+}
@@ -33,13 +33,62 @@ const (
 	// S3 object tagging
 	AmzObjectTagging = "X-Amz-Tagging"
 	AmzTagCount      = "x-amz-tagging-count"
+
+	// S3 temporary credentials
+	AmzSecurityToken = "X-Amz-Security-Token"
+
+	// S3 object versioning
+	AmzVersionId    = "x-amz-version-id"
+	AmzDeleteMarker = "x-amz-delete-marker"
+
+	// S3 object lock
+	AmzObjectLockMode            = "X-Amz-Object-Lock-Mode"
+	AmzObjectLockRetainUntilDate = "X-Amz-Object-Lock-Retain-Until-Date"
+	AmzObjectLockLegalHold       = "X-Amz-Object-Lock-Legal-Hold"
+	AmzBypassGovernanceRetention = "X-Amz-Bypass-Governance-Retention"
+	AmzBucketObjectLockEnabled   = "X-Amz-Bucket-Object-Lock-Enabled"
+
+	// S3 ACL
+	AmzCannedAcl        = "X-Amz-Acl"
+	AmzGrantFullControl = "X-Amz-Grant-Full-Control"
+	AmzGrantRead        = "X-Amz-Grant-Read"
+	AmzGrantWrite       = "X-Amz-Grant-Write"
+	AmzGrantReadAcp     = "X-Amz-Grant-Read-Acp"
+	AmzGrantWriteAcp    = "X-Amz-Grant-Write-Acp"
+
+	// S3 server-side encryption
+	AmzServerSideEncryption                            = "X-Amz-Server-Side-Encryption"
+	AmzServerSideEncryptionAwsKmsKeyId                 = "X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id"
+	AmzServerSideEncryptionCustomerAlgorithm           = "X-Amz-Server-Side-Encryption-Customer-Algorithm"
+	AmzServerSideEncryptionCustomerKey                 = "X-Amz-Server-Side-Encryption-Customer-Key"
+	AmzServerSideEncryptionCustomerKeyMD5              = "X-Amz-Server-Side-Encryption-Customer-Key-Md5"
+	AmzCopySourceServerSideEncryptionCustomerAlgorithm = "X-Amz-Copy-Source-Server-Side-Encryption-Customer-Algorithm"
+	AmzCopySourceServerSideEncryptionCustomerKey       = "X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key"
+	AmzCopySourceServerSideEncryptionCustomerKeyMD5    = "X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key-Md5"
 )
 
 // Non-Standard S3 HTTP request constants
 const (
-	AmzIdentityId = "s3-identity-id"
-	AmzAuthType   = "s3-auth-type"
-	AmzIsAdmin    = "s3-is-admin" // only set to http request header as a context
+	AmzIdentityId          = "s3-identity-id"
+	AmzAuthType            = "s3-auth-type"
+	AmzIsAdmin             = "s3-is-admin"              // only set to http request header as a context
+	AmzIsPolicyAllowed     = "s3-is-policy-allowed"     // only set to http request header as a context
+	AmzCanBypassGovernance = "s3-can-bypass-governance" // only set to http request header as a context
+	AmzIsAclAllowed        = "s3-is-acl-allowed"        // only set to http request header as a context
+	AmzAcl                 = "s3-acl"                   // stored in the bucket and object entries
+	AmzPromotedVersion     = "s3-promoted-version"      // stored in the object entry moved back from the noncurrent versions
+	AmzSseIv               = "s3-sse-iv"                // stored in the object entry encrypted by the gateway
+	AmzSseKeyHmac          = "s3-sse-key-hmac"          // stored in the SSE-C object entry, to check the customer key
+	AmzSseDataKey          = "s3-sse-data-key"          // stored in the SSE-KMS object entry, wrapped by the KMS key
+	AmzSseParts            = "s3-sse-parts"             // stored in the multipart object entry, the parts being encrypted separately
+
+	AmzBucketVersioning   = "s3-versioning"   // stored in the bucket entry
+	AmzBucketPolicy       = "s3-policy"       // stored in the bucket entry
+	AmzBucketCors         = "s3-cors"         // stored in the bucket entry
+	AmzBucketLifecycle    = "s3-lifecycle"    // stored in the bucket entry
+	AmzBucketObjectLock   = "s3-object-lock"  // stored in the bucket entry
+	AmzBucketNotification = "s3-notification" // stored in the bucket entry
+	AmzBucketEncryption   = "s3-encryption"   // stored in the bucket entry
 )
 
 func GetBucketAndObject(r *http.Request) (bucket, object string) {
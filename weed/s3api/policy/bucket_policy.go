@@ -0,0 +1,385 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Effect of a policy statement, or the result of evaluating a bucket policy
+type Effect string
+
+const (
+	Allow Effect = "Allow"
+	Deny  Effect = "Deny"
+	// NoMatch means no statement applies to the request
+	NoMatch Effect = ""
+)
+
+const (
+	ResourceArnPrefix = "arn:aws:s3:::"
+	// MaxBucketPolicySize is the size limit of a bucket policy document, same as AWS S3
+	MaxBucketPolicySize = 20 * 1024
+)
+
+// BucketPolicy is an S3 bucket policy document.
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/access-policy-language-overview.html
+type BucketPolicy struct {
+	Version   string      `json:"Version"`
+	Id        string      `json:"Id,omitempty"`
+	Statement []Statement `json:"Statement"`
+}
+
+type Statement struct {
+	Sid         string     `json:"Sid,omitempty"`
+	Effect      Effect     `json:"Effect"`
+	Principal   *Principal `json:"Principal,omitempty"`
+	Action      StringSet  `json:"Action,omitempty"`
+	NotAction   StringSet  `json:"NotAction,omitempty"`
+	Resource    StringSet  `json:"Resource,omitempty"`
+	NotResource StringSet  `json:"NotResource,omitempty"`
+	// operator => condition key => values
+	Condition map[string]map[string]StringSet `json:"Condition,omitempty"`
+}
+
+// Principal is either "*" or {"AWS": ...}
+type Principal struct {
+	AWS StringSet `json:"AWS"`
+}
+
+func (p *Principal) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		if s != "*" {
+			return fmt.Errorf("invalid principal %q", s)
+		}
+		p.AWS = StringSet{"*"}
+		return nil
+	}
+	type principal Principal
+	var t principal
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+	*p = Principal(t)
+	return nil
+}
+
+// StringSet accepts either a single string or a list of strings
+type StringSet []string
+
+func (s *StringSet) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*s = StringSet{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*s = many
+	return nil
+}
+
+// Args describes the request to be checked against a bucket policy
+type Args struct {
+	Action    string // e.g. s3:GetObject
+	Resource  string // e.g. arn:aws:s3:::bucket/path/to/object
+	Principal string // identity name, empty for anonymous requests
+	// condition key in lower case => values
+	Conditions map[string][]string
+}
+
+// ParseBucketPolicy parses and validates the bucket policy of one bucket
+func ParseBucketPolicy(data []byte, bucket string) (*BucketPolicy, error) {
+	if len(data) > MaxBucketPolicySize {
+		return nil, fmt.Errorf("policy size %d exceeds %d", len(data), MaxBucketPolicySize)
+	}
+	p := &BucketPolicy{}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, err
+	}
+	if err := p.validate(bucket); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *BucketPolicy) validate(bucket string) error {
+	if p.Version != "2012-10-17" && p.Version != "2008-10-17" {
+		return fmt.Errorf("invalid policy version %q", p.Version)
+	}
+	if len(p.Statement) == 0 {
+		return fmt.Errorf("missing policy statement")
+	}
+	for i, st := range p.Statement {
+		if err := st.validate(bucket); err != nil {
+			return fmt.Errorf("statement %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (st *Statement) validate(bucket string) error {
+	if st.Effect != Allow && st.Effect != Deny {
+		return fmt.Errorf("invalid effect %q", st.Effect)
+	}
+	if st.Principal == nil || len(st.Principal.AWS) == 0 {
+		return fmt.Errorf("missing principal")
+	}
+	if (len(st.Action) == 0) == (len(st.NotAction) == 0) {
+		return fmt.Errorf("exactly one of Action and NotAction is required")
+	}
+	for _, action := range append(st.Action, st.NotAction...) {
+		if action != "*" && !strings.HasPrefix(strings.ToLower(action), "s3:") {
+			return fmt.Errorf("invalid action %q", action)
+		}
+	}
+	if (len(st.Resource) == 0) == (len(st.NotResource) == 0) {
+		return fmt.Errorf("exactly one of Resource and NotResource is required")
+	}
+	for _, resource := range append(st.Resource, st.NotResource...) {
+		if !strings.HasPrefix(resource, ResourceArnPrefix) {
+			return fmt.Errorf("invalid resource %q", resource)
+		}
+		name := strings.TrimPrefix(resource, ResourceArnPrefix)
+		if name != bucket && !strings.HasPrefix(name, bucket+"/") {
+			return fmt.Errorf("resource %q is not in bucket %s", resource, bucket)
+		}
+	}
+	for operator, conditions := range st.Condition {
+		op := strings.TrimSuffix(operator, "IfExists")
+		if _, found := conditionOperators[op]; !found {
+			return fmt.Errorf("unsupported condition operator %q", operator)
+		}
+		for key, values := range conditions {
+			for _, value := range values {
+				if err := validateConditionValue(op, value); err != nil {
+					return fmt.Errorf("condition %s %s: %v", operator, key, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Evaluate returns Deny if any statement denies the request, otherwise Allow
+// if any statement allows it, and NoMatch if no statement applies.
+func (p *BucketPolicy) Evaluate(args *Args) Effect {
+	result := NoMatch
+	for _, st := range p.Statement {
+		if !st.matches(args) {
+			continue
+		}
+		if st.Effect == Deny {
+			return Deny
+		}
+		result = Allow
+	}
+	return result
+}
+
+func (st *Statement) matches(args *Args) bool {
+	if !st.Principal.matches(args.Principal) {
+		return false
+	}
+	if len(st.Action) > 0 && !matchAny(st.Action, args.Action, true) {
+		return false
+	}
+	if len(st.NotAction) > 0 && matchAny(st.NotAction, args.Action, true) {
+		return false
+	}
+	if len(st.Resource) > 0 && !matchAny(st.Resource, args.Resource, false) {
+		return false
+	}
+	if len(st.NotResource) > 0 && matchAny(st.NotResource, args.Resource, false) {
+		return false
+	}
+	for operator, conditions := range st.Condition {
+		for key, values := range conditions {
+			if !evalCondition(operator, values, args.Conditions[strings.ToLower(key)]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matches checks the principal by identity name, either plain or as an IAM user arn.
+// Anonymous requests only match "*".
+func (p *Principal) matches(name string) bool {
+	for _, principal := range p.AWS {
+		if principal == "*" {
+			return true
+		}
+		if name == "" {
+			continue
+		}
+		if principal == name {
+			return true
+		}
+		if strings.HasPrefix(principal, "arn:aws:iam:") && strings.HasSuffix(principal, ":user/"+name) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAny(patterns []string, text string, ignoreCase bool) bool {
+	for _, pattern := range patterns {
+		if ignoreCase {
+			if MatchWildcard(strings.ToLower(pattern), strings.ToLower(text)) {
+				return true
+			}
+		} else if MatchWildcard(pattern, text) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchWildcard matches text against a pattern where '*' matches any sequence
+// of characters, including '/', and '?' matches any single character.
+func MatchWildcard(pattern, text string) bool {
+	p, t := 0, 0
+	starP, starT := -1, 0
+	for t < len(text) {
+		if p < len(pattern) && (pattern[p] == '?' || pattern[p] == text[t]) {
+			p++
+			t++
+		} else if p < len(pattern) && pattern[p] == '*' {
+			starP, starT = p, t
+			p++
+		} else if starP >= 0 {
+			p = starP + 1
+			starT++
+			t = starT
+		} else {
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+type conditionFunc func(policyValue, requestValue string) bool
+
+// conditionOperators lists the supported operators, negated ones are handled in evalCondition
+var conditionOperators = map[string]conditionFunc{
+	"StringEquals":              func(p, v string) bool { return p == v },
+	"StringNotEquals":           func(p, v string) bool { return p == v },
+	"StringEqualsIgnoreCase":    strings.EqualFold,
+	"StringNotEqualsIgnoreCase": strings.EqualFold,
+	"StringLike":                MatchWildcard,
+	"StringNotLike":             MatchWildcard,
+	"NumericEquals":             numericCompare(func(p, v float64) bool { return v == p }),
+	"NumericNotEquals":          numericCompare(func(p, v float64) bool { return v == p }),
+	"NumericLessThan":           numericCompare(func(p, v float64) bool { return v < p }),
+	"NumericLessThanEquals":     numericCompare(func(p, v float64) bool { return v <= p }),
+	"NumericGreaterThan":        numericCompare(func(p, v float64) bool { return v > p }),
+	"NumericGreaterThanEquals":  numericCompare(func(p, v float64) bool { return v >= p }),
+	"Bool":                      strings.EqualFold,
+	"IpAddress":                 ipInRange,
+	"NotIpAddress":              ipInRange,
+	"Null":                      nil,
+}
+
+var negatedConditionOperators = map[string]bool{
+	"StringNotEquals":           true,
+	"StringNotEqualsIgnoreCase": true,
+	"StringNotLike":             true,
+	"NumericNotEquals":          true,
+	"NotIpAddress":              true,
+}
+
+func numericCompare(cmp func(p, v float64) bool) conditionFunc {
+	return func(policyValue, requestValue string) bool {
+		p, err := strconv.ParseFloat(policyValue, 64)
+		if err != nil {
+			return false
+		}
+		v, err := strconv.ParseFloat(requestValue, 64)
+		if err != nil {
+			return false
+		}
+		return cmp(p, v)
+	}
+}
+
+func ipInRange(policyValue, requestValue string) bool {
+	ip := net.ParseIP(requestValue)
+	if ip == nil {
+		return false
+	}
+	if !strings.Contains(policyValue, "/") {
+		return ip.Equal(net.ParseIP(policyValue))
+	}
+	_, ipNet, err := net.ParseCIDR(policyValue)
+	if err != nil {
+		return false
+	}
+	return ipNet.Contains(ip)
+}
+
+func validateConditionValue(operator, value string) error {
+	switch {
+	case strings.HasPrefix(operator, "Numeric"):
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("invalid number %q", value)
+		}
+	case operator == "IpAddress" || operator == "NotIpAddress":
+		if strings.Contains(value, "/") {
+			if _, _, err := net.ParseCIDR(value); err != nil {
+				return fmt.Errorf("invalid cidr %q", value)
+			}
+		} else if net.ParseIP(value) == nil {
+			return fmt.Errorf("invalid ip %q", value)
+		}
+	case operator == "Bool" || operator == "Null":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("invalid boolean %q", value)
+		}
+	}
+	return nil
+}
+
+// evalCondition checks one condition key. A missing key fails positive operators,
+// but satisfies negated ones and any operator with the IfExists suffix.
+func evalCondition(operator string, policyValues []string, requestValues []string) bool {
+	op := strings.TrimSuffix(operator, "IfExists")
+	ifExists := op != operator
+
+	if op == "Null" {
+		for _, p := range policyValues {
+			isNull, _ := strconv.ParseBool(p)
+			if isNull != (len(requestValues) == 0) {
+				return false
+			}
+		}
+		return true
+	}
+
+	negated := negatedConditionOperators[op]
+	if len(requestValues) == 0 {
+		return ifExists || negated
+	}
+
+	fn := conditionOperators[op]
+	if fn == nil {
+		return false
+	}
+	matched := false
+	for _, p := range policyValues {
+		for _, v := range requestValues {
+			if fn(p, v) {
+				matched = true
+			}
+		}
+	}
+	return matched != negated
+}
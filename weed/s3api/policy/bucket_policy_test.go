@@ -0,0 +1,74 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testBucketPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "PublicRead",
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": ["s3:GetObject"],
+      "Resource": "arn:aws:s3:::bucket1/public/*"
+    },
+    {
+      "Sid": "TeamList",
+      "Effect": "Allow",
+      "Principal": {"AWS": ["arn:aws:iam::123456789012:user/team2"]},
+      "Action": "s3:ListBucket",
+      "Resource": "arn:aws:s3:::bucket1",
+      "Condition": {"StringLike": {"s3:prefix": "shared/*"}}
+    },
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "s3:*",
+      "Resource": "arn:aws:s3:::bucket1/*",
+      "Condition": {"NotIpAddress": {"aws:SourceIp": ["10.0.0.0/8", "127.0.0.1"]}}
+    }
+  ]
+}`
+
+func TestParseBucketPolicy(t *testing.T) {
+	_, err := ParseBucketPolicy([]byte(testBucketPolicy), "bucket1")
+	assert.NoError(t, err)
+
+	_, err = ParseBucketPolicy([]byte(testBucketPolicy), "bucket2")
+	assert.Error(t, err, "resource of another bucket")
+
+	_, err = ParseBucketPolicy([]byte(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket1/*","Condition":{"DateEquals":{"aws:CurrentTime":"2020-01-01"}}}]}`), "bucket1")
+	assert.Error(t, err, "unsupported operator")
+
+	_, err = ParseBucketPolicy([]byte(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket1/*"}]}`), "bucket1")
+	assert.Error(t, err, "missing principal")
+}
+
+func TestEvaluateBucketPolicy(t *testing.T) {
+	p, err := ParseBucketPolicy([]byte(testBucketPolicy), "bucket1")
+	assert.NoError(t, err)
+
+	local := map[string][]string{"aws:sourceip": {"10.1.2.3"}}
+	remote := map[string][]string{"aws:sourceip": {"192.168.1.1"}}
+
+	assert.Equal(t, Allow, p.Evaluate(&Args{Action: "s3:GetObject", Resource: "arn:aws:s3:::bucket1/public/a/b.txt", Conditions: local}))
+	assert.Equal(t, NoMatch, p.Evaluate(&Args{Action: "s3:GetObject", Resource: "arn:aws:s3:::bucket1/private/b.txt", Conditions: local}))
+	assert.Equal(t, Deny, p.Evaluate(&Args{Action: "s3:GetObject", Resource: "arn:aws:s3:::bucket1/public/b.txt", Conditions: remote}))
+
+	team2 := map[string][]string{"aws:sourceip": {"127.0.0.1"}, "s3:prefix": {"shared/docs/"}}
+	assert.Equal(t, Allow, p.Evaluate(&Args{Action: "s3:ListBucket", Resource: "arn:aws:s3:::bucket1", Principal: "team2", Conditions: team2}))
+	assert.Equal(t, NoMatch, p.Evaluate(&Args{Action: "s3:ListBucket", Resource: "arn:aws:s3:::bucket1", Principal: "team3", Conditions: team2}))
+	assert.Equal(t, NoMatch, p.Evaluate(&Args{Action: "s3:ListBucket", Resource: "arn:aws:s3:::bucket1", Principal: "team2", Conditions: local}))
+}
+
+func TestMatchWildcard(t *testing.T) {
+	assert.True(t, MatchWildcard("*", ""))
+	assert.True(t, MatchWildcard("a/*", "a/b/c"))
+	assert.True(t, MatchWildcard("a?c*", "abcdef"))
+	assert.False(t, MatchWildcard("a?c", "ac"))
+	assert.False(t, MatchWildcard("a/*/d", "a/b/c"))
+}
@@ -6,4 +6,7 @@ const (
 	ACTION_ADMIN   = "Admin"
 	ACTION_TAGGING = "Tagging"
 	ACTION_LIST    = "List"
+
+	// ACTION_ASSUME_ROLE allows to assume the identity named after the colon, e.g. "AssumeRole:ci-*"
+	ACTION_ASSUME_ROLE = "AssumeRole"
 )
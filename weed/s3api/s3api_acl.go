@@ -0,0 +1,259 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+)
+
+const (
+	PermissionFullControl = Permission("FULL_CONTROL")
+	PermissionRead        = Permission("READ")
+	PermissionWrite       = Permission("WRITE")
+	PermissionReadAcp     = Permission("READ_ACP")
+	PermissionWriteAcp    = Permission("WRITE_ACP")
+
+	GranteeCanonicalUser = "CanonicalUser"
+	GranteeGroup         = "Group"
+
+	GroupAllUsers           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	GroupAuthenticatedUsers = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+
+	CannedAclPrivate                = "private"
+	CannedAclPublicRead             = "public-read"
+	CannedAclPublicReadWrite        = "public-read-write"
+	CannedAclAuthenticatedRead      = "authenticated-read"
+	CannedAclBucketOwnerRead        = "bucket-owner-read"
+	CannedAclBucketOwnerFullControl = "bucket-owner-full-control"
+)
+
+// the explicit grant headers and their permissions
+var grantHeaders = map[string]Permission{
+	xhttp.AmzGrantFullControl: PermissionFullControl,
+	xhttp.AmzGrantRead:        PermissionRead,
+	xhttp.AmzGrantWrite:       PermissionWrite,
+	xhttp.AmzGrantReadAcp:     PermissionReadAcp,
+	xhttp.AmzGrantWriteAcp:    PermissionWriteAcp,
+}
+
+// accessControlPolicyInput is the AccessControlPolicy sent by clients, where the grantee type is an xsi:type attribute
+type accessControlPolicyInput struct {
+	XMLName           xml.Name      `xml:"AccessControlPolicy"`
+	Owner             CanonicalUser `xml:"Owner"`
+	AccessControlList struct {
+		Grants []struct {
+			Grantee struct {
+				Type         string `xml:"http://www.w3.org/2001/XMLSchema-instance type,attr"`
+				ID           string `xml:"ID"`
+				URI          string `xml:"URI"`
+				EmailAddress string `xml:"EmailAddress"`
+			} `xml:"Grantee"`
+			Permission Permission `xml:"Permission"`
+		} `xml:"Grant"`
+	} `xml:"AccessControlList"`
+}
+
+func newGrant(granteeType, grantee string, permission Permission) Grant {
+	grant := Grant{
+		Grantee: Grantee{
+			XMLNS:  "http://www.w3.org/2001/XMLSchema-instance",
+			XMLXSI: granteeType,
+			Type:   granteeType,
+		},
+		Permission: permission,
+	}
+	if granteeType == GranteeGroup {
+		grant.Grantee.URI = grantee
+	} else {
+		grant.Grantee.ID = grantee
+		grant.Grantee.DisplayName = grantee
+	}
+	return grant
+}
+
+func newAccessControlPolicy(owner string, grants ...Grant) *AccessControlPolicy {
+	acp := &AccessControlPolicy{
+		Owner: CanonicalUser{
+			ID:          owner,
+			DisplayName: owner,
+		},
+	}
+	if owner != "" {
+		acp.AccessControlList.Grant = append(acp.AccessControlList.Grant, newGrant(GranteeCanonicalUser, owner, PermissionFullControl))
+	}
+	acp.AccessControlList.Grant = append(acp.AccessControlList.Grant, grants...)
+	return acp
+}
+
+// cannedAcl translates the canned ACL, where the bucket owner only matters for objects
+func cannedAcl(canned, owner, bucketOwner string) (*AccessControlPolicy, error) {
+	switch canned {
+	case CannedAclPrivate:
+		return newAccessControlPolicy(owner), nil
+	case CannedAclPublicRead:
+		return newAccessControlPolicy(owner, newGrant(GranteeGroup, GroupAllUsers, PermissionRead)), nil
+	case CannedAclPublicReadWrite:
+		return newAccessControlPolicy(owner,
+			newGrant(GranteeGroup, GroupAllUsers, PermissionRead),
+			newGrant(GranteeGroup, GroupAllUsers, PermissionWrite)), nil
+	case CannedAclAuthenticatedRead:
+		return newAccessControlPolicy(owner, newGrant(GranteeGroup, GroupAuthenticatedUsers, PermissionRead)), nil
+	case CannedAclBucketOwnerRead, CannedAclBucketOwnerFullControl:
+		if bucketOwner == "" || bucketOwner == owner {
+			return newAccessControlPolicy(owner), nil
+		}
+		permission := PermissionRead
+		if canned == CannedAclBucketOwnerFullControl {
+			permission = PermissionFullControl
+		}
+		return newAccessControlPolicy(owner, newGrant(GranteeCanonicalUser, bucketOwner, permission)), nil
+	}
+	return nil, fmt.Errorf("unsupported canned acl %q", canned)
+}
+
+// aclFromHeaders builds the ACL from either the canned ACL or the explicit grant headers, nil if none is specified
+func aclFromHeaders(r *http.Request, owner, bucketOwner string) (*AccessControlPolicy, error) {
+	canned := r.Header.Get(xhttp.AmzCannedAcl)
+	var grants []Grant
+	for header, permission := range grantHeaders {
+		value := r.Header.Get(header)
+		if value == "" {
+			continue
+		}
+		for _, grantee := range strings.Split(value, ",") {
+			grant, err := parseGrantHeader(strings.TrimSpace(grantee), permission)
+			if err != nil {
+				return nil, err
+			}
+			grants = append(grants, grant)
+		}
+	}
+	if canned != "" && len(grants) > 0 {
+		return nil, fmt.Errorf("canned acl can not be used with grant headers")
+	}
+	if canned != "" {
+		return cannedAcl(canned, owner, bucketOwner)
+	}
+	if len(grants) > 0 {
+		return newAccessControlPolicy(owner, grants...), nil
+	}
+	return nil, nil
+}
+
+// parseGrantHeader parses one grantee of the grant headers, in the form of id="..." or uri="..."
+func parseGrantHeader(grantee string, permission Permission) (Grant, error) {
+	parts := strings.SplitN(grantee, "=", 2)
+	if len(parts) != 2 {
+		return Grant{}, fmt.Errorf("invalid grantee %q", grantee)
+	}
+	value := strings.Trim(parts[1], `"`)
+	switch strings.ToLower(parts[0]) {
+	case "id":
+		return newGrant(GranteeCanonicalUser, value, permission), nil
+	case "uri":
+		if value != GroupAllUsers && value != GroupAuthenticatedUsers {
+			return Grant{}, fmt.Errorf("unsupported group %q", value)
+		}
+		return newGrant(GranteeGroup, value, permission), nil
+	}
+	return Grant{}, fmt.Errorf("unsupported grantee %q", grantee)
+}
+
+// parseAccessControlPolicyInput parses the ACL in the request body, keeping the given owner
+func parseAccessControlPolicyInput(data []byte, owner string) (*AccessControlPolicy, error) {
+	input := &accessControlPolicyInput{}
+	if err := xml.Unmarshal(data, input); err != nil {
+		return nil, err
+	}
+	acp := &AccessControlPolicy{
+		Owner: CanonicalUser{
+			ID:          owner,
+			DisplayName: owner,
+		},
+	}
+	for _, g := range input.AccessControlList.Grants {
+		if !isValidPermission(g.Permission) {
+			return nil, fmt.Errorf("invalid permission %q", g.Permission)
+		}
+		switch g.Grantee.Type {
+		case GranteeCanonicalUser:
+			if g.Grantee.ID == "" {
+				return nil, fmt.Errorf("missing grantee id")
+			}
+			acp.AccessControlList.Grant = append(acp.AccessControlList.Grant, newGrant(GranteeCanonicalUser, g.Grantee.ID, g.Permission))
+		case GranteeGroup:
+			if g.Grantee.URI != GroupAllUsers && g.Grantee.URI != GroupAuthenticatedUsers {
+				return nil, fmt.Errorf("unsupported group %q", g.Grantee.URI)
+			}
+			acp.AccessControlList.Grant = append(acp.AccessControlList.Grant, newGrant(GranteeGroup, g.Grantee.URI, g.Permission))
+		default:
+			return nil, fmt.Errorf("unsupported grantee type %q", g.Grantee.Type)
+		}
+	}
+	return acp, nil
+}
+
+func isValidPermission(permission Permission) bool {
+	switch permission {
+	case PermissionFullControl, PermissionRead, PermissionWrite, PermissionReadAcp, PermissionWriteAcp:
+		return true
+	}
+	return false
+}
+
+// parseAccessControlPolicy parses the ACL stored in the entry
+func parseAccessControlPolicy(data []byte) (*AccessControlPolicy, error) {
+	acp := &AccessControlPolicy{}
+	if err := xml.Unmarshal(data, acp); err != nil {
+		return nil, err
+	}
+	return acp, nil
+}
+
+// isGranted checks whether the requester has the permission. The owner always has full control.
+func (acp *AccessControlPolicy) isGranted(identityId string, isAuthenticated bool, permission Permission) bool {
+	if identityId != "" && acp.Owner.ID == identityId {
+		return true
+	}
+	for _, grant := range acp.AccessControlList.Grant {
+		if grant.Permission != PermissionFullControl && grant.Permission != permission {
+			continue
+		}
+		switch grant.Grantee.Type {
+		case GranteeCanonicalUser:
+			if identityId != "" && grant.Grantee.ID == identityId {
+				return true
+			}
+		case GranteeGroup:
+			if grant.Grantee.URI == GroupAllUsers || grant.Grantee.URI == GroupAuthenticatedUsers && isAuthenticated {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// aclPermissionOf maps the bucket policy action to the ACL permission, and whether it is checked against the object ACL.
+// Object writes are granted by the bucket ACL. Empty permission means the action can not be granted by ACLs.
+func aclPermissionOf(action string) (permission Permission, onObject bool) {
+	switch action {
+	case "s3:GetObject", "s3:GetObjectVersion":
+		return PermissionRead, true
+	case "s3:GetObjectAcl", "s3:GetObjectVersionAcl":
+		return PermissionReadAcp, true
+	case "s3:PutObjectAcl", "s3:PutObjectVersionAcl":
+		return PermissionWriteAcp, true
+	case "s3:ListBucket", "s3:ListBucketVersions", "s3:ListBucketMultipartUploads":
+		return PermissionRead, false
+	case "s3:PutObject", "s3:DeleteObject", "s3:DeleteObjectVersion", "s3:AbortMultipartUpload", "s3:ListMultipartUploadParts":
+		return PermissionWrite, false
+	case "s3:GetBucketAcl":
+		return PermissionReadAcp, false
+	case "s3:PutBucketAcl":
+		return PermissionWriteAcp, false
+	}
+	return "", false
+}
@@ -0,0 +1,211 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+// GetBucketAclHandler Get Bucket ACL
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketAcl.html
+func (s3a *S3ApiServer) GetBucketAclHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("GetBucketAclHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	entry, err := s3a.getEntry(s3a.option.BucketsPath, bucket)
+	if err != nil {
+		glog.Errorf("GetBucketAclHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	writeSuccessResponseXML(w, r, entryAcl(entry, string(entry.Extended[xhttp.AmzIdentityId])))
+}
+
+// PutBucketAclHandler Put bucket ACL
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketAcl.html
+func (s3a *S3ApiServer) PutBucketAclHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("PutBucketAclHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	entry, err := s3a.getEntry(s3a.option.BucketsPath, bucket)
+	if err != nil {
+		glog.Errorf("PutBucketAclHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	owner := string(entry.Extended[xhttp.AmzIdentityId])
+	acp, errCode := aclFromRequest(r, owner, owner)
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	data, err := xml.Marshal(acp)
+	if err != nil {
+		glog.Errorf("PutBucketAclHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	if err = s3a.updateBucketEntry(bucket, func(entry *filer_pb.Entry) {
+		entry.Extended[xhttp.AmzAcl] = data
+	}); err != nil {
+		glog.Errorf("PutBucketAclHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	writeSuccessResponseEmpty(w, r)
+}
+
+// GetObjectAclHandler Get object ACL
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectAcl.html
+func (s3a *S3ApiServer) GetObjectAclHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, object := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("GetObjectAclHandler %s %s", bucket, object)
+
+	_, entry, errCode := s3a.getLockableEntry(bucket, object, r.URL.Query().Get("versionId"))
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+
+	writeSuccessResponseXML(w, r, entryAcl(entry, s3a.getBucketOwner(bucket)))
+}
+
+// PutObjectAclHandler Put object ACL
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectAcl.html
+func (s3a *S3ApiServer) PutObjectAclHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, object := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("PutObjectAclHandler %s %s", bucket, object)
+
+	dir, entry, errCode := s3a.getLockableEntry(bucket, object, r.URL.Query().Get("versionId"))
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+
+	bucketOwner := s3a.getBucketOwner(bucket)
+	acp, errCode := aclFromRequest(r, entryAcl(entry, bucketOwner).Owner.ID, bucketOwner)
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	data, err := xml.Marshal(acp)
+	if err != nil {
+		glog.Errorf("PutObjectAclHandler %s%s: %v", bucket, object, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	entry.Extended[xhttp.AmzAcl] = data
+	if err = s3a.touch(dir, entry.Name, entry); err != nil {
+		glog.Errorf("PutObjectAclHandler %s%s: %v", bucket, object, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	writeSuccessResponseEmpty(w, r)
+}
+
+// aclFromRequest reads the new ACL from either the ACL headers or the request body, keeping the current owner
+func aclFromRequest(r *http.Request, owner, bucketOwner string) (*AccessControlPolicy, s3err.ErrorCode) {
+	acp, err := aclFromHeaders(r, owner, bucketOwner)
+	if err != nil {
+		glog.V(1).Infof("acl headers %s: %v", r.URL, err)
+		return nil, s3err.ErrInvalidAclArgument
+	}
+	if acp != nil {
+		return acp, s3err.ErrNone
+	}
+
+	input, err := io.ReadAll(io.LimitReader(r.Body, maxConfigurationSize))
+	if err != nil {
+		glog.Errorf("read acl %s: %v", r.URL, err)
+		return nil, s3err.ErrInternalError
+	}
+	if acp, err = parseAccessControlPolicyInput(input, owner); err != nil {
+		glog.V(1).Infof("acl %s: %v", r.URL, err)
+		return nil, s3err.ErrMalformedACLError
+	}
+	return acp, s3err.ErrNone
+}
+
+// entryAcl returns the ACL stored in the entry, which defaults to full control by the owner
+func entryAcl(entry *filer_pb.Entry, owner string) *AccessControlPolicy {
+	if data, found := entry.Extended[xhttp.AmzAcl]; found {
+		acp, err := parseAccessControlPolicy(data)
+		if err == nil {
+			return acp
+		}
+		glog.Warningf("entry %s has invalid acl: %v", entry.Name, err)
+	}
+	return newAccessControlPolicy(owner)
+}
+
+func (s3a *S3ApiServer) getBucketOwner(bucket string) string {
+	if config := s3a.bucketConfigs.get(bucket); config != nil {
+		return config.Owner
+	}
+	return ""
+}
+
+// getObjectAcl reads the ACL of the object version, nil if the object is missing or has no ACL
+func (s3a *S3ApiServer) getObjectAcl(bucket, object, versionId string) *AccessControlPolicy {
+	_, entry, errCode := s3a.getLockableEntry(bucket, object, versionId)
+	if errCode != s3err.ErrNone {
+		return nil
+	}
+	data, found := entry.Extended[xhttp.AmzAcl]
+	if !found {
+		return nil
+	}
+	acp, err := parseAccessControlPolicy(data)
+	if err != nil {
+		glog.Warningf("object %s%s has invalid acl: %v", bucket, object, err)
+		return nil
+	}
+	return acp
+}
+
+// prepareObjectAcl translates the ACL headers of a new object into its ACL,
+// which is saved along with the object by the filer.
+func (s3a *S3ApiServer) prepareObjectAcl(r *http.Request, bucket string) s3err.ErrorCode {
+	r.Header.Del(xhttp.AmzAcl)
+	bucketOwner := s3a.getBucketOwner(bucket)
+	owner := r.Header.Get(xhttp.AmzIdentityId)
+	if owner == "" {
+		owner = bucketOwner
+	}
+	acp, err := aclFromHeaders(r, owner, bucketOwner)
+	if err != nil {
+		glog.V(1).Infof("acl headers %s: %v", r.URL, err)
+		return s3err.ErrInvalidAclArgument
+	}
+	if acp == nil {
+		return s3err.ErrNone
+	}
+	data, err := xml.Marshal(acp)
+	if err != nil {
+		glog.Errorf("marshal acl %s: %v", r.URL, err)
+		return s3err.ErrInternalError
+	}
+	r.Header.Set(xhttp.AmzAcl, string(data))
+	return s3err.ErrNone
+}
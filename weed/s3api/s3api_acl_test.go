@@ -0,0 +1,95 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"net/http"
+	"testing"
+
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+)
+
+func TestCannedAcl(t *testing.T) {
+	acp, err := cannedAcl(CannedAclPublicRead, "alice", "bob")
+	if err != nil {
+		t.Fatalf("canned acl: %v", err)
+	}
+
+	// the stored form is parsed back with the grantee types
+	data, err := xml.Marshal(acp)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if acp, err = parseAccessControlPolicy(data); err != nil {
+		t.Fatalf("parse %s: %v", data, err)
+	}
+
+	if !acp.isGranted("alice", true, PermissionWriteAcp) {
+		t.Errorf("owner should have full control")
+	}
+	if !acp.isGranted("", false, PermissionRead) {
+		t.Errorf("anonymous should read public-read objects")
+	}
+	if acp.isGranted("bob", true, PermissionWrite) {
+		t.Errorf("public-read should not grant write")
+	}
+
+	if acp, _ = cannedAcl(CannedAclAuthenticatedRead, "alice", ""); acp.isGranted("", false, PermissionRead) {
+		t.Errorf("authenticated-read should not grant anonymous read")
+	}
+	if acp, _ = cannedAcl(CannedAclBucketOwnerFullControl, "alice", "bob"); !acp.isGranted("bob", true, PermissionWriteAcp) {
+		t.Errorf("bucket-owner-full-control should grant the bucket owner")
+	}
+	if _, err = cannedAcl("log-delivery-write", "alice", ""); err == nil {
+		t.Errorf("expect unsupported canned acl")
+	}
+}
+
+func TestAclFromHeaders(t *testing.T) {
+	r, _ := http.NewRequest(http.MethodPut, "http://localhost/bucket/object?acl", nil)
+	if acp, err := aclFromHeaders(r, "alice", ""); acp != nil || err != nil {
+		t.Errorf("expect no acl without headers: %v %v", acp, err)
+	}
+
+	r.Header.Set(xhttp.AmzGrantRead, `id="bob", uri="http://acs.amazonaws.com/groups/global/AuthenticatedUsers"`)
+	acp, err := aclFromHeaders(r, "alice", "")
+	if err != nil {
+		t.Fatalf("grant headers: %v", err)
+	}
+	if !acp.isGranted("bob", true, PermissionRead) || !acp.isGranted("carol", true, PermissionRead) {
+		t.Errorf("expect read granted")
+	}
+	if acp.isGranted("", false, PermissionRead) || acp.isGranted("bob", true, PermissionWrite) {
+		t.Errorf("expect only authenticated read granted")
+	}
+
+	r.Header.Set(xhttp.AmzCannedAcl, CannedAclPrivate)
+	if _, err = aclFromHeaders(r, "alice", ""); err == nil {
+		t.Errorf("expect canned acl and grant headers to conflict")
+	}
+}
+
+func TestParseAccessControlPolicyInput(t *testing.T) {
+	data := []byte(`<AccessControlPolicy xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Owner><ID>mallory</ID></Owner>
+  <AccessControlList>
+    <Grant>
+      <Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="Group"><URI>http://acs.amazonaws.com/groups/global/AllUsers</URI></Grantee>
+      <Permission>READ</Permission>
+    </Grant>
+    <Grant>
+      <Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>bob</ID></Grantee>
+      <Permission>FULL_CONTROL</Permission>
+    </Grant>
+  </AccessControlList>
+</AccessControlPolicy>`)
+	acp, err := parseAccessControlPolicyInput(data, "alice")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if acp.Owner.ID != "alice" {
+		t.Errorf("owner should not be changed, got %s", acp.Owner.ID)
+	}
+	if !acp.isGranted("", false, PermissionRead) || !acp.isGranted("bob", true, PermissionWriteAcp) {
+		t.Errorf("expect the grants to be applied")
+	}
+}
@@ -0,0 +1,123 @@
+package s3api
+
+import (
+	"sync"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+)
+
+// BucketConfig holds the parsed bucket level configurations stored in the bucket entry
+type BucketConfig struct {
+	Owner      string
+	Cors       *CORSConfiguration
+	Lifecycle  *Lifecycle
+	ObjectLock *ObjectLockConfiguration
+	// Encryption is the default encryption of the new objects, nil if not configured
+	Encryption *ServerSideEncryptionConfiguration
+	// Notification is nil if the bucket has no notification rules
+	Notification *BucketNotificationConfiguration
+}
+
+// bucketConfigCache is refreshed by the filer metadata events on the bucket entries
+type bucketConfigCache struct {
+	sync.RWMutex
+	configs map[string]*BucketConfig
+}
+
+func newBucketConfigCache() *bucketConfigCache {
+	return &bucketConfigCache{
+		configs: make(map[string]*BucketConfig),
+	}
+}
+
+func (c *bucketConfigCache) get(bucket string) *BucketConfig {
+	c.RLock()
+	defer c.RUnlock()
+	return c.configs[bucket]
+}
+
+func (c *bucketConfigCache) lifecycles() map[string]*Lifecycle {
+	c.RLock()
+	defer c.RUnlock()
+	lifecycles := make(map[string]*Lifecycle)
+	for bucket, config := range c.configs {
+		if config.Lifecycle != nil {
+			lifecycles[bucket] = config.Lifecycle
+		}
+	}
+	return lifecycles
+}
+
+// update parses the configurations of the bucket entry, nil entry removes the bucket
+func (c *bucketConfigCache) update(bucket string, entry *filer_pb.Entry) {
+	if entry == nil {
+		c.Lock()
+		delete(c.configs, bucket)
+		c.Unlock()
+		return
+	}
+
+	config := &BucketConfig{
+		Owner: string(entry.Extended[xhttp.AmzIdentityId]),
+	}
+	if data, found := entry.Extended[xhttp.AmzBucketCors]; found {
+		cors, err := parseCORSConfiguration(data)
+		if err != nil {
+			glog.Warningf("bucket %s has invalid cors configuration: %v", bucket, err)
+		}
+		config.Cors = cors
+	}
+	if data, found := entry.Extended[xhttp.AmzBucketLifecycle]; found {
+		lifecycle, err := parseLifecycle(data)
+		if err != nil {
+			glog.Warningf("bucket %s has invalid lifecycle configuration: %v", bucket, err)
+		}
+		config.Lifecycle = lifecycle
+	}
+	if data, found := entry.Extended[xhttp.AmzBucketObjectLock]; found {
+		objectLock, err := parseObjectLockConfiguration(data)
+		if err != nil {
+			glog.Warningf("bucket %s has invalid object lock configuration: %v", bucket, err)
+			// keep the bucket locked
+			objectLock = &ObjectLockConfiguration{ObjectLockEnabled: ObjectLockEnabled}
+		}
+		config.ObjectLock = objectLock
+	}
+	if data, found := entry.Extended[xhttp.AmzBucketEncryption]; found {
+		encryption, err := parseServerSideEncryptionConfiguration(data)
+		if err != nil {
+			glog.Warningf("bucket %s has invalid encryption configuration: %v", bucket, err)
+		}
+		config.Encryption = encryption
+	}
+	if data, found := entry.Extended[xhttp.AmzBucketNotification]; found {
+		notification, err := parseBucketNotificationConfiguration(data)
+		if err != nil {
+			glog.Warningf("bucket %s has invalid notification configuration: %v", bucket, err)
+		}
+		config.Notification = notification
+	}
+
+	c.Lock()
+	c.configs[bucket] = config
+	c.Unlock()
+}
+
+// updateBucketEntry modifies the bucket entry and refreshes the local caches right away
+func (s3a *S3ApiServer) updateBucketEntry(bucket string, fn func(entry *filer_pb.Entry)) error {
+	entry, err := s3a.getEntry(s3a.option.BucketsPath, bucket)
+	if err != nil {
+		return err
+	}
+	if entry.Extended == nil {
+		entry.Extended = make(map[string][]byte)
+	}
+	fn(entry)
+	if err := s3a.touch(s3a.option.BucketsPath, bucket, entry); err != nil {
+		return err
+	}
+	s3a.onBucketMetadataChange(bucket, entry)
+	return nil
+}
@@ -0,0 +1,187 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+const maxCORSRules = 100
+
+var corsAllowedMethods = []string{http.MethodGet, http.MethodPut, http.MethodHead, http.MethodPost, http.MethodDelete}
+
+// CORSConfiguration is the cross-origin resource sharing configuration of a bucket
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_CORSConfiguration.html
+type CORSConfiguration struct {
+	XMLName   xml.Name   `xml:"CORSConfiguration"`
+	Xmlns     string     `xml:"xmlns,attr,omitempty"`
+	CORSRules []CORSRule `xml:"CORSRule"`
+}
+
+type CORSRule struct {
+	ID             string   `xml:"ID,omitempty"`
+	AllowedHeaders []string `xml:"AllowedHeader,omitempty"`
+	AllowedMethods []string `xml:"AllowedMethod"`
+	AllowedOrigins []string `xml:"AllowedOrigin"`
+	ExposeHeaders  []string `xml:"ExposeHeader,omitempty"`
+	MaxAgeSeconds  *int     `xml:"MaxAgeSeconds,omitempty"`
+}
+
+// GetBucketCorsHandler Get bucket CORS
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketCors.html
+func (s3a *S3ApiServer) GetBucketCorsHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("GetBucketCorsHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	entry, err := s3a.getEntry(s3a.option.BucketsPath, bucket)
+	if err != nil {
+		glog.Errorf("GetBucketCorsHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	data, found := entry.Extended[xhttp.AmzBucketCors]
+	if !found {
+		s3err.WriteErrorResponse(w, r, s3err.ErrNoSuchCORSConfiguration)
+		return
+	}
+	cors, err := parseCORSConfiguration(data)
+	if err != nil {
+		glog.Errorf("GetBucketCorsHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	cors.Xmlns = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+	writeSuccessResponseXML(w, r, cors)
+}
+
+// PutBucketCorsHandler Put bucket CORS
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketCors.html
+func (s3a *S3ApiServer) PutBucketCorsHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("PutBucketCorsHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	input, err := io.ReadAll(io.LimitReader(r.Body, maxConfigurationSize))
+	if err != nil {
+		glog.Errorf("PutBucketCorsHandler read input %s: %v", r.URL, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	cors := &CORSConfiguration{}
+	if err = xml.Unmarshal(input, cors); err != nil {
+		glog.V(1).Infof("PutBucketCorsHandler unmarshal %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrMalformedXML)
+		return
+	}
+	if err = cors.validate(); err != nil {
+		glog.V(1).Infof("PutBucketCorsHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrMalformedXML)
+		return
+	}
+
+	cors.Xmlns = ""
+	data, err := xml.Marshal(cors)
+	if err != nil {
+		glog.Errorf("PutBucketCorsHandler marshal %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	if err = s3a.updateBucketEntry(bucket, func(entry *filer_pb.Entry) {
+		entry.Extended[xhttp.AmzBucketCors] = data
+	}); err != nil {
+		glog.Errorf("PutBucketCorsHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	writeSuccessResponseEmpty(w, r)
+}
+
+// DeleteBucketCorsHandler Delete bucket CORS
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteBucketCors.html
+func (s3a *S3ApiServer) DeleteBucketCorsHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("DeleteBucketCorsHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	if err := s3a.updateBucketEntry(bucket, func(entry *filer_pb.Entry) {
+		delete(entry.Extended, xhttp.AmzBucketCors)
+	}); err != nil {
+		glog.Errorf("DeleteBucketCorsHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	s3err.WriteEmptyResponse(w, r, http.StatusNoContent)
+}
+
+func parseCORSConfiguration(data []byte) (*CORSConfiguration, error) {
+	cors := &CORSConfiguration{}
+	if err := xml.Unmarshal(data, cors); err != nil {
+		return nil, err
+	}
+	return cors, nil
+}
+
+func (cors *CORSConfiguration) validate() error {
+	if len(cors.CORSRules) == 0 || len(cors.CORSRules) > maxCORSRules {
+		return fmt.Errorf("expecting 1 to %d rules, but found %d", maxCORSRules, len(cors.CORSRules))
+	}
+	for _, rule := range cors.CORSRules {
+		if len(rule.AllowedOrigins) == 0 {
+			return fmt.Errorf("rule %q: missing AllowedOrigin", rule.ID)
+		}
+		if len(rule.AllowedMethods) == 0 {
+			return fmt.Errorf("rule %q: missing AllowedMethod", rule.ID)
+		}
+		for _, method := range rule.AllowedMethods {
+			if !isCorsAllowedMethod(method) {
+				return fmt.Errorf("rule %q: unsupported method %q", rule.ID, method)
+			}
+		}
+		for _, origin := range rule.AllowedOrigins {
+			if strings.Count(origin, "*") > 1 {
+				return fmt.Errorf("rule %q: origin %q can have at most one wildcard", rule.ID, origin)
+			}
+		}
+		for _, header := range rule.AllowedHeaders {
+			if strings.Count(header, "*") > 1 {
+				return fmt.Errorf("rule %q: header %q can have at most one wildcard", rule.ID, header)
+			}
+		}
+		if rule.MaxAgeSeconds != nil && *rule.MaxAgeSeconds < 0 {
+			return fmt.Errorf("rule %q: negative MaxAgeSeconds", rule.ID)
+		}
+	}
+	return nil
+}
+
+func isCorsAllowedMethod(method string) bool {
+	for _, m := range corsAllowedMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+// ServerSideEncryptionConfiguration is the default encryption of the new objects of a bucket
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_ServerSideEncryptionConfiguration.html
+type ServerSideEncryptionConfiguration struct {
+	XMLName xml.Name                   `xml:"ServerSideEncryptionConfiguration"`
+	Xmlns   string                     `xml:"xmlns,attr,omitempty"`
+	Rules   []ServerSideEncryptionRule `xml:"Rule"`
+}
+
+type ServerSideEncryptionRule struct {
+	ApplyServerSideEncryptionByDefault ServerSideEncryptionByDefault `xml:"ApplyServerSideEncryptionByDefault"`
+	BucketKeyEnabled                   bool                          `xml:"BucketKeyEnabled,omitempty"`
+}
+
+type ServerSideEncryptionByDefault struct {
+	SSEAlgorithm   string `xml:"SSEAlgorithm"`
+	KMSMasterKeyID string `xml:"KMSMasterKeyID,omitempty"`
+}
+
+// GetBucketEncryptionHandler Get bucket encryption
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketEncryption.html
+func (s3a *S3ApiServer) GetBucketEncryptionHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("GetBucketEncryptionHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	entry, err := s3a.getEntry(s3a.option.BucketsPath, bucket)
+	if err != nil {
+		glog.Errorf("GetBucketEncryptionHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	data, found := entry.Extended[xhttp.AmzBucketEncryption]
+	if !found {
+		s3err.WriteErrorResponse(w, r, s3err.ErrNoSuchBucketEncryptionConfiguration)
+		return
+	}
+	encryption, err := parseServerSideEncryptionConfiguration(data)
+	if err != nil {
+		glog.Errorf("GetBucketEncryptionHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	encryption.Xmlns = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+	writeSuccessResponseXML(w, r, encryption)
+}
+
+// PutBucketEncryptionHandler Put bucket encryption
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketEncryption.html
+func (s3a *S3ApiServer) PutBucketEncryptionHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("PutBucketEncryptionHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	input, err := io.ReadAll(io.LimitReader(r.Body, maxConfigurationSize))
+	if err != nil {
+		glog.Errorf("PutBucketEncryptionHandler read input %s: %v", r.URL, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	encryption, err := parseServerSideEncryptionConfiguration(input)
+	if err != nil {
+		glog.V(1).Infof("PutBucketEncryptionHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrMalformedXML)
+		return
+	}
+	if rule := encryption.Rules[0].ApplyServerSideEncryptionByDefault; rule.SSEAlgorithm == sseAlgorithmKMS {
+		if s3a.kms == nil {
+			s3err.WriteErrorResponse(w, r, s3err.ErrKMSNotConfigured)
+			return
+		}
+		if rule.KMSMasterKeyID != "" {
+			if _, _, err = s3a.kms.GenerateDataKey(rule.KMSMasterKeyID); err != nil {
+				glog.V(1).Infof("PutBucketEncryptionHandler %s: kms key %s: %v", bucket, rule.KMSMasterKeyID, err)
+				s3err.WriteErrorResponse(w, r, s3err.ErrKMSKeyNotFound)
+				return
+			}
+		}
+	}
+
+	encryption.Xmlns = ""
+	data, err := xml.Marshal(encryption)
+	if err != nil {
+		glog.Errorf("PutBucketEncryptionHandler marshal %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	if err = s3a.updateBucketEntry(bucket, func(entry *filer_pb.Entry) {
+		entry.Extended[xhttp.AmzBucketEncryption] = data
+	}); err != nil {
+		glog.Errorf("PutBucketEncryptionHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	writeSuccessResponseEmpty(w, r)
+}
+
+// DeleteBucketEncryptionHandler Delete bucket encryption
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteBucketEncryption.html
+func (s3a *S3ApiServer) DeleteBucketEncryptionHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("DeleteBucketEncryptionHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	if err := s3a.updateBucketEntry(bucket, func(entry *filer_pb.Entry) {
+		delete(entry.Extended, xhttp.AmzBucketEncryption)
+	}); err != nil {
+		glog.Errorf("DeleteBucketEncryptionHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	s3err.WriteEmptyResponse(w, r, http.StatusNoContent)
+}
+
+// parseServerSideEncryptionConfiguration returns nil if the configuration is invalid
+func parseServerSideEncryptionConfiguration(data []byte) (*ServerSideEncryptionConfiguration, error) {
+	encryption := &ServerSideEncryptionConfiguration{}
+	if err := xml.Unmarshal(data, encryption); err != nil {
+		return nil, err
+	}
+	if err := encryption.validate(); err != nil {
+		return nil, err
+	}
+	return encryption, nil
+}
+
+func (encryption *ServerSideEncryptionConfiguration) validate() error {
+	if len(encryption.Rules) != 1 {
+		return fmt.Errorf("expecting 1 rule, but found %d", len(encryption.Rules))
+	}
+	rule := encryption.Rules[0].ApplyServerSideEncryptionByDefault
+	switch rule.SSEAlgorithm {
+	case sseAlgorithmAES256:
+		if rule.KMSMasterKeyID != "" {
+			return fmt.Errorf("KMSMasterKeyID is only for %s", sseAlgorithmKMS)
+		}
+	case sseAlgorithmKMS:
+	default:
+		return fmt.Errorf("unsupported SSEAlgorithm %q", rule.SSEAlgorithm)
+	}
+	return nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/xml"
 	"fmt"
+	"io"
 	"math"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/filer"
@@ -119,13 +121,30 @@ func (s3a *S3ApiServer) PutBucketHandler(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
+	identityId := r.Header.Get(xhttp.AmzIdentityId)
+	var aclData []byte
+	if acp, err := aclFromHeaders(r, identityId, identityId); err != nil {
+		glog.V(1).Infof("PutBucketHandler %s acl headers: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidAclArgument)
+		return
+	} else if acp != nil {
+		if aclData, err = xml.Marshal(acp); err != nil {
+			glog.Errorf("PutBucketHandler %s marshal acl: %v", bucket, err)
+			s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+			return
+		}
+	}
+
 	fn := func(entry *filer_pb.Entry) {
-		if identityId := r.Header.Get(xhttp.AmzIdentityId); identityId != "" {
-			if entry.Extended == nil {
-				entry.Extended = make(map[string][]byte)
-			}
+		if entry.Extended == nil {
+			entry.Extended = make(map[string][]byte)
+		}
+		if identityId != "" {
 			entry.Extended[xhttp.AmzIdentityId] = []byte(identityId)
 		}
+		if aclData != nil {
+			entry.Extended[xhttp.AmzAcl] = aclData
+		}
 	}
 
 	// create the folder for bucket, but lazily create actual collection
@@ -134,6 +153,21 @@ func (s3a *S3ApiServer) PutBucketHandler(w http.ResponseWriter, r *http.Request)
 		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
 		return
 	}
+
+	if strings.EqualFold(r.Header.Get(xhttp.AmzBucketObjectLockEnabled), "true") {
+		// object lock requires versioning
+		if err := s3a.setBucketVersioning(bucket, VersioningEnabled); err != nil {
+			glog.Errorf("PutBucketHandler enable versioning %s: %v", bucket, err)
+			s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+			return
+		}
+		if err := s3a.setBucketObjectLock(bucket, &ObjectLockConfiguration{ObjectLockEnabled: ObjectLockEnabled}); err != nil {
+			glog.Errorf("PutBucketHandler enable object lock %s: %v", bucket, err)
+			s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+			return
+		}
+	}
+
 	writeSuccessResponseEmpty(w, r)
 }
 
@@ -147,6 +181,20 @@ func (s3a *S3ApiServer) DeleteBucketHandler(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	// the collection is dropped as a whole, so the locked objects must not be in it
+	if s3a.getBucketObjectLock(bucket) != nil {
+		entries, _, err := s3a.list(s3a.option.BucketsPath+"/"+bucket, "", "", false, 1)
+		if err != nil {
+			glog.Errorf("DeleteBucketHandler list %s: %v", bucket, err)
+			s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+			return
+		}
+		if len(entries) > 0 {
+			s3err.WriteErrorResponse(w, r, s3err.ErrBucketNotEmpty)
+			return
+		}
+	}
+
 	err := s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
 
 		// delete collection
@@ -202,6 +250,9 @@ func (s3a *S3ApiServer) hasAccess(r *http.Request, entry *filer_pb.Entry) bool {
 	if isAdmin {
 		return true
 	}
+	if r.Header.Get(xhttp.AmzIsPolicyAllowed) != "" || r.Header.Get(xhttp.AmzIsAclAllowed) != "" {
+		return true
+	}
 	if entry.Extended == nil {
 		return true
 	}
@@ -209,52 +260,13 @@ func (s3a *S3ApiServer) hasAccess(r *http.Request, entry *filer_pb.Entry) bool {
 	identityId := r.Header.Get(xhttp.AmzIdentityId)
 	if id, ok := entry.Extended[xhttp.AmzIdentityId]; ok {
 		if identityId != string(id) {
-			return false
+			// the bucket ACL may grant other identities access to the bucket
+			return s3a.iam.isAclGranted(r, &Identity{Name: identityId}, entry.Name, "", s3ActionOf(r, ""))
 		}
 	}
 	return true
 }
 
-// GetBucketAclHandler Get Bucket ACL
-// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketAcl.html
-func (s3a *S3ApiServer) GetBucketAclHandler(w http.ResponseWriter, r *http.Request) {
-	// collect parameters
-	bucket, _ := xhttp.GetBucketAndObject(r)
-	glog.V(3).Infof("GetBucketAclHandler %s", bucket)
-
-	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
-		s3err.WriteErrorResponse(w, r, err)
-		return
-	}
-
-	response := AccessControlPolicy{}
-	for _, ident := range s3a.iam.identities {
-		if len(ident.Credentials) == 0 {
-			continue
-		}
-		for _, action := range ident.Actions {
-			if !action.overBucket(bucket) || action.getPermission() == "" {
-				continue
-			}
-			id := ident.Credentials[0].AccessKey
-			if response.Owner.DisplayName == "" && action.isOwner(bucket) && len(ident.Credentials) > 0 {
-				response.Owner.DisplayName = ident.Name
-				response.Owner.ID = id
-			}
-			response.AccessControlList.Grant = append(response.AccessControlList.Grant, Grant{
-				Grantee: Grantee{
-					ID:          id,
-					DisplayName: ident.Name,
-					Type:        "CanonicalUser",
-					XMLXSI:      "CanonicalUser",
-					XMLNS:       "http://www.w3.org/2001/XMLSchema-instance"},
-				Permission: action.getPermission(),
-			})
-		}
-	}
-	writeSuccessResponseXML(w, r, response)
-}
-
 // GetBucketLifecycleConfigurationHandler Get Bucket Lifecycle configuration
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketLifecycleConfiguration.html
 func (s3a *S3ApiServer) GetBucketLifecycleConfigurationHandler(w http.ResponseWriter, r *http.Request) {
@@ -266,6 +278,25 @@ func (s3a *S3ApiServer) GetBucketLifecycleConfigurationHandler(w http.ResponseWr
 		s3err.WriteErrorResponse(w, r, err)
 		return
 	}
+
+	entry, err := s3a.getEntry(s3a.option.BucketsPath, bucket)
+	if err != nil {
+		glog.Errorf("GetBucketLifecycleConfigurationHandler: %s", err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	if data, found := entry.Extended[xhttp.AmzBucketLifecycle]; found {
+		lifecycle, err := parseLifecycle(data)
+		if err != nil {
+			glog.Errorf("GetBucketLifecycleConfigurationHandler %s: %v", bucket, err)
+			s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+			return
+		}
+		writeSuccessResponseXML(w, r, lifecycle)
+		return
+	}
+
+	// fall back to the collection TTLs configured in the filer
 	fc, err := filer.ReadFilerConf(s3a.option.Filer, s3a.option.GrpcDialOption, nil)
 	if err != nil {
 		glog.Errorf("GetBucketLifecycleConfigurationHandler: %s", err)
@@ -298,17 +329,74 @@ func (s3a *S3ApiServer) GetBucketLifecycleConfigurationHandler(w http.ResponseWr
 // PutBucketLifecycleConfigurationHandler Put Bucket Lifecycle configuration
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketLifecycleConfiguration.html
 func (s3a *S3ApiServer) PutBucketLifecycleConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+	// collect parameters
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("PutBucketLifecycleConfigurationHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
 
-	s3err.WriteErrorResponse(w, r, s3err.ErrNotImplemented)
+	input, err := io.ReadAll(io.LimitReader(r.Body, maxConfigurationSize))
+	if err != nil {
+		glog.Errorf("PutBucketLifecycleConfigurationHandler read input %s: %v", r.URL, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	lifecycle, err := parseLifecycle(input)
+	if err != nil {
+		glog.V(1).Infof("PutBucketLifecycleConfigurationHandler unmarshal %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrMalformedXML)
+		return
+	}
+	if err = lifecycle.validate(); err != nil {
+		glog.V(1).Infof("PutBucketLifecycleConfigurationHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrMalformedXML)
+		return
+	}
 
+	data, err := xml.Marshal(lifecycle)
+	if err != nil {
+		glog.Errorf("PutBucketLifecycleConfigurationHandler marshal %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	if err = s3a.updateBucketEntry(bucket, func(entry *filer_pb.Entry) {
+		entry.Extended[xhttp.AmzBucketLifecycle] = data
+	}); err != nil {
+		glog.Errorf("PutBucketLifecycleConfigurationHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	if s3a.option.LifecycleInterval <= 0 {
+		glog.Warningf("bucket %s lifecycle rules are saved, but not applied by this gateway: lifecycleIntervalMinutes is 0", bucket)
+	}
+
+	writeSuccessResponseEmpty(w, r)
 }
 
-// DeleteBucketMetricsConfiguration Delete Bucket Lifecycle
+// DeleteBucketLifecycleHandler Delete Bucket Lifecycle
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteBucketLifecycle.html
 func (s3a *S3ApiServer) DeleteBucketLifecycleHandler(w http.ResponseWriter, r *http.Request) {
+	// collect parameters
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("DeleteBucketLifecycleHandler %s", bucket)
 
-	s3err.WriteEmptyResponse(w, r, http.StatusNoContent)
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
 
+	if err := s3a.updateBucketEntry(bucket, func(entry *filer_pb.Entry) {
+		delete(entry.Extended, xhttp.AmzBucketLifecycle)
+	}); err != nil {
+		glog.Errorf("DeleteBucketLifecycleHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	s3err.WriteEmptyResponse(w, r, http.StatusNoContent)
 }
 
 // GetBucketLocationHandler Get bucket location
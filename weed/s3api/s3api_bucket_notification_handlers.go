@@ -0,0 +1,139 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+// BucketNotificationConfiguration is the event notification configuration of a bucket
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_NotificationConfiguration.html
+type BucketNotificationConfiguration struct {
+	XMLName                     xml.Name           `xml:"NotificationConfiguration"`
+	Xmlns                       string             `xml:"xmlns,attr,omitempty"`
+	TopicConfigurations         []NotificationRule `xml:"TopicConfiguration,omitempty"`
+	QueueConfigurations         []NotificationRule `xml:"QueueConfiguration,omitempty"`
+	CloudFunctionConfigurations []NotificationRule `xml:"CloudFunctionConfiguration,omitempty"`
+}
+
+// NotificationRule is one of the topic, queue or cloud function configurations.
+// Only the destination element of its kind is set.
+type NotificationRule struct {
+	Id            string              `xml:"Id,omitempty"`
+	Topic         string              `xml:"Topic,omitempty"`
+	Queue         string              `xml:"Queue,omitempty"`
+	CloudFunction string              `xml:"CloudFunction,omitempty"`
+	Events        []string            `xml:"Event"`
+	Filter        *NotificationFilter `xml:"Filter,omitempty"`
+}
+
+type NotificationFilter struct {
+	S3Key NotificationKeyFilter `xml:"S3Key"`
+}
+
+type NotificationKeyFilter struct {
+	FilterRules []NotificationFilterRule `xml:"FilterRule"`
+}
+
+type NotificationFilterRule struct {
+	Name  string `xml:"Name"`
+	Value string `xml:"Value"`
+}
+
+// GetBucketNotificationConfigurationHandler Get bucket notification configuration
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketNotificationConfiguration.html
+func (s3a *S3ApiServer) GetBucketNotificationConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("GetBucketNotificationConfigurationHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	entry, err := s3a.getEntry(s3a.option.BucketsPath, bucket)
+	if err != nil {
+		glog.Errorf("GetBucketNotificationConfigurationHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	// a bucket without notifications has an empty configuration
+	config := &BucketNotificationConfiguration{}
+	if data, found := entry.Extended[xhttp.AmzBucketNotification]; found {
+		if config, err = parseBucketNotificationConfiguration(data); err != nil {
+			glog.Errorf("GetBucketNotificationConfigurationHandler %s: %v", bucket, err)
+			s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+			return
+		}
+	}
+	config.Xmlns = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+	writeSuccessResponseXML(w, r, config)
+}
+
+// PutBucketNotificationConfigurationHandler Put bucket notification configuration
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketNotificationConfiguration.html
+func (s3a *S3ApiServer) PutBucketNotificationConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("PutBucketNotificationConfigurationHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	input, err := io.ReadAll(io.LimitReader(r.Body, maxConfigurationSize))
+	if err != nil {
+		glog.Errorf("PutBucketNotificationConfigurationHandler read input %s: %v", r.URL, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	config := &BucketNotificationConfiguration{}
+	if err = xml.Unmarshal(input, config); err != nil {
+		glog.V(1).Infof("PutBucketNotificationConfigurationHandler unmarshal %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrMalformedXML)
+		return
+	}
+	if err = config.validate(); err != nil {
+		glog.V(1).Infof("PutBucketNotificationConfigurationHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidNotificationConfiguration)
+		return
+	}
+	targetIds := config.targetIds()
+	for _, targetId := range targetIds {
+		if _, found := s3a.notificationTargets[targetId]; !found {
+			glog.V(1).Infof("PutBucketNotificationConfigurationHandler %s: unknown destination %s", bucket, targetId)
+			s3err.WriteErrorResponse(w, r, s3err.ErrInvalidNotificationDestination)
+			return
+		}
+	}
+
+	config.Xmlns = ""
+	config.assignRuleIds()
+	data, err := xml.Marshal(config)
+	if err != nil {
+		glog.Errorf("PutBucketNotificationConfigurationHandler marshal %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	if err = s3a.updateBucketEntry(bucket, func(entry *filer_pb.Entry) {
+		if config.isEmpty() {
+			delete(entry.Extended, xhttp.AmzBucketNotification)
+		} else {
+			entry.Extended[xhttp.AmzBucketNotification] = data
+		}
+	}); err != nil {
+		glog.Errorf("PutBucketNotificationConfigurationHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	go s3a.sendTestEvent(bucket, targetIds)
+
+	writeSuccessResponseEmpty(w, r)
+}
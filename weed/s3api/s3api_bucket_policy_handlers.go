@@ -0,0 +1,101 @@
+package s3api
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/policy"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+// GetBucketPolicyHandler Get bucket Policy
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketPolicy.html
+func (s3a *S3ApiServer) GetBucketPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("GetBucketPolicyHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	entry, err := s3a.getEntry(s3a.option.BucketsPath, bucket)
+	if err != nil {
+		glog.Errorf("GetBucketPolicyHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	data := entry.Extended[xhttp.AmzBucketPolicy]
+	if len(data) == 0 {
+		s3err.WriteErrorResponse(w, r, s3err.ErrNoSuchBucketPolicy)
+		return
+	}
+
+	s3err.WriteResponse(w, r, http.StatusOK, data, s3err.MimeJSON)
+}
+
+// PutBucketPolicyHandler Put bucket Policy
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketPolicy.html
+func (s3a *S3ApiServer) PutBucketPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("PutBucketPolicyHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, policy.MaxBucketPolicySize+1))
+	if err != nil {
+		glog.Errorf("PutBucketPolicyHandler read input %s: %v", r.URL, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	if _, err := policy.ParseBucketPolicy(data, bucket); err != nil {
+		glog.V(1).Infof("PutBucketPolicyHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrMalformedPolicy)
+		return
+	}
+
+	if err := s3a.setBucketPolicy(bucket, data); err != nil {
+		glog.Errorf("PutBucketPolicyHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	s3err.WriteEmptyResponse(w, r, http.StatusNoContent)
+}
+
+// DeleteBucketPolicyHandler Delete bucket Policy
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteBucketPolicy.html
+func (s3a *S3ApiServer) DeleteBucketPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("DeleteBucketPolicyHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	if err := s3a.setBucketPolicy(bucket, nil); err != nil {
+		glog.Errorf("DeleteBucketPolicyHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	s3err.WriteEmptyResponse(w, r, http.StatusNoContent)
+}
+
+// setBucketPolicy stores the policy document in the bucket entry, empty data removes it
+func (s3a *S3ApiServer) setBucketPolicy(bucket string, data []byte) error {
+	return s3a.updateBucketEntry(bucket, func(entry *filer_pb.Entry) {
+		if len(data) == 0 {
+			delete(entry.Extended, xhttp.AmzBucketPolicy)
+		} else {
+			entry.Extended[xhttp.AmzBucketPolicy] = data
+		}
+	})
+}
@@ -0,0 +1,448 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+// GetBucketVersioningHandler Get Bucket Versioning
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketVersioning.html
+func (s3a *S3ApiServer) GetBucketVersioningHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("GetBucketVersioningHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	versioning, err := s3a.getBucketVersioning(bucket)
+	if err != nil {
+		glog.Errorf("GetBucketVersioningHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	writeSuccessResponseXML(w, r, VersioningConfiguration{
+		Status: VersioningStatus(versioning),
+	})
+}
+
+// PutBucketVersioningHandler Put Bucket Versioning
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketVersioning.html
+func (s3a *S3ApiServer) PutBucketVersioningHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("PutBucketVersioningHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	input, err := io.ReadAll(io.LimitReader(r.Body, maxConfigurationSize))
+	if err != nil {
+		glog.Errorf("PutBucketVersioningHandler read input %s: %v", r.URL, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	config := &VersioningConfiguration{}
+	if err = xml.Unmarshal(input, config); err != nil {
+		glog.Errorf("PutBucketVersioningHandler Unmarshal %s: %v", r.URL, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrMalformedXML)
+		return
+	}
+
+	status := string(config.Status)
+	if status != VersioningEnabled && status != VersioningSuspended {
+		s3err.WriteErrorResponse(w, r, s3err.ErrIllegalVersioningConfiguration)
+		return
+	}
+
+	if status == VersioningSuspended && s3a.getBucketObjectLock(bucket) != nil {
+		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidBucketState)
+		return
+	}
+
+	if err = s3a.setBucketVersioning(bucket, status); err != nil {
+		glog.Errorf("PutBucketVersioningHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	writeSuccessResponseEmpty(w, r)
+}
+
+// ListObjectVersionsHandler List Object Versions
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListObjectVersions.html
+func (s3a *S3ApiServer) ListObjectVersionsHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("ListObjectVersionsHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	prefix, keyMarker, versionIdMarker, delimiter, maxKeys := getListObjectVersionsArgs(r.URL.Query())
+
+	if maxKeys < 0 {
+		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidMaxKeys)
+		return
+	}
+	if delimiter != "" && delimiter != "/" {
+		s3err.WriteErrorResponse(w, r, s3err.ErrNotImplemented)
+		return
+	}
+
+	response, err := s3a.listObjectVersions(bucket, prefix, keyMarker, versionIdMarker, delimiter, maxKeys)
+	if err != nil {
+		glog.Errorf("ListObjectVersionsHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	writeSuccessResponseXML(w, r, response)
+}
+
+const versionsListingPageSize = 1024
+
+// versionListingItem is either a common prefix or an object key having current or noncurrent versions
+type versionListingItem struct {
+	key           string
+	isPrefix      bool
+	current       *filer_pb.Entry
+	hasNoncurrent bool
+}
+
+func (s3a *S3ApiServer) listObjectVersions(bucket, originalPrefix, keyMarker, versionIdMarker, delimiter string, maxKeys int) (response ListVersionsResult, err error) {
+
+	response = ListVersionsResult{
+		Name:            bucket,
+		Prefix:          originalPrefix,
+		KeyMarker:       keyMarker,
+		VersionIdMarker: versionIdMarker,
+		MaxKeys:         maxKeys,
+		Delimiter:       delimiter,
+	}
+	if maxKeys == 0 {
+		return
+	}
+
+	items := make(map[string]*versionListingItem)
+	getItem := func(key string, isPrefix bool) *versionListingItem {
+		item, found := items[key]
+		if !found {
+			item = &versionListingItem{key: key, isPrefix: isPrefix}
+			items[key] = item
+		}
+		return item
+	}
+
+	// keys beyond the bound of a truncated listing are not reliable
+	var bound string
+	isTruncated := false
+	updateBound := func(key string) {
+		if !isTruncated || key < bound {
+			bound = key
+		}
+		isTruncated = true
+	}
+
+	// the versions of the key marker itself are continued first
+	if versionIdMarker != "" && keyMarker != "" {
+		dir, name := s3a.objectDirAndName(bucket, "/"+keyMarker)
+		item := getItem(keyMarker, false)
+		if entry, getErr := s3a.getEntry(dir, name); getErr == nil && entry != nil && !entry.IsDirectory {
+			item.current = entry
+		}
+		item.hasNoncurrent = true
+	}
+
+	// current versions
+	reqDir, prefix, bucketPrefix := s3a.toListingDirAndPrefix(bucket, originalPrefix)
+	// the key marker is relative to the bucket, but the listing starts from the prefix directory
+	prefixDir, _ := filepath.Split(originalPrefix)
+	marker := strings.TrimPrefix(keyMarker, prefixDir)
+	var lastCurrentKey string
+	err = s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		_, currentTruncated, _, doErr := s3a.doListFilerEntries(client, reqDir, prefix, maxKeys, marker, delimiter, func(dir string, entry *filer_pb.Entry) {
+			if entry.IsDirectory {
+				if delimiter == "/" {
+					lastCurrentKey = fmt.Sprintf("%s/%s/", dir, entry.Name)[len(bucketPrefix):]
+					getItem(lastCurrentKey, true)
+				}
+				return
+			}
+			lastCurrentKey = fmt.Sprintf("%s/%s", dir, entry.Name)[len(bucketPrefix):]
+			getItem(lastCurrentKey, false).current = entry
+		})
+		if doErr != nil {
+			return doErr
+		}
+		if currentTruncated {
+			updateBound(lastCurrentKey)
+		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+
+	// keys with noncurrent versions
+	escapedPrefix := url.PathEscape(originalPrefix)
+	startFrom := url.PathEscape(keyMarker)
+	counter := 0
+	var lastVersionedKey string
+	for counter <= maxKeys {
+		var pageCount uint32
+		err = filer_pb.List(s3a, s3a.genVersionsFolder(bucket), escapedPrefix, func(entry *filer_pb.Entry, isLast bool) error {
+			pageCount++
+			startFrom = entry.Name
+			if counter > maxKeys {
+				return nil
+			}
+			key, unescapeErr := url.PathUnescape(entry.Name)
+			if unescapeErr != nil {
+				return nil
+			}
+			if delimiter == "/" {
+				if sepIndex := strings.Index(key[len(originalPrefix):], "/"); sepIndex >= 0 {
+					commonPrefix := key[:len(originalPrefix)+sepIndex+1]
+					if strings.HasPrefix(keyMarker, commonPrefix) {
+						return nil
+					}
+					if _, found := items[commonPrefix]; !found {
+						counter++
+					}
+					lastVersionedKey = commonPrefix
+					getItem(commonPrefix, true)
+					return nil
+				}
+			}
+			counter++
+			lastVersionedKey = key
+			getItem(key, false).hasNoncurrent = true
+			return nil
+		}, startFrom, false, versionsListingPageSize)
+		if err != nil || pageCount < versionsListingPageSize {
+			break
+		}
+	}
+	if err != nil {
+		return
+	}
+	if counter > maxKeys {
+		updateBound(lastVersionedKey)
+	}
+
+	var keys []string
+	for key := range items {
+		if isTruncated && key > bound {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	count := 0
+	for _, key := range keys {
+		item := items[key]
+		if item.isPrefix {
+			if count >= maxKeys {
+				response.IsTruncated = true
+				return
+			}
+			response.CommonPrefixes = append(response.CommonPrefixes, PrefixEntry{Prefix: key})
+			response.NextKeyMarker, response.NextVersionIdMarker = key, ""
+			count++
+			continue
+		}
+
+		var versions []*filer_pb.Entry
+		if item.current != nil {
+			versions = append(versions, item.current)
+		}
+		if item.hasNoncurrent {
+			noncurrent, listErr := s3a.listNoncurrentVersions(bucket, "/"+key)
+			if listErr != nil {
+				return response, listErr
+			}
+			versions = append(versions, noncurrent...)
+		}
+
+		skipping := key == keyMarker && versionIdMarker != ""
+		for i, version := range versions {
+			versionId := getVersionId(version)
+			if skipping {
+				if versionId == versionIdMarker {
+					skipping = false
+				}
+				continue
+			}
+			if count >= maxKeys {
+				response.IsTruncated = true
+				return
+			}
+			isLatest := i == 0
+			lastModified := time.Unix(version.Attributes.Mtime, 0).UTC()
+			owner := CanonicalUser{
+				ID:          fmt.Sprintf("%x", version.Attributes.Uid),
+				DisplayName: version.Attributes.UserName,
+			}
+			if isDeleteMarker(version) {
+				response.DeleteMarker = append(response.DeleteMarker, DeleteMarkerEntry{
+					Key:          key,
+					VersionId:    versionId,
+					IsLatest:     isLatest,
+					LastModified: lastModified,
+					Owner:        owner,
+				})
+			} else {
+				storageClass := "STANDARD"
+				if v, ok := version.Extended[xhttp.AmzStorageClass]; ok {
+					storageClass = string(v)
+				}
+				response.Version = append(response.Version, VersionEntry{
+					Key:          key,
+					VersionId:    versionId,
+					IsLatest:     isLatest,
+					LastModified: lastModified,
+					ETag:         "\"" + filer.ETag(version) + "\"",
+					Size:         int64(filer.FileSize(version)),
+					Owner:        owner,
+					StorageClass: StorageClass(storageClass),
+				})
+			}
+			response.NextKeyMarker, response.NextVersionIdMarker = key, versionId
+			count++
+		}
+	}
+
+	response.IsTruncated = isTruncated
+	if !response.IsTruncated {
+		response.NextKeyMarker, response.NextVersionIdMarker = "", ""
+	}
+
+	return
+}
+
+func getListObjectVersionsArgs(values url.Values) (prefix, keyMarker, versionIdMarker, delimiter string, maxkeys int) {
+	prefix = values.Get("prefix")
+	keyMarker = values.Get("key-marker")
+	versionIdMarker = values.Get("version-id-marker")
+	delimiter = values.Get("delimiter")
+	if values.Get("max-keys") != "" {
+		maxkeys, _ = strconv.Atoi(values.Get("max-keys"))
+	} else {
+		maxkeys = maxObjectListSizeLimit
+	}
+	return
+}
+
+// prepareVersionedPut locates where to upload the new object, staged in the versions folder when the bucket is versioned,
+// and passes the new version id to the filer along with the upload.
+func (s3a *S3ApiServer) prepareVersionedPut(r *http.Request, bucket, object string) (write *versionedWrite, uploadUrl string, code s3err.ErrorCode) {
+	r.Header.Del(xhttp.AmzVersionId)
+	write, err := s3a.prepareVersionedWrite(bucket, object)
+	if err != nil {
+		glog.Errorf("prepare versioned write %s%s: %v", bucket, object, err)
+		return nil, "", s3err.ErrInternalError
+	}
+	if write.versionId != "" {
+		r.Header.Set(xhttp.AmzVersionId, write.versionId)
+	}
+	uploadUrl = fmt.Sprintf("http://%s%s/%s", s3a.option.Filer.ToHttpAddress(), urlPathEscape(write.dir), url.PathEscape(write.name))
+	return write, uploadUrl, s3err.ErrNone
+}
+
+// finishVersionedPut makes the uploaded object current, or cleans it up after a failed upload
+func (s3a *S3ApiServer) finishVersionedPut(write *versionedWrite, code s3err.ErrorCode) s3err.ErrorCode {
+	if code == s3err.ErrNone {
+		if err := s3a.commitVersionedWrite(write); err != nil {
+			glog.Errorf("commit versioned write %s%s: %v", write.bucket, write.object, err)
+			code = s3err.ErrInternalError
+		}
+	}
+	if code != s3err.ErrNone {
+		s3a.abortVersionedWrite(write, true)
+	}
+	return code
+}
+
+func setVersionId(w http.ResponseWriter, versionId string) {
+	if versionId != "" {
+		w.Header().Set(xhttp.AmzVersionId, versionId)
+	}
+}
+
+// objectUrl locates the filer url of the object, or of one of its versions
+func (s3a *S3ApiServer) objectUrl(bucket, object, versionId string) (string, s3err.ErrorCode) {
+	if versionId == "" {
+		return fmt.Sprintf("http://%s%s/%s%s",
+			s3a.option.Filer.ToHttpAddress(), s3a.option.BucketsPath, bucket, urlPathEscape(object)), s3err.ErrNone
+	}
+	dir, entry, _, err := s3a.lookupVersion(bucket, object, versionId)
+	if err == filer_pb.ErrNotFound {
+		return "", s3err.ErrNoSuchVersion
+	}
+	if err != nil {
+		glog.Errorf("lookup %s%s version %s: %v", bucket, object, versionId, err)
+		return "", s3err.ErrInternalError
+	}
+	if isDeleteMarker(entry) {
+		return "", s3err.ErrMethodNotAllowed
+	}
+	return fmt.Sprintf("http://%s%s/%s", s3a.option.Filer.ToHttpAddress(), urlPathEscape(dir), url.PathEscape(entry.Name)), s3err.ErrNone
+}
+
+// writeVersionedErrorResponse marks the response if the requested version is a delete marker
+func writeVersionedErrorResponse(w http.ResponseWriter, r *http.Request, versionId string, errCode s3err.ErrorCode) {
+	if errCode == s3err.ErrMethodNotAllowed {
+		w.Header().Set(xhttp.AmzDeleteMarker, "true")
+		setVersionId(w, versionId)
+	}
+	s3err.WriteErrorResponse(w, r, errCode)
+}
+
+// splitCopySourceVersion separates the optional version id from the copy source
+func splitCopySourceVersion(cpSrcPath string) (path, versionId string) {
+	if i := strings.Index(cpSrcPath, "?versionId="); i >= 0 {
+		return cpSrcPath[:i], cpSrcPath[i+len("?versionId="):]
+	}
+	return cpSrcPath, ""
+}
+
+// deleteObjectVersion removes one version permanently if the version id is specified,
+// otherwise a delete marker is placed on top of the existing versions.
+func (s3a *S3ApiServer) deleteObjectVersion(bucket, object, versionId string, versioning string, bypassGovernance bool) (deletedVersionId string, deletedMarker bool, code s3err.ErrorCode) {
+	var err error
+	if versionId != "" {
+		if code = s3a.unlockForDelete(bucket, object, versionId, bypassGovernance); code != s3err.ErrNone {
+			return "", false, code
+		}
+		deletedVersionId = versionId
+		deletedMarker, err = s3a.deleteVersion(bucket, object, versionId)
+	} else {
+		deletedMarker = true
+		deletedVersionId, err = s3a.deleteCurrentVersion(bucket, object, versioning)
+	}
+	if err != nil {
+		glog.Errorf("delete %s%s version %s: %v", bucket, object, versionId, err)
+		return "", false, s3err.ErrInternalError
+	}
+	return deletedVersionId, deletedMarker, s3err.ErrNone
+}
@@ -0,0 +1,30 @@
+package s3api
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVersionIdOrdering(t *testing.T) {
+	var ids []string
+	for i := 0; i < 3; i++ {
+		ids = append(ids, newVersionId())
+		time.Sleep(time.Millisecond)
+	}
+	assert.True(t, sort.SliceIsSorted(ids, func(i, j int) bool {
+		return ids[i] > ids[j]
+	}), "later version ids should sort first: %v", ids)
+}
+
+func TestSplitCopySourceVersion(t *testing.T) {
+	path, versionId := splitCopySourceVersion("/bucket/dir/obj?versionId=abc")
+	assert.Equal(t, "/bucket/dir/obj", path)
+	assert.Equal(t, "abc", versionId)
+
+	path, versionId = splitCopySourceVersion("/bucket/dir/obj")
+	assert.Equal(t, "/bucket/dir/obj", path)
+	assert.Equal(t, "", versionId)
+}
@@ -0,0 +1,157 @@
+package s3api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/policy"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+// corsMiddleware adds the Access-Control-* headers for cross-origin requests.
+// Buckets without a CORS configuration get no CORS headers, as on AWS S3.
+func (s3a *S3ApiServer) corsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" || r.Method == http.MethodOptions {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		bucket, _ := xhttp.GetBucketAndObject(r)
+		if cors := s3a.getBucketCors(bucket); cors != nil {
+			if rule := cors.matchRule(origin, r.Method, nil); rule != nil {
+				setCorsHeaders(w, rule, origin)
+			}
+			w.Header().Add("Vary", "Origin")
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// PreflightHandler answers the CORS preflight OPTIONS request
+// https://docs.aws.amazon.com/AmazonS3/latest/API/RESTOPTIONSobject.html
+func (s3a *S3ApiServer) PreflightHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, object := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("PreflightHandler %s %s", bucket, object)
+
+	origin := r.Header.Get("Origin")
+	method := r.Header.Get("Access-Control-Request-Method")
+	if origin == "" || method == "" {
+		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidRequest)
+		return
+	}
+	var requestHeaders []string
+	for _, header := range strings.Split(r.Header.Get("Access-Control-Request-Headers"), ",") {
+		if header = strings.TrimSpace(header); header != "" {
+			requestHeaders = append(requestHeaders, header)
+		}
+	}
+
+	w.Header().Add("Vary", "Origin, Access-Control-Request-Headers, Access-Control-Request-Method")
+
+	cors := s3a.getBucketCors(bucket)
+	if cors == nil {
+		s3err.WriteErrorResponse(w, r, s3err.ErrCORSForbidden)
+		return
+	}
+
+	rule := cors.matchRule(origin, method, requestHeaders)
+	if rule == nil {
+		s3err.WriteErrorResponse(w, r, s3err.ErrCORSForbidden)
+		return
+	}
+	setCorsHeaders(w, rule, origin)
+	if len(requestHeaders) > 0 {
+		w.Header().Set("Access-Control-Allow-Headers", strings.Join(requestHeaders, ", "))
+	}
+	if rule.MaxAgeSeconds != nil {
+		w.Header().Set("Access-Control-Max-Age", strconv.Itoa(*rule.MaxAgeSeconds))
+	}
+
+	writeSuccessResponseEmpty(w, r)
+}
+
+func (s3a *S3ApiServer) getBucketCors(bucket string) *CORSConfiguration {
+	if bucket == "" {
+		return nil
+	}
+	if config := s3a.bucketConfigs.get(bucket); config != nil {
+		return config.Cors
+	}
+	return nil
+}
+
+// matchRule returns the first rule allowing the origin, method and all the request headers
+func (cors *CORSConfiguration) matchRule(origin, method string, requestHeaders []string) *CORSRule {
+	for i, rule := range cors.CORSRules {
+		if !rule.allowsOrigin(origin) || !rule.allowsMethod(method) {
+			continue
+		}
+		allowed := true
+		for _, header := range requestHeaders {
+			if !rule.allowsHeader(header) {
+				allowed = false
+				break
+			}
+		}
+		if allowed {
+			return &cors.CORSRules[i]
+		}
+	}
+	return nil
+}
+
+func (rule *CORSRule) allowsOrigin(origin string) bool {
+	for _, allowed := range rule.AllowedOrigins {
+		if policy.MatchWildcard(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *CORSRule) allowsMethod(method string) bool {
+	for _, allowed := range rule.AllowedMethods {
+		if allowed == method {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *CORSRule) allowsHeader(header string) bool {
+	header = strings.ToLower(header)
+	for _, allowed := range rule.AllowedHeaders {
+		if policy.MatchWildcard(strings.ToLower(allowed), header) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *CORSRule) isAnyOrigin() bool {
+	for _, allowed := range rule.AllowedOrigins {
+		if allowed == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+func setCorsHeaders(w http.ResponseWriter, rule *CORSRule, origin string) {
+	if rule.isAnyOrigin() {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+	} else {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+	w.Header().Set("Access-Control-Allow-Methods", strings.Join(rule.AllowedMethods, ", "))
+	if len(rule.ExposeHeaders) > 0 {
+		w.Header().Set("Access-Control-Expose-Headers", strings.Join(rule.ExposeHeaders, ", "))
+	}
+}
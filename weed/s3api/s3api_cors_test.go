@@ -0,0 +1,82 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSMatchRule(t *testing.T) {
+	input := `<CORSConfiguration>
+ <CORSRule>
+   <AllowedOrigin>https://*.example.com</AllowedOrigin>
+   <AllowedMethod>PUT</AllowedMethod>
+   <AllowedMethod>POST</AllowedMethod>
+   <AllowedHeader>x-amz-*</AllowedHeader>
+   <AllowedHeader>Content-Type</AllowedHeader>
+   <MaxAgeSeconds>3000</MaxAgeSeconds>
+ </CORSRule>
+ <CORSRule>
+   <AllowedOrigin>*</AllowedOrigin>
+   <AllowedMethod>GET</AllowedMethod>
+ </CORSRule>
+</CORSConfiguration>`
+
+	cors := &CORSConfiguration{}
+	assert.NoError(t, xml.Unmarshal([]byte(input), cors))
+	assert.NoError(t, cors.validate())
+
+	rule := cors.matchRule("https://app.example.com", "PUT", []string{"X-Amz-Date", "content-type"})
+	if assert.NotNil(t, rule) {
+		assert.Equal(t, 3000, *rule.MaxAgeSeconds)
+	}
+	assert.Nil(t, cors.matchRule("https://app.example.com", "PUT", []string{"Authorization"}))
+	assert.Nil(t, cors.matchRule("https://example.org", "PUT", nil))
+	assert.Nil(t, cors.matchRule("https://example.org", "DELETE", nil))
+
+	rule = cors.matchRule("https://example.org", "GET", nil)
+	if assert.NotNil(t, rule) {
+		assert.True(t, rule.isAnyOrigin())
+	}
+
+	cors.CORSRules[1].AllowedMethods = []string{"PATCH"}
+	assert.Error(t, cors.validate())
+}
+
+func TestCORSMiddleware(t *testing.T) {
+	s3a := &S3ApiServer{bucketConfigs: newBucketConfigCache()}
+	s3a.bucketConfigs.configs["web"] = &BucketConfig{Cors: &CORSConfiguration{
+		CORSRules: []CORSRule{{AllowedOrigins: []string{"https://app.example.com"}, AllowedMethods: []string{"GET"}}},
+	}}
+	router := mux.NewRouter()
+	router.Use(s3a.corsMiddleware)
+	router.Methods("GET").Path("/{bucket}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	router.Methods("OPTIONS").Path("/{bucket}").HandlerFunc(s3a.PreflightHandler)
+
+	request := func(method, bucket string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/"+bucket, nil)
+		r.Header.Set("Origin", "https://app.example.com")
+		r.Header.Set("Access-Control-Request-Method", "GET")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+
+	w := request("GET", "web")
+	assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	w = request("OPTIONS", "web")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+
+	// a bucket without a CORS configuration gets no CORS headers, and refuses the preflight requests
+	w = request("GET", "plain")
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+	w = request("OPTIONS", "plain")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+}
@@ -13,6 +13,10 @@ import (
 
 var _ = filer_pb.FilerClient(&S3ApiServer{})
 
+// maxConfigurationSize limits reading the xml request bodies,
+// which may be chunked without a content length
+const maxConfigurationSize = 1 << 20
+
 func (s3a *S3ApiServer) WithFilerClient(streamingMode bool, fn func(filer_pb.SeaweedFilerClient) error) error {
 
 	return pb.WithGrpcClient(streamingMode, func(grpcConnection *grpc.ClientConn) error {
@@ -0,0 +1,88 @@
+package s3api
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+var ErrKmsKeyNotFound = errors.New("kms key not found")
+
+// KeyManagementService wraps the data keys of the SSE-KMS objects,
+// so only the wrapped data keys are stored along with the objects.
+type KeyManagementService interface {
+	// DefaultKeyId is used if neither the request nor the bucket encryption specifies a key
+	DefaultKeyId() string
+	// GenerateDataKey returns a new 256-bit data key, in plaintext and wrapped by the key
+	GenerateDataKey(keyId string) (dataKey, wrappedKey []byte, err error)
+	// DecryptDataKey unwraps a data key returned by GenerateDataKey
+	DecryptDataKey(keyId string, wrappedKey []byte) (dataKey []byte, err error)
+}
+
+// localKms keeps the master keys in the s3.kms section of security.toml
+type localKms struct {
+	defaultKeyId string
+	keys         map[string][]byte
+}
+
+// loadKms returns nil if no kms key is configured
+func loadKms(config *util.ViperProxy) (KeyManagementService, error) {
+	kms := &localKms{
+		defaultKeyId: config.GetString("s3.kms.default_key_id"),
+		keys:         make(map[string][]byte),
+	}
+	for keyId := range config.GetStringMap("s3.kms.keys") {
+		key, err := base64.StdEncoding.DecodeString(config.GetString("s3.kms.keys." + keyId))
+		if err != nil {
+			return nil, fmt.Errorf("s3.kms.keys.%s: %v", keyId, err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("s3.kms.keys.%s: expecting 32 bytes, but found %d", keyId, len(key))
+		}
+		kms.keys[keyId] = key
+	}
+	if len(kms.keys) == 0 {
+		return nil, nil
+	}
+	if kms.defaultKeyId == "" {
+		var keyIds []string
+		for keyId := range kms.keys {
+			keyIds = append(keyIds, keyId)
+		}
+		sort.Strings(keyIds)
+		kms.defaultKeyId = keyIds[0]
+	}
+	if _, found := kms.keys[kms.defaultKeyId]; !found {
+		return nil, fmt.Errorf("s3.kms.default_key_id: %s is not in s3.kms.keys", kms.defaultKeyId)
+	}
+	glog.V(0).Infof("Configure s3 kms with %d keys, default key %s", len(kms.keys), kms.defaultKeyId)
+	return kms, nil
+}
+
+func (kms *localKms) DefaultKeyId() string {
+	return kms.defaultKeyId
+}
+
+func (kms *localKms) GenerateDataKey(keyId string) (dataKey, wrappedKey []byte, err error) {
+	key, found := kms.keys[keyId]
+	if !found {
+		return nil, nil, ErrKmsKeyNotFound
+	}
+	dataKey = util.GenCipherKey()
+	if wrappedKey, err = util.Encrypt(dataKey, key); err != nil {
+		return nil, nil, err
+	}
+	return dataKey, wrappedKey, nil
+}
+
+func (kms *localKms) DecryptDataKey(keyId string, wrappedKey []byte) (dataKey []byte, err error) {
+	key, found := kms.keys[keyId]
+	if !found {
+		return nil, ErrKmsKeyNotFound
+	}
+	return util.Decrypt(wrappedKey, key)
+}
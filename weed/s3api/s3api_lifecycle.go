@@ -0,0 +1,173 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const maxLifecycleRules = 1000
+
+func parseLifecycle(data []byte) (*Lifecycle, error) {
+	lifecycle := &Lifecycle{}
+	if err := xml.Unmarshal(data, lifecycle); err != nil {
+		return nil, err
+	}
+	return lifecycle, nil
+}
+
+func (lc *Lifecycle) validate() error {
+	if len(lc.Rules) == 0 || len(lc.Rules) > maxLifecycleRules {
+		return fmt.Errorf("expecting 1 to %d rules, but found %d", maxLifecycleRules, len(lc.Rules))
+	}
+	ids := make(map[string]bool)
+	for _, rule := range lc.Rules {
+		if len(rule.ID) > 255 {
+			return fmt.Errorf("rule id %q is longer than 255", rule.ID)
+		}
+		if rule.ID != "" {
+			if ids[rule.ID] {
+				return fmt.Errorf("duplicated rule id %q", rule.ID)
+			}
+			ids[rule.ID] = true
+		}
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf("rule %q: %v", rule.ID, err)
+		}
+	}
+	return nil
+}
+
+func (rule *Rule) validate() error {
+	if rule.Status != Enabled && rule.Status != Disabled {
+		return fmt.Errorf("invalid status %q", rule.Status)
+	}
+	if rule.Prefix.set && rule.Filter.set {
+		return fmt.Errorf("both Prefix and Filter are specified")
+	}
+	if rule.Filter.tagSet && (rule.Filter.andSet || rule.Filter.Prefix.set) ||
+		rule.Filter.andSet && rule.Filter.Prefix.set {
+		return fmt.Errorf("filter can only have one of Prefix, Tag and And")
+	}
+	if !rule.Expiration.set && !rule.Transition.set && !rule.NoncurrentVersionExpiration.set && !rule.AbortIncompleteMultipartUpload.set {
+		return fmt.Errorf("no lifecycle action")
+	}
+	if rule.Expiration.set {
+		exp := rule.Expiration
+		count := 0
+		if exp.Days != 0 {
+			count++
+		}
+		if !exp.Date.IsZero() {
+			count++
+		}
+		if exp.DeleteMarker.set {
+			count++
+		}
+		if count != 1 {
+			return fmt.Errorf("expiration needs exactly one of Days, Date and ExpiredObjectDeleteMarker")
+		}
+		if exp.Days < 0 {
+			return fmt.Errorf("expiration days must be positive")
+		}
+		if !isMidnight(exp.Date.Time) {
+			return fmt.Errorf("expiration date must be at midnight UTC")
+		}
+		if exp.DeleteMarker.set && len(rule.tags()) > 0 {
+			return fmt.Errorf("ExpiredObjectDeleteMarker can not be used with tag filters")
+		}
+	}
+	if rule.Transition.set {
+		tr := rule.Transition
+		if tr.StorageClass == "" {
+			return fmt.Errorf("missing transition storage class")
+		}
+		if tr.Days < 0 {
+			return fmt.Errorf("transition days can not be negative")
+		}
+		if tr.Days != 0 && !tr.Date.IsZero() {
+			return fmt.Errorf("transition can not have both Days and Date")
+		}
+		if !isMidnight(tr.Date.Time) {
+			return fmt.Errorf("transition date must be at midnight UTC")
+		}
+	}
+	if rule.NoncurrentVersionExpiration.set && rule.NoncurrentVersionExpiration.NoncurrentDays <= 0 {
+		return fmt.Errorf("noncurrent days must be positive")
+	}
+	if rule.AbortIncompleteMultipartUpload.set {
+		if rule.AbortIncompleteMultipartUpload.DaysAfterInitiation <= 0 {
+			return fmt.Errorf("days after initiation must be positive")
+		}
+		if len(rule.tags()) > 0 {
+			return fmt.Errorf("AbortIncompleteMultipartUpload can not be used with tag filters")
+		}
+	}
+	return nil
+}
+
+func isMidnight(t time.Time) bool {
+	return t.IsZero() || t.Equal(t.UTC().Truncate(24*time.Hour))
+}
+
+func (rule *Rule) isEnabled() bool {
+	return rule.Status == Enabled
+}
+
+// prefix returns the object key prefix, from either the Filter or the legacy Prefix
+func (rule *Rule) prefix() string {
+	switch {
+	case rule.Filter.andSet:
+		return rule.Filter.And.Prefix.string
+	case rule.Filter.Prefix.set:
+		return rule.Filter.Prefix.string
+	}
+	return rule.Prefix.string
+}
+
+func (rule *Rule) tags() []Tag {
+	switch {
+	case rule.Filter.andSet:
+		return rule.Filter.And.Tags
+	case rule.Filter.tagSet:
+		return []Tag{rule.Filter.Tag}
+	}
+	return nil
+}
+
+// matches checks the object key, without the leading "/", and its tags against the rule filter
+func (rule *Rule) matches(key string, tags map[string]string) bool {
+	if !strings.HasPrefix(key, rule.prefix()) {
+		return false
+	}
+	for _, tag := range rule.tags() {
+		if v, found := tags[tag.Key]; !found || v != tag.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// mayMatchDir checks whether the directory, without the leading "/" and with a trailing "/", can contain matched objects
+func (rule *Rule) mayMatchDir(dir string) bool {
+	prefix := rule.prefix()
+	return strings.HasPrefix(dir, prefix) || strings.HasPrefix(prefix, dir)
+}
+
+// isDue checks whether an action by days or date is due for something since the given time.
+// Zero days without a date means right away.
+func isDue(days int, date ExpirationDate, since, now time.Time) bool {
+	if !date.IsZero() {
+		return !now.Before(date.Time)
+	}
+	if days <= 0 {
+		return true
+	}
+	// the same as AWS S3, the time is rounded to the next midnight UTC
+	due := since.UTC().Truncate(24 * time.Hour).Add(time.Duration(days) * 24 * time.Hour)
+	if !since.UTC().Equal(since.UTC().Truncate(24 * time.Hour)) {
+		due = due.Add(24 * time.Hour)
+	}
+	return !now.Before(due)
+}
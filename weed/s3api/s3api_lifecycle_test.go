@@ -0,0 +1,60 @@
+package s3api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseLifecycle(t *testing.T) {
+	data := []byte(`<LifecycleConfiguration>
+  <Rule>
+    <ID>logs</ID>
+    <Filter><And><Prefix>logs/</Prefix><Tag><Key>k</Key><Value>v</Value></Tag></And></Filter>
+    <Status>Enabled</Status>
+    <Expiration><Days>7</Days></Expiration>
+    <NoncurrentVersionExpiration><NoncurrentDays>3</NoncurrentDays></NoncurrentVersionExpiration>
+  </Rule>
+</LifecycleConfiguration>`)
+	lc, err := parseLifecycle(data)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if err = lc.validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	rule := lc.Rules[0]
+	if rule.prefix() != "logs/" || rule.Expiration.Days != 7 || rule.NoncurrentVersionExpiration.NoncurrentDays != 3 {
+		t.Errorf("unexpected rule %+v", rule)
+	}
+	if !rule.matches("logs/a.txt", map[string]string{"k": "v"}) {
+		t.Errorf("expect logs/a.txt to match")
+	}
+	if rule.matches("logs/a.txt", nil) || rule.matches("data/a.txt", map[string]string{"k": "v"}) {
+		t.Errorf("unexpected match")
+	}
+	if !rule.mayMatchDir("logs/2021/") || !rule.mayMatchDir("") || rule.mayMatchDir("data/") {
+		t.Errorf("unexpected directory match")
+	}
+
+	invalid := []byte(`<LifecycleConfiguration><Rule><Status>Enabled</Status><Expiration><Days>1</Days><ExpiredObjectDeleteMarker>true</ExpiredObjectDeleteMarker></Expiration></Rule></LifecycleConfiguration>`)
+	if lc, err = parseLifecycle(invalid); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if lc.validate() == nil {
+		t.Errorf("expect both Days and ExpiredObjectDeleteMarker to be invalid")
+	}
+}
+
+func TestLifecycleIsDue(t *testing.T) {
+	since := time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC)
+	if isDue(1, ExpirationDate{}, since, time.Date(2021, 1, 2, 23, 59, 0, 0, time.UTC)) {
+		t.Errorf("expect to be due at the next midnight after one day")
+	}
+	if !isDue(1, ExpirationDate{}, since, time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expect to be due")
+	}
+	date := ExpirationDate{time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)}
+	if isDue(0, date, since, time.Date(2021, 1, 31, 0, 0, 0, 0, time.UTC)) || !isDue(0, date, since, date.Time) {
+		t.Errorf("unexpected due by date")
+	}
+}
@@ -0,0 +1,368 @@
+package s3api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// lifecycleFolder keeps the temporary copies while objects are moved to another storage class
+const lifecycleFolder = ".lifecycle"
+
+// lifecycleLockPath is locked on the filer during each pass, so only one gateway applies the rules at a time
+const lifecycleLockPath = filer.DirectoryEtcSeaweedFS + "/s3.lifecycle.lock"
+
+// loopLifecycle periodically applies the bucket lifecycle rules
+func (s3a *S3ApiServer) loopLifecycle(interval time.Duration) {
+	clientId := fmt.Sprintf("s3.lifecycle.%x", time.Now().UnixNano())
+	for {
+		time.Sleep(interval)
+		acquired, err := s3a.withFilerLock(lifecycleLockPath, clientId, func(lost <-chan struct{}) {
+			for bucket, lifecycle := range s3a.bucketConfigs.lifecycles() {
+				select {
+				case <-lost:
+					glog.Warningf("stop applying lifecycle rules after losing lock %s", lifecycleLockPath)
+					return
+				default:
+				}
+				if err := s3a.applyLifecycle(bucket, lifecycle, time.Now()); err != nil {
+					glog.Errorf("apply lifecycle to bucket %s: %v", bucket, err)
+				}
+			}
+		})
+		if err != nil {
+			glog.Warningf("skip applying lifecycle rules: %v", err)
+		} else if !acquired {
+			glog.V(1).Infof("lifecycle rules are being applied by another gateway")
+		}
+	}
+}
+
+func (s3a *S3ApiServer) applyLifecycle(bucket string, lifecycle *Lifecycle, now time.Time) error {
+	var rules []*Rule
+	for i := range lifecycle.Rules {
+		if lifecycle.Rules[i].isEnabled() {
+			rules = append(rules, &lifecycle.Rules[i])
+		}
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	glog.V(1).Infof("apply lifecycle to bucket %s", bucket)
+
+	versioning, err := s3a.getBucketVersioning(bucket)
+	if err != nil {
+		return err
+	}
+	if err = s3a.applyLifecycleToObjects(bucket, "", rules, versioning, now); err != nil {
+		return fmt.Errorf("objects: %v", err)
+	}
+	if err = s3a.applyLifecycleToVersions(bucket, rules, now); err != nil {
+		return fmt.Errorf("versions: %v", err)
+	}
+	if err = s3a.applyLifecycleToUploads(bucket, rules, now); err != nil {
+		return fmt.Errorf("uploads: %v", err)
+	}
+	return nil
+}
+
+// applyLifecycleToObjects walks the current objects under the directory, which is relative to the bucket
+func (s3a *S3ApiServer) applyLifecycleToObjects(bucket, relDir string, rules []*Rule, versioning string, now time.Time) error {
+	dir := strings.TrimSuffix(fmt.Sprintf("%s/%s/%s", s3a.option.BucketsPath, bucket, relDir), "/")
+	var subDirs []string
+	err := filer_pb.ReadDirAllEntries(s3a, util.FullPath(dir), "", func(entry *filer_pb.Entry, isLast bool) error {
+		if relDir == "" && (entry.Name == ".uploads" || entry.Name == versionsFolder || entry.Name == lifecycleFolder) {
+			return nil
+		}
+		if entry.IsDirectory {
+			subDir := relDir + entry.Name + "/"
+			for _, rule := range rules {
+				if rule.mayMatchDir(subDir) {
+					subDirs = append(subDirs, subDir)
+					break
+				}
+			}
+			return nil
+		}
+		if err := s3a.applyLifecycleToObject(bucket, relDir+entry.Name, entry, rules, versioning, now); err != nil {
+			glog.Warningf("apply lifecycle to %s/%s%s: %v", bucket, relDir, entry.Name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, subDir := range subDirs {
+		if err = s3a.applyLifecycleToObjects(bucket, subDir, rules, versioning, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s3a *S3ApiServer) applyLifecycleToObject(bucket, key string, entry *filer_pb.Entry, rules []*Rule, versioning string, now time.Time) error {
+	tags := getEntryTags(entry)
+	mtime := time.Unix(entry.Attributes.Mtime, 0)
+
+	for _, rule := range rules {
+		exp := rule.Expiration
+		if !exp.set || (exp.Days == 0 && exp.Date.IsZero()) || !rule.matches(key, tags) {
+			continue
+		}
+		if !isDue(exp.Days, exp.Date, mtime, now) {
+			continue
+		}
+		glog.V(2).Infof("lifecycle rule %q expires %s/%s", rule.ID, bucket, key)
+		if versioning != "" {
+			_, err := s3a.deleteCurrentVersion(bucket, "/"+key, versioning)
+			return err
+		}
+		if filer.IsObjectLocked(entry.Extended, now) {
+			return nil
+		}
+		dir, name := s3a.objectDirAndName(bucket, "/"+key)
+		return s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+			return doDeleteEntry(client, dir, name, true, false)
+		})
+	}
+
+	for _, rule := range rules {
+		tr := rule.Transition
+		if !tr.set || !rule.matches(key, tags) {
+			continue
+		}
+		if string(entry.Extended[xhttp.AmzStorageClass]) == tr.StorageClass {
+			return nil
+		}
+		if !isDue(tr.Days, tr.Date, mtime, now) || filer.IsObjectLocked(entry.Extended, now) {
+			continue
+		}
+		glog.V(2).Infof("lifecycle rule %q moves %s/%s to %s", rule.ID, bucket, key, tr.StorageClass)
+		return s3a.transitionObject(bucket, key, entry, tr.StorageClass)
+	}
+	return nil
+}
+
+// applyLifecycleToVersions expires the noncurrent versions, and the delete markers left alone
+func (s3a *S3ApiServer) applyLifecycleToVersions(bucket string, rules []*Rule, now time.Time) error {
+	var needed bool
+	for _, rule := range rules {
+		if rule.NoncurrentVersionExpiration.set || rule.Expiration.DeleteMarker.val {
+			needed = true
+		}
+	}
+	if !needed {
+		return nil
+	}
+
+	var keys []string
+	err := filer_pb.ReadDirAllEntries(s3a, util.FullPath(s3a.genVersionsFolder(bucket)), "", func(entry *filer_pb.Entry, isLast bool) error {
+		if key, err := url.PathUnescape(entry.Name); err == nil && entry.IsDirectory {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err == filer_pb.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := s3a.applyLifecycleToObjectVersions(bucket, key, rules, now); err != nil {
+			glog.Warningf("apply lifecycle to versions of %s/%s: %v", bucket, key, err)
+		}
+	}
+	return nil
+}
+
+func (s3a *S3ApiServer) applyLifecycleToObjectVersions(bucket, key string, rules []*Rule, now time.Time) error {
+	object := "/" + key
+	defer s3a.versionLocks.lock(bucket, object)()
+	versions, err := s3a.listNoncurrentVersions(bucket, object)
+	if err != nil || len(versions) == 0 {
+		return err
+	}
+
+	// a version becomes noncurrent when its successor is created
+	dir, name := s3a.objectDirAndName(bucket, object)
+	current, err := s3a.getEntry(dir, name)
+	if err != nil && err != filer_pb.ErrNotFound {
+		return err
+	}
+	var latest *filer_pb.Entry
+	if current == nil {
+		// the latest one is a delete marker when the object does not exist
+		latest, current, versions = versions[0], versions[0], versions[1:]
+		if !isDeleteMarker(latest) {
+			latest = nil
+		}
+	}
+
+	versionsDir := s3a.genObjectVersionsFolder(bucket, object)
+	successorTime := time.Unix(current.Attributes.Mtime, 0)
+	remaining := 0
+	var deleted bool
+	for _, version := range versions {
+		expired := false
+		tags := getEntryTags(version)
+		for _, rule := range rules {
+			nve := rule.NoncurrentVersionExpiration
+			if nve.set && rule.matches(key, tags) && isDue(nve.NoncurrentDays, ExpirationDate{}, successorTime, now) {
+				expired = true
+				break
+			}
+		}
+		successorTime = time.Unix(version.Attributes.Mtime, 0)
+		if !expired || filer.IsObjectLocked(version.Extended, now) {
+			remaining++
+			continue
+		}
+		glog.V(2).Infof("lifecycle expires version %s of %s/%s", version.Name, bucket, key)
+		if err := s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+			return doDeleteEntry(client, versionsDir, version.Name, true, false)
+		}); err != nil {
+			return err
+		}
+		deleted = true
+	}
+
+	if latest != nil && remaining == 0 {
+		for _, rule := range rules {
+			if rule.Expiration.DeleteMarker.val && rule.matches(key, nil) {
+				glog.V(2).Infof("lifecycle removes expired delete marker of %s/%s", bucket, key)
+				if err := s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+					return doDeleteEntry(client, versionsDir, latest.Name, true, false)
+				}); err != nil {
+					return err
+				}
+				deleted = true
+				break
+			}
+		}
+	}
+
+	if !deleted {
+		return nil
+	}
+	return s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		return s3a.promoteLatestVersion(client, bucket, object)
+	})
+}
+
+// applyLifecycleToUploads aborts the multipart uploads not completed in time
+func (s3a *S3ApiServer) applyLifecycleToUploads(bucket string, rules []*Rule, now time.Time) error {
+	var staleUploads []string
+	uploadsDir := s3a.genUploadsFolder(bucket)
+	err := filer_pb.ReadDirAllEntries(s3a, util.FullPath(uploadsDir), "", func(entry *filer_pb.Entry, isLast bool) error {
+		key := strings.TrimPrefix(string(entry.Extended["key"]), "/")
+		crtime := time.Unix(entry.Attributes.Crtime, 0)
+		for _, rule := range rules {
+			abort := rule.AbortIncompleteMultipartUpload
+			if abort.set && rule.matches(key, nil) && isDue(abort.DaysAfterInitiation, ExpirationDate{}, crtime, now) {
+				staleUploads = append(staleUploads, entry.Name)
+				break
+			}
+		}
+		return nil
+	})
+	if err == filer_pb.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		for _, uploadId := range staleUploads {
+			glog.V(2).Infof("lifecycle aborts upload %s/%s", uploadsDir, uploadId)
+			if err := doDeleteEntry(client, uploadsDir, uploadId, true, true); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// transitionObject rewrites the object content onto the disk type of the storage class.
+// The object entry keeps its metadata, only the chunks are swapped.
+func (s3a *S3ApiServer) transitionObject(bucket, key string, entry *filer_pb.Entry, storageClass string) error {
+	dir, name := s3a.objectDirAndName(bucket, "/"+key)
+	tmpDir := fmt.Sprintf("%s/%s/%s", s3a.option.BucketsPath, bucket, lifecycleFolder)
+	tmpName := fmt.Sprintf("%x", util.RandomInt32())
+
+	filerUrl := "http://" + s3a.option.Filer.ToHttpAddress()
+	getReq, err := http.NewRequest(http.MethodGet, filerUrl+urlPathEscape(dir+"/"+name), nil)
+	if err != nil {
+		return err
+	}
+	s3a.maybeAddFilerJwtAuthorization(getReq, false)
+	getResp, err := client.Do(getReq)
+	if err != nil {
+		return fmt.Errorf("read %s/%s: %v", dir, name, err)
+	}
+	defer util.CloseResponse(getResp)
+	if getResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("read %s/%s: %s", dir, name, getResp.Status)
+	}
+
+	putReq, err := http.NewRequest(http.MethodPut, filerUrl+urlPathEscape(tmpDir+"/"+tmpName)+"?disk="+url.QueryEscape(storageClassToDiskType(storageClass)), getResp.Body)
+	if err != nil {
+		return err
+	}
+	putReq.ContentLength = getResp.ContentLength
+	s3a.maybeAddFilerJwtAuthorization(putReq, true)
+	putResp, err := client.Do(putReq)
+	if err != nil {
+		return fmt.Errorf("write %s/%s: %v", tmpDir, tmpName, err)
+	}
+	util.CloseResponse(putResp)
+	if putResp.StatusCode >= 300 {
+		return fmt.Errorf("write %s/%s: %s", tmpDir, tmpName, putResp.Status)
+	}
+
+	return s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		tmpEntry, err := filer_pb.GetEntry(s3a, util.NewFullPath(tmpDir, tmpName))
+		if err != nil {
+			return fmt.Errorf("lookup %s/%s: %v", tmpDir, tmpName, err)
+		}
+		latest, err := filer_pb.GetEntry(s3a, util.NewFullPath(dir, name))
+		if err != nil || latest == nil || latest.Attributes.Mtime != entry.Attributes.Mtime || latest.Attributes.FileSize != tmpEntry.Attributes.FileSize {
+			// the object has been changed in the mean time
+			return doDeleteEntry(client, tmpDir, tmpName, true, false)
+		}
+		latest.Chunks = tmpEntry.Chunks
+		latest.Content = tmpEntry.Content
+		if latest.Extended == nil {
+			latest.Extended = make(map[string][]byte)
+		}
+		latest.Extended[xhttp.AmzStorageClass] = []byte(storageClass)
+		if err := filer_pb.UpdateEntry(client, &filer_pb.UpdateEntryRequest{
+			Directory: dir,
+			Entry:     latest,
+		}); err != nil {
+			doDeleteEntry(client, tmpDir, tmpName, true, false)
+			return err
+		}
+		// the chunks now belong to the object
+		return doDeleteEntry(client, tmpDir, tmpName, false, false)
+	})
+}
+
+// storageClassToDiskType maps STANDARD to the default disk type,
+// and other storage classes to the disk type of the same name in lower case, e.g. "ssd" or "archive".
+func storageClassToDiskType(storageClass string) string {
+	if storageClass == "STANDARD" {
+		return ""
+	}
+	return strings.ToLower(storageClass)
+}
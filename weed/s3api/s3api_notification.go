@@ -0,0 +1,604 @@
+package s3api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/notification"
+	_ "github.com/chrislusf/seaweedfs/weed/notification/aws_sqs"
+	_ "github.com/chrislusf/seaweedfs/weed/notification/gocdk_pub_sub"
+	_ "github.com/chrislusf/seaweedfs/weed/notification/google_pub_sub"
+	_ "github.com/chrislusf/seaweedfs/weed/notification/kafka"
+	_ "github.com/chrislusf/seaweedfs/weed/notification/log"
+	_ "github.com/chrislusf/seaweedfs/weed/notification/webhook"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+const (
+	maxNotificationRules = 100
+
+	notificationRegion    = "us-east-1"
+	notificationOffsetKey = "s3.notification.offset"
+
+	// notificationLockPath is locked on the filer by the only gateway publishing the bucket notifications
+	notificationLockPath          = filer.DirectoryEtcSeaweedFS + "/s3.notification.lock"
+	notificationLockRetryInterval = 10 * time.Second
+)
+
+// the events which can be derived from the filer metadata events.
+// Copy and POST uploads are reported as s3:ObjectCreated:Put.
+var notificationEvents = map[string]bool{
+	"s3:ObjectCreated:*":                       true,
+	"s3:ObjectCreated:Put":                     true,
+	"s3:ObjectCreated:CompleteMultipartUpload": true,
+	"s3:ObjectRemoved:*":                       true,
+	"s3:ObjectRemoved:Delete":                  true,
+	"s3:ObjectRemoved:DeleteMarkerCreated":     true,
+	"s3:ObjectTagging:*":                       true,
+	"s3:ObjectTagging:Put":                     true,
+	"s3:ObjectTagging:Delete":                  true,
+}
+
+func parseBucketNotificationConfiguration(data []byte) (*BucketNotificationConfiguration, error) {
+	config := &BucketNotificationConfiguration{}
+	if err := xml.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func (config *BucketNotificationConfiguration) rules() (rules []*NotificationRule) {
+	for i := range config.TopicConfigurations {
+		rules = append(rules, &config.TopicConfigurations[i])
+	}
+	for i := range config.QueueConfigurations {
+		rules = append(rules, &config.QueueConfigurations[i])
+	}
+	for i := range config.CloudFunctionConfigurations {
+		rules = append(rules, &config.CloudFunctionConfigurations[i])
+	}
+	return
+}
+
+func (config *BucketNotificationConfiguration) isEmpty() bool {
+	return len(config.rules()) == 0
+}
+
+func (config *BucketNotificationConfiguration) validate() error {
+	rules := config.rules()
+	if len(rules) > maxNotificationRules {
+		return fmt.Errorf("expecting at most %d rules, but found %d", maxNotificationRules, len(rules))
+	}
+	ids := make(map[string]bool)
+	for _, rule := range rules {
+		if len(rule.Id) > 255 {
+			return fmt.Errorf("rule id %q is longer than 255", rule.Id)
+		}
+		if rule.Id != "" {
+			if ids[rule.Id] {
+				return fmt.Errorf("duplicated rule id %q", rule.Id)
+			}
+			ids[rule.Id] = true
+		}
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf("rule %q: %v", rule.Id, err)
+		}
+	}
+	return nil
+}
+
+func (rule *NotificationRule) validate() error {
+	if rule.destination() == "" {
+		return fmt.Errorf("missing destination")
+	}
+	if len(rule.Events) == 0 {
+		return fmt.Errorf("missing event")
+	}
+	for _, event := range rule.Events {
+		if !notificationEvents[event] {
+			return fmt.Errorf("unsupported event %q", event)
+		}
+	}
+	if rule.Filter != nil {
+		names := make(map[string]bool)
+		for _, filterRule := range rule.Filter.S3Key.FilterRules {
+			name := strings.ToLower(filterRule.Name)
+			if name != "prefix" && name != "suffix" {
+				return fmt.Errorf("unsupported filter rule name %q", filterRule.Name)
+			}
+			if names[name] {
+				return fmt.Errorf("duplicated filter rule %q", filterRule.Name)
+			}
+			names[name] = true
+		}
+	}
+	return nil
+}
+
+// destination is the ARN of the topic, queue or cloud function
+func (rule *NotificationRule) destination() string {
+	return util.Nvl(rule.Topic, rule.Queue, rule.CloudFunction)
+}
+
+// targetId is the last part of the destination ARN, which names a target configured in notification.toml
+func (rule *NotificationRule) targetId() string {
+	arn := rule.destination()
+	return strings.ToLower(arn[strings.LastIndex(arn, ":")+1:])
+}
+
+func (config *BucketNotificationConfiguration) targetIds() (targetIds []string) {
+	seen := make(map[string]bool)
+	for _, rule := range config.rules() {
+		if targetId := rule.targetId(); !seen[targetId] {
+			seen[targetId] = true
+			targetIds = append(targetIds, targetId)
+		}
+	}
+	return
+}
+
+// assignRuleIds names the rules without an id, the id is reported as the configurationId of the events
+func (config *BucketNotificationConfiguration) assignRuleIds() {
+	for _, rule := range config.rules() {
+		if rule.Id == "" {
+			rule.Id = fmt.Sprintf("%08x%08x", util.RandomInt32(), util.RandomInt32())
+		}
+	}
+}
+
+func (rule *NotificationRule) matches(eventName, key string) bool {
+	matched := false
+	for _, event := range rule.Events {
+		if event == eventName || strings.HasSuffix(event, "*") && strings.HasPrefix(eventName, strings.TrimSuffix(event, "*")) {
+			matched = true
+			break
+		}
+	}
+	if !matched || rule.Filter == nil {
+		return matched
+	}
+	for _, filterRule := range rule.Filter.S3Key.FilterRules {
+		switch strings.ToLower(filterRule.Name) {
+		case "prefix":
+			if !strings.HasPrefix(key, filterRule.Value) {
+				return false
+			}
+		case "suffix":
+			if !strings.HasSuffix(key, filterRule.Value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// loadNotificationTargets creates the message queues configured as [s3.notification.<queue type>.<target id>]
+// in notification.toml. Bucket notification rules refer to the target id at the end of their destination ARN.
+func loadNotificationTargets(config *util.ViperProxy) map[string]notification.MessageQueue {
+	targets := make(map[string]notification.MessageQueue)
+	for queueType := range config.GetStringMap("s3.notification") {
+		for targetId := range config.GetStringMap("s3.notification." + queueType) {
+			prefix := fmt.Sprintf("s3.notification.%s.%s.", queueType, targetId)
+			if !config.GetBool(prefix + "enabled") {
+				continue
+			}
+			if _, found := targets[targetId]; found {
+				glog.Fatalf("duplicated s3 notification target %s", targetId)
+			}
+			queue, err := notification.NewMessageQueue(queueType, config, prefix)
+			if err != nil {
+				glog.Fatalf("Failed to initialize s3 notification target %s of %s: %v", targetId, queueType, err)
+			}
+			glog.V(0).Infof("Configure s3 notification target %s of %s", targetId, queueType)
+			targets[targetId] = queue
+		}
+	}
+	return targets
+}
+
+// notificationEvent is an object change derived from the filer metadata events
+type notificationEvent struct {
+	name      string
+	bucket    string
+	key       string
+	versionId string
+	entry     *filer_pb.Entry
+	tsNs      int64
+}
+
+// toObjectLocation maps an entry to its object key, or to the object key and version id for the entries under .versions
+func toObjectLocation(bucketsPath, dir, name string) (bucket, key, versionId string, isVersion, ok bool) {
+	if !strings.HasPrefix(dir, bucketsPath+"/") {
+		return
+	}
+	parts := strings.SplitN(dir[len(bucketsPath)+1:], "/", 2)
+	bucket = parts[0]
+	if len(parts) == 1 {
+		return bucket, name, "", false, true
+	}
+	rest := parts[1]
+	top := strings.SplitN(rest, "/", 2)[0]
+	switch top {
+	case ".uploads", lifecycleFolder:
+		return
+	case versionsFolder:
+		escaped := strings.TrimPrefix(rest, versionsFolder+"/")
+		if escaped == rest || strings.Contains(escaped, "/") {
+			return
+		}
+		object, err := url.PathUnescape(escaped)
+		if err != nil {
+			return
+		}
+		return bucket, object, name, true, true
+	}
+	return bucket, rest + "/" + name, "", false, true
+}
+
+// deriveNotificationEvents translates a filer metadata event on the buckets folder into S3 events
+func deriveNotificationEvents(bucketsPath string, resp *filer_pb.SubscribeMetadataResponse) (events []*notificationEvent) {
+	message := resp.EventNotification
+	oldEntry, newEntry := message.OldEntry, message.NewEntry
+	if oldEntry != nil && oldEntry.IsDirectory || newEntry != nil && newEntry.IsDirectory {
+		return nil
+	}
+	newDir := resp.Directory
+	if message.NewParentPath != "" {
+		newDir = message.NewParentPath
+	}
+
+	addEvent := func(name, bucket, key, versionId string, entry *filer_pb.Entry) {
+		events = append(events, &notificationEvent{
+			name:      name,
+			bucket:    bucket,
+			key:       key,
+			versionId: versionId,
+			entry:     entry,
+			tsNs:      resp.TsNs,
+		})
+	}
+
+	var oldBucket, oldKey, oldVersionId, newBucket, newKey, newVersionId string
+	var oldIsVersion, oldOk, newIsVersion, newOk bool
+	if oldEntry != nil {
+		oldBucket, oldKey, oldVersionId, oldIsVersion, oldOk = toObjectLocation(bucketsPath, resp.Directory, oldEntry.Name)
+		if !oldIsVersion {
+			oldVersionId = string(oldEntry.Extended[xhttp.AmzVersionId])
+		}
+	}
+	if newEntry != nil {
+		newBucket, newKey, newVersionId, newIsVersion, newOk = toObjectLocation(bucketsPath, newDir, newEntry.Name)
+		if !newIsVersion {
+			newVersionId = string(newEntry.Extended[xhttp.AmzVersionId])
+		}
+	}
+
+	switch {
+	case oldEntry == nil && newEntry != nil:
+		if !newOk {
+			return nil
+		}
+		if newIsVersion {
+			// noncurrent versions are only moved into .versions, except the delete markers
+			if isDeleteMarker(newEntry) {
+				addEvent("s3:ObjectRemoved:DeleteMarkerCreated", newBucket, newKey, newVersionId, newEntry)
+			}
+			return
+		}
+		if _, found := newEntry.Extended[xhttp.AmzPromotedVersion]; found {
+			return nil
+		}
+		addEvent(objectCreatedEventName(newEntry), newBucket, newKey, newVersionId, newEntry)
+	case oldEntry != nil && newEntry == nil:
+		// the renaming, e.g., moving versions in and out of .versions, keeps the data
+		if !oldOk || !message.DeleteChunks {
+			return nil
+		}
+		addEvent("s3:ObjectRemoved:Delete", oldBucket, oldKey, oldVersionId, oldEntry)
+	case oldEntry != nil && newEntry != nil:
+		if !newOk {
+			return nil
+		}
+		if !newIsVersion && isContentChanged(oldEntry, newEntry) {
+			addEvent(objectCreatedEventName(newEntry), newBucket, newKey, newVersionId, newEntry)
+			return
+		}
+		oldTags, newTags := getEntryTags(oldEntry), getEntryTags(newEntry)
+		if isTagsChanged(oldTags, newTags) {
+			if len(newTags) == 0 {
+				addEvent("s3:ObjectTagging:Delete", newBucket, newKey, newVersionId, newEntry)
+			} else {
+				addEvent("s3:ObjectTagging:Put", newBucket, newKey, newVersionId, newEntry)
+			}
+		}
+	}
+	return
+}
+
+// objectCreatedEventName tells the multipart uploads by their etag with the part count
+func objectCreatedEventName(entry *filer_pb.Entry) string {
+	if strings.Contains(filer.ETag(entry), "-") {
+		return "s3:ObjectCreated:CompleteMultipartUpload"
+	}
+	return "s3:ObjectCreated:Put"
+}
+
+func isContentChanged(oldEntry, newEntry *filer_pb.Entry) bool {
+	if oldEntry.Attributes.GetMtime() != newEntry.Attributes.GetMtime() ||
+		filer.FileSize(oldEntry) != filer.FileSize(newEntry) ||
+		!bytes.Equal(oldEntry.Attributes.GetMd5(), newEntry.Attributes.GetMd5()) {
+		return true
+	}
+	// the lifecycle transition only moves the same content to other chunks
+	if !bytes.Equal(oldEntry.Extended[xhttp.AmzStorageClass], newEntry.Extended[xhttp.AmzStorageClass]) {
+		return false
+	}
+	return filer.ETag(oldEntry) != filer.ETag(newEntry)
+}
+
+func isTagsChanged(oldTags, newTags map[string]string) bool {
+	if len(oldTags) != len(newTags) {
+		return true
+	}
+	for k, v := range oldTags {
+		if newValue, found := newTags[k]; !found || newValue != v {
+			return true
+		}
+	}
+	return false
+}
+
+// s3EventMessage follows the json structure of the AWS S3 event notifications
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-content-structure.html
+type s3EventMessage struct {
+	Records []*s3EventRecord `json:"Records"`
+}
+
+type s3EventRecord struct {
+	EventVersion string          `json:"eventVersion"`
+	EventSource  string          `json:"eventSource"`
+	AwsRegion    string          `json:"awsRegion"`
+	EventTime    string          `json:"eventTime"`
+	EventName    string          `json:"eventName"`
+	UserIdentity s3EventIdentity `json:"userIdentity"`
+	S3           s3EventEntity   `json:"s3"`
+}
+
+type s3EventIdentity struct {
+	PrincipalId string `json:"principalId"`
+}
+
+type s3EventEntity struct {
+	SchemaVersion   string        `json:"s3SchemaVersion"`
+	ConfigurationId string        `json:"configurationId"`
+	Bucket          s3EventBucket `json:"bucket"`
+	Object          s3EventObject `json:"object"`
+}
+
+type s3EventBucket struct {
+	Name          string          `json:"name"`
+	OwnerIdentity s3EventIdentity `json:"ownerIdentity"`
+	Arn           string          `json:"arn"`
+}
+
+type s3EventObject struct {
+	Key       string `json:"key"`
+	Size      uint64 `json:"size,omitempty"`
+	ETag      string `json:"eTag,omitempty"`
+	VersionId string `json:"versionId,omitempty"`
+	Sequencer string `json:"sequencer"`
+}
+
+// s3TestEvent is sent to the destinations when the bucket notification configuration is set
+type s3TestEvent struct {
+	Service string
+	Event   string
+	Time    string
+	Bucket  string
+}
+
+func (event *notificationEvent) toRecord(configurationId, bucketOwner string) *s3EventRecord {
+	record := &s3EventRecord{
+		EventVersion: "2.1",
+		EventSource:  "aws:s3",
+		AwsRegion:    notificationRegion,
+		EventTime:    time.Unix(0, event.tsNs).UTC().Format("2006-01-02T15:04:05.000Z"),
+		EventName:    strings.TrimPrefix(event.name, "s3:"),
+		S3: s3EventEntity{
+			SchemaVersion:   "1.0",
+			ConfigurationId: configurationId,
+			Bucket: s3EventBucket{
+				Name:          event.bucket,
+				OwnerIdentity: s3EventIdentity{PrincipalId: bucketOwner},
+				Arn:           "arn:aws:s3:::" + event.bucket,
+			},
+			Object: s3EventObject{
+				// the keys are url encoded as in the AWS events
+				Key:       strings.ReplaceAll(url.QueryEscape(event.key), "%2F", "/"),
+				VersionId: event.versionId,
+				Sequencer: fmt.Sprintf("%016X", event.tsNs),
+			},
+		},
+	}
+	if strings.HasPrefix(event.name, "s3:ObjectCreated:") {
+		// the other events are not done by the object creator
+		record.UserIdentity.PrincipalId = string(event.entry.Extended[xhttp.AmzIdentityId])
+	}
+	if strings.HasPrefix(event.name, "s3:ObjectCreated:") || strings.HasPrefix(event.name, "s3:ObjectTagging:") {
+		record.S3.Object.Size = filer.FileSize(event.entry)
+		record.S3.Object.ETag = filer.ETag(event.entry)
+	}
+	return record
+}
+
+// publishNotificationEvent sends the event to the destinations of the matching rules of the bucket.
+// A failed send is retried until it succeeds, or until the notification lock is lost.
+func (s3a *S3ApiServer) publishNotificationEvent(event *notificationEvent, lost <-chan struct{}) error {
+	bucketConfig := s3a.bucketConfigs.get(event.bucket)
+	if bucketConfig == nil || bucketConfig.Notification == nil {
+		return nil
+	}
+	for _, rule := range bucketConfig.Notification.rules() {
+		if !rule.matches(event.name, event.key) {
+			continue
+		}
+		queue, found := s3a.notificationTargets[rule.targetId()]
+		if !found {
+			glog.Warningf("bucket %s notification rule %s has unknown destination %s", event.bucket, rule.Id, rule.destination())
+			continue
+		}
+		data, err := json.Marshal(&s3EventMessage{
+			Records: []*s3EventRecord{event.toRecord(rule.Id, bucketConfig.Owner)},
+		})
+		if err != nil {
+			glog.Errorf("marshal %s event of %s/%s: %v", event.name, event.bucket, event.key, err)
+			continue
+		}
+		waitTime := time.Second
+		for {
+			if err = queue.SendRawMessage(event.bucket+"/"+event.key, data); err == nil {
+				break
+			}
+			glog.Errorf("send %s event of %s/%s to %s: %v", event.name, event.bucket, event.key, rule.targetId(), err)
+			select {
+			case <-lost:
+				return fmt.Errorf("send %s event of %s/%s to %s: %v", event.name, event.bucket, event.key, rule.targetId(), err)
+			case <-time.After(waitTime):
+			}
+			if waitTime < time.Minute {
+				waitTime *= 2
+			}
+		}
+	}
+	return nil
+}
+
+func (s3a *S3ApiServer) sendTestEvent(bucket string, targetIds []string) {
+	data, err := json.Marshal(&s3TestEvent{
+		Service: "Amazon S3",
+		Event:   "s3:TestEvent",
+		Time:    time.Now().UTC().Format("2006-01-02T15:04:05.000Z"),
+		Bucket:  bucket,
+	})
+	if err != nil {
+		return
+	}
+	for _, targetId := range targetIds {
+		if queue, found := s3a.notificationTargets[targetId]; found {
+			if err = queue.SendRawMessage(bucket, data); err != nil {
+				glog.Warningf("send test event of bucket %s to %s: %v", bucket, targetId, err)
+			}
+		}
+	}
+}
+
+// subscribeBucketEvents publishes the object changes in the buckets while holding the notification lock,
+// so that each event is published by only one gateway
+func (s3a *S3ApiServer) subscribeBucketEvents(clientName string) {
+	clientId := fmt.Sprintf("%s.%x", clientName, time.Now().UnixNano())
+	for {
+		acquired, err := s3a.withFilerLock(notificationLockPath, clientId, func(lost <-chan struct{}) {
+			s3a.followBucketEvents(clientName, lost)
+		})
+		if err != nil {
+			glog.Warningf("s3 notification: %v", err)
+		} else if !acquired {
+			glog.V(1).Infof("s3 notifications are published by another gateway")
+		}
+		time.Sleep(notificationLockRetryInterval)
+	}
+}
+
+// followBucketEvents follows the object changes in the buckets from the offset saved in the filer,
+// until the notification lock is lost
+func (s3a *S3ApiServer) followBucketEvents(clientName string, lost <-chan struct{}) {
+
+	lastTsNs, err := s3a.readNotificationOffset()
+	if err != nil {
+		glog.Warningf("read s3 notification offset: %v", err)
+		return
+	}
+	if lastTsNs == 0 {
+		lastTsNs = time.Now().UnixNano()
+	}
+	glog.V(0).Infof("s3 notification starts from %v", time.Unix(0, lastTsNs))
+
+	processEventFn := func(resp *filer_pb.SubscribeMetadataResponse) error {
+		select {
+		case <-lost:
+			return pb.ErrStopFollowing
+		default:
+		}
+		for _, event := range deriveNotificationEvents(s3a.option.BucketsPath, resp) {
+			if err := s3a.publishNotificationEvent(event, lost); err != nil {
+				glog.Warningf("stop publishing s3 notifications: %v", err)
+				return pb.ErrStopFollowing
+			}
+		}
+		return nil
+	}
+	processEventFnWithOffset := pb.AddOffsetFunc(processEventFn, 3*time.Second, func(counter int64, lastTsNs int64) error {
+		return s3a.writeNotificationOffset(lastTsNs)
+	})
+
+	clientId := util.RandomInt32()
+	for {
+		err := pb.WithFilerClientFollowMetadata(s3a, clientName, clientId, s3a.option.BucketsPath+"/", nil, &lastTsNs, 0, processEventFnWithOffset, false)
+		select {
+		case <-lost:
+			return
+		default:
+		}
+		glog.V(0).Infof("s3 notification follow metadata changes: %v", err)
+		time.Sleep(time.Second)
+	}
+
+}
+
+func (s3a *S3ApiServer) readNotificationOffset() (lastTsNs int64, err error) {
+	err = s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.KvGet(context.Background(), &filer_pb.KvGetRequest{Key: []byte(notificationOffsetKey)})
+		if err != nil {
+			return err
+		}
+		if len(resp.Error) != 0 {
+			return errors.New(resp.Error)
+		}
+		if len(resp.Value) < 8 {
+			return nil
+		}
+		lastTsNs = int64(util.BytesToUint64(resp.Value))
+		return nil
+	})
+	return
+}
+
+func (s3a *S3ApiServer) writeNotificationOffset(lastTsNs int64) error {
+	return s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		value := make([]byte, 8)
+		util.Uint64toBytes(value, uint64(lastTsNs))
+		resp, err := client.KvPut(context.Background(), &filer_pb.KvPutRequest{
+			Key:   []byte(notificationOffsetKey),
+			Value: value,
+		})
+		if err != nil {
+			return err
+		}
+		if len(resp.Error) != 0 {
+			return errors.New(resp.Error)
+		}
+		return nil
+	})
+}
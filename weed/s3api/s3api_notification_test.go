@@ -0,0 +1,190 @@
+package s3api
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/notification"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketNotificationMatches(t *testing.T) {
+	input := `<NotificationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+ <QueueConfiguration>
+   <Id>images</Id>
+   <Queue>arn:aws:sqs:us-east-1:000000000000:Orders</Queue>
+   <Event>s3:ObjectCreated:*</Event>
+   <Filter>
+     <S3Key>
+       <FilterRule><Name>prefix</Name><Value>images/</Value></FilterRule>
+       <FilterRule><Name>Suffix</Name><Value>.jpg</Value></FilterRule>
+     </S3Key>
+   </Filter>
+ </QueueConfiguration>
+ <TopicConfiguration>
+   <Topic>arn:aws:sns:us-east-1:000000000000:audit</Topic>
+   <Event>s3:ObjectRemoved:Delete</Event>
+ </TopicConfiguration>
+</NotificationConfiguration>`
+
+	config, err := parseBucketNotificationConfiguration([]byte(input))
+	assert.NoError(t, err)
+	assert.NoError(t, config.validate())
+	assert.Equal(t, []string{"audit", "orders"}, config.targetIds())
+
+	queue := &config.QueueConfigurations[0]
+	assert.True(t, queue.matches("s3:ObjectCreated:Put", "images/a.jpg"))
+	assert.False(t, queue.matches("s3:ObjectCreated:Put", "images/a.png"))
+	assert.False(t, queue.matches("s3:ObjectCreated:Put", "docs/a.jpg"))
+	assert.False(t, queue.matches("s3:ObjectRemoved:Delete", "images/a.jpg"))
+
+	topic := &config.TopicConfigurations[0]
+	assert.True(t, topic.matches("s3:ObjectRemoved:Delete", "docs/a.txt"))
+	assert.False(t, topic.matches("s3:ObjectRemoved:DeleteMarkerCreated", "docs/a.txt"))
+
+	config.assignRuleIds()
+	assert.Equal(t, "images", queue.Id)
+	assert.NotEmpty(t, topic.Id)
+
+	topic.Events = []string{"s3:ObjectRestore:Post"}
+	assert.Error(t, config.validate())
+	topic.Events = []string{"s3:ObjectRemoved:*"}
+	queue.Filter.S3Key.FilterRules[1].Name = "contains"
+	assert.Error(t, config.validate())
+}
+
+func TestDeriveNotificationEvents(t *testing.T) {
+	newEntry := func(name string, mtime int64, extended map[string][]byte) *filer_pb.Entry {
+		return &filer_pb.Entry{
+			Name:       name,
+			Attributes: &filer_pb.FuseAttributes{Mtime: mtime, FileSize: 3, Md5: []byte{1, 2, 3}},
+			Extended:   extended,
+		}
+	}
+	derive := func(dir string, oldEntry, newEntry *filer_pb.Entry, deleteChunks bool) []*notificationEvent {
+		return deriveNotificationEvents("/buckets", &filer_pb.SubscribeMetadataResponse{
+			Directory: dir,
+			EventNotification: &filer_pb.EventNotification{
+				OldEntry:     oldEntry,
+				NewEntry:     newEntry,
+				DeleteChunks: deleteChunks,
+			},
+			TsNs: 1,
+		})
+	}
+
+	events := derive("/buckets/b/dir", nil, newEntry("a b.txt", 1, nil), true)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, "s3:ObjectCreated:Put", events[0].name)
+		assert.Equal(t, "b", events[0].bucket)
+		assert.Equal(t, "dir/a b.txt", events[0].key)
+		record := events[0].toRecord("rule", "owner")
+		assert.Equal(t, "ObjectCreated:Put", record.EventName)
+		assert.Equal(t, "dir/a+b.txt", record.S3.Object.Key)
+		assert.Equal(t, "010203", record.S3.Object.ETag)
+		_, err := json.Marshal(record)
+		assert.NoError(t, err)
+	}
+
+	// tagging only changes the extended attributes
+	tagged := newEntry("a", 1, map[string][]byte{S3TAG_PREFIX + "k": []byte("v")})
+	events = derive("/buckets/b", newEntry("a", 1, nil), tagged, true)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, "s3:ObjectTagging:Put", events[0].name)
+	}
+	events = derive("/buckets/b", tagged, newEntry("a", 1, nil), true)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, "s3:ObjectTagging:Delete", events[0].name)
+	}
+	assert.Empty(t, derive("/buckets/b", tagged, tagged, true))
+
+	// overwriting
+	events = derive("/buckets/b", newEntry("a", 1, nil), newEntry("a", 2, nil), true)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, "s3:ObjectCreated:Put", events[0].name)
+	}
+
+	// archiving the current version renames it into .versions
+	versioned := map[string][]byte{xhttp.AmzVersionId: []byte("v1")}
+	assert.Empty(t, derive("/buckets/b/.versions/dir%2Fa", nil, newEntry("v1", 1, versioned), true))
+	assert.Empty(t, derive("/buckets/b/dir", newEntry("a", 1, versioned), nil, false))
+
+	// promoting the noncurrent version
+	promoted := map[string][]byte{xhttp.AmzVersionId: []byte("v1"), xhttp.AmzPromotedVersion: []byte("true")}
+	assert.Empty(t, derive("/buckets/b/dir", nil, newEntry("a", 1, promoted), true))
+
+	// deleting without a version id
+	marker := map[string][]byte{xhttp.AmzVersionId: []byte("v2"), xhttp.AmzDeleteMarker: []byte("true")}
+	events = derive("/buckets/b/.versions/dir%2Fa", nil, newEntry("v2", 1, marker), true)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, "s3:ObjectRemoved:DeleteMarkerCreated", events[0].name)
+		assert.Equal(t, "dir/a", events[0].key)
+		assert.Equal(t, "v2", events[0].versionId)
+	}
+
+	// deleting a noncurrent version
+	events = derive("/buckets/b/.versions/dir%2Fa", newEntry("v1", 1, versioned), nil, true)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, "s3:ObjectRemoved:Delete", events[0].name)
+		assert.Equal(t, "v1", events[0].versionId)
+	}
+
+	// the multipart uploads in progress
+	assert.Empty(t, derive("/buckets/b/.uploads/123", nil, newEntry("0001.part", 1, nil), true))
+	// the bucket itself
+	assert.Empty(t, derive("/buckets", nil, &filer_pb.Entry{Name: "c", IsDirectory: true}, true))
+}
+
+// failingQueue fails the first sends
+type failingQueue struct {
+	failures int
+	sent     []string
+}
+
+func (q *failingQueue) GetName() string { return "failing" }
+func (q *failingQueue) Initialize(configuration util.Configuration, prefix string) error {
+	return nil
+}
+func (q *failingQueue) SendMessage(key string, message proto.Message) error { return nil }
+func (q *failingQueue) SendRawMessage(key string, data []byte) error {
+	if q.failures > 0 {
+		q.failures--
+		return errors.New("unavailable")
+	}
+	q.sent = append(q.sent, key)
+	return nil
+}
+
+func TestPublishNotificationEventRetries(t *testing.T) {
+	config, err := parseBucketNotificationConfiguration([]byte(`<NotificationConfiguration>
+ <QueueConfiguration>
+   <Queue>arn:aws:sqs:us-east-1:000000000000:orders</Queue>
+   <Event>s3:ObjectCreated:*</Event>
+ </QueueConfiguration>
+</NotificationConfiguration>`))
+	assert.NoError(t, err)
+	config.assignRuleIds()
+	queue := &failingQueue{failures: 1}
+	s3a := &S3ApiServer{
+		bucketConfigs:       newBucketConfigCache(),
+		notificationTargets: map[string]notification.MessageQueue{"orders": queue},
+	}
+	s3a.bucketConfigs.configs["b"] = &BucketConfig{Notification: config}
+	event := &notificationEvent{name: "s3:ObjectCreated:Put", bucket: "b", key: "a", entry: &filer_pb.Entry{Name: "a", Attributes: &filer_pb.FuseAttributes{}}}
+
+	// a failed send is retried
+	assert.NoError(t, s3a.publishNotificationEvent(event, make(chan struct{})))
+	assert.Equal(t, []string{"b/a"}, queue.sent)
+
+	// after losing the lock, a failed event is left for the next publisher
+	queue.failures = 1
+	lost := make(chan struct{})
+	close(lost)
+	assert.Error(t, s3a.publishNotificationEvent(event, lost))
+	assert.Equal(t, []string{"b/a"}, queue.sent)
+}
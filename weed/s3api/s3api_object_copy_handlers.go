@@ -26,11 +26,17 @@ func (s3a *S3ApiServer) CopyObjectHandler(w http.ResponseWriter, r *http.Request
 		cpSrcPath = r.Header.Get("X-Amz-Copy-Source")
 	}
 
+	cpSrcPath, srcVersionId := splitCopySourceVersion(cpSrcPath)
 	srcBucket, srcObject := pathToBucketAndObject(cpSrcPath)
 
 	glog.V(3).Infof("CopyObjectHandler %s %s => %s %s", srcBucket, srcObject, dstBucket, dstObject)
 
-	if (srcBucket == dstBucket && srcObject == dstObject || cpSrcPath == "") && isReplace(r) {
+	if isReservedObject(srcObject) {
+		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidCopySource)
+		return
+	}
+
+	if (srcBucket == dstBucket && srcObject == dstObject && srcVersionId == "" || cpSrcPath == "") && isReplace(r) {
 		fullPath := util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, dstBucket, dstObject))
 		dir, name := fullPath.DirAndName()
 		entry, err := s3a.getEntry(dir, name)
@@ -38,7 +44,25 @@ func (s3a *S3ApiServer) CopyObjectHandler(w http.ResponseWriter, r *http.Request
 			s3err.WriteErrorResponse(w, r, s3err.ErrInvalidCopySource)
 			return
 		}
+		versionId, hasVersionId := entry.Extended[xhttp.AmzVersionId]
+		r.Header.Del(xhttp.AmzVersionId)
+		// the object lock of an existing object is only changed by its own APIs
+		r.Header.Del(xhttp.AmzObjectLockMode)
+		r.Header.Del(xhttp.AmzObjectLockRetainUntilDate)
+		r.Header.Del(xhttp.AmzObjectLockLegalHold)
+		// and the content is not re-encrypted when only replacing the metadata
+		clearSseRequestHeaders(r)
+		r.Header.Del(xhttp.AmzServerSideEncryption)
+		r.Header.Del(xhttp.AmzServerSideEncryptionAwsKmsKeyId)
+		r.Header.Del(xhttp.AmzServerSideEncryptionCustomerAlgorithm)
+		if errCode := s3a.prepareObjectAcl(r, dstBucket); errCode != s3err.ErrNone {
+			s3err.WriteErrorResponse(w, r, errCode)
+			return
+		}
 		entry.Extended = weed_server.SaveAmzMetaData(r, entry.Extended, isReplace(r))
+		if hasVersionId {
+			entry.Extended[xhttp.AmzVersionId] = versionId
+		}
 		err = s3a.touch(dir, name, entry)
 		if err != nil {
 			s3err.WriteErrorResponse(w, r, s3err.ErrInvalidCopySource)
@@ -52,43 +76,85 @@ func (s3a *S3ApiServer) CopyObjectHandler(w http.ResponseWriter, r *http.Request
 	}
 
 	// If source object is empty or bucket is empty, reply back invalid copy source.
-	if srcObject == "" || srcBucket == "" {
+	if srcObject == "" || srcBucket == "" || isReservedObject(srcObject) {
 		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidCopySource)
 		return
 	}
-	srcPath := util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, srcBucket, srcObject))
-	dir, name := srcPath.DirAndName()
-	if entry, err := s3a.getEntry(dir, name); err != nil || entry.IsDirectory {
-		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidCopySource)
-		return
+	if srcVersionId == "" {
+		srcPath := util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, srcBucket, srcObject))
+		dir, name := srcPath.DirAndName()
+		if entry, err := s3a.getEntry(dir, name); err != nil || entry.IsDirectory {
+			s3err.WriteErrorResponse(w, r, s3err.ErrInvalidCopySource)
+			return
+		}
 	}
 
-	if srcBucket == dstBucket && srcObject == dstObject {
+	if srcBucket == dstBucket && srcObject == dstObject && srcVersionId == "" {
 		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidCopyDest)
 		return
 	}
 
 	dstUrl := fmt.Sprintf("http://%s%s/%s%s?collection=%s",
 		s3a.option.Filer.ToHttpAddress(), s3a.option.BucketsPath, dstBucket, urlPathEscape(dstObject), dstBucket)
-	srcUrl := fmt.Sprintf("http://%s%s/%s%s",
-		s3a.option.Filer.ToHttpAddress(), s3a.option.BucketsPath, srcBucket, urlPathEscape(srcObject))
+	srcUrl, errCode := s3a.objectUrl(srcBucket, srcObject, srcVersionId)
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidCopySource)
+		return
+	}
 
-	_, _, resp, err := util.DownloadFile(srcUrl, s3a.maybeGetFilerJwtAuthorizationToken(false))
+	_, _, resp, err := util.DownloadFile(srcUrl, s3a.maybeGetFilerJwtAuthorizationToken(filerUrlPath(srcUrl), false))
 	if err != nil {
 		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidCopySource)
 		return
 	}
 	defer util.CloseResponse(resp)
 
-	glog.V(2).Infof("copy from %s to %s", srcUrl, dstUrl)
-	etag, errCode := s3a.putToFiler(r, dstUrl, resp.Body)
+	srcReader, errCode := s3a.decryptCopySource(r, resp.Body, resp.Header.Get, 0)
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+
+	if errCode = s3a.prepareObjectLock(r, dstBucket); errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	if errCode = s3a.prepareObjectAcl(r, dstBucket); errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	encryption, errCode := s3a.prepareServerSideEncryption(r, dstBucket)
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	body, err := encryption.encryptReader(srcReader)
+	if err != nil {
+		glog.Errorf("CopyObjectHandler %s %s encrypt: %v", dstBucket, dstObject, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
 
+	versioned, dstUrl, errCode := s3a.prepareVersionedPut(r, dstBucket, dstObject)
 	if errCode != s3err.ErrNone {
 		s3err.WriteErrorResponse(w, r, errCode)
 		return
 	}
 
+	glog.V(2).Infof("copy from %s to %s", srcUrl, dstUrl)
+	etag, errCode := s3a.putToFiler(r, dstUrl, body)
+
+	if errCode = s3a.finishVersionedPut(versioned, errCode); errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+
 	setEtag(w, etag)
+	setVersionId(w, versioned.versionId)
+	setServerSideEncryption(w, r, encryption)
+	if srcVersionId != "" {
+		w.Header().Set("x-amz-copy-source-version-id", srcVersionId)
+	}
 
 	response := CopyObjectResult{
 		ETag:         etag,
@@ -125,9 +191,10 @@ func (s3a *S3ApiServer) CopyObjectPartHandler(w http.ResponseWriter, r *http.Req
 		cpSrcPath = r.Header.Get("X-Amz-Copy-Source")
 	}
 
+	cpSrcPath, srcVersionId := splitCopySourceVersion(cpSrcPath)
 	srcBucket, srcObject := pathToBucketAndObject(cpSrcPath)
 	// If source object is empty or bucket is empty, reply back invalid copy source.
-	if srcObject == "" || srcBucket == "" {
+	if srcObject == "" || srcBucket == "" || isReservedObject(srcObject) {
 		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidCopySource)
 		return
 	}
@@ -153,18 +220,51 @@ func (s3a *S3ApiServer) CopyObjectPartHandler(w http.ResponseWriter, r *http.Req
 
 	dstUrl := fmt.Sprintf("http://%s%s/%s/%04d.part?collection=%s",
 		s3a.option.Filer.ToHttpAddress(), s3a.genUploadsFolder(dstBucket), uploadID, partID, dstBucket)
-	srcUrl := fmt.Sprintf("http://%s%s/%s%s",
-		s3a.option.Filer.ToHttpAddress(), s3a.option.BucketsPath, srcBucket, urlPathEscape(srcObject))
+	srcUrl, errCode := s3a.objectUrl(srcBucket, srcObject, srcVersionId)
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidCopySource)
+		return
+	}
 
-	dataReader, err := util.ReadUrlAsReaderCloser(srcUrl, s3a.maybeGetFilerJwtAuthorizationToken(false), rangeHeader)
+	srcEntry, err := s3a.getEntry(util.FullPath(filerUrlPath(srcUrl)).DirAndName())
+	if err != nil || srcEntry.IsDirectory {
+		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidCopySource)
+		return
+	}
+	rangeStart, err := parseRangeStart(rangeHeader)
+	if err != nil {
+		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidCopySource)
+		return
+	}
+
+	dataReader, err := util.ReadUrlAsReaderCloser(srcUrl, s3a.maybeGetFilerJwtAuthorizationToken(filerUrlPath(srcUrl), false), rangeHeader)
 	if err != nil {
 		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidCopySource)
 		return
 	}
 	defer dataReader.Close()
 
+	srcReader, errCode := s3a.decryptCopySource(r, dataReader, func(name string) string {
+		return string(srcEntry.Extended[name])
+	}, rangeStart)
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	encryption, errCode := s3a.prepareUploadPartEncryption(r, dstBucket, uploadID)
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	body, err := encryption.encryptReader(srcReader)
+	if err != nil {
+		glog.Errorf("CopyObjectPartHandler %s %s encrypt: %v", dstBucket, uploadID, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
 	glog.V(2).Infof("copy from %s to %s", srcUrl, dstUrl)
-	etag, errCode := s3a.putToFiler(r, dstUrl, dataReader)
+	etag, errCode := s3a.putToFiler(r, dstUrl, body)
 
 	if errCode != s3err.ErrNone {
 		s3err.WriteErrorResponse(w, r, errCode)
@@ -172,6 +272,7 @@ func (s3a *S3ApiServer) CopyObjectPartHandler(w http.ResponseWriter, r *http.Req
 	}
 
 	setEtag(w, etag)
+	setServerSideEncryption(w, r, encryption)
 
 	response := CopyPartResult{
 		ETag:         etag,
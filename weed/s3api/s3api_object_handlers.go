@@ -104,20 +104,47 @@ func (s3a *S3ApiServer) PutObjectHandler(w http.ResponseWriter, r *http.Request)
 			return
 		}
 	} else {
-		uploadUrl := fmt.Sprintf("http://%s%s/%s%s", s3a.option.Filer.ToHttpAddress(), s3a.option.BucketsPath, bucket, urlPathEscape(object))
-
 		if r.Header.Get("Content-Type") == "" {
 			dataReader = mimeDetect(r, dataReader)
 		}
 
-		etag, errCode := s3a.putToFiler(r, uploadUrl, dataReader)
+		if errCode := s3a.prepareObjectLock(r, bucket); errCode != s3err.ErrNone {
+			s3err.WriteErrorResponse(w, r, errCode)
+			return
+		}
+		if errCode := s3a.prepareObjectAcl(r, bucket); errCode != s3err.ErrNone {
+			s3err.WriteErrorResponse(w, r, errCode)
+			return
+		}
 
+		encryption, errCode := s3a.prepareServerSideEncryption(r, bucket)
+		if errCode != s3err.ErrNone {
+			s3err.WriteErrorResponse(w, r, errCode)
+			return
+		}
+		body, err := encryption.encryptReader(dataReader)
+		if err != nil {
+			glog.Errorf("PutObjectHandler %s %s encrypt: %v", bucket, object, err)
+			s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+			return
+		}
+
+		versioned, uploadUrl, errCode := s3a.prepareVersionedPut(r, bucket, object)
 		if errCode != s3err.ErrNone {
 			s3err.WriteErrorResponse(w, r, errCode)
 			return
 		}
 
+		etag, errCode := s3a.putToFiler(r, uploadUrl, body)
+
+		if errCode = s3a.finishVersionedPut(versioned, errCode); errCode != s3err.ErrNone {
+			s3err.WriteErrorResponse(w, r, errCode)
+			return
+		}
+
 		setEtag(w, etag)
+		setVersionId(w, versioned.versionId)
+		setServerSideEncryption(w, r, encryption)
 	}
 
 	writeSuccessResponseEmpty(w, r)
@@ -141,10 +168,21 @@ func (s3a *S3ApiServer) GetObjectHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	destUrl := fmt.Sprintf("http://%s%s/%s%s",
-		s3a.option.Filer.ToHttpAddress(), s3a.option.BucketsPath, bucket, urlPathEscape(object))
+	versionId := r.URL.Query().Get("versionId")
+	destUrl, errCode := s3a.objectUrl(bucket, object, versionId)
+	if errCode != s3err.ErrNone {
+		writeVersionedErrorResponse(w, r, versionId, errCode)
+		return
+	}
+
+	customer, errCode := parseSseCustomerKey(r.Header, false)
+	clearSseRequestHeaders(r)
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
 
-	s3a.proxyToFiler(w, r, destUrl, false, passThroughResponse)
+	s3a.proxyToFiler(w, r, destUrl, false, s3a.sseResponse(r, customer))
 }
 
 func (s3a *S3ApiServer) HeadObjectHandler(w http.ResponseWriter, r *http.Request) {
@@ -152,10 +190,21 @@ func (s3a *S3ApiServer) HeadObjectHandler(w http.ResponseWriter, r *http.Request
 	bucket, object := xhttp.GetBucketAndObject(r)
 	glog.V(3).Infof("HeadObjectHandler %s %s", bucket, object)
 
-	destUrl := fmt.Sprintf("http://%s%s/%s%s",
-		s3a.option.Filer.ToHttpAddress(), s3a.option.BucketsPath, bucket, urlPathEscape(object))
+	versionId := r.URL.Query().Get("versionId")
+	destUrl, errCode := s3a.objectUrl(bucket, object, versionId)
+	if errCode != s3err.ErrNone {
+		writeVersionedErrorResponse(w, r, versionId, errCode)
+		return
+	}
 
-	s3a.proxyToFiler(w, r, destUrl, false, passThroughResponse)
+	customer, errCode := parseSseCustomerKey(r.Header, false)
+	clearSseRequestHeaders(r)
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+
+	s3a.proxyToFiler(w, r, destUrl, false, s3a.sseResponse(r, customer))
 }
 
 func (s3a *S3ApiServer) DeleteObjectHandler(w http.ResponseWriter, r *http.Request) {
@@ -163,6 +212,27 @@ func (s3a *S3ApiServer) DeleteObjectHandler(w http.ResponseWriter, r *http.Reque
 	bucket, object := xhttp.GetBucketAndObject(r)
 	glog.V(3).Infof("DeleteObjectHandler %s %s", bucket, object)
 
+	versionId := r.URL.Query().Get("versionId")
+	versioning, err := s3a.getBucketVersioning(bucket)
+	if err != nil {
+		glog.Errorf("DeleteObjectHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	if versionId != "" || versioning != "" {
+		deletedVersionId, deletedMarker, errCode := s3a.deleteObjectVersion(bucket, object, versionId, versioning, s3a.isGovernanceBypassed(r))
+		if errCode != s3err.ErrNone {
+			s3err.WriteErrorResponse(w, r, errCode)
+			return
+		}
+		setVersionId(w, deletedVersionId)
+		if deletedMarker {
+			w.Header().Set(xhttp.AmzDeleteMarker, "true")
+		}
+		s3err.WriteEmptyResponse(w, r, http.StatusNoContent)
+		return
+	}
+
 	destUrl := fmt.Sprintf("http://%s%s/%s%s?recursive=true",
 		s3a.option.Filer.ToHttpAddress(), s3a.option.BucketsPath, bucket, urlPathEscape(object))
 
@@ -178,7 +248,10 @@ func (s3a *S3ApiServer) DeleteObjectHandler(w http.ResponseWriter, r *http.Reque
 
 // / ObjectIdentifier carries key name for the object to delete.
 type ObjectIdentifier struct {
-	ObjectName string `xml:"Key"`
+	ObjectName            string `xml:"Key"`
+	VersionId             string `xml:"VersionId,omitempty"`
+	DeleteMarker          bool   `xml:"DeleteMarker,omitempty"`
+	DeleteMarkerVersionId string `xml:"DeleteMarkerVersionId,omitempty"`
 }
 
 // DeleteObjectsRequest - xml carrying the object key names which needs to be deleted.
@@ -191,9 +264,10 @@ type DeleteObjectsRequest struct {
 
 // DeleteError structure.
 type DeleteError struct {
-	Code    string
-	Message string
-	Key     string
+	Code      string
+	Message   string
+	Key       string
+	VersionId string `xml:"VersionId,omitempty"`
 }
 
 // DeleteObjectsResponse container for multiple object deletes.
@@ -234,10 +308,62 @@ func (s3a *S3ApiServer) DeleteMultipleObjectsHandler(w http.ResponseWriter, r *h
 	if s3err.Logger != nil {
 		auditLog = s3err.GetAccessLog(r, http.StatusNoContent, s3err.ErrNone)
 	}
+
+	versioning, err := s3a.getBucketVersioning(bucket)
+	if err != nil {
+		glog.Errorf("DeleteMultipleObjectsHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	bypassGovernance := s3a.isGovernanceBypassed(r)
+
 	s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
 
 		// delete file entries
 		for _, object := range deleteObjects.Objects {
+			// the request is authorized on the bucket, the bucket policy may still deny the object
+			s3Action := "s3:DeleteObject"
+			if object.VersionId != "" {
+				s3Action = "s3:DeleteObjectVersion"
+			}
+			errCode := s3err.ErrAccessDenied
+			if !isReservedObject(object.ObjectName) {
+				errCode = s3a.iam.checkObjectPolicy(r, bucket, "/"+object.ObjectName, s3Action)
+			}
+			if errCode != s3err.ErrNone {
+				apiErr := s3err.GetAPIError(errCode)
+				deleteErrors = append(deleteErrors, DeleteError{
+					Code:      apiErr.Code,
+					Message:   apiErr.Description,
+					Key:       object.ObjectName,
+					VersionId: object.VersionId,
+				})
+				continue
+			}
+			if object.VersionId != "" || versioning != "" {
+				deletedVersionId, deletedMarker, errCode := s3a.deleteObjectVersion(bucket, "/"+object.ObjectName, object.VersionId, versioning, bypassGovernance)
+				if errCode == s3err.ErrNone {
+					if deletedMarker {
+						object.DeleteMarker = true
+						object.DeleteMarkerVersionId = deletedVersionId
+					}
+					deletedObjects = append(deletedObjects, object)
+				} else {
+					apiErr := s3err.GetAPIError(errCode)
+					deleteErrors = append(deleteErrors, DeleteError{
+						Code:      apiErr.Code,
+						Message:   apiErr.Description,
+						Key:       object.ObjectName,
+						VersionId: object.VersionId,
+					})
+				}
+				if auditLog != nil {
+					auditLog.Key = object.ObjectName
+					s3err.PostAccessLog(*auditLog)
+				}
+				continue
+			}
 			lastSeparator := strings.LastIndex(object.ObjectName, "/")
 			parentDirectoryPath, entryName, isDeleteData, isRecursive := "", object.ObjectName, true, false
 			if lastSeparator > 0 && lastSeparator+1 < len(object.ObjectName) {
@@ -359,6 +485,14 @@ func (s3a *S3ApiServer) proxyToFiler(w http.ResponseWriter, r *http.Request, des
 
 func passThroughResponse(proxyResponse *http.Response, w http.ResponseWriter) (statusCode int) {
 	for k, v := range proxyResponse.Header {
+		// the cross-origin headers are decided by the bucket CORS configuration
+		if strings.HasPrefix(k, "Access-Control-") {
+			continue
+		}
+		// the object acl is only returned by GetObjectAcl
+		if k == http.CanonicalHeaderKey(xhttp.AmzAcl) {
+			continue
+		}
 		w.Header()[k] = v
 	}
 	if proxyResponse.Header.Get("Content-Range") != "" && proxyResponse.StatusCode == 200 {
@@ -447,7 +581,7 @@ func filerErrorToS3Error(errString string) s3err.ErrorCode {
 }
 
 func (s3a *S3ApiServer) maybeAddFilerJwtAuthorization(r *http.Request, isWrite bool) {
-	encodedJwt := s3a.maybeGetFilerJwtAuthorizationToken(isWrite)
+	encodedJwt := s3a.maybeGetFilerJwtAuthorizationToken(r.URL.Path, isWrite)
 
 	if encodedJwt == "" {
 		return
@@ -456,12 +590,22 @@ func (s3a *S3ApiServer) maybeAddFilerJwtAuthorization(r *http.Request, isWrite b
 	r.Header.Set("Authorization", "BEARER "+string(encodedJwt))
 }
 
-func (s3a *S3ApiServer) maybeGetFilerJwtAuthorizationToken(isWrite bool) string {
+// maybeGetFilerJwtAuthorizationToken creates a filer token only allowing the access to the path
+func (s3a *S3ApiServer) maybeGetFilerJwtAuthorizationToken(path string, isWrite bool) string {
 	var encodedJwt security.EncodedJwt
 	if isWrite {
-		encodedJwt = security.GenJwtForFilerServer(s3a.filerGuard.SigningKey, s3a.filerGuard.ExpiresAfterSec)
+		encodedJwt = security.GenJwtForFilerServer(s3a.filerGuard.SigningKeys, s3a.filerGuard.ExpiresAfterSec, path, security.JwtOperationWrite)
 	} else {
-		encodedJwt = security.GenJwtForFilerServer(s3a.filerGuard.ReadSigningKey, s3a.filerGuard.ReadExpiresAfterSec)
+		encodedJwt = security.GenJwtForFilerServer(s3a.filerGuard.ReadSigningKeys, s3a.filerGuard.ReadExpiresAfterSec, path, security.JwtOperationRead)
 	}
 	return string(encodedJwt)
 }
+
+// filerUrlPath is the path of a filer url, as the filer sees it when checking the token
+func filerUrlPath(fileUrl string) string {
+	u, err := url.Parse(fileUrl)
+	if err != nil {
+		return fileUrl
+	}
+	return u.Path
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/base64"
 	"errors"
-	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
@@ -12,7 +11,9 @@ import (
 	"strings"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
 	"github.com/chrislusf/seaweedfs/weed/s3api/policy"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
 	"github.com/dustin/go-humanize"
 	"github.com/gorilla/mux"
@@ -56,6 +57,10 @@ func (s3a *S3ApiServer) PostPolicyBucketHandler(w http.ResponseWriter, r *http.R
 		formValues.Set("Key", strings.Replace(formValues.Get("Key"), "${filename}", fileName, -1))
 	}
 	object := formValues.Get("Key")
+	if isReservedObject(object) {
+		s3err.WriteErrorResponse(w, r, s3err.ErrAccessDenied)
+		return
+	}
 
 	successRedirect := formValues.Get("success_action_redirect")
 	successStatus := formValues.Get("success_action_status")
@@ -69,12 +74,23 @@ func (s3a *S3ApiServer) PostPolicyBucketHandler(w http.ResponseWriter, r *http.R
 	}
 
 	// Verify policy signature.
-	errCode := s3a.iam.doesPolicySignatureMatch(formValues)
+	identity, errCode := s3a.iam.doesPolicySignatureMatch(formValues)
 	if errCode != s3err.ErrNone {
 		s3err.WriteErrorResponse(w, r, errCode)
 		return
 	}
 
+	// the signature only authenticates the form, the key is authorized as uploading the object
+	if s3a.iam.isEnabled() {
+		if errCode = s3a.iam.authorize(r, identity, s3_constants.ACTION_WRITE, bucket, "/"+object, "s3:PutObject"); errCode != s3err.ErrNone {
+			s3err.WriteErrorResponse(w, r, errCode)
+			return
+		}
+		if identity.Name != "" {
+			r.Header.Set(xhttp.AmzIdentityId, identity.Name)
+		}
+	}
+
 	policyBytes, err := base64.StdEncoding.DecodeString(formValues.Get("Policy"))
 	if err != nil {
 		s3err.WriteErrorResponse(w, r, s3err.ErrMalformedPOSTRequest)
@@ -113,15 +129,51 @@ func (s3a *S3ApiServer) PostPolicyBucketHandler(w http.ResponseWriter, r *http.R
 		}
 	}
 
-	uploadUrl := fmt.Sprintf("http://%s%s/%s%s", s3a.option.Filer.ToHttpAddress(), s3a.option.BucketsPath, bucket, urlPathEscape(object))
-
-	etag, errCode := s3a.putToFiler(r, uploadUrl, fileBody)
+	if errCode := s3a.prepareObjectLock(r, bucket); errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	if acl := formValues.Get("Acl"); acl != "" {
+		r.Header.Set(xhttp.AmzCannedAcl, acl)
+	}
+	if errCode := s3a.prepareObjectAcl(r, bucket); errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	for _, header := range []string{xhttp.AmzServerSideEncryption, xhttp.AmzServerSideEncryptionAwsKmsKeyId,
+		xhttp.AmzServerSideEncryptionCustomerAlgorithm, xhttp.AmzServerSideEncryptionCustomerKey, xhttp.AmzServerSideEncryptionCustomerKeyMD5} {
+		if v := formValues.Get(header); v != "" {
+			r.Header.Set(header, v)
+		}
+	}
+	encryption, errCode := s3a.prepareServerSideEncryption(r, bucket)
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	body, err := encryption.encryptReader(fileBody)
+	if err != nil {
+		glog.Errorf("PostPolicyBucketHandler %s %s encrypt: %v", bucket, object, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
 
+	versioned, uploadUrl, errCode := s3a.prepareVersionedPut(r, bucket, "/"+strings.TrimPrefix(object, "/"))
 	if errCode != s3err.ErrNone {
 		s3err.WriteErrorResponse(w, r, errCode)
 		return
 	}
 
+	etag, errCode := s3a.putToFiler(r, uploadUrl, body)
+
+	if errCode = s3a.finishVersionedPut(versioned, errCode); errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+
+	setVersionId(w, versioned.versionId)
+	setServerSideEncryption(w, r, encryption)
+
 	if successRedirect != "" {
 		// Replace raw query params..
 		redirectURL.RawQuery = getRedirectPostRawQuery(bucket, object, etag)
@@ -236,7 +288,7 @@ func getRedirectPostRawQuery(bucket, key, etag string) string {
 }
 
 // Check to see if Policy is signed correctly.
-func (iam *IdentityAccessManagement) doesPolicySignatureMatch(formValues http.Header) s3err.ErrorCode {
+func (iam *IdentityAccessManagement) doesPolicySignatureMatch(formValues http.Header) (*Identity, s3err.ErrorCode) {
 	// For SignV2 - Signature field will be valid
 	if _, ok := formValues["Signature"]; ok {
 		return iam.doesPolicySignatureV2Match(formValues)
@@ -0,0 +1,216 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func (config *ObjectLockConfiguration) validate() error {
+	if config.ObjectLockEnabled != ObjectLockEnabled {
+		return fmt.Errorf("invalid ObjectLockEnabled %q", config.ObjectLockEnabled)
+	}
+	if config.Rule == nil {
+		return nil
+	}
+	retention := config.Rule.DefaultRetention
+	if retention == nil {
+		return fmt.Errorf("missing DefaultRetention")
+	}
+	if !isObjectLockMode(retention.Mode) {
+		return fmt.Errorf("invalid retention mode %q", retention.Mode)
+	}
+	if (retention.Days > 0) == (retention.Years > 0) || retention.Days < 0 || retention.Years < 0 {
+		return fmt.Errorf("expecting either positive Days or positive Years")
+	}
+	return nil
+}
+
+// defaultRetainUntil returns when the default retention of newly created objects expires
+func (config *ObjectLockConfiguration) defaultRetainUntil(now time.Time) (mode string, retainUntil time.Time) {
+	if config.Rule == nil || config.Rule.DefaultRetention == nil {
+		return "", time.Time{}
+	}
+	retention := config.Rule.DefaultRetention
+	return retention.Mode, now.UTC().AddDate(retention.Years, 0, retention.Days)
+}
+
+// validate checks the new retention, where empty mode and date removes the retention
+func (retention *ObjectRetention) validate(now time.Time) s3err.ErrorCode {
+	if retention.Mode == "" && retention.RetainUntilDate == nil {
+		return s3err.ErrNone
+	}
+	if !isObjectLockMode(retention.Mode) || retention.RetainUntilDate == nil {
+		return s3err.ErrMalformedXML
+	}
+	if !retention.RetainUntilDate.After(now) {
+		return s3err.ErrPastObjectLockRetainDate
+	}
+	return s3err.ErrNone
+}
+
+// checkRetentionChange allows to extend the retention, but only governance retention can be
+// shortened or removed, and only when bypassing governance retention is requested and permitted.
+func checkRetentionChange(extended map[string][]byte, retention *ObjectRetention, bypassGovernance bool, now time.Time) s3err.ErrorCode {
+	mode, retainUntil := filer.ObjectRetainUntil(extended)
+	if mode == "" || !now.Before(retainUntil) {
+		return s3err.ErrNone
+	}
+	weakened := retention.Mode == "" || retention.RetainUntilDate.Before(retainUntil)
+	switch mode {
+	case filer.ObjectLockCompliance:
+		if weakened || retention.Mode != filer.ObjectLockCompliance {
+			return s3err.ErrObjectLocked
+		}
+	case filer.ObjectLockGovernance:
+		if weakened && !bypassGovernance {
+			return s3err.ErrObjectLocked
+		}
+	}
+	return s3err.ErrNone
+}
+
+func isObjectLockMode(mode string) bool {
+	return mode == filer.ObjectLockGovernance || mode == filer.ObjectLockCompliance
+}
+
+func isLegalHoldStatus(status string) bool {
+	return status == filer.LegalHoldOn || status == filer.LegalHoldOff
+}
+
+func (s3a *S3ApiServer) getBucketObjectLock(bucket string) *ObjectLockConfiguration {
+	if config := s3a.bucketConfigs.get(bucket); config != nil {
+		return config.ObjectLock
+	}
+	return nil
+}
+
+func (s3a *S3ApiServer) setBucketObjectLock(bucket string, config *ObjectLockConfiguration) error {
+	config.Xmlns = ""
+	data, err := xml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	return s3a.updateBucketEntry(bucket, func(entry *filer_pb.Entry) {
+		entry.Extended[xhttp.AmzBucketObjectLock] = data
+	})
+}
+
+// isGovernanceBypassed checks whether the request asks to bypass the governance retention,
+// with the permission checked during authentication.
+func (s3a *S3ApiServer) isGovernanceBypassed(r *http.Request) bool {
+	if !strings.EqualFold(r.Header.Get(xhttp.AmzBypassGovernanceRetention), "true") {
+		return false
+	}
+	return !s3a.iam.isEnabled() || r.Header.Get(xhttp.AmzCanBypassGovernance) == "true"
+}
+
+// updateObjectLock saves the changed retention or legal hold of the object version.
+// The filer refuses to remove a legal hold or to shorten a governance retention, unless the release operation,
+// security.JwtOperationRemoveLegalHold or security.JwtOperationBypassGovernance, is signed with the jwt.filer_signing key.
+func (s3a *S3ApiServer) updateObjectLock(dir string, entry *filer_pb.Entry, releaseOperation string) error {
+	var objectLockJwt security.EncodedJwt
+	if releaseOperation != "" {
+		if !s3a.filerGuard.SigningKeys.CanSign() {
+			return fmt.Errorf("%s of %s/%s needs the jwt.filer_signing key", releaseOperation, dir, entry.Name)
+		}
+		objectLockJwt = security.GenJwtForFilerServer(s3a.filerGuard.SigningKeys, s3a.filerGuard.ExpiresAfterSec, util.Join(dir, entry.Name), releaseOperation)
+	}
+	return s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		return filer_pb.UpdateEntry(client, &filer_pb.UpdateEntryRequest{
+			Directory:     dir,
+			Entry:         entry,
+			ObjectLockJwt: string(objectLockJwt),
+		})
+	})
+}
+
+// prepareObjectLock validates the object lock headers of a new object,
+// and applies the bucket default retention when the retention is not specified.
+// The headers are saved along with the object by the filer.
+func (s3a *S3ApiServer) prepareObjectLock(r *http.Request, bucket string) s3err.ErrorCode {
+	mode := r.Header.Get(xhttp.AmzObjectLockMode)
+	retainUntilDate := r.Header.Get(xhttp.AmzObjectLockRetainUntilDate)
+	legalHold := r.Header.Get(xhttp.AmzObjectLockLegalHold)
+
+	config := s3a.getBucketObjectLock(bucket)
+	if config == nil {
+		if mode != "" || retainUntilDate != "" || legalHold != "" {
+			return s3err.ErrMissingObjectLockConfiguration
+		}
+		return s3err.ErrNone
+	}
+
+	if legalHold != "" && !isLegalHoldStatus(legalHold) {
+		return s3err.ErrInvalidRequest
+	}
+	if (mode == "") != (retainUntilDate == "") {
+		return s3err.ErrObjectLockInvalidHeaders
+	}
+
+	now := time.Now()
+	var retainUntil time.Time
+	if mode == "" {
+		mode, retainUntil = config.defaultRetainUntil(now)
+		if mode == "" {
+			return s3err.ErrNone
+		}
+	} else {
+		if !isObjectLockMode(mode) {
+			return s3err.ErrInvalidRequest
+		}
+		var err error
+		if retainUntil, err = time.Parse(time.RFC3339, retainUntilDate); err != nil {
+			return s3err.ErrMalformedDate
+		}
+		if !retainUntil.After(now) {
+			return s3err.ErrPastObjectLockRetainDate
+		}
+	}
+	r.Header.Set(xhttp.AmzObjectLockMode, mode)
+	r.Header.Set(xhttp.AmzObjectLockRetainUntilDate, retainUntil.UTC().Format(time.RFC3339))
+	return s3err.ErrNone
+}
+
+// unlockForDelete checks whether the object version can be deleted. A permitted governance bypass
+// removes the governance retention first, since the filer refuses to delete locked objects.
+func (s3a *S3ApiServer) unlockForDelete(bucket, object, versionId string, bypassGovernance bool) s3err.ErrorCode {
+	dir, entry, _, err := s3a.lookupVersion(bucket, object, versionId)
+	if err == filer_pb.ErrNotFound {
+		return s3err.ErrNone
+	}
+	if err != nil {
+		glog.Errorf("lookup %s%s version %s: %v", bucket, object, versionId, err)
+		return s3err.ErrInternalError
+	}
+
+	now := time.Now()
+	if !filer.IsObjectLocked(entry.Extended, now) {
+		return s3err.ErrNone
+	}
+	if filer.IsObjectLegalHold(entry.Extended) {
+		return s3err.ErrObjectLocked
+	}
+	if mode, _ := filer.ObjectRetainUntil(entry.Extended); mode != filer.ObjectLockGovernance || !bypassGovernance {
+		return s3err.ErrObjectLocked
+	}
+
+	glog.V(1).Infof("bypass governance retention to delete %s%s version %s", bucket, object, versionId)
+	delete(entry.Extended, xhttp.AmzObjectLockMode)
+	delete(entry.Extended, xhttp.AmzObjectLockRetainUntilDate)
+	if err := s3a.updateObjectLock(dir, entry, security.JwtOperationBypassGovernance); err != nil {
+		glog.Errorf("remove governance retention %s%s version %s: %v", bucket, object, versionId, err)
+		return s3err.ErrInternalError
+	}
+	return s3err.ErrNone
+}
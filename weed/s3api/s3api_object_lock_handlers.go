@@ -0,0 +1,321 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/security"
+)
+
+const ObjectLockEnabled = "Enabled"
+
+// ObjectLockConfiguration is the object lock configuration of a bucket
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_ObjectLockConfiguration.html
+type ObjectLockConfiguration struct {
+	XMLName           xml.Name        `xml:"ObjectLockConfiguration"`
+	Xmlns             string          `xml:"xmlns,attr,omitempty"`
+	ObjectLockEnabled string          `xml:"ObjectLockEnabled,omitempty"`
+	Rule              *ObjectLockRule `xml:"Rule,omitempty"`
+}
+
+type ObjectLockRule struct {
+	DefaultRetention *DefaultRetention `xml:"DefaultRetention"`
+}
+
+type DefaultRetention struct {
+	Mode  string `xml:"Mode"`
+	Days  int    `xml:"Days,omitempty"`
+	Years int    `xml:"Years,omitempty"`
+}
+
+// ObjectRetention https://docs.aws.amazon.com/AmazonS3/latest/API/API_ObjectLockRetention.html
+type ObjectRetention struct {
+	XMLName         xml.Name   `xml:"Retention"`
+	Xmlns           string     `xml:"xmlns,attr,omitempty"`
+	Mode            string     `xml:"Mode,omitempty"`
+	RetainUntilDate *time.Time `xml:"RetainUntilDate,omitempty"`
+}
+
+// ObjectLegalHold https://docs.aws.amazon.com/AmazonS3/latest/API/API_ObjectLockLegalHold.html
+type ObjectLegalHold struct {
+	XMLName xml.Name `xml:"LegalHold"`
+	Xmlns   string   `xml:"xmlns,attr,omitempty"`
+	Status  string   `xml:"Status"`
+}
+
+// GetObjectLockConfigurationHandler Get object Lock configuration
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectLockConfiguration.html
+func (s3a *S3ApiServer) GetObjectLockConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("GetObjectLockConfigurationHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	entry, err := s3a.getEntry(s3a.option.BucketsPath, bucket)
+	if err != nil {
+		glog.Errorf("GetObjectLockConfigurationHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	data, found := entry.Extended[xhttp.AmzBucketObjectLock]
+	if !found {
+		s3err.WriteErrorResponse(w, r, s3err.ErrObjectLockConfigurationNotFound)
+		return
+	}
+	config, err := parseObjectLockConfiguration(data)
+	if err != nil {
+		glog.Errorf("GetObjectLockConfigurationHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	config.Xmlns = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+	writeSuccessResponseXML(w, r, config)
+}
+
+// PutObjectLockConfigurationHandler Put object Lock configuration
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLockConfiguration.html
+func (s3a *S3ApiServer) PutObjectLockConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, _ := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("PutObjectLockConfigurationHandler %s", bucket)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, err)
+		return
+	}
+
+	input, err := io.ReadAll(io.LimitReader(r.Body, maxConfigurationSize))
+	if err != nil {
+		glog.Errorf("PutObjectLockConfigurationHandler read input %s: %v", r.URL, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	config, err := parseObjectLockConfiguration(input)
+	if err == nil {
+		err = config.validate()
+	}
+	if err != nil {
+		glog.V(1).Infof("PutObjectLockConfigurationHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrMalformedXML)
+		return
+	}
+
+	// object lock can only be enabled on versioned buckets, and stays enabled
+	if s3a.getBucketObjectLock(bucket) == nil {
+		versioning, err := s3a.getBucketVersioning(bucket)
+		if err != nil {
+			glog.Errorf("PutObjectLockConfigurationHandler %s: %v", bucket, err)
+			s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+			return
+		}
+		if versioning != VersioningEnabled {
+			s3err.WriteErrorResponse(w, r, s3err.ErrInvalidBucketState)
+			return
+		}
+	}
+
+	if err = s3a.setBucketObjectLock(bucket, config); err != nil {
+		glog.Errorf("PutObjectLockConfigurationHandler %s: %v", bucket, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	writeSuccessResponseEmpty(w, r)
+}
+
+// GetObjectRetentionHandler Get object Retention
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectRetention.html
+func (s3a *S3ApiServer) GetObjectRetentionHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, object := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("GetObjectRetentionHandler %s %s", bucket, object)
+
+	_, entry, errCode := s3a.getLockableEntry(bucket, object, r.URL.Query().Get("versionId"))
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	mode, retainUntil := filer.ObjectRetainUntil(entry.Extended)
+	if mode == "" {
+		s3err.WriteErrorResponse(w, r, s3err.ErrNoSuchObjectLockConfiguration)
+		return
+	}
+
+	writeSuccessResponseXML(w, r, &ObjectRetention{
+		Xmlns:           "http://s3.amazonaws.com/doc/2006-03-01/",
+		Mode:            mode,
+		RetainUntilDate: &retainUntil,
+	})
+}
+
+// PutObjectRetentionHandler Put object Retention
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectRetention.html
+func (s3a *S3ApiServer) PutObjectRetentionHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, object := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("PutObjectRetentionHandler %s %s", bucket, object)
+
+	if s3a.getBucketObjectLock(bucket) == nil {
+		s3err.WriteErrorResponse(w, r, s3err.ErrMissingObjectLockConfiguration)
+		return
+	}
+
+	input, err := io.ReadAll(io.LimitReader(r.Body, maxConfigurationSize))
+	if err != nil {
+		glog.Errorf("PutObjectRetentionHandler read input %s: %v", r.URL, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	retention := &ObjectRetention{}
+	if err = xml.Unmarshal(input, retention); err != nil {
+		glog.V(1).Infof("PutObjectRetentionHandler unmarshal %s%s: %v", bucket, object, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrMalformedXML)
+		return
+	}
+	if errCode := retention.validate(time.Now()); errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+
+	dir, entry, errCode := s3a.getLockableEntry(bucket, object, r.URL.Query().Get("versionId"))
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	bypassGovernance := s3a.isGovernanceBypassed(r)
+	if errCode = checkRetentionChange(entry.Extended, retention, bypassGovernance, time.Now()); errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+
+	if retention.Mode == "" {
+		delete(entry.Extended, xhttp.AmzObjectLockMode)
+		delete(entry.Extended, xhttp.AmzObjectLockRetainUntilDate)
+	} else {
+		entry.Extended[xhttp.AmzObjectLockMode] = []byte(retention.Mode)
+		entry.Extended[xhttp.AmzObjectLockRetainUntilDate] = []byte(retention.RetainUntilDate.UTC().Format(time.RFC3339))
+	}
+	releaseOperation := ""
+	if bypassGovernance {
+		releaseOperation = security.JwtOperationBypassGovernance
+	}
+	if err = s3a.updateObjectLock(dir, entry, releaseOperation); err != nil {
+		glog.Errorf("PutObjectRetentionHandler %s%s: %v", bucket, object, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	writeSuccessResponseEmpty(w, r)
+}
+
+// GetObjectLegalHoldHandler Get object Legal Hold
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectLegalHold.html
+func (s3a *S3ApiServer) GetObjectLegalHoldHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, object := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("GetObjectLegalHoldHandler %s %s", bucket, object)
+
+	_, entry, errCode := s3a.getLockableEntry(bucket, object, r.URL.Query().Get("versionId"))
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	status, found := entry.Extended[xhttp.AmzObjectLockLegalHold]
+	if !found {
+		s3err.WriteErrorResponse(w, r, s3err.ErrNoSuchObjectLockConfiguration)
+		return
+	}
+
+	writeSuccessResponseXML(w, r, &ObjectLegalHold{
+		Xmlns:  "http://s3.amazonaws.com/doc/2006-03-01/",
+		Status: string(status),
+	})
+}
+
+// PutObjectLegalHoldHandler Put object Legal Hold
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLegalHold.html
+func (s3a *S3ApiServer) PutObjectLegalHoldHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, object := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("PutObjectLegalHoldHandler %s %s", bucket, object)
+
+	if s3a.getBucketObjectLock(bucket) == nil {
+		s3err.WriteErrorResponse(w, r, s3err.ErrMissingObjectLockConfiguration)
+		return
+	}
+
+	input, err := io.ReadAll(io.LimitReader(r.Body, maxConfigurationSize))
+	if err != nil {
+		glog.Errorf("PutObjectLegalHoldHandler read input %s: %v", r.URL, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	legalHold := &ObjectLegalHold{}
+	if err = xml.Unmarshal(input, legalHold); err != nil || !isLegalHoldStatus(legalHold.Status) {
+		glog.V(1).Infof("PutObjectLegalHoldHandler %s%s: %v", bucket, object, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrMalformedXML)
+		return
+	}
+
+	dir, entry, errCode := s3a.getLockableEntry(bucket, object, r.URL.Query().Get("versionId"))
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	// the permission to put the legal hold is checked during authentication
+	releaseOperation := ""
+	if legalHold.Status == filer.LegalHoldOff && filer.IsObjectLegalHold(entry.Extended) {
+		releaseOperation = security.JwtOperationRemoveLegalHold
+	}
+	entry.Extended[xhttp.AmzObjectLockLegalHold] = []byte(legalHold.Status)
+	if err = s3a.updateObjectLock(dir, entry, releaseOperation); err != nil {
+		glog.Errorf("PutObjectLegalHoldHandler %s%s: %v", bucket, object, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+
+	writeSuccessResponseEmpty(w, r)
+}
+
+// getLockableEntry finds the object version to get or set its retention, legal hold and acl
+func (s3a *S3ApiServer) getLockableEntry(bucket, object, versionId string) (dir string, entry *filer_pb.Entry, code s3err.ErrorCode) {
+	var err error
+	if versionId == "" {
+		var name string
+		dir, name = s3a.objectDirAndName(bucket, object)
+		entry, err = s3a.getEntry(dir, name)
+	} else {
+		dir, entry, _, err = s3a.lookupVersion(bucket, object, versionId)
+	}
+	if err == filer_pb.ErrNotFound || err == nil && (entry == nil || entry.IsDirectory) {
+		if versionId != "" {
+			return "", nil, s3err.ErrNoSuchVersion
+		}
+		return "", nil, s3err.ErrNoSuchKey
+	}
+	if err != nil {
+		glog.Errorf("lookup %s%s version %q: %v", bucket, object, versionId, err)
+		return "", nil, s3err.ErrInternalError
+	}
+	if isDeleteMarker(entry) {
+		return "", nil, s3err.ErrMethodNotAllowed
+	}
+	if entry.Extended == nil {
+		entry.Extended = make(map[string][]byte)
+	}
+	return dir, entry, s3err.ErrNone
+}
+
+func parseObjectLockConfiguration(data []byte) (*ObjectLockConfiguration, error) {
+	config := &ObjectLockConfiguration{}
+	if err := xml.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
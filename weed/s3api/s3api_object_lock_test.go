@@ -0,0 +1,63 @@
+package s3api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+func TestObjectLockConfigurationValidate(t *testing.T) {
+	data := []byte(`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>3</Days></DefaultRetention></Rule></ObjectLockConfiguration>`)
+	config, err := parseObjectLockConfiguration(data)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if err = config.validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	if mode, retainUntil := config.defaultRetainUntil(now); mode != filer.ObjectLockGovernance || !retainUntil.Equal(now.AddDate(0, 0, 3)) {
+		t.Errorf("unexpected default retention %s %v", mode, retainUntil)
+	}
+
+	config.Rule.DefaultRetention.Years = 1
+	if config.validate() == nil {
+		t.Errorf("expect both Days and Years to be invalid")
+	}
+}
+
+func TestCheckRetentionChange(t *testing.T) {
+	now := time.Now()
+	retainUntil := now.Add(time.Hour)
+	shorter, longer := now.Add(time.Minute), now.Add(2*time.Hour)
+
+	locked := func(mode string) map[string][]byte {
+		return map[string][]byte{
+			xhttp.AmzObjectLockMode:            []byte(mode),
+			xhttp.AmzObjectLockRetainUntilDate: []byte(retainUntil.UTC().Format(time.RFC3339)),
+		}
+	}
+
+	tests := []struct {
+		extended  map[string][]byte
+		retention *ObjectRetention
+		bypass    bool
+		expected  s3err.ErrorCode
+	}{
+		{nil, &ObjectRetention{Mode: filer.ObjectLockCompliance, RetainUntilDate: &shorter}, false, s3err.ErrNone},
+		{locked(filer.ObjectLockCompliance), &ObjectRetention{Mode: filer.ObjectLockCompliance, RetainUntilDate: &longer}, false, s3err.ErrNone},
+		{locked(filer.ObjectLockCompliance), &ObjectRetention{Mode: filer.ObjectLockCompliance, RetainUntilDate: &shorter}, true, s3err.ErrObjectLocked},
+		{locked(filer.ObjectLockCompliance), &ObjectRetention{Mode: filer.ObjectLockGovernance, RetainUntilDate: &longer}, true, s3err.ErrObjectLocked},
+		{locked(filer.ObjectLockGovernance), &ObjectRetention{Mode: filer.ObjectLockCompliance, RetainUntilDate: &longer}, false, s3err.ErrNone},
+		{locked(filer.ObjectLockGovernance), &ObjectRetention{}, false, s3err.ErrObjectLocked},
+		{locked(filer.ObjectLockGovernance), &ObjectRetention{}, true, s3err.ErrNone},
+	}
+	for i, test := range tests {
+		if code := checkRetentionChange(test.extended, test.retention, test.bypass, now); code != test.expected {
+			t.Errorf("case %d: expected %v, got %v", i, test.expected, code)
+		}
+	}
+}
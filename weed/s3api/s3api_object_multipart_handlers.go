@@ -32,6 +32,20 @@ func (s3a *S3ApiServer) NewMultipartUploadHandler(w http.ResponseWriter, r *http
 		Metadata: make(map[string]*string),
 	}
 
+	if errCode := s3a.prepareObjectLock(r, bucket); errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	if errCode := s3a.prepareObjectAcl(r, bucket); errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	encryption, errCode := s3a.prepareServerSideEncryption(r, bucket)
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+
 	metadata := weed_server.SaveAmzMetaData(r, nil, false)
 	for k, v := range metadata {
 		createMultipartUploadInput.Metadata[k] = aws.String(string(v))
@@ -50,6 +64,7 @@ func (s3a *S3ApiServer) NewMultipartUploadHandler(w http.ResponseWriter, r *http
 		return
 	}
 
+	setServerSideEncryption(w, r, encryption)
 	writeSuccessResponseXML(w, r, response)
 
 }
@@ -74,6 +89,10 @@ func (s3a *S3ApiServer) CompleteMultipartUploadHandler(w http.ResponseWriter, r
 		return
 	}
 
+	if response.VersionId != nil {
+		setVersionId(w, *response.VersionId)
+	}
+
 	writeSuccessResponseXML(w, r, response)
 
 }
@@ -215,6 +234,13 @@ func (s3a *S3ApiServer) PutObjectPartHandler(w http.ResponseWriter, r *http.Requ
 	}
 	defer dataReader.Close()
 
+	// after the signature checks, which cover the customer key headers removed here
+	encryption, errCode := s3a.prepareUploadPartEncryption(r, bucket, uploadID)
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+
 	glog.V(2).Infof("PutObjectPartHandler %s %s %04d", bucket, uploadID, partID)
 
 	uploadUrl := fmt.Sprintf("http://%s%s/%s/%04d.part?collection=%s",
@@ -223,14 +249,21 @@ func (s3a *S3ApiServer) PutObjectPartHandler(w http.ResponseWriter, r *http.Requ
 	if partID == 1 && r.Header.Get("Content-Type") == "" {
 		dataReader = mimeDetect(r, dataReader)
 	}
+	body, err := encryption.encryptReader(dataReader)
+	if err != nil {
+		glog.Errorf("PutObjectPartHandler %s %s encrypt: %v", bucket, uploadID, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
 
-	etag, errCode := s3a.putToFiler(r, uploadUrl, dataReader)
+	etag, errCode := s3a.putToFiler(r, uploadUrl, body)
 	if errCode != s3err.ErrNone {
 		s3err.WriteErrorResponse(w, r, errCode)
 		return
 	}
 
 	setEtag(w, etag)
+	setServerSideEncryption(w, r, encryption)
 
 	writeSuccessResponseEmpty(w, r)
 
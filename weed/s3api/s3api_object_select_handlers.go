@@ -0,0 +1,380 @@
+package s3api
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	stdjson "encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/query/csv"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3select"
+	"github.com/chrislusf/seaweedfs/weed/util/chunk_cache"
+)
+
+// SelectObjectContentRequest https://docs.aws.amazon.com/AmazonS3/latest/API/API_SelectObjectContent.html
+type SelectObjectContentRequest struct {
+	XMLName             xml.Name                  `xml:"SelectObjectContentRequest"`
+	Expression          string                    `xml:"Expression"`
+	ExpressionType      string                    `xml:"ExpressionType"`
+	InputSerialization  SelectInputSerialization  `xml:"InputSerialization"`
+	OutputSerialization SelectOutputSerialization `xml:"OutputSerialization"`
+}
+
+type SelectInputSerialization struct {
+	CompressionType string          `xml:"CompressionType,omitempty"`
+	CSV             *SelectCsvInput `xml:"CSV,omitempty"`
+	JSON            *struct {
+		Type string `xml:"Type,omitempty"`
+	} `xml:"JSON,omitempty"`
+	Parquet *struct{} `xml:"Parquet,omitempty"`
+}
+
+type SelectCsvInput struct {
+	FileHeaderInfo             string `xml:"FileHeaderInfo,omitempty"`
+	Comments                   string `xml:"Comments,omitempty"`
+	QuoteEscapeCharacter       string `xml:"QuoteEscapeCharacter,omitempty"`
+	RecordDelimiter            string `xml:"RecordDelimiter,omitempty"`
+	FieldDelimiter             string `xml:"FieldDelimiter,omitempty"`
+	QuoteCharacter             string `xml:"QuoteCharacter,omitempty"`
+	AllowQuotedRecordDelimiter bool   `xml:"AllowQuotedRecordDelimiter,omitempty"`
+}
+
+type SelectOutputSerialization struct {
+	CSV *struct {
+		QuoteFields          string `xml:"QuoteFields,omitempty"`
+		QuoteEscapeCharacter string `xml:"QuoteEscapeCharacter,omitempty"`
+		RecordDelimiter      string `xml:"RecordDelimiter,omitempty"`
+		FieldDelimiter       string `xml:"FieldDelimiter,omitempty"`
+		QuoteCharacter       string `xml:"QuoteCharacter,omitempty"`
+	} `xml:"CSV,omitempty"`
+	JSON *struct {
+		RecordDelimiter string `xml:"RecordDelimiter,omitempty"`
+	} `xml:"JSON,omitempty"`
+}
+
+const selectBlockSize = 4 * 1024 * 1024
+const selectRecordsMessageSize = 64 * 1024
+
+// SelectObjectContentHandler filters the csv or json object with a sql expression
+func (s3a *S3ApiServer) SelectObjectContentHandler(w http.ResponseWriter, r *http.Request) {
+	bucket, object := xhttp.GetBucketAndObject(r)
+	glog.V(3).Infof("SelectObjectContentHandler %s %s", bucket, object)
+
+	input, err := io.ReadAll(io.LimitReader(r.Body, maxConfigurationSize))
+	if err != nil {
+		glog.Errorf("SelectObjectContentHandler read input %s: %v", r.URL, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+		return
+	}
+	request := &SelectObjectContentRequest{}
+	if err = xml.Unmarshal(input, request); err != nil {
+		s3err.WriteErrorResponse(w, r, s3err.ErrMalformedXML)
+		return
+	}
+	if errCode := validateSelectRequest(request); errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	query, err := s3select.Parse(request.Expression)
+	if err != nil {
+		glog.V(1).Infof("SelectObjectContentHandler parse %q: %v", request.Expression, err)
+		s3err.WriteErrorResponse(w, r, s3err.ErrInvalidSelectExpression)
+		return
+	}
+
+	_, entry, errCode := s3a.getLockableEntry(bucket, object, r.URL.Query().Get("versionId"))
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	customer, errCode := parseSseCustomerKey(r.Header, false)
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+	encryption, errCode := s3a.storedEncryption(customer, func(name string) string {
+		return string(entry.Extended[name])
+	})
+	if errCode != s3err.ErrNone {
+		s3err.WriteErrorResponse(w, r, errCode)
+		return
+	}
+
+	var writer s3select.RecordWriter
+	if output := request.OutputSerialization.CSV; output != nil {
+		writer = &s3select.CsvWriter{Output: csv.Output{
+			QuoteFields:          output.QuoteFields,
+			RecordDelimiter:      output.RecordDelimiter,
+			FieldDelimiter:       output.FieldDelimiter,
+			QuoteCharacter:       output.QuoteCharacter,
+			QuoteEscapeCharacter: output.QuoteEscapeCharacter,
+		}}
+	} else {
+		writer = &s3select.JsonWriter{RecordDelimiter: request.OutputSerialization.JSON.RecordDelimiter}
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.WriteHeader(http.StatusOK)
+	events := s3select.NewEventStreamWriter(w)
+	scanner := newSelectScanner(request, s3select.NewSelector(query, writer), events)
+
+	if err = s3a.selectObject(entry, encryption, request, query, scanner); err == nil {
+		err = scanner.finish()
+	}
+	if err != nil {
+		glog.Errorf("SelectObjectContentHandler %s %s: %v", bucket, object, err)
+		events.WriteError("InternalError", err.Error())
+		return
+	}
+
+	size := int64(filer.FileSize(entry))
+	if err = events.WriteStats(size, size, scanner.returned); err == nil {
+		err = events.WriteEnd()
+	}
+	if err != nil {
+		glog.V(1).Infof("SelectObjectContentHandler %s %s response: %v", bucket, object, err)
+	}
+}
+
+func validateSelectRequest(request *SelectObjectContentRequest) s3err.ErrorCode {
+	if !strings.EqualFold(request.ExpressionType, "SQL") {
+		return s3err.ErrInvalidExpressionType
+	}
+	in, out := request.InputSerialization, request.OutputSerialization
+	if in.Parquet != nil || in.CSV == nil && in.JSON == nil || in.CSV != nil && in.JSON != nil {
+		return s3err.ErrInvalidDataSource
+	}
+	if in.JSON != nil {
+		switch strings.ToUpper(in.JSON.Type) {
+		case "", "DOCUMENT", "LINES":
+		default:
+			return s3err.ErrInvalidRequest
+		}
+	}
+	switch strings.ToUpper(in.CompressionType) {
+	case "", "NONE", "GZIP", "BZIP2":
+	default:
+		return s3err.ErrInvalidCompressionFormat
+	}
+	if out.CSV == nil && out.JSON == nil || out.CSV != nil && out.JSON != nil {
+		return s3err.ErrInvalidRequest
+	}
+	return s3err.ErrNone
+}
+
+// selectObject feeds all records of the object to the scanner
+func (s3a *S3ApiServer) selectObject(entry *filer_pb.Entry, encryption *objectEncryption, request *SelectObjectContentRequest, query *s3select.Query, scanner *selectScanner) error {
+
+	// the volume servers can not filter the objects encrypted by the gateway
+	if encryption == nil {
+		if pushed, err := s3a.selectWithPushdown(entry, request, query, scanner); pushed || err != nil {
+			return err
+		}
+	}
+
+	var reader io.Reader
+	if len(entry.Content) > 0 {
+		reader = bytes.NewReader(entry.Content)
+	} else {
+		lookupFn := filer.LookupFn(s3a)
+		fileSize := int64(filer.FileSize(entry))
+		chunkViews := filer.ViewFromChunks(lookupFn, entry.Chunks, 0, fileSize)
+		var chunkCache *chunk_cache.TieredChunkCache
+		readerAt := filer.NewChunkReaderAtFromClient(lookupFn, chunkViews, chunkCache, fileSize)
+		defer readerAt.Close()
+		reader = io.NewSectionReader(readerAt, 0, fileSize)
+	}
+
+	reader, err := encryption.decryptReader(reader, 0)
+	if err != nil {
+		return err
+	}
+	switch strings.ToUpper(request.InputSerialization.CompressionType) {
+	case "GZIP":
+		if reader, err = gzip.NewReader(reader); err != nil {
+			return err
+		}
+	case "BZIP2":
+		reader = bzip2.NewReader(reader)
+	}
+
+	if !scanner.splittable {
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			return err
+		}
+		_, err = scanner.scan(data)
+		return err
+	}
+
+	// split the data into blocks of whole records
+	var remaining []byte
+	for {
+		block := make([]byte, len(remaining)+selectBlockSize)
+		copy(block, remaining)
+		n, readErr := io.ReadFull(reader, block[len(remaining):])
+		block = block[:len(remaining)+n]
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			_, err = scanner.scan(block)
+			return err
+		}
+		if readErr != nil {
+			return readErr
+		}
+		end := bytes.LastIndex(block, []byte(scanner.recordDelimiter))
+		if end < 0 {
+			remaining = block
+			continue
+		}
+		end += len(scanner.recordDelimiter)
+		if done, err := scanner.scan(block[:end]); done || err != nil {
+			return err
+		}
+		remaining = block[end:]
+	}
+}
+
+// selectScanner parses the blocks of the object into records, and sends the selected rows as events
+type selectScanner struct {
+	csvInput *csv.Input
+	// header is learned from the first block of a csv object
+	header        []string
+	headerPending bool
+	jsonLines     bool
+	// splittable input can be split into blocks at any record delimiter
+	splittable      bool
+	recordDelimiter string
+
+	selector *s3select.Selector
+	events   *s3select.EventStreamWriter
+	buf      []byte
+	returned int64
+}
+
+func newSelectScanner(request *SelectObjectContentRequest, selector *s3select.Selector, events *s3select.EventStreamWriter) *selectScanner {
+	s := &selectScanner{
+		selector: selector,
+		events:   events,
+	}
+	if in := request.InputSerialization.CSV; in != nil {
+		s.csvInput = &csv.Input{
+			FileHeaderInfo:             strings.ToUpper(in.FileHeaderInfo),
+			RecordDelimiter:            in.RecordDelimiter,
+			FieldDelimiter:             in.FieldDelimiter,
+			QuoteCharacter:             in.QuoteCharacter,
+			QuoteEscapeCharacter:       in.QuoteEscapeCharacter,
+			Comments:                   in.Comments,
+			AllowQuotedRecordDelimiter: in.AllowQuotedRecordDelimiter,
+		}
+		s.headerPending = s.csvInput.FileHeaderInfo == "USE" || s.csvInput.FileHeaderInfo == "IGNORE"
+		s.splittable = !in.AllowQuotedRecordDelimiter
+		s.recordDelimiter = in.RecordDelimiter
+	} else {
+		s.jsonLines = strings.EqualFold(request.InputSerialization.JSON.Type, "LINES")
+		s.splittable = s.jsonLines
+	}
+	if s.recordDelimiter == "" {
+		s.recordDelimiter = "\n"
+	}
+	return s
+}
+
+// scan selects the records in the block of whole records, and returns done=true if no more records are needed
+func (s *selectScanner) scan(data []byte) (done bool, err error) {
+	if s.csvInput == nil {
+		return s.scanJson(data)
+	}
+
+	input := *s.csvInput
+	if !s.headerPending {
+		input.FileHeaderInfo = "NONE"
+	}
+	reader := csv.NewReader(data, input)
+	for !s.selector.Done() {
+		fields, readErr := reader.Read()
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return true, readErr
+		}
+		if s.headerPending {
+			s.header, s.headerPending = reader.Header, false
+		}
+		if err = s.add(&s3select.CsvRecord{Header: s.header, Fields: fields}); err != nil {
+			return true, err
+		}
+	}
+	if s.headerPending && reader.HeaderDone() {
+		s.header, s.headerPending = reader.Header, false
+	}
+	return s.selector.Done(), nil
+}
+
+func (s *selectScanner) scanJson(data []byte) (done bool, err error) {
+	if s.jsonLines {
+		for len(data) > 0 && !s.selector.Done() {
+			line := data
+			if i := bytes.IndexByte(data, '\n'); i >= 0 {
+				line, data = data[:i], data[i+1:]
+			} else {
+				data = nil
+			}
+			if line = bytes.TrimSpace(line); len(line) == 0 {
+				continue
+			}
+			if err = s.add(&s3select.JsonRecord{Raw: string(line)}); err != nil {
+				return true, err
+			}
+		}
+		return s.selector.Done(), nil
+	}
+
+	decoder := stdjson.NewDecoder(bytes.NewReader(data))
+	for !s.selector.Done() {
+		var document stdjson.RawMessage
+		if err = decoder.Decode(&document); err == io.EOF {
+			break
+		}
+		if err != nil {
+			return true, fmt.Errorf("invalid json document: %v", err)
+		}
+		if err = s.add(&s3select.JsonRecord{Raw: string(document)}); err != nil {
+			return true, err
+		}
+	}
+	return s.selector.Done(), nil
+}
+
+func (s *selectScanner) add(record s3select.Record) error {
+	s.buf = s.selector.Select(s.buf, record)
+	if len(s.buf) < selectRecordsMessageSize {
+		return nil
+	}
+	return s.flush()
+}
+
+func (s *selectScanner) flush() error {
+	if len(s.buf) == 0 {
+		return nil
+	}
+	s.returned += int64(len(s.buf))
+	err := s.events.WriteRecords(s.buf)
+	s.buf = s.buf[:0]
+	return err
+}
+
+func (s *selectScanner) finish() error {
+	s.buf = s.selector.Finish(s.buf)
+	return s.flush()
+}
@@ -0,0 +1,294 @@
+package s3api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3select"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/tidwall/gjson"
+)
+
+// selectWithPushdown sends one filter of the query to the volume servers holding the chunks,
+// so that only the matching records of each chunk come back to the gateway.
+// The returned records are still evaluated by the full query.
+//
+// This only works if every chunk consists of whole records. It returns pushed=false if the object can not be pushed down.
+func (s3a *S3ApiServer) selectWithPushdown(entry *filer_pb.Entry, request *SelectObjectContentRequest, query *s3select.Query, scanner *selectScanner) (pushed bool, err error) {
+
+	compression := strings.ToUpper(request.InputSerialization.CompressionType)
+	if compression != "" && compression != "NONE" || !scanner.splittable || len(entry.Content) > 0 || len(entry.Chunks) == 0 {
+		return false, nil
+	}
+	filters := query.Filters()
+	if len(filters) == 0 {
+		return false, nil
+	}
+
+	lookupFn := filer.LookupFn(s3a)
+	chunks, _, err := filer.ResolveChunkManifest(lookupFn, entry.Chunks, 0, math.MaxInt64)
+	if err != nil {
+		return false, err
+	}
+	if !isContiguous(chunks) {
+		return false, nil
+	}
+	for _, chunk := range chunks[:len(chunks)-1] {
+		if endsWithDelimiter, err := chunkEndsWith(lookupFn, chunk, scanner.recordDelimiter); err != nil || !endsWithDelimiter {
+			glog.V(4).Infof("select without pushdown, chunk %s does not end with a whole record: %v", chunk.GetFileIdString(), err)
+			return false, nil
+		}
+	}
+
+	for i, chunk := range chunks {
+		var filter *volume_server_pb.QueryRequest_Filter
+		if !scanner.headerPending {
+			filter = pushdownFilter(filters, scanner)
+		}
+		if filter == nil {
+			glog.V(4).Infof("select %s locally", chunk.GetFileIdString())
+			data, err := readSelectChunk(lookupFn, chunk)
+			if err != nil {
+				return true, err
+			}
+			if done, err := scanner.scan(data); done || err != nil {
+				return true, err
+			}
+			continue
+		}
+
+		glog.V(4).Infof("select %s with filter %+v on volume server", chunk.GetFileIdString(), filter)
+		records, err := s3a.queryVolume(chunk.GetFileIdString(), request, filter)
+		if err != nil {
+			return true, fmt.Errorf("query chunk %d %s: %v", i, chunk.GetFileIdString(), err)
+		}
+		if done, err := scanner.scanQueried(records); done || err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+func isContiguous(chunks []*filer_pb.FileChunk) bool {
+	sort.Slice(chunks, func(i, j int) bool {
+		return chunks[i].Offset < chunks[j].Offset
+	})
+	var offset int64
+	for _, chunk := range chunks {
+		if chunk.Offset != offset || len(chunk.CipherKey) > 0 {
+			return false
+		}
+		offset += int64(chunk.Size)
+	}
+	return true
+}
+
+func chunkEndsWith(lookupFn func(fileId string) ([]string, error), chunk *filer_pb.FileChunk, suffix string) (bool, error) {
+	if chunk.Size < uint64(len(suffix)) {
+		return false, nil
+	}
+	urls, err := lookupFn(chunk.GetFileIdString())
+	if err != nil {
+		return false, err
+	}
+	buf := make([]byte, len(suffix))
+	for _, url := range urls {
+		n, readErr := util.ReadUrl(url, nil, chunk.IsCompressed, false, int64(chunk.Size)-int64(len(suffix)), len(suffix), buf)
+		if readErr != nil {
+			err = readErr
+			continue
+		}
+		return string(buf[:n]) == suffix, nil
+	}
+	return false, err
+}
+
+func readSelectChunk(lookupFn func(fileId string) ([]string, error), chunk *filer_pb.FileChunk) ([]byte, error) {
+	urls, err := lookupFn(chunk.GetFileIdString())
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, chunk.Size)
+	for _, url := range urls {
+		n, readErr := util.ReadUrl(url, nil, chunk.IsCompressed, true, 0, int(chunk.Size), buf)
+		if readErr != nil {
+			err = readErr
+			continue
+		}
+		return buf[:n], nil
+	}
+	return nil, err
+}
+
+var simpleJsonPath = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$|^[0-9]+$`)
+
+// pushdownFilter picks a filter that the volume server evaluates to a superset of the query result.
+// The volume server compares csv fields as numbers when both sides look like numbers,
+// so string literals that look like numbers are not pushed down. For json, only numbers are compared the same way.
+func pushdownFilter(filters []s3select.Filter, scanner *selectScanner) *volume_server_pb.QueryRequest_Filter {
+	for _, f := range filters {
+		if f.Value.Type != s3select.Number && f.Value.Type != s3select.String {
+			continue
+		}
+		if scanner.csvInput != nil {
+			if len(f.Path) != 1 {
+				continue
+			}
+			if f.Value.Type == s3select.String {
+				if _, err := strconv.ParseFloat(strings.TrimSpace(f.Value.Str), 64); err == nil {
+					continue
+				}
+			}
+			// the chunks are queried without the header, so the fields are referenced by position
+			position := csvFieldPosition(scanner.header, f.Path[0])
+			if position == 0 {
+				continue
+			}
+			return &volume_server_pb.QueryRequest_Filter{Field: "_" + strconv.Itoa(position), Operand: f.Op, Value: f.Value.String()}
+		}
+
+		if f.Value.Type != s3select.Number {
+			continue
+		}
+		simple := true
+		for _, p := range f.Path {
+			simple = simple && simpleJsonPath.MatchString(p)
+		}
+		if simple {
+			return &volume_server_pb.QueryRequest_Filter{Field: strings.Join(f.Path, "."), Operand: f.Op, Value: f.Value.String()}
+		}
+	}
+	return nil
+}
+
+// csvFieldPosition returns the 1-based position of the named field, the same way as s3select.CsvRecord, or 0 if not found
+func csvFieldPosition(header []string, name string) int {
+	for i, h := range header {
+		if h == name {
+			return i + 1
+		}
+	}
+	for i, h := range header {
+		if strings.EqualFold(h, name) {
+			return i + 1
+		}
+	}
+	if strings.HasPrefix(name, "_") {
+		if i, err := strconv.Atoi(name[1:]); err == nil && i > 0 {
+			return i
+		}
+	}
+	return 0
+}
+
+// queryVolume runs the filter on one of the volume servers holding the chunk, and returns the json lines of the matching records
+func (s3a *S3ApiServer) queryVolume(fileId string, request *SelectObjectContentRequest, filter *volume_server_pb.QueryRequest_Filter) (records []byte, err error) {
+
+	var locations []*filer_pb.Location
+	err = s3a.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		vid := filer.VolumeId(fileId)
+		resp, err := client.LookupVolume(context.Background(), &filer_pb.LookupVolumeRequest{
+			VolumeIds: []string{vid},
+		})
+		if err != nil {
+			return err
+		}
+		if resp.LocationsMap[vid] == nil || len(resp.LocationsMap[vid].Locations) == 0 {
+			return fmt.Errorf("failed to locate %s", fileId)
+		}
+		locations = resp.LocationsMap[vid].Locations
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	queryRequest := &volume_server_pb.QueryRequest{
+		FromFileIds: []string{fileId},
+		Filter:      filter,
+		InputSerialization: &volume_server_pb.QueryRequest_InputSerialization{
+			CompressionType: "NONE",
+		},
+		OutputSerialization: &volume_server_pb.QueryRequest_OutputSerialization{
+			JsonOutput: &volume_server_pb.QueryRequest_OutputSerialization_JSONOutput{RecordDelimiter: "\n"},
+		},
+	}
+	if in := request.InputSerialization.CSV; in != nil {
+		queryRequest.InputSerialization.CsvInput = &volume_server_pb.QueryRequest_InputSerialization_CSVInput{
+			FileHeaderInfo:             "NONE",
+			RecordDelimiter:            in.RecordDelimiter,
+			FieldDelimiter:             in.FieldDelimiter,
+			QuoteCharactoer:            in.QuoteCharacter,
+			QuoteEscapeCharacter:       in.QuoteEscapeCharacter,
+			Comments:                   in.Comments,
+			AllowQuotedRecordDelimiter: in.AllowQuotedRecordDelimiter,
+		}
+	} else {
+		queryRequest.InputSerialization.JsonInput = &volume_server_pb.QueryRequest_InputSerialization_JSONInput{Type: "LINES"}
+	}
+
+	for _, loc := range locations {
+		records = records[:0]
+		err = operation.WithVolumeServerClient(true, pb.NewServerAddressWithGrpcPort(loc.Url, int(loc.GrpcPort)), s3a.option.GrpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			stream, err := client.Query(context.Background(), queryRequest)
+			if err != nil {
+				return err
+			}
+			for {
+				stripe, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				records = append(records, stripe.Records...)
+			}
+		})
+		if err == nil {
+			return records, nil
+		}
+		glog.V(1).Infof("query %s on %s: %v", fileId, loc.Url, err)
+	}
+	return nil, err
+}
+
+// scanQueried selects the json lines returned by the volume server
+func (s *selectScanner) scanQueried(records []byte) (done bool, err error) {
+	if s.csvInput == nil {
+		return s.scanJson(records)
+	}
+	for len(records) > 0 && !s.selector.Done() {
+		line := records
+		if i := bytes.IndexByte(records, '\n'); i >= 0 {
+			line, records = records[:i], records[i+1:]
+		} else {
+			records = nil
+		}
+		if len(line) == 0 {
+			continue
+		}
+		var fields []string
+		gjson.ParseBytes(line).ForEach(func(key, value gjson.Result) bool {
+			fields = append(fields, value.String())
+			return true
+		})
+		if err = s.add(&s3select.CsvRecord{Header: s.header, Fields: fields}); err != nil {
+			return true, err
+		}
+	}
+	return s.selector.Done(), nil
+}
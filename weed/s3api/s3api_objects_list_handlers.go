@@ -127,18 +127,19 @@ func (s3a *S3ApiServer) ListObjectsV1Handler(w http.ResponseWriter, r *http.Requ
 }
 
 func (s3a *S3ApiServer) listFilerEntries(bucket string, originalPrefix string, maxKeys int, marker string, delimiter string) (response ListBucketResult, err error) {
-	// convert full path prefix into directory name and prefix for entry name
-	reqDir, prefix := filepath.Split(originalPrefix)
-	if strings.HasPrefix(reqDir, "/") {
-		reqDir = reqDir[1:]
-	}
-	bucketPrefix := fmt.Sprintf("%s/%s/", s3a.option.BucketsPath, bucket)
-	reqDir = fmt.Sprintf("%s%s", bucketPrefix, reqDir)
-	if strings.HasSuffix(reqDir, "/") {
-		// remove trailing "/"
-		reqDir = reqDir[:len(reqDir)-1]
+	// the reserved folders are not listed as objects
+	if strings.Contains(strings.TrimPrefix(originalPrefix, "/"), "/") && isReservedObject(originalPrefix) {
+		return ListBucketResult{
+			Name:      bucket,
+			Prefix:    originalPrefix,
+			Marker:    marker,
+			MaxKeys:   maxKeys,
+			Delimiter: delimiter,
+		}, nil
 	}
 
+	reqDir, prefix, bucketPrefix := s3a.toListingDirAndPrefix(bucket, originalPrefix)
+
 	var contents []ListEntry
 	var commonPrefixes []PrefixEntry
 	var isTruncated bool
@@ -199,6 +200,21 @@ func (s3a *S3ApiServer) listFilerEntries(bucket string, originalPrefix string, m
 	return
 }
 
+func (s3a *S3ApiServer) toListingDirAndPrefix(bucket, originalPrefix string) (reqDir, prefix, bucketPrefix string) {
+	// convert full path prefix into directory name and prefix for entry name
+	reqDir, prefix = filepath.Split(originalPrefix)
+	if strings.HasPrefix(reqDir, "/") {
+		reqDir = reqDir[1:]
+	}
+	bucketPrefix = fmt.Sprintf("%s/%s/", s3a.option.BucketsPath, bucket)
+	reqDir = fmt.Sprintf("%s%s", bucketPrefix, reqDir)
+	if strings.HasSuffix(reqDir, "/") {
+		// remove trailing "/"
+		reqDir = reqDir[:len(reqDir)-1]
+	}
+	return
+}
+
 func (s3a *S3ApiServer) doListFilerEntries(client filer_pb.SeaweedFilerClient, dir, prefix string, maxKeys int, marker, delimiter string, eachEntryFn func(dir string, entry *filer_pb.Entry)) (counter int, isTruncated bool, nextMarker string, err error) {
 	// invariants
 	//   prefix and marker should be under dir, marker may contain "/"
@@ -266,7 +282,7 @@ func (s3a *S3ApiServer) doListFilerEntries(client filer_pb.SeaweedFilerClient, d
 		nextMarker = entry.Name
 		if entry.IsDirectory {
 			// println("ListEntries", dir, "dir:", entry.Name)
-			if entry.Name != ".uploads" { // FIXME no need to apply to all directories. this extra also affects maxKeys
+			if entry.Name != ".uploads" && entry.Name != versionsFolder && entry.Name != lifecycleFolder { // FIXME no need to apply to all directories. this extra also affects maxKeys
 				if delimiter != "/" {
 					eachEntryFn(dir, entry)
 					// println("doListFilerEntries2 dir", dir+"/"+entry.Name, "maxKeys", maxKeys-counter)
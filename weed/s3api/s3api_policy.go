@@ -29,6 +29,9 @@ type Rule struct {
 	Prefix     Prefix     `xml:"Prefix,omitempty"`
 	Expiration Expiration `xml:"Expiration,omitempty"`
 	Transition Transition `xml:"Transition,omitempty"`
+
+	NoncurrentVersionExpiration    NoncurrentVersionExpiration    `xml:"NoncurrentVersionExpiration,omitempty"`
+	AbortIncompleteMultipartUpload AbortIncompleteMultipartUpload `xml:"AbortIncompleteMultipartUpload,omitempty"`
 }
 
 // Filter - a filter for a lifecycle configuration Rule.
@@ -59,17 +62,61 @@ func (p Prefix) MarshalXML(e *xml.Encoder, startElement xml.StartElement) error
 	return e.EncodeElement(p.string, startElement)
 }
 
+// UnmarshalXML decodes Prefix field from an XML form.
+func (p *Prefix) UnmarshalXML(d *xml.Decoder, startElement xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &startElement); err != nil {
+		return err
+	}
+	p.string, p.set = s, true
+	return nil
+}
+
 // MarshalXML encodes Filter field into an XML form.
 func (f Filter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !f.set {
+		return nil
+	}
 	if err := e.EncodeToken(start); err != nil {
 		return err
 	}
 	if err := e.EncodeElement(f.Prefix, xml.StartElement{Name: xml.Name{Local: "Prefix"}}); err != nil {
 		return err
 	}
+	if f.tagSet {
+		if err := e.EncodeElement(f.Tag, xml.StartElement{Name: xml.Name{Local: "Tag"}}); err != nil {
+			return err
+		}
+	}
+	if f.andSet {
+		if err := e.EncodeElement(f.And, xml.StartElement{Name: xml.Name{Local: "And"}}); err != nil {
+			return err
+		}
+	}
 	return e.EncodeToken(xml.EndElement{Name: start.Name})
 }
 
+// UnmarshalXML decodes Filter field from an XML form.
+func (f *Filter) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var filter struct {
+		Prefix Prefix `xml:"Prefix"`
+		Tag    *Tag   `xml:"Tag"`
+		And    *And   `xml:"And"`
+	}
+	if err := d.DecodeElement(&filter, &start); err != nil {
+		return err
+	}
+	f.set = true
+	f.Prefix = filter.Prefix
+	if filter.Tag != nil {
+		f.Tag, f.tagSet = *filter.Tag, true
+	}
+	if filter.And != nil {
+		f.And, f.andSet = *filter.And, true
+	}
+	return nil
+}
+
 // And - a tag to combine a prefix and multiple tags for lifecycle configuration rule.
 type And struct {
 	XMLName xml.Name `xml:"And"`
@@ -96,6 +143,18 @@ func (e Expiration) MarshalXML(enc *xml.Encoder, startElement xml.StartElement)
 	return enc.EncodeElement(expirationWrapper(e), startElement)
 }
 
+// UnmarshalXML decodes expiration field from an XML form.
+func (e *Expiration) UnmarshalXML(d *xml.Decoder, startElement xml.StartElement) error {
+	type expirationWrapper Expiration
+	var exp expirationWrapper
+	if err := d.DecodeElement(&exp, &startElement); err != nil {
+		return err
+	}
+	*e = Expiration(exp)
+	e.set = true
+	return nil
+}
+
 // ExpireDeleteMarker represents value of ExpiredObjectDeleteMarker field in Expiration XML element.
 type ExpireDeleteMarker struct {
 	val bool
@@ -110,6 +169,16 @@ func (b ExpireDeleteMarker) MarshalXML(e *xml.Encoder, startElement xml.StartEle
 	return e.EncodeElement(b.val, startElement)
 }
 
+// UnmarshalXML decodes delete marker boolean from an XML form.
+func (b *ExpireDeleteMarker) UnmarshalXML(d *xml.Decoder, startElement xml.StartElement) error {
+	var val bool
+	if err := d.DecodeElement(&val, &startElement); err != nil {
+		return err
+	}
+	b.val, b.set = val, true
+	return nil
+}
+
 // ExpirationDate is a embedded type containing time.Time to unmarshal
 // Date in Expiration
 type ExpirationDate struct {
@@ -125,12 +194,26 @@ func (eDate ExpirationDate) MarshalXML(e *xml.Encoder, startElement xml.StartEle
 	return e.EncodeElement(eDate.Format(time.RFC3339), startElement)
 }
 
+// UnmarshalXML decodes expiration date in RFC3339 format
+func (eDate *ExpirationDate) UnmarshalXML(d *xml.Decoder, startElement xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &startElement); err != nil {
+		return err
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return err
+	}
+	eDate.Time = t.UTC()
+	return nil
+}
+
 // Transition - transition actions for a rule in lifecycle configuration.
 type Transition struct {
-	XMLName      xml.Name  `xml:"Transition"`
-	Days         int       `xml:"Days,omitempty"`
-	Date         time.Time `xml:"Date,omitempty"`
-	StorageClass string    `xml:"StorageClass,omitempty"`
+	XMLName      xml.Name       `xml:"Transition"`
+	Days         int            `xml:"Days,omitempty"`
+	Date         ExpirationDate `xml:"Date,omitempty"`
+	StorageClass string         `xml:"StorageClass,omitempty"`
 
 	set bool
 }
@@ -144,5 +227,75 @@ func (t Transition) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
 	return enc.EncodeElement(transitionWrapper(t), start)
 }
 
+// UnmarshalXML decodes transition field from an XML form.
+func (t *Transition) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type transitionWrapper Transition
+	var tr transitionWrapper
+	if err := d.DecodeElement(&tr, &start); err != nil {
+		return err
+	}
+	*t = Transition(tr)
+	t.set = true
+	return nil
+}
+
+// NoncurrentVersionExpiration - expiration of the noncurrent versions in a versioned bucket.
+type NoncurrentVersionExpiration struct {
+	XMLName        xml.Name `xml:"NoncurrentVersionExpiration"`
+	NoncurrentDays int      `xml:"NoncurrentDays,omitempty"`
+
+	set bool
+}
+
+// MarshalXML encodes noncurrent version expiration field into an XML form.
+func (n NoncurrentVersionExpiration) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	if !n.set {
+		return nil
+	}
+	type noncurrentVersionExpirationWrapper NoncurrentVersionExpiration
+	return enc.EncodeElement(noncurrentVersionExpirationWrapper(n), start)
+}
+
+// UnmarshalXML decodes noncurrent version expiration field from an XML form.
+func (n *NoncurrentVersionExpiration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type noncurrentVersionExpirationWrapper NoncurrentVersionExpiration
+	var nve noncurrentVersionExpirationWrapper
+	if err := d.DecodeElement(&nve, &start); err != nil {
+		return err
+	}
+	*n = NoncurrentVersionExpiration(nve)
+	n.set = true
+	return nil
+}
+
+// AbortIncompleteMultipartUpload - removal of the multipart uploads not completed in time.
+type AbortIncompleteMultipartUpload struct {
+	XMLName             xml.Name `xml:"AbortIncompleteMultipartUpload"`
+	DaysAfterInitiation int      `xml:"DaysAfterInitiation,omitempty"`
+
+	set bool
+}
+
+// MarshalXML encodes abort incomplete multipart upload field into an XML form.
+func (a AbortIncompleteMultipartUpload) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	if !a.set {
+		return nil
+	}
+	type abortIncompleteMultipartUploadWrapper AbortIncompleteMultipartUpload
+	return enc.EncodeElement(abortIncompleteMultipartUploadWrapper(a), start)
+}
+
+// UnmarshalXML decodes abort incomplete multipart upload field from an XML form.
+func (a *AbortIncompleteMultipartUpload) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type abortIncompleteMultipartUploadWrapper AbortIncompleteMultipartUpload
+	var aimu abortIncompleteMultipartUploadWrapper
+	if err := d.DecodeElement(&aimu, &start); err != nil {
+		return err
+	}
+	*a = AbortIncompleteMultipartUpload(aimu)
+	a.set = true
+	return nil
+}
+
 // TransitionDays is a type alias to unmarshal Days in Transition
 type TransitionDays int
@@ -0,0 +1,541 @@
+package s3api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/security"
+)
+
+const (
+	rateLimitSplitByIdentity = "identity"
+	rateLimitSplitByBucket   = "bucket"
+	rateLimitSplitByAction   = "action"
+
+	rateLimitReasonRate        = "rate"
+	rateLimitReasonConcurrency = "concurrency"
+
+	// rateLimitGlobalRule is the metric label of the gateway wide concurrency cap
+	rateLimitGlobalRule = "global"
+	// rateLimitIpRule is the metric label of the per client ip request rate
+	rateLimitIpRule = "ip"
+
+	// maxRateLimitStates bounds the number of tracked identity/bucket/action keys
+	maxRateLimitStates = 100000
+	// maxRateLimitIpStates bounds the number of tracked client ips, separately,
+	// so that a flood from many ips does not block the identities, buckets and actions
+	maxRateLimitIpStates = 100000
+)
+
+// RateLimitConfiguration is the content of /etc/iam/rate_limit.json, or of the -rateLimitConfig file
+//
+//	{
+//	  "maxConcurrentRequests": 1000,
+//	  "perIpRequestsPerSecond": 200,
+//	  "rules": [
+//	    {
+//	      "name": "per_user_writes",
+//	      "actions": ["Write"],
+//	      "splitBy": ["identity"],
+//	      "requestsPerSecond": 100,
+//	      "bytesPerSecond": 104857600
+//	    },
+//	    {
+//	      "name": "public_bucket",
+//	      "buckets": ["public-*"],
+//	      "maxConcurrentRequests": 50
+//	    }
+//	  ]
+//	}
+type RateLimitConfiguration struct {
+	// MaxConcurrentRequests caps the requests being processed by the gateway, 0 for no limit
+	MaxConcurrentRequests int64 `json:"maxConcurrentRequests,omitempty"`
+	// PerIpRequestsPerSecond limits the requests of each client ip, checked before the authentication
+	// so that the unauthenticated and badly signed requests are also throttled, 0 for no limit.
+	// The client ip is the remote address, or taken from the X-Forwarded-For header of the requests
+	// from the guard.s3.trusted_proxies in security.toml.
+	PerIpRequestsPerSecond float64          `json:"perIpRequestsPerSecond,omitempty"`
+	PerIpBurst             int64            `json:"perIpBurst,omitempty"`
+	Rules                  []*RateLimitRule `json:"rules,omitempty"`
+}
+
+// RateLimitRule limits the requests matching all of its identities, buckets and actions.
+// An empty list matches everything, and a trailing "*" matches by prefix.
+// Without splitBy, all matching requests share one limit; otherwise each distinct
+// identity, bucket and/or action gets its own.
+type RateLimitRule struct {
+	Name                  string   `json:"name"`
+	Identities            []string `json:"identities,omitempty"`
+	Buckets               []string `json:"buckets,omitempty"`
+	Actions               []string `json:"actions,omitempty"`
+	SplitBy               []string `json:"splitBy,omitempty"`
+	RequestsPerSecond     float64  `json:"requestsPerSecond,omitempty"`
+	Burst                 int64    `json:"burst,omitempty"`
+	BytesPerSecond        int64    `json:"bytesPerSecond,omitempty"`
+	MaxConcurrentRequests int64    `json:"maxConcurrentRequests,omitempty"`
+}
+
+func (rule *RateLimitRule) matches(identity, bucket string, action Action) bool {
+	return matchRateLimitPatterns(rule.Identities, identity) &&
+		matchRateLimitPatterns(rule.Buckets, bucket) &&
+		matchRateLimitPatterns(rule.Actions, string(action))
+}
+
+func matchRateLimitPatterns(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if strings.HasSuffix(pattern, "*") {
+			if strings.HasPrefix(value, pattern[:len(pattern)-1]) {
+				return true
+			}
+		} else if pattern == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *RateLimitRule) stateKey(identity, bucket string, action Action) string {
+	key := rule.Name
+	for _, dimension := range rule.SplitBy {
+		switch dimension {
+		case rateLimitSplitByIdentity:
+			key += "|" + identity
+		case rateLimitSplitByBucket:
+			key += "|" + bucket
+		case rateLimitSplitByAction:
+			key += "|" + string(action)
+		}
+	}
+	return key
+}
+
+func (config *RateLimitConfiguration) validate() error {
+	if config.PerIpRequestsPerSecond < 0 || config.PerIpBurst < 0 {
+		return fmt.Errorf("negative per ip rate limit")
+	}
+	names := make(map[string]bool)
+	for _, rule := range config.Rules {
+		if rule.Name == "" {
+			return fmt.Errorf("rate limit rule without name")
+		}
+		if names[rule.Name] {
+			return fmt.Errorf("duplicated rate limit rule %s", rule.Name)
+		}
+		names[rule.Name] = true
+		if rule.RequestsPerSecond < 0 || rule.Burst < 0 || rule.BytesPerSecond < 0 || rule.MaxConcurrentRequests < 0 {
+			return fmt.Errorf("rate limit rule %s: negative limit", rule.Name)
+		}
+		for _, dimension := range rule.SplitBy {
+			switch dimension {
+			case rateLimitSplitByIdentity, rateLimitSplitByBucket, rateLimitSplitByAction:
+			default:
+				return fmt.Errorf("rate limit rule %s: unknown splitBy %s", rule.Name, dimension)
+			}
+		}
+	}
+	return nil
+}
+
+// tokenBucket refills at rate tokens per second up to burst tokens
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst float64, now time.Time) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   now,
+	}
+}
+
+func (tb *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(tb.last).Seconds(); elapsed > 0 {
+		tb.tokens += elapsed * tb.rate
+		if tb.tokens > tb.burst {
+			tb.tokens = tb.burst
+		}
+		tb.last = now
+	}
+}
+
+// available checks whether n tokens can be taken
+func (tb *tokenBucket) available(n float64, now time.Time) bool {
+	tb.refill(now)
+	return tb.tokens >= n
+}
+
+// isFull checks whether the bucket has refilled, so that dropping it loses no state
+func (tb *tokenBucket) isFull(now time.Time) bool {
+	tb.refill(now)
+	return tb.tokens >= tb.burst
+}
+
+// take consumes n tokens if all of them are available
+func (tb *tokenBucket) take(n float64, now time.Time) bool {
+	if !tb.available(n, now) {
+		return false
+	}
+	tb.tokens -= n
+	return true
+}
+
+// reserve consumes n tokens, going into debt if needed, and returns how long to wait for the debt to clear
+func (tb *tokenBucket) reserve(n float64, now time.Time) time.Duration {
+	tb.refill(now)
+	tb.tokens -= n
+	if tb.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-tb.tokens / tb.rate * float64(time.Second))
+}
+
+type rateLimitState struct {
+	rule     *RateLimitRule
+	requests *tokenBucket
+	bytes    *tokenBucket
+	inFlight int64
+}
+
+// isIdle checks whether the state would be recreated the same, with no requests in flight and the buckets refilled
+func (state *rateLimitState) isIdle(now time.Time) bool {
+	return state.inFlight == 0 &&
+		(state.requests == nil || state.requests.isFull(now)) &&
+		(state.bytes == nil || state.bytes.isFull(now))
+}
+
+// rateLimitStates are the states by key, up to max keys
+type rateLimitStates struct {
+	states       map[string]*rateLimitState
+	max          int
+	nextEviction time.Time
+}
+
+func newRateLimitStates(max int) *rateLimitStates {
+	return &rateLimitStates{
+		states: make(map[string]*rateLimitState),
+		max:    max,
+	}
+}
+
+type RateLimiter struct {
+	sync.Mutex
+	config     *RateLimitConfiguration
+	ipRule     *RateLimitRule // nil without the per ip limit
+	ruleStates *rateLimitStates
+	ipStates   *rateLimitStates
+	inFlight   int64
+	now        func() time.Time
+	// guard finds the client ip behind the trusted proxies
+	guard *security.Guard
+}
+
+func NewRateLimiter(guard *security.Guard) *RateLimiter {
+	return &RateLimiter{
+		config:     &RateLimitConfiguration{},
+		ruleStates: newRateLimitStates(maxRateLimitStates),
+		ipStates:   newRateLimitStates(maxRateLimitIpStates),
+		now:        time.Now,
+		guard:      guard,
+	}
+}
+
+func (rl *RateLimiter) loadConfigurationFromBytes(content []byte) error {
+	config := &RateLimitConfiguration{}
+	if len(content) > 0 {
+		if err := json.Unmarshal(content, config); err != nil {
+			return fmt.Errorf("unmarshal rate limit config: %v", err)
+		}
+	}
+	if err := config.validate(); err != nil {
+		return err
+	}
+	var ipRule *RateLimitRule
+	if config.PerIpRequestsPerSecond > 0 {
+		ipRule = &RateLimitRule{
+			Name:              rateLimitIpRule,
+			RequestsPerSecond: config.PerIpRequestsPerSecond,
+			Burst:             config.PerIpBurst,
+		}
+	}
+	rl.Lock()
+	// counters of the requests in flight are kept by the requests themselves, so the states can be dropped
+	rl.config = config
+	rl.ipRule = ipRule
+	rl.ruleStates = newRateLimitStates(rl.ruleStates.max)
+	rl.ipStates = newRateLimitStates(rl.ipStates.max)
+	rl.Unlock()
+	return nil
+}
+
+func (rl *RateLimiter) loadConfigurationFromFile(fileName string) error {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return fmt.Errorf("fail to read %s : %v", fileName, err)
+	}
+	return rl.loadConfigurationFromBytes(content)
+}
+
+func (rl *RateLimiter) loadConfigurationFromFiler(option *S3ApiServerOption) (err error) {
+	var content []byte
+	err = pb.WithFilerClient(false, option.Filer, option.GrpcDialOption, func(client filer_pb.SeaweedFilerClient) error {
+		content, err = filer.ReadInsideFiler(client, filer.IamConfigDirecotry, filer.IamRateLimitFile)
+		return err
+	})
+	if err == filer_pb.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read rate limit config: %v", err)
+	}
+	return rl.loadConfigurationFromBytes(content)
+}
+
+// getState returns the state of the key, or nil if too many keys are tracked already.
+// Only the idle states are evicted, so a client can not regain the full burst by pausing briefly.
+func (s *rateLimitStates) getState(rule *RateLimitRule, key string, now time.Time) *rateLimitState {
+	if state, found := s.states[key]; found {
+		return state
+	}
+	if len(s.states) >= s.max {
+		s.evictIdleStates(now)
+		if len(s.states) >= s.max {
+			return nil
+		}
+	}
+	state := &rateLimitState{rule: rule}
+	if rule.RequestsPerSecond > 0 {
+		burst := float64(rule.Burst)
+		if burst == 0 {
+			burst = rule.RequestsPerSecond
+		}
+		state.requests = newTokenBucket(rule.RequestsPerSecond, burst, now)
+	}
+	if rule.BytesPerSecond > 0 {
+		state.bytes = newTokenBucket(float64(rule.BytesPerSecond), float64(rule.BytesPerSecond), now)
+	}
+	s.states[key] = state
+	return state
+}
+
+// evictIdleStates scans the states at most once per second, to keep a flood of new keys cheap
+func (s *rateLimitStates) evictIdleStates(now time.Time) {
+	if now.Before(s.nextEviction) {
+		return
+	}
+	s.nextEviction = now.Add(time.Second)
+	for key, state := range s.states {
+		if state.isIdle(now) {
+			delete(s.states, key)
+		}
+	}
+}
+
+// admitIp checks the request rate of the client ip
+func (rl *RateLimiter) admitIp(ip string) bool {
+	rl.Lock()
+	defer rl.Unlock()
+	if rl.ipRule == nil {
+		return true
+	}
+	now := rl.now()
+	state := rl.ipStates.getState(rl.ipRule, ip, now)
+	return state != nil && state.requests.take(1, now)
+}
+
+// admit checks the request against the concurrency caps and the request rates.
+// On success, the returned states carry the bandwidth limits and must be released.
+func (rl *RateLimiter) admit(identity, bucket string, action Action) (states []*rateLimitState, rule string, reason string) {
+	rl.Lock()
+	defer rl.Unlock()
+
+	if rl.config.MaxConcurrentRequests > 0 && rl.inFlight >= rl.config.MaxConcurrentRequests {
+		return nil, rateLimitGlobalRule, rateLimitReasonConcurrency
+	}
+
+	now := rl.now()
+	for _, r := range rl.config.Rules {
+		if !r.matches(identity, bucket, action) {
+			continue
+		}
+		state := rl.ruleStates.getState(r, r.stateKey(identity, bucket, action), now)
+		if state == nil {
+			return nil, r.Name, rateLimitReasonRate
+		}
+		if r.MaxConcurrentRequests > 0 && state.inFlight >= r.MaxConcurrentRequests {
+			return nil, r.Name, rateLimitReasonConcurrency
+		}
+		states = append(states, state)
+	}
+	// only consume the request tokens once all rules admit the request
+	for _, state := range states {
+		if state.requests != nil && !state.requests.available(1, now) {
+			return nil, state.rule.Name, rateLimitReasonRate
+		}
+	}
+	for _, state := range states {
+		if state.requests != nil {
+			state.requests.take(1, now)
+		}
+		state.inFlight++
+	}
+	rl.inFlight++
+	return states, "", ""
+}
+
+func (rl *RateLimiter) release(states []*rateLimitState) {
+	rl.Lock()
+	for _, state := range states {
+		state.inFlight--
+	}
+	rl.inFlight--
+	rl.Unlock()
+}
+
+// reserveBytes returns how long to wait before transferring n more bytes, and the rule imposing the wait
+func (rl *RateLimiter) reserveBytes(states []*rateLimitState, n int) (wait time.Duration, rule string) {
+	rl.Lock()
+	defer rl.Unlock()
+	now := rl.now()
+	for _, state := range states {
+		if state.bytes == nil {
+			continue
+		}
+		if d := state.bytes.reserve(float64(n), now); d > wait {
+			wait, rule = d, state.rule.Name
+		}
+	}
+	return
+}
+
+func (rl *RateLimiter) throttle(r *http.Request, states []*rateLimitState, n int) error {
+	wait, rule := rl.reserveBytes(states, n)
+	if wait <= 0 {
+		return nil
+	}
+	recordThrottledDelay(rule, wait)
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-r.Context().Done():
+		return r.Context().Err()
+	}
+}
+
+func (rl *RateLimiter) hasBandwidthLimit(states []*rateLimitState) bool {
+	for _, state := range states {
+		if state.bytes != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ipMiddleware admits the requests through the per ip request rate, before they are authenticated.
+// The X-Forwarded-For header can be set by any client, so it is only used behind the trusted proxies.
+func (rl *RateLimiter) ipMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip, err := rl.guard.RemoteHost(r)
+		if err != nil {
+			ip = r.RemoteAddr
+		}
+		if !rl.admitIp(ip) {
+			glog.V(2).Infof("throttle %s %s by rule %s: %s", ip, r.URL.Path, rateLimitIpRule, rateLimitReasonRate)
+			recordThrottledRequest(rateLimitIpRule, rateLimitReasonRate)
+			s3err.WriteErrorResponse(w, r, s3err.ErrSlowDown)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Limit admits the request through the rate limits before passing it to f,
+// and throttles the request and response bodies to the matching bandwidth limits.
+// The identity is the one authenticated by Auth or Identify, the requests without one being anonymous.
+func (rl *RateLimiter) Limit(f http.HandlerFunc, action Action) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bucket, _ := xhttp.GetBucketAndObject(r)
+		identity := authenticatedIdentityName(r)
+		if identity == "" {
+			identity = "anonymous"
+		}
+		states, rule, reason := rl.admit(identity, bucket, action)
+		if reason != "" {
+			glog.V(2).Infof("throttle %s %s by rule %s: %s", identity, r.URL.Path, rule, reason)
+			recordThrottledRequest(rule, reason)
+			s3err.WriteErrorResponse(w, r, s3err.ErrSlowDown)
+			return
+		}
+		defer rl.release(states)
+
+		if rl.hasBandwidthLimit(states) {
+			if r.Body != nil && r.Body != http.NoBody {
+				r.Body = &throttledReader{ReadCloser: r.Body, limiter: rl, states: states, r: r}
+			}
+			w = &throttledResponseWriter{ResponseWriter: w, limiter: rl, states: states, r: r}
+		}
+		f(w, r)
+	}
+}
+
+type throttledReader struct {
+	io.ReadCloser
+	limiter *RateLimiter
+	states  []*rateLimitState
+	r       *http.Request
+}
+
+func (tr *throttledReader) Read(p []byte) (n int, err error) {
+	n, err = tr.ReadCloser.Read(p)
+	if n > 0 {
+		if throttleErr := tr.limiter.throttle(tr.r, tr.states, n); throttleErr != nil && err == nil {
+			err = throttleErr
+		}
+	}
+	return
+}
+
+type throttledResponseWriter struct {
+	http.ResponseWriter
+	limiter *RateLimiter
+	states  []*rateLimitState
+	r       *http.Request
+}
+
+func (tw *throttledResponseWriter) Write(p []byte) (n int, err error) {
+	n, err = tw.ResponseWriter.Write(p)
+	if n > 0 && err == nil {
+		err = tw.limiter.throttle(tw.r, tw.states, n)
+	}
+	return
+}
+
+func (tw *throttledResponseWriter) Flush() {
+	if flusher, ok := tw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
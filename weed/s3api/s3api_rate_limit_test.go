@@ -0,0 +1,239 @@
+package s3api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterAdmit(t *testing.T) {
+	input := `{
+  "maxConcurrentRequests": 3,
+  "rules": [
+    {
+      "name": "per_user_writes",
+      "actions": ["Write"],
+      "splitBy": ["identity"],
+      "requestsPerSecond": 2
+    },
+    {
+      "name": "public",
+      "buckets": ["public-*"],
+      "maxConcurrentRequests": 1
+    }
+  ]
+}`
+
+	now := time.Unix(1600000000, 0)
+	rl := NewRateLimiter(&security.Guard{})
+	rl.now = func() time.Time { return now }
+	assert.NoError(t, rl.loadConfigurationFromBytes([]byte(input)))
+
+	// each identity has its own write rate
+	for i := 0; i < 2; i++ {
+		states, _, reason := rl.admit("alice", "photos", s3_constants.ACTION_WRITE)
+		assert.Equal(t, "", reason)
+		rl.release(states)
+	}
+	_, rule, reason := rl.admit("alice", "photos", s3_constants.ACTION_WRITE)
+	assert.Equal(t, "per_user_writes", rule)
+	assert.Equal(t, rateLimitReasonRate, reason)
+	states, _, reason := rl.admit("bob", "photos", s3_constants.ACTION_WRITE)
+	assert.Equal(t, "", reason)
+	rl.release(states)
+	states, _, reason = rl.admit("alice", "photos", s3_constants.ACTION_READ)
+	assert.Equal(t, "", reason)
+	rl.release(states)
+
+	now = now.Add(500 * time.Millisecond)
+	states, _, reason = rl.admit("alice", "photos", s3_constants.ACTION_WRITE)
+	assert.Equal(t, "", reason)
+	rl.release(states)
+
+	// concurrency caps
+	held, _, reason := rl.admit("alice", "public-data", s3_constants.ACTION_READ)
+	assert.Equal(t, "", reason)
+	_, rule, reason = rl.admit("bob", "public-data", s3_constants.ACTION_READ)
+	assert.Equal(t, "public", rule)
+	assert.Equal(t, rateLimitReasonConcurrency, reason)
+	other1, _, _ := rl.admit("bob", "photos", s3_constants.ACTION_READ)
+	other2, _, _ := rl.admit("bob", "photos", s3_constants.ACTION_READ)
+	_, rule, reason = rl.admit("bob", "photos", s3_constants.ACTION_READ)
+	assert.Equal(t, rateLimitGlobalRule, rule)
+	assert.Equal(t, rateLimitReasonConcurrency, reason)
+	rl.release(held)
+	rl.release(other1)
+	rl.release(other2)
+	states, _, reason = rl.admit("bob", "public-data", s3_constants.ACTION_READ)
+	assert.Equal(t, "", reason)
+	rl.release(states)
+}
+
+func TestRateLimiterReserveBytes(t *testing.T) {
+	input := `{"rules": [{"name": "bandwidth", "bytesPerSecond": 1000}]}`
+
+	now := time.Unix(1600000000, 0)
+	rl := NewRateLimiter(&security.Guard{})
+	rl.now = func() time.Time { return now }
+	assert.NoError(t, rl.loadConfigurationFromBytes([]byte(input)))
+
+	states, _, reason := rl.admit("alice", "photos", s3_constants.ACTION_READ)
+	assert.Equal(t, "", reason)
+	assert.True(t, rl.hasBandwidthLimit(states))
+
+	wait, _ := rl.reserveBytes(states, 1000)
+	assert.Equal(t, time.Duration(0), wait)
+	wait, rule := rl.reserveBytes(states, 500)
+	assert.Equal(t, 500*time.Millisecond, wait)
+	assert.Equal(t, "bandwidth", rule)
+
+	now = now.Add(time.Second)
+	wait, _ = rl.reserveBytes(states, 250)
+	assert.Equal(t, time.Duration(0), wait)
+	rl.release(states)
+}
+
+func TestRateLimitConfigurationValidate(t *testing.T) {
+	rl := NewRateLimiter(&security.Guard{})
+	assert.NoError(t, rl.loadConfigurationFromBytes(nil))
+	assert.Error(t, rl.loadConfigurationFromBytes([]byte(`{"rules": [{"requestsPerSecond": 1}]}`)))
+	assert.Error(t, rl.loadConfigurationFromBytes([]byte(`{"rules": [{"name": "a"}, {"name": "a"}]}`)))
+	assert.Error(t, rl.loadConfigurationFromBytes([]byte(`{"rules": [{"name": "a", "splitBy": ["object"]}]}`)))
+	assert.Error(t, rl.loadConfigurationFromBytes([]byte(`{"rules": [{"name": "a", "bytesPerSecond": -1}]}`)))
+	assert.Error(t, rl.loadConfigurationFromBytes([]byte(`{"perIpRequestsPerSecond": -1}`)))
+}
+
+func TestRateLimiterIp(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	rl := NewRateLimiter(&security.Guard{})
+	rl.now = func() time.Time { return now }
+	assert.NoError(t, rl.loadConfigurationFromBytes([]byte(`{"perIpRequestsPerSecond": 2}`)))
+
+	// the requests are throttled before the authentication, whether or not they are signed
+	handler := rl.ipMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	codes := func(remoteAddr string, count int) (codes []int) {
+		for i := 0; i < count; i++ {
+			r := httptest.NewRequest(http.MethodGet, "/bucket/object", nil)
+			r.RemoteAddr = remoteAddr
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			codes = append(codes, w.Code)
+		}
+		return
+	}
+	assert.Equal(t, []int{http.StatusForbidden, http.StatusForbidden, http.StatusServiceUnavailable}, codes("10.0.0.1:1234", 3))
+	assert.Equal(t, []int{http.StatusServiceUnavailable}, codes("10.0.0.1:5678", 1))
+	assert.Equal(t, []int{http.StatusForbidden}, codes("10.0.0.2:1234", 1))
+
+	now = now.Add(500 * time.Millisecond)
+	assert.Equal(t, []int{http.StatusForbidden, http.StatusServiceUnavailable}, codes("10.0.0.1:1234", 2))
+
+	assert.NoError(t, rl.loadConfigurationFromBytes(nil))
+	assert.True(t, rl.admitIp("10.0.0.1"))
+}
+
+func TestRateLimiterEviction(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	rl := NewRateLimiter(&security.Guard{})
+	rl.now = func() time.Time { return now }
+	rl.ipStates.max = 2
+	assert.NoError(t, rl.loadConfigurationFromBytes([]byte(`{"perIpRequestsPerSecond": 1, "perIpBurst": 2}`)))
+
+	assert.True(t, rl.admitIp("10.0.0.1"))
+	assert.True(t, rl.admitIp("10.0.0.1"))
+	assert.False(t, rl.admitIp("10.0.0.1"))
+	assert.True(t, rl.admitIp("10.0.0.2"))
+	assert.True(t, rl.admitIp("10.0.0.2"))
+
+	// the states not refilled yet are kept, and the new clients wait for the table to have room
+	now = now.Add(time.Second)
+	assert.False(t, rl.admitIp("10.0.0.3"))
+	assert.Len(t, rl.ipStates.states, 2)
+
+	// a client pausing briefly does not regain the full burst
+	assert.True(t, rl.admitIp("10.0.0.1"))
+	assert.False(t, rl.admitIp("10.0.0.1"))
+
+	// the client ips filling their table do not block the rules
+	assert.NoError(t, rl.loadConfigurationFromBytes([]byte(`{"perIpRequestsPerSecond": 1, "rules": [{"name": "users", "splitBy": ["identity"], "requestsPerSecond": 1}]}`)))
+	assert.True(t, rl.admitIp("10.0.0.1"))
+	assert.True(t, rl.admitIp("10.0.0.2"))
+	assert.False(t, rl.admitIp("10.0.0.3"))
+	states, _, reason := rl.admit("alice", "photos", s3_constants.ACTION_READ)
+	assert.Equal(t, "", reason)
+	rl.release(states)
+
+	// the refilled states are evicted, as they would be recreated the same
+	now = now.Add(2 * time.Second)
+	assert.True(t, rl.admitIp("10.0.0.3"))
+	assert.Len(t, rl.ipStates.states, 1)
+}
+
+func TestRateLimiterIdentity(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	rl := NewRateLimiter(&security.Guard{})
+	rl.now = func() time.Time { return now }
+	assert.NoError(t, rl.loadConfigurationFromBytes([]byte(`{"rules": [{"name": "users", "splitBy": ["identity"], "requestsPerSecond": 1}]}`)))
+
+	handler := rl.Limit(func(w http.ResponseWriter, r *http.Request) {}, s3_constants.ACTION_LIST)
+	code := func(identity string, header string) int {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		if identity != "" {
+			r = withAuthenticatedIdentity(r, &Identity{Name: identity})
+		}
+		if header != "" {
+			r.Header.Set(xhttp.AmzIdentityId, header)
+		}
+		w := httptest.NewRecorder()
+		handler(w, r)
+		return w.Code
+	}
+
+	// the identity header set by the client is ignored, and does not spend the tokens of the identity
+	assert.Equal(t, http.StatusOK, code("", "alice"))
+	assert.Equal(t, http.StatusServiceUnavailable, code("", "alice"))
+	assert.Equal(t, http.StatusOK, code("alice", ""))
+	assert.Equal(t, http.StatusServiceUnavailable, code("alice", "bob"))
+	assert.Equal(t, http.StatusOK, code("bob", ""))
+}
+
+func TestRateLimiterTrustedProxy(t *testing.T) {
+	config := &util.ViperProxy{Viper: viper.New()}
+	config.Set("guard.s3.trusted_proxies", []string{"10.0.0.100"})
+	guard := security.NewGuard(nil, security.NewJwtKeys(""), 0, security.NewJwtKeys(""), 0)
+	assert.NoError(t, guard.LoadAllowLists(config, "s3"))
+
+	now := time.Unix(1600000000, 0)
+	rl := NewRateLimiter(guard)
+	rl.now = func() time.Time { return now }
+	assert.NoError(t, rl.loadConfigurationFromBytes([]byte(`{"perIpRequestsPerSecond": 1}`)))
+
+	handler := rl.ipMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	code := func(remoteAddr, forwardedFor string) int {
+		r := httptest.NewRequest(http.MethodGet, "/bucket/object", nil)
+		r.RemoteAddr = remoteAddr
+		r.Header.Set("X-Forwarded-For", forwardedFor)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	// the clients behind the trusted proxy have their own limits
+	assert.Equal(t, http.StatusOK, code("10.0.0.100:1234", "192.168.0.1"))
+	assert.Equal(t, http.StatusOK, code("10.0.0.100:1234", "192.168.0.2"))
+	assert.Equal(t, http.StatusServiceUnavailable, code("10.0.0.100:1234", "192.168.0.1"))
+
+	// the header is ignored from the other addresses
+	assert.Equal(t, http.StatusOK, code("10.0.0.1:1234", "192.168.0.3"))
+	assert.Equal(t, http.StatusServiceUnavailable, code("10.0.0.1:1234", "192.168.0.4"))
+}
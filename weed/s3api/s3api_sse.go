@@ -0,0 +1,447 @@
+package s3api
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+const (
+	sseAlgorithmAES256 = "AES256"
+	sseAlgorithmKMS    = "aws:kms"
+)
+
+// the internal encryption fields are saved as "s3-sse-*" by the filer, and returned as "S3-Sse-*" headers
+var sseInternalHeaderPrefix = http.CanonicalHeaderKey("s3-sse-")
+
+// sseRequestHeaders are not forwarded to the filer: the customer keys are never stored,
+// and the internal encryption fields are only set by the gateway
+var sseRequestHeaders = []string{
+	xhttp.AmzServerSideEncryptionCustomerKey,
+	xhttp.AmzServerSideEncryptionCustomerKeyMD5,
+	xhttp.AmzCopySourceServerSideEncryptionCustomerAlgorithm,
+	xhttp.AmzCopySourceServerSideEncryptionCustomerKey,
+	xhttp.AmzCopySourceServerSideEncryptionCustomerKeyMD5,
+	xhttp.AmzSseIv,
+	xhttp.AmzSseKeyHmac,
+	xhttp.AmzSseDataKey,
+	xhttp.AmzSseParts,
+}
+
+// objectEncryption is the key of an object encrypted by the gateway with AES-256-CTR,
+// either provided by the customer with SSE-C or a data key of the KMS with SSE-KMS.
+// The SSE-S3 objects are encrypted by the filer with the chunk cipher instead.
+type objectEncryption struct {
+	customerKey    []byte
+	customerKeyMD5 string
+	kmsKeyId       string
+	dataKey        []byte
+	// iv is of the object, or of the multipart upload whose parts are encrypted with their own iv
+	iv    []byte
+	parts []ssePart
+}
+
+type ssePart struct {
+	size int64
+	iv   []byte
+}
+
+func (e *objectEncryption) key() []byte {
+	if e.customerKey != nil {
+		return e.customerKey
+	}
+	return e.dataKey
+}
+
+// encryptReader returns the reader as is if the object is not encrypted by the gateway
+func (e *objectEncryption) encryptReader(reader io.Reader) (io.Reader, error) {
+	if e == nil {
+		return reader, nil
+	}
+	return newSseCipherReader(reader, e.key(), e.iv, 0)
+}
+
+// decryptReader decrypts the content read from the offset, part by part for the multipart objects
+func (e *objectEncryption) decryptReader(reader io.Reader, offset int64) (io.Reader, error) {
+	if e == nil {
+		return reader, nil
+	}
+	if len(e.parts) == 0 {
+		return newSseCipherReader(reader, e.key(), e.iv, offset)
+	}
+	var readers []io.Reader
+	var partStart int64
+	for _, part := range e.parts {
+		partEnd := partStart + part.size
+		if partEnd > offset {
+			var partOffset int64
+			if offset > partStart {
+				partOffset = offset - partStart
+			}
+			partReader, err := newSseCipherReader(io.LimitReader(reader, part.size-partOffset), e.key(), part.iv, partOffset)
+			if err != nil {
+				return nil, err
+			}
+			readers = append(readers, partReader)
+		}
+		partStart = partEnd
+	}
+	return io.MultiReader(readers...), nil
+}
+
+// newSseCipherReader encrypts, or decrypts, the content with AES-256-CTR starting from the offset
+func newSseCipherReader(reader io.Reader, key, iv []byte, offset int64) (io.Reader, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, fmt.Errorf("invalid iv size %d", len(iv))
+	}
+	// the counter of the block at the offset, wrapping around as a 128-bit integer
+	counter := new(big.Int).SetBytes(iv)
+	counter.Add(counter, big.NewInt(offset/aes.BlockSize))
+	counter.Mod(counter, new(big.Int).Lsh(big.NewInt(1), 8*aes.BlockSize))
+	stream := cipher.NewCTR(block, counter.FillBytes(make([]byte, aes.BlockSize)))
+	skipped := make([]byte, offset%aes.BlockSize)
+	stream.XORKeyStream(skipped, skipped)
+	return &cipher.StreamReader{S: stream, R: reader}, nil
+}
+
+func newSseIv() []byte {
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		glog.Fatalf("generate sse iv: %v", err)
+	}
+	return iv
+}
+
+// sseKeyHmac checks the customer key of the SSE-C objects, without storing the key or its MD5
+func sseKeyHmac(customerKey, iv []byte) []byte {
+	mac := hmac.New(sha256.New, customerKey)
+	mac.Write(iv)
+	return mac.Sum(nil)
+}
+
+// parseSseCustomerKey reads the SSE-C headers of the request, or of the copy source
+func parseSseCustomerKey(h http.Header, isCopySource bool) (*objectEncryption, s3err.ErrorCode) {
+	algorithmHeader := xhttp.AmzServerSideEncryptionCustomerAlgorithm
+	keyHeader := xhttp.AmzServerSideEncryptionCustomerKey
+	keyMD5Header := xhttp.AmzServerSideEncryptionCustomerKeyMD5
+	if isCopySource {
+		algorithmHeader = xhttp.AmzCopySourceServerSideEncryptionCustomerAlgorithm
+		keyHeader = xhttp.AmzCopySourceServerSideEncryptionCustomerKey
+		keyMD5Header = xhttp.AmzCopySourceServerSideEncryptionCustomerKeyMD5
+	}
+	algorithm, key, keyMD5 := h.Get(algorithmHeader), h.Get(keyHeader), h.Get(keyMD5Header)
+	if algorithm == "" && key == "" && keyMD5 == "" {
+		return nil, s3err.ErrNone
+	}
+	if algorithm != sseAlgorithmAES256 {
+		return nil, s3err.ErrInvalidEncryptionAlgorithm
+	}
+	if key == "" {
+		return nil, s3err.ErrMissingSSECustomerKey
+	}
+	if keyMD5 == "" {
+		return nil, s3err.ErrMissingSSECustomerKeyMD5
+	}
+	customerKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(customerKey) != 32 {
+		return nil, s3err.ErrInvalidSSECustomerKey
+	}
+	sum := md5.Sum(customerKey)
+	if base64.StdEncoding.EncodeToString(sum[:]) != keyMD5 {
+		return nil, s3err.ErrSSECustomerKeyMD5Mismatch
+	}
+	return &objectEncryption{customerKey: customerKey, customerKeyMD5: keyMD5}, s3err.ErrNone
+}
+
+func clearSseRequestHeaders(r *http.Request) {
+	for _, header := range sseRequestHeaders {
+		r.Header.Del(header)
+	}
+}
+
+// prepareServerSideEncryption validates the encryption headers of a new object or multipart upload,
+// applying the bucket default encryption if the request specifies none.
+// The encryption headers are saved along with the object by the filer,
+// and the returned key, if not nil, is to encrypt the content in the gateway.
+func (s3a *S3ApiServer) prepareServerSideEncryption(r *http.Request, bucket string) (*objectEncryption, s3err.ErrorCode) {
+	customer, errCode := parseSseCustomerKey(r.Header, false)
+	clearSseRequestHeaders(r)
+	if errCode != s3err.ErrNone {
+		return nil, errCode
+	}
+
+	algorithm := r.Header.Get(xhttp.AmzServerSideEncryption)
+	kmsKeyId := r.Header.Get(xhttp.AmzServerSideEncryptionAwsKmsKeyId)
+	if customer != nil {
+		if algorithm != "" || kmsKeyId != "" {
+			return nil, s3err.ErrInvalidEncryptionMethod
+		}
+		customer.iv = newSseIv()
+		r.Header.Set(xhttp.AmzSseIv, base64.StdEncoding.EncodeToString(customer.iv))
+		r.Header.Set(xhttp.AmzSseKeyHmac, base64.StdEncoding.EncodeToString(sseKeyHmac(customer.customerKey, customer.iv)))
+		return customer, s3err.ErrNone
+	}
+	if algorithm == "" && kmsKeyId == "" {
+		if config := s3a.bucketConfigs.get(bucket); config != nil && config.Encryption != nil {
+			rule := config.Encryption.Rules[0].ApplyServerSideEncryptionByDefault
+			algorithm, kmsKeyId = rule.SSEAlgorithm, rule.KMSMasterKeyID
+		}
+	}
+
+	switch algorithm {
+	case "":
+		if kmsKeyId != "" {
+			return nil, s3err.ErrInvalidEncryptionMethod
+		}
+		return nil, s3err.ErrNone
+	case sseAlgorithmAES256:
+		if kmsKeyId != "" {
+			return nil, s3err.ErrInvalidEncryptionMethod
+		}
+		// encrypted by the filer
+		r.Header.Set(xhttp.AmzServerSideEncryption, algorithm)
+		return nil, s3err.ErrNone
+	case sseAlgorithmKMS:
+		if s3a.kms == nil {
+			return nil, s3err.ErrKMSNotConfigured
+		}
+		if kmsKeyId == "" {
+			kmsKeyId = s3a.kms.DefaultKeyId()
+		}
+		dataKey, wrappedKey, err := s3a.kms.GenerateDataKey(kmsKeyId)
+		if err == ErrKmsKeyNotFound {
+			return nil, s3err.ErrKMSKeyNotFound
+		}
+		if err != nil {
+			glog.Errorf("generate data key of kms key %s: %v", kmsKeyId, err)
+			return nil, s3err.ErrInternalError
+		}
+		e := &objectEncryption{kmsKeyId: kmsKeyId, dataKey: dataKey, iv: newSseIv()}
+		r.Header.Set(xhttp.AmzServerSideEncryption, algorithm)
+		r.Header.Set(xhttp.AmzServerSideEncryptionAwsKmsKeyId, kmsKeyId)
+		r.Header.Set(xhttp.AmzSseDataKey, base64.StdEncoding.EncodeToString(wrappedKey))
+		r.Header.Set(xhttp.AmzSseIv, base64.StdEncoding.EncodeToString(e.iv))
+		return e, s3err.ErrNone
+	}
+	return nil, s3err.ErrInvalidEncryptionAlgorithm
+}
+
+// setServerSideEncryption reports the encryption of a new object, as prepared in the request headers
+func setServerSideEncryption(w http.ResponseWriter, r *http.Request, encryption *objectEncryption) {
+	for _, header := range []string{xhttp.AmzServerSideEncryption, xhttp.AmzServerSideEncryptionAwsKmsKeyId, xhttp.AmzServerSideEncryptionCustomerAlgorithm} {
+		if v := r.Header.Get(header); v != "" {
+			w.Header().Set(header, v)
+		}
+	}
+	if encryption != nil && encryption.customerKey != nil {
+		w.Header().Set(xhttp.AmzServerSideEncryptionCustomerKeyMD5, encryption.customerKeyMD5)
+	}
+}
+
+// prepareUploadPartEncryption encrypts a part like its multipart upload, each part with its own iv.
+// The SSE-C key has to be provided again with each part.
+func (s3a *S3ApiServer) prepareUploadPartEncryption(r *http.Request, bucket, uploadID string) (*objectEncryption, s3err.ErrorCode) {
+	customer, errCode := parseSseCustomerKey(r.Header, false)
+	clearSseRequestHeaders(r)
+	if errCode != s3err.ErrNone {
+		return nil, errCode
+	}
+
+	uploadEntry, err := s3a.getEntry(s3a.genUploadsFolder(bucket), uploadID)
+	if err != nil {
+		return nil, s3err.ErrNoSuchUpload
+	}
+	// the SSE-S3 parts are encrypted by the filer with this header
+	for _, header := range []string{xhttp.AmzServerSideEncryption, xhttp.AmzServerSideEncryptionAwsKmsKeyId, xhttp.AmzServerSideEncryptionCustomerAlgorithm} {
+		if v := uploadEntry.Extended[header]; len(v) > 0 {
+			r.Header.Set(header, string(v))
+		} else {
+			r.Header.Del(header)
+		}
+	}
+	e, errCode := s3a.storedEncryption(customer, func(name string) string {
+		return string(uploadEntry.Extended[name])
+	})
+	if e == nil || errCode != s3err.ErrNone {
+		return nil, errCode
+	}
+	e.iv = newSseIv()
+	r.Header.Set(xhttp.AmzSseIv, base64.StdEncoding.EncodeToString(e.iv))
+	return e, s3err.ErrNone
+}
+
+// decryptCopySource decrypts the source object of a copy from the offset, with the copy source SSE-C headers
+func (s3a *S3ApiServer) decryptCopySource(r *http.Request, reader io.Reader, stored func(name string) string, offset int64) (io.Reader, s3err.ErrorCode) {
+	customer, errCode := parseSseCustomerKey(r.Header, true)
+	if errCode != s3err.ErrNone {
+		return nil, errCode
+	}
+	encryption, errCode := s3a.storedEncryption(customer, stored)
+	if errCode != s3err.ErrNone {
+		return nil, errCode
+	}
+	decrypted, err := encryption.decryptReader(reader, offset)
+	if err != nil {
+		glog.Errorf("decrypt copy source %s: %v", r.Header.Get("X-Amz-Copy-Source"), err)
+		return nil, s3err.ErrInternalError
+	}
+	return decrypted, s3err.ErrNone
+}
+
+// storedEncryption returns the key of an object encrypted by the gateway, or nil for the other objects,
+// with the encryption fields of its entry. The customer key of SSE-C objects is checked against the object.
+func (s3a *S3ApiServer) storedEncryption(customer *objectEncryption, stored func(name string) string) (*objectEncryption, s3err.ErrorCode) {
+	if stored(xhttp.AmzSseIv) == "" {
+		return nil, s3err.ErrNone
+	}
+	iv, err := base64.StdEncoding.DecodeString(stored(xhttp.AmzSseIv))
+	if err != nil {
+		glog.Errorf("invalid sse iv: %v", err)
+		return nil, s3err.ErrInternalError
+	}
+	parts, err := parseSseParts(stored(xhttp.AmzSseParts))
+	if err != nil {
+		glog.Errorf("invalid sse parts: %v", err)
+		return nil, s3err.ErrInternalError
+	}
+
+	if stored(xhttp.AmzServerSideEncryptionCustomerAlgorithm) != "" {
+		if customer == nil {
+			return nil, s3err.ErrSSEEncryptedObject
+		}
+		keyHmac, _ := base64.StdEncoding.DecodeString(stored(xhttp.AmzSseKeyHmac))
+		if !hmac.Equal(keyHmac, sseKeyHmac(customer.customerKey, iv)) {
+			return nil, s3err.ErrSSECustomerKeyMismatch
+		}
+		return &objectEncryption{customerKey: customer.customerKey, customerKeyMD5: customer.customerKeyMD5, iv: iv, parts: parts}, s3err.ErrNone
+	}
+
+	if stored(xhttp.AmzServerSideEncryption) != sseAlgorithmKMS {
+		return nil, s3err.ErrNone
+	}
+	if s3a.kms == nil {
+		return nil, s3err.ErrKMSNotConfigured
+	}
+	kmsKeyId := stored(xhttp.AmzServerSideEncryptionAwsKmsKeyId)
+	wrappedKey, err := base64.StdEncoding.DecodeString(stored(xhttp.AmzSseDataKey))
+	if err != nil {
+		glog.Errorf("invalid sse data key: %v", err)
+		return nil, s3err.ErrInternalError
+	}
+	dataKey, err := s3a.kms.DecryptDataKey(kmsKeyId, wrappedKey)
+	if err == ErrKmsKeyNotFound {
+		return nil, s3err.ErrKMSKeyNotFound
+	}
+	if err != nil {
+		glog.Errorf("decrypt data key of kms key %s: %v", kmsKeyId, err)
+		return nil, s3err.ErrInternalError
+	}
+	return &objectEncryption{kmsKeyId: kmsKeyId, dataKey: dataKey, iv: iv, parts: parts}, s3err.ErrNone
+}
+
+// formatSsePart lists the size and the base64 iv of a part in the s3-sse-parts field of the multipart objects
+func formatSsePart(size int64, encodedIv string) string {
+	return fmt.Sprintf("%d:%s", size, encodedIv)
+}
+
+func parseSseParts(s string) (parts []ssePart, err error) {
+	if s == "" {
+		return nil, nil
+	}
+	for _, field := range strings.Split(s, ",") {
+		sizeAndIv := strings.SplitN(field, ":", 2)
+		if len(sizeAndIv) != 2 {
+			return nil, fmt.Errorf("invalid part %q", field)
+		}
+		size, err := strconv.ParseInt(sizeAndIv[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid part %q: %v", field, err)
+		}
+		iv, err := base64.StdEncoding.DecodeString(sizeAndIv[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid part %q: %v", field, err)
+		}
+		parts = append(parts, ssePart{size: size, iv: iv})
+	}
+	return parts, nil
+}
+
+// sseResponse decrypts the objects encrypted by the gateway, and hides their internal encryption fields.
+// The customer key of the request is parsed before proxying, as its headers are not forwarded to the filer.
+func (s3a *S3ApiServer) sseResponse(r *http.Request, customer *objectEncryption) func(proxyResponse *http.Response, w http.ResponseWriter) (statusCode int) {
+	return func(proxyResponse *http.Response, w http.ResponseWriter) (statusCode int) {
+		encryption, errCode := s3a.storedEncryption(customer, proxyResponse.Header.Get)
+		for header := range proxyResponse.Header {
+			if strings.HasPrefix(header, sseInternalHeaderPrefix) {
+				delete(proxyResponse.Header, header)
+			}
+		}
+		if errCode != s3err.ErrNone {
+			s3err.WriteErrorResponse(w, r, errCode)
+			return s3err.GetAPIError(errCode).HTTPStatusCode
+		}
+		if encryption == nil {
+			return passThroughResponse(proxyResponse, w)
+		}
+		if encryption.customerKey != nil {
+			w.Header().Set(xhttp.AmzServerSideEncryptionCustomerKeyMD5, encryption.customerKeyMD5)
+		}
+		if r.Method == http.MethodHead || proxyResponse.StatusCode != http.StatusOK && proxyResponse.StatusCode != http.StatusPartialContent {
+			return passThroughResponse(proxyResponse, w)
+		}
+
+		if strings.HasPrefix(proxyResponse.Header.Get("Content-Type"), "multipart/byteranges") {
+			s3err.WriteErrorResponse(w, r, s3err.ErrNotImplemented)
+			return http.StatusNotImplemented
+		}
+		offset, err := parseRangeStart(proxyResponse.Header.Get("Content-Range"))
+		if err != nil {
+			glog.Errorf("sse response %s: %v", r.URL.Path, err)
+			s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+			return http.StatusInternalServerError
+		}
+		decrypted, err := encryption.decryptReader(proxyResponse.Body, offset)
+		if err != nil {
+			glog.Errorf("sse response %s: %v", r.URL.Path, err)
+			s3err.WriteErrorResponse(w, r, s3err.ErrInternalError)
+			return http.StatusInternalServerError
+		}
+		proxyResponse.Body = struct {
+			io.Reader
+			io.Closer
+		}{decrypted, proxyResponse.Body}
+		return passThroughResponse(proxyResponse, w)
+	}
+}
+
+// parseRangeStart returns the first byte of a "bytes first-last/size" content range,
+// or of a "bytes=first-last" copy source range, and 0 without the header
+func parseRangeStart(byteRange string) (int64, error) {
+	if byteRange == "" {
+		return 0, nil
+	}
+	firstAndLast := strings.TrimPrefix(strings.TrimPrefix(byteRange, "bytes="), "bytes ")
+	dash := strings.Index(firstAndLast, "-")
+	if dash < 0 {
+		return 0, fmt.Errorf("invalid range %q", byteRange)
+	}
+	return strconv.ParseInt(firstAndLast[:dash], 10, 64)
+}
@@ -0,0 +1,214 @@
+package s3api
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSseCipherReaderRange(t *testing.T) {
+	plaintext := make([]byte, 100*1024+7)
+	rand.Read(plaintext)
+	key, iv := make([]byte, 32), newSseIv()
+	rand.Read(key)
+	e := &objectEncryption{dataKey: key, iv: iv}
+
+	encrypted, err := e.encryptReader(bytes.NewReader(plaintext))
+	assert.NoError(t, err)
+	ciphertext, _ := io.ReadAll(encrypted)
+	assert.NotEqual(t, plaintext, ciphertext)
+
+	for _, offset := range []int64{0, 1, 15, 16, 4099, int64(len(plaintext)) - 1} {
+		decrypted, err := e.decryptReader(bytes.NewReader(ciphertext[offset:]), offset)
+		assert.NoError(t, err)
+		data, _ := io.ReadAll(decrypted)
+		assert.Equal(t, plaintext[offset:], data, "offset %d", offset)
+	}
+
+	// the counter wraps around
+	e.iv = bytes.Repeat([]byte{0xff}, 16)
+	encrypted, _ = e.encryptReader(bytes.NewReader(plaintext))
+	ciphertext, _ = io.ReadAll(encrypted)
+	decrypted, _ := e.decryptReader(bytes.NewReader(ciphertext[33:]), 33)
+	data, _ := io.ReadAll(decrypted)
+	assert.Equal(t, plaintext[33:], data)
+}
+
+func TestSseMultipartDecrypt(t *testing.T) {
+	key := make([]byte, 32)
+	rand.Read(key)
+	var plaintext, ciphertext []byte
+	e := &objectEncryption{customerKey: key}
+	for _, size := range []int{5000, 0, 3001, 20} {
+		part := make([]byte, size)
+		rand.Read(part)
+		partEncryption := &objectEncryption{customerKey: key, iv: newSseIv()}
+		encrypted, _ := partEncryption.encryptReader(bytes.NewReader(part))
+		data, _ := io.ReadAll(encrypted)
+		plaintext, ciphertext = append(plaintext, part...), append(ciphertext, data...)
+		e.parts = append(e.parts, ssePart{size: int64(size), iv: partEncryption.iv})
+	}
+
+	parts, err := parseSseParts(formatSsePart(e.parts[0].size, base64.StdEncoding.EncodeToString(e.parts[0].iv)) + "," +
+		formatSsePart(e.parts[1].size, base64.StdEncoding.EncodeToString(e.parts[1].iv)))
+	assert.NoError(t, err)
+	assert.Equal(t, e.parts[:2], parts)
+
+	for _, offset := range []int64{0, 4999, 5000, 5001, 8000, 8001, 8020} {
+		decrypted, err := e.decryptReader(bytes.NewReader(ciphertext[offset:]), offset)
+		assert.NoError(t, err)
+		data, _ := io.ReadAll(decrypted)
+		assert.Equal(t, plaintext[offset:], data, "offset %d", offset)
+	}
+}
+
+func TestSseCustomerKey(t *testing.T) {
+	key := make([]byte, 32)
+	rand.Read(key)
+	sum := md5.Sum(key)
+	s3a := &S3ApiServer{bucketConfigs: newBucketConfigCache()}
+
+	r := httptest.NewRequest("PUT", "/bucket/object", nil)
+	setSseCustomerKey(r, xhttp.AmzServerSideEncryptionCustomerAlgorithm, xhttp.AmzServerSideEncryptionCustomerKey, xhttp.AmzServerSideEncryptionCustomerKeyMD5,
+		key, base64.StdEncoding.EncodeToString(sum[:]))
+	encryption, errCode := s3a.prepareServerSideEncryption(r, "bucket")
+	assert.Equal(t, s3err.ErrNone, errCode)
+	assert.Equal(t, key, encryption.key())
+	// the key is never forwarded to the filer
+	assert.Equal(t, "", r.Header.Get(xhttp.AmzServerSideEncryptionCustomerKey))
+	assert.Equal(t, "", r.Header.Get(xhttp.AmzServerSideEncryptionCustomerKeyMD5))
+	assert.Equal(t, sseAlgorithmAES256, r.Header.Get(xhttp.AmzServerSideEncryptionCustomerAlgorithm))
+
+	customer := &objectEncryption{customerKey: key, customerKeyMD5: base64.StdEncoding.EncodeToString(sum[:])}
+	stored, errCode := s3a.storedEncryption(customer, r.Header.Get)
+	assert.Equal(t, s3err.ErrNone, errCode)
+	assert.Equal(t, encryption.iv, stored.iv)
+	_, errCode = s3a.storedEncryption(nil, r.Header.Get)
+	assert.Equal(t, s3err.ErrSSEEncryptedObject, errCode)
+	otherKey := make([]byte, 32)
+	_, errCode = s3a.storedEncryption(&objectEncryption{customerKey: otherKey}, r.Header.Get)
+	assert.Equal(t, s3err.ErrSSECustomerKeyMismatch, errCode)
+
+	// the copy source has its own headers
+	r = httptest.NewRequest("PUT", "/bucket/object", nil)
+	setSseCustomerKey(r, xhttp.AmzCopySourceServerSideEncryptionCustomerAlgorithm, xhttp.AmzCopySourceServerSideEncryptionCustomerKey, xhttp.AmzCopySourceServerSideEncryptionCustomerKeyMD5,
+		key, base64.StdEncoding.EncodeToString(sum[:]))
+	copySource, errCode := parseSseCustomerKey(r.Header, true)
+	assert.Equal(t, s3err.ErrNone, errCode)
+	assert.Equal(t, key, copySource.customerKey)
+	copySource, errCode = parseSseCustomerKey(r.Header, false)
+	assert.Equal(t, s3err.ErrNone, errCode)
+	assert.Nil(t, copySource)
+
+	for _, test := range []struct {
+		algorithm, key, keyMD5 string
+		errCode                s3err.ErrorCode
+	}{
+		{"AES128", base64.StdEncoding.EncodeToString(key), base64.StdEncoding.EncodeToString(sum[:]), s3err.ErrInvalidEncryptionAlgorithm},
+		{"AES256", "", base64.StdEncoding.EncodeToString(sum[:]), s3err.ErrMissingSSECustomerKey},
+		{"AES256", base64.StdEncoding.EncodeToString(key), "", s3err.ErrMissingSSECustomerKeyMD5},
+		{"AES256", base64.StdEncoding.EncodeToString(key[:16]), base64.StdEncoding.EncodeToString(sum[:]), s3err.ErrInvalidSSECustomerKey},
+		{"AES256", base64.StdEncoding.EncodeToString(otherKey), base64.StdEncoding.EncodeToString(sum[:]), s3err.ErrSSECustomerKeyMD5Mismatch},
+	} {
+		r = httptest.NewRequest("GET", "/bucket/object", nil)
+		r.Header.Set(xhttp.AmzServerSideEncryptionCustomerAlgorithm, test.algorithm)
+		r.Header.Set(xhttp.AmzServerSideEncryptionCustomerKey, test.key)
+		r.Header.Set(xhttp.AmzServerSideEncryptionCustomerKeyMD5, test.keyMD5)
+		_, errCode = parseSseCustomerKey(r.Header, false)
+		assert.Equal(t, test.errCode, errCode)
+	}
+}
+
+func TestSseKms(t *testing.T) {
+	masterKey := make([]byte, 32)
+	rand.Read(masterKey)
+	s3a := &S3ApiServer{bucketConfigs: newBucketConfigCache()}
+
+	r := httptest.NewRequest("PUT", "/bucket/object", nil)
+	r.Header.Set(xhttp.AmzServerSideEncryption, sseAlgorithmKMS)
+	_, errCode := s3a.prepareServerSideEncryption(r, "bucket")
+	assert.Equal(t, s3err.ErrKMSNotConfigured, errCode)
+
+	s3a.kms = &localKms{defaultKeyId: "key1", keys: map[string][]byte{"key1": masterKey}}
+	encryption, errCode := s3a.prepareServerSideEncryption(r, "bucket")
+	assert.Equal(t, s3err.ErrNone, errCode)
+	assert.Equal(t, "key1", r.Header.Get(xhttp.AmzServerSideEncryptionAwsKmsKeyId))
+	assert.NotEqual(t, "", r.Header.Get(xhttp.AmzSseDataKey))
+	stored, errCode := s3a.storedEncryption(nil, r.Header.Get)
+	assert.Equal(t, s3err.ErrNone, errCode)
+	assert.Equal(t, encryption.dataKey, stored.dataKey)
+
+	r = httptest.NewRequest("PUT", "/bucket/object", nil)
+	r.Header.Set(xhttp.AmzServerSideEncryption, sseAlgorithmKMS)
+	r.Header.Set(xhttp.AmzServerSideEncryptionAwsKmsKeyId, "key2")
+	_, errCode = s3a.prepareServerSideEncryption(r, "bucket")
+	assert.Equal(t, s3err.ErrKMSKeyNotFound, errCode)
+
+	// the internal fields can not be set by the clients
+	r = httptest.NewRequest("PUT", "/bucket/object", nil)
+	r.Header.Set(xhttp.AmzSseIv, "AAAAAAAAAAAAAAAAAAAAAA==")
+	encryption, errCode = s3a.prepareServerSideEncryption(r, "bucket")
+	assert.Equal(t, s3err.ErrNone, errCode)
+	assert.Nil(t, encryption)
+	assert.Equal(t, "", r.Header.Get(xhttp.AmzSseIv))
+}
+
+func TestBucketDefaultEncryption(t *testing.T) {
+	s3a := &S3ApiServer{bucketConfigs: newBucketConfigCache()}
+	encryption, err := parseServerSideEncryptionConfiguration([]byte(`
+<ServerSideEncryptionConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Rule>
+    <ApplyServerSideEncryptionByDefault>
+      <SSEAlgorithm>AES256</SSEAlgorithm>
+    </ApplyServerSideEncryptionByDefault>
+  </Rule>
+</ServerSideEncryptionConfiguration>`))
+	assert.NoError(t, err)
+	s3a.bucketConfigs.configs["bucket"] = &BucketConfig{Encryption: encryption}
+
+	r := httptest.NewRequest("PUT", "/bucket/object", nil)
+	e, errCode := s3a.prepareServerSideEncryption(r, "bucket")
+	assert.Equal(t, s3err.ErrNone, errCode)
+	assert.Nil(t, e)
+	assert.Equal(t, sseAlgorithmAES256, r.Header.Get(xhttp.AmzServerSideEncryption))
+
+	r = httptest.NewRequest("PUT", "/bucket/object", nil)
+	r.Header.Set(xhttp.AmzServerSideEncryption, sseAlgorithmKMS)
+	_, errCode = s3a.prepareServerSideEncryption(r, "bucket")
+	assert.Equal(t, s3err.ErrKMSNotConfigured, errCode)
+
+	_, err = parseServerSideEncryptionConfiguration([]byte(`<ServerSideEncryptionConfiguration><Rule><ApplyServerSideEncryptionByDefault>
+<SSEAlgorithm>AES256</SSEAlgorithm><KMSMasterKeyID>key1</KMSMasterKeyID></ApplyServerSideEncryptionByDefault></Rule></ServerSideEncryptionConfiguration>`))
+	assert.Error(t, err)
+	_, err = parseServerSideEncryptionConfiguration([]byte(`<ServerSideEncryptionConfiguration></ServerSideEncryptionConfiguration>`))
+	assert.Error(t, err)
+}
+
+func TestParseRangeStart(t *testing.T) {
+	start, err := parseRangeStart("bytes 100-199/1000")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), start)
+	start, err = parseRangeStart("bytes=5-9")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), start)
+	start, err = parseRangeStart("")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), start)
+	_, err = parseRangeStart("bytes */1000")
+	assert.Error(t, err)
+}
+
+func setSseCustomerKey(r *http.Request, algorithmHeader, keyHeader, keyMD5Header string, key []byte, keyMD5 string) {
+	r.Header.Set(algorithmHeader, sseAlgorithmAES256)
+	r.Header.Set(keyHeader, base64.StdEncoding.EncodeToString(key))
+	r.Header.Set(keyMD5Header, keyMD5)
+}
@@ -0,0 +1,107 @@
+package s3select
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net/http"
+)
+
+// EventStreamWriter writes the SelectObjectContent response messages in the aws event stream format:
+//
+//	total length(4) | headers length(4) | prelude crc(4) | headers | payload | message crc(4)
+type EventStreamWriter struct {
+	w io.Writer
+}
+
+func NewEventStreamWriter(w io.Writer) *EventStreamWriter {
+	return &EventStreamWriter{w: w}
+}
+
+type header struct {
+	name, value string
+}
+
+func (e *EventStreamWriter) WriteRecords(payload []byte) error {
+	return e.writeMessage([]header{
+		{":event-type", "Records"},
+		{":content-type", "application/octet-stream"},
+		{":message-type", "event"},
+	}, payload)
+}
+
+func (e *EventStreamWriter) WriteContinuation() error {
+	return e.writeMessage([]header{
+		{":event-type", "Cont"},
+		{":message-type", "event"},
+	}, nil)
+}
+
+func (e *EventStreamWriter) WriteProgress(scanned, processed, returned int64) error {
+	return e.writeMessage([]header{
+		{":event-type", "Progress"},
+		{":content-type", "text/xml"},
+		{":message-type", "event"},
+	}, statsXml("Progress", scanned, processed, returned))
+}
+
+func (e *EventStreamWriter) WriteStats(scanned, processed, returned int64) error {
+	return e.writeMessage([]header{
+		{":event-type", "Stats"},
+		{":content-type", "text/xml"},
+		{":message-type", "event"},
+	}, statsXml("Stats", scanned, processed, returned))
+}
+
+func (e *EventStreamWriter) WriteEnd() error {
+	return e.writeMessage([]header{
+		{":event-type", "End"},
+		{":message-type", "event"},
+	}, nil)
+}
+
+// WriteError reports an error after the response has started
+func (e *EventStreamWriter) WriteError(code, message string) error {
+	return e.writeMessage([]header{
+		{":error-code", code},
+		{":error-message", message},
+		{":message-type", "error"},
+	}, nil)
+}
+
+func statsXml(element string, scanned, processed, returned int64) []byte {
+	return []byte(fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?><%s><BytesScanned>%d</BytesScanned><BytesProcessed>%d</BytesProcessed><BytesReturned>%d</BytesReturned></%s>`,
+		element, scanned, processed, returned, element))
+}
+
+const headerValueTypeString = 7
+
+func (e *EventStreamWriter) writeMessage(headers []header, payload []byte) error {
+	var headerBytes []byte
+	for _, h := range headers {
+		headerBytes = append(headerBytes, byte(len(h.name)))
+		headerBytes = append(headerBytes, h.name...)
+		headerBytes = append(headerBytes, headerValueTypeString)
+		headerBytes = append(headerBytes, byte(len(h.value)>>8), byte(len(h.value)))
+		headerBytes = append(headerBytes, h.value...)
+	}
+
+	totalLength := 12 + len(headerBytes) + len(payload) + 4
+	message := make([]byte, 12, totalLength)
+	binary.BigEndian.PutUint32(message[0:4], uint32(totalLength))
+	binary.BigEndian.PutUint32(message[4:8], uint32(len(headerBytes)))
+	binary.BigEndian.PutUint32(message[8:12], crc32.ChecksumIEEE(message[0:8]))
+	message = append(message, headerBytes...)
+	message = append(message, payload...)
+	messageCrc := crc32.ChecksumIEEE(message)
+	message = append(message, byte(messageCrc>>24), byte(messageCrc>>16), byte(messageCrc>>8), byte(messageCrc))
+
+	if _, err := e.w.Write(message); err != nil {
+		return err
+	}
+	if flusher, ok := e.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,434 @@
+package s3select
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type expr interface {
+	eval(ctx *evalContext) Value
+}
+
+type evalContext struct {
+	record Record
+	// aggregates is set when evaluating the final aggregated row
+	aggregates map[*aggregate]*aggregateState
+}
+
+type literal struct {
+	value Value
+}
+
+func (e *literal) eval(ctx *evalContext) Value {
+	return e.value
+}
+
+type column struct {
+	path []string
+}
+
+func (e *column) eval(ctx *evalContext) Value {
+	if ctx.record == nil {
+		return nullValue
+	}
+	return ctx.record.Get(e.path)
+}
+
+type logical struct {
+	op          string
+	left, right expr
+}
+
+// eval follows the three-valued logic, where null is neither true nor false
+func (e *logical) eval(ctx *evalContext) Value {
+	left, right := e.left.eval(ctx), e.right.eval(ctx)
+	if e.op == "AND" {
+		if isFalse(left) || isFalse(right) {
+			return boolValue(false)
+		}
+		if left.truthy() && right.truthy() {
+			return boolValue(true)
+		}
+		return nullValue
+	}
+	if left.truthy() || right.truthy() {
+		return boolValue(true)
+	}
+	if isFalse(left) && isFalse(right) {
+		return boolValue(false)
+	}
+	return nullValue
+}
+
+func isFalse(v Value) bool {
+	return v.Type == Bool && !v.Bool
+}
+
+type not struct {
+	expr expr
+}
+
+func (e *not) eval(ctx *evalContext) Value {
+	v := e.expr.eval(ctx)
+	if v.Type != Bool {
+		return nullValue
+	}
+	return boolValue(!v.Bool)
+}
+
+type comparison struct {
+	op          string
+	left, right expr
+}
+
+func (e *comparison) eval(ctx *evalContext) Value {
+	c, ok := compareValues(e.left.eval(ctx), e.right.eval(ctx))
+	if !ok {
+		return nullValue
+	}
+	return boolValue(compareResult(e.op, c))
+}
+
+func compareResult(op string, c int) bool {
+	switch op {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+type isNull struct {
+	expr expr
+	not  bool
+}
+
+func (e *isNull) eval(ctx *evalContext) Value {
+	return boolValue(e.expr.eval(ctx).IsNull() != e.not)
+}
+
+type inList struct {
+	expr expr
+	list []expr
+}
+
+func (e *inList) eval(ctx *evalContext) Value {
+	v := e.expr.eval(ctx)
+	result := boolValue(false)
+	for _, item := range e.list {
+		c, ok := compareValues(v, item.eval(ctx))
+		if !ok {
+			result = nullValue
+			continue
+		}
+		if c == 0 {
+			return boolValue(true)
+		}
+	}
+	return result
+}
+
+type like struct {
+	expr, pattern, escape expr
+}
+
+func (e *like) eval(ctx *evalContext) Value {
+	v, pattern := e.expr.eval(ctx), e.pattern.eval(ctx)
+	if !isText(v) || !isText(pattern) {
+		return nullValue
+	}
+	escape := rune(0)
+	if e.escape != nil {
+		escapeValue := e.escape.eval(ctx)
+		if !isText(escapeValue) || utf8.RuneCountInString(escapeValue.Str) != 1 {
+			return nullValue
+		}
+		escape, _ = utf8.DecodeRuneInString(escapeValue.Str)
+	}
+	return boolValue(matchLike([]rune(v.Str), []rune(pattern.Str), escape))
+}
+
+func isText(v Value) bool {
+	return v.Type == String || v.Type == Text
+}
+
+// matchLike matches the sql LIKE pattern, where % matches any characters, and _ matches one character
+func matchLike(s, pattern []rune, escape rune) bool {
+	for len(pattern) > 0 {
+		c := pattern[0]
+		switch {
+		case escape != 0 && c == escape && len(pattern) > 1:
+			if len(s) == 0 || s[0] != pattern[1] {
+				return false
+			}
+			s, pattern = s[1:], pattern[2:]
+		case c == '%':
+			for len(pattern) > 0 && pattern[0] == '%' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 0 {
+				return true
+			}
+			for i := 0; i <= len(s); i++ {
+				if matchLike(s[i:], pattern, escape) {
+					return true
+				}
+			}
+			return false
+		case c == '_':
+			if len(s) == 0 {
+				return false
+			}
+			s, pattern = s[1:], pattern[1:]
+		default:
+			if len(s) == 0 || s[0] != c {
+				return false
+			}
+			s, pattern = s[1:], pattern[1:]
+		}
+	}
+	return len(s) == 0
+}
+
+type arithmetic struct {
+	op          string
+	left, right expr
+}
+
+func (e *arithmetic) eval(ctx *evalContext) Value {
+	a, aok := e.left.eval(ctx).number()
+	b, bok := e.right.eval(ctx).number()
+	if !aok || !bok {
+		return nullValue
+	}
+	switch e.op {
+	case "+":
+		return numberValue(a + b)
+	case "-":
+		return numberValue(a - b)
+	case "*":
+		return numberValue(a * b)
+	case "/":
+		if b == 0 {
+			return nullValue
+		}
+		return numberValue(a / b)
+	case "%":
+		if b == 0 {
+			return nullValue
+		}
+		return numberValue(math.Mod(a, b))
+	}
+	return nullValue
+}
+
+// scalarFunctions maps the function names to the number of arguments, -1 for any number of arguments
+var scalarFunctions = map[string]int{
+	"LOWER":            1,
+	"UPPER":            1,
+	"TRIM":             1,
+	"CHAR_LENGTH":      1,
+	"CHARACTER_LENGTH": 1,
+	"COALESCE":         -1,
+}
+
+type function struct {
+	name string
+	args []expr
+}
+
+func (e *function) eval(ctx *evalContext) Value {
+	if e.name == "COALESCE" {
+		for _, arg := range e.args {
+			if v := arg.eval(ctx); !v.IsNull() {
+				return v
+			}
+		}
+		return nullValue
+	}
+
+	v := e.args[0].eval(ctx)
+	if v.IsNull() {
+		return nullValue
+	}
+	s := v.String()
+	switch e.name {
+	case "LOWER":
+		return stringValue(strings.ToLower(s))
+	case "UPPER":
+		return stringValue(strings.ToUpper(s))
+	case "TRIM":
+		return stringValue(strings.TrimSpace(s))
+	case "CHAR_LENGTH", "CHARACTER_LENGTH":
+		return numberValue(float64(utf8.RuneCountInString(s)))
+	}
+	return nullValue
+}
+
+var castTypes = map[string]bool{
+	"INT": true, "INTEGER": true, "FLOAT": true, "DECIMAL": true, "NUMERIC": true,
+	"STRING": true, "VARCHAR": true, "BOOL": true, "BOOLEAN": true,
+}
+
+type cast struct {
+	expr expr
+	to   string
+}
+
+// eval converts the value, and returns null if it can not be converted
+func (e *cast) eval(ctx *evalContext) Value {
+	v := e.expr.eval(ctx)
+	if v.IsNull() {
+		return nullValue
+	}
+	switch e.to {
+	case "INT", "INTEGER":
+		if f, ok := toNumber(v); ok {
+			return numberValue(math.Trunc(f))
+		}
+	case "FLOAT", "DECIMAL", "NUMERIC":
+		if f, ok := toNumber(v); ok {
+			return numberValue(f)
+		}
+	case "STRING", "VARCHAR":
+		return stringValue(v.String())
+	case "BOOL", "BOOLEAN":
+		if v.Type == Bool {
+			return v
+		}
+		if b, err := strconv.ParseBool(strings.TrimSpace(v.String())); err == nil {
+			return boolValue(b)
+		}
+	}
+	return nullValue
+}
+
+func toNumber(v Value) (float64, bool) {
+	if v.Type == String {
+		f, err := strconv.ParseFloat(strings.TrimSpace(v.Str), 64)
+		return f, err == nil
+	}
+	return v.number()
+}
+
+type aggregate struct {
+	name string
+	// arg is nil for COUNT(*)
+	arg expr
+}
+
+type aggregateState struct {
+	count int64
+	sum   float64
+	value Value
+}
+
+func (e *aggregate) accumulate(ctx *evalContext, state *aggregateState) {
+	if e.arg == nil {
+		state.count++
+		return
+	}
+	v := e.arg.eval(ctx)
+	if v.IsNull() {
+		return
+	}
+	switch e.name {
+	case "COUNT":
+		state.count++
+	case "SUM", "AVG":
+		if f, ok := v.number(); ok {
+			state.count++
+			state.sum += f
+		}
+	case "MIN", "MAX":
+		if state.value.IsNull() {
+			state.value = v
+			return
+		}
+		if c, ok := compareValues(v, state.value); ok && (c < 0 && e.name == "MIN" || c > 0 && e.name == "MAX") {
+			state.value = v
+		}
+	}
+}
+
+func (e *aggregate) eval(ctx *evalContext) Value {
+	state, found := ctx.aggregates[e]
+	if !found {
+		return nullValue
+	}
+	switch e.name {
+	case "COUNT":
+		return numberValue(float64(state.count))
+	case "SUM":
+		if state.count == 0 {
+			return nullValue
+		}
+		return numberValue(state.sum)
+	case "AVG":
+		if state.count == 0 {
+			return nullValue
+		}
+		return numberValue(state.sum / float64(state.count))
+	}
+	return state.value
+}
+
+// walk visits the expression tree in depth first order
+func walk(e expr, visit func(expr)) {
+	if e == nil {
+		return
+	}
+	visit(e)
+	switch t := e.(type) {
+	case *logical:
+		walk(t.left, visit)
+		walk(t.right, visit)
+	case *not:
+		walk(t.expr, visit)
+	case *comparison:
+		walk(t.left, visit)
+		walk(t.right, visit)
+	case *isNull:
+		walk(t.expr, visit)
+	case *inList:
+		walk(t.expr, visit)
+		for _, item := range t.list {
+			walk(item, visit)
+		}
+	case *like:
+		walk(t.expr, visit)
+		walk(t.pattern, visit)
+		walk(t.escape, visit)
+	case *arithmetic:
+		walk(t.left, visit)
+		walk(t.right, visit)
+	case *function:
+		for _, arg := range t.args {
+			walk(arg, visit)
+		}
+	case *cast:
+		walk(t.expr, visit)
+	case *aggregate:
+		walk(t.arg, visit)
+	}
+}
+
+func containsAggregate(e expr) (found bool) {
+	walk(e, func(e expr) {
+		if _, ok := e.(*aggregate); ok {
+			found = true
+		}
+	})
+	return
+}
@@ -0,0 +1,626 @@
+package s3select
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenIdent
+	tokenQuotedIdent
+	tokenString
+	tokenNumber
+	tokenSymbol
+)
+
+type token struct {
+	typ  tokenType
+	text string
+}
+
+func (t token) is(keyword string) bool {
+	return t.typ == tokenIdent && strings.EqualFold(t.text, keyword)
+}
+
+func (t token) isSymbol(symbol string) bool {
+	return t.typ == tokenSymbol && t.text == symbol
+}
+
+func tokenize(sql string) (tokens []token, err error) {
+	runes := []rune(sql)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case unicode.IsLetter(c) || c == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{tokenIdent, string(runes[start:i])})
+		case unicode.IsDigit(c) || c == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1]):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				i++
+				if i < len(runes) && (runes[i] == '+' || runes[i] == '-') {
+					i++
+				}
+				for i < len(runes) && unicode.IsDigit(runes[i]) {
+					i++
+				}
+			}
+			tokens = append(tokens, token{tokenNumber, string(runes[start:i])})
+		case c == '\'' || c == '"':
+			// a doubled quote stands for the quote itself
+			var text []rune
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, fmt.Errorf("unterminated quote %c", c)
+				}
+				if runes[i] == c {
+					if i+1 < len(runes) && runes[i+1] == c {
+						text = append(text, c)
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				text = append(text, runes[i])
+				i++
+			}
+			typ := tokenString
+			if c == '"' {
+				typ = tokenQuotedIdent
+			}
+			tokens = append(tokens, token{typ, string(text)})
+		default:
+			symbol := string(c)
+			if i+1 < len(runes) {
+				switch two := string(runes[i : i+2]); two {
+				case "!=", "<>", "<=", ">=":
+					symbol = two
+				}
+			}
+			if !strings.Contains("*,().[]=<>+-/%!", string(c)) {
+				return nil, fmt.Errorf("unexpected character %q", c)
+			}
+			tokens = append(tokens, token{tokenSymbol, symbol})
+			i += len(symbol)
+		}
+	}
+	return append(tokens, token{typ: tokenEOF}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	alias  string
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.typ != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) acceptKeyword(keyword string) bool {
+	if p.peek().is(keyword) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) acceptSymbol(symbol string) bool {
+	if p.peek().isSymbol(symbol) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expectKeyword(keyword string) error {
+	if !p.acceptKeyword(keyword) {
+		return fmt.Errorf("expect %s instead of %q", keyword, p.peek().text)
+	}
+	return nil
+}
+
+func (p *parser) expectSymbol(symbol string) error {
+	if !p.acceptSymbol(symbol) {
+		return fmt.Errorf("expect %s instead of %q", symbol, p.peek().text)
+	}
+	return nil
+}
+
+var reservedWords = map[string]bool{
+	"SELECT": true, "FROM": true, "WHERE": true, "LIMIT": true, "AS": true,
+	"AND": true, "OR": true, "NOT": true, "LIKE": true, "ESCAPE": true, "IS": true, "NULL": true,
+	"BETWEEN": true, "IN": true, "TRUE": true, "FALSE": true, "MISSING": true,
+}
+
+func isReserved(t token) bool {
+	return t.typ == tokenIdent && reservedWords[strings.ToUpper(t.text)]
+}
+
+// Parse parses the supported sql subset:
+//
+//	SELECT * | expression [[AS] name], ... FROM S3Object[[*]] [[AS] alias] [WHERE condition] [LIMIT n]
+func Parse(sql string) (*Query, error) {
+	tokens, err := tokenize(sql)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	q := &Query{limit: -1}
+
+	if err = p.expectKeyword("SELECT"); err != nil {
+		return nil, err
+	}
+
+	// the projections are parsed after the alias is known
+	projectionStart := p.pos
+	for depth := 0; ; p.next() {
+		t := p.peek()
+		if t.typ == tokenEOF {
+			return nil, fmt.Errorf("missing FROM")
+		}
+		if depth == 0 && t.is("FROM") {
+			break
+		}
+		if t.isSymbol("(") {
+			depth++
+		} else if t.isSymbol(")") {
+			depth--
+		}
+	}
+
+	p.next()
+	if !p.acceptKeyword("S3Object") {
+		return nil, fmt.Errorf("expect S3Object instead of %q", p.peek().text)
+	}
+	if p.acceptSymbol("[") {
+		if err = p.expectSymbol("*"); err != nil {
+			return nil, err
+		}
+		if err = p.expectSymbol("]"); err != nil {
+			return nil, err
+		}
+	}
+	p.acceptKeyword("AS")
+	if t := p.peek(); (t.typ == tokenIdent || t.typ == tokenQuotedIdent) && !isReserved(t) {
+		p.alias = p.next().text
+	}
+	if p.acceptKeyword("WHERE") {
+		if q.where, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+		if containsAggregate(q.where) {
+			return nil, fmt.Errorf("aggregate functions are not allowed in WHERE")
+		}
+	}
+	if p.acceptKeyword("LIMIT") {
+		t := p.next()
+		limit, parseErr := strconv.ParseInt(t.text, 10, 64)
+		if t.typ != tokenNumber || parseErr != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid LIMIT %q", t.text)
+		}
+		q.limit = limit
+	}
+	if t := p.peek(); t.typ != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q", t.text)
+	}
+
+	// parse the projections
+	p.pos = projectionStart
+	if err = p.parseProjections(q); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+func (p *parser) parseProjections(q *Query) error {
+	if p.acceptSymbol("*") {
+		q.selectAll = true
+		if !p.peek().is("FROM") {
+			return fmt.Errorf("unexpected %q after *", p.peek().text)
+		}
+		return nil
+	}
+	aggregates := 0
+	for {
+		e, err := p.parseExpr()
+		if err != nil {
+			return err
+		}
+		proj := projection{expr: e}
+		if p.acceptKeyword("AS") {
+			t := p.next()
+			if t.typ != tokenIdent && t.typ != tokenQuotedIdent {
+				return fmt.Errorf("invalid alias %q", t.text)
+			}
+			proj.name = t.text
+		} else if t := p.peek(); (t.typ == tokenIdent || t.typ == tokenQuotedIdent) && !isReserved(t) {
+			proj.name = p.next().text
+		}
+		if proj.name == "" {
+			if c, ok := e.(*column); ok {
+				proj.name = c.path[len(c.path)-1]
+			} else {
+				proj.name = "_" + strconv.Itoa(len(q.projections)+1)
+			}
+		}
+		if containsAggregate(e) {
+			aggregates++
+		}
+		q.projections = append(q.projections, proj)
+		if !p.acceptSymbol(",") {
+			break
+		}
+	}
+	if !p.peek().is("FROM") {
+		return fmt.Errorf("unexpected %q", p.peek().text)
+	}
+	if aggregates > 0 {
+		if aggregates != len(q.projections) {
+			return fmt.Errorf("aggregate functions can not be mixed with other projections")
+		}
+		q.isAggregate = true
+	}
+	return nil
+}
+
+func (p *parser) parseExpr() (expr, error) {
+	return p.parseOr()
+}
+
+func (p *parser) parseOr() (expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKeyword("OR") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logical{op: "OR", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKeyword("AND") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &logical{op: "AND", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (expr, error) {
+	if p.acceptKeyword("NOT") {
+		e, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &not{expr: e}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (expr, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	t := p.peek()
+	if t.typ == tokenSymbol {
+		switch t.text {
+		case "=", "!=", "<>", "<", "<=", ">", ">=":
+			p.next()
+			right, err := p.parseAdditive()
+			if err != nil {
+				return nil, err
+			}
+			op := t.text
+			if op == "<>" {
+				op = "!="
+			}
+			return &comparison{op: op, left: left, right: right}, nil
+		}
+		return left, nil
+	}
+
+	if p.acceptKeyword("IS") {
+		isNot := p.acceptKeyword("NOT")
+		if !p.acceptKeyword("NULL") && !p.acceptKeyword("MISSING") {
+			return nil, fmt.Errorf("expect NULL after IS")
+		}
+		return &isNull{expr: left, not: isNot}, nil
+	}
+
+	isNot := false
+	if p.peek().is("NOT") && (p.tokens[p.pos+1].is("LIKE") || p.tokens[p.pos+1].is("BETWEEN") || p.tokens[p.pos+1].is("IN")) {
+		p.next()
+		isNot = true
+	}
+
+	var e expr
+	switch {
+	case p.acceptKeyword("LIKE"):
+		pattern, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		l := &like{expr: left, pattern: pattern}
+		if p.acceptKeyword("ESCAPE") {
+			if l.escape, err = p.parseAdditive(); err != nil {
+				return nil, err
+			}
+		}
+		e = l
+	case p.acceptKeyword("BETWEEN"):
+		low, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expectKeyword("AND"); err != nil {
+			return nil, err
+		}
+		high, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		e = &logical{op: "AND",
+			left:  &comparison{op: ">=", left: left, right: low},
+			right: &comparison{op: "<=", left: left, right: high},
+		}
+	case p.acceptKeyword("IN"):
+		if err = p.expectSymbol("("); err != nil {
+			return nil, err
+		}
+		in := &inList{expr: left}
+		for {
+			item, err := p.parseAdditive()
+			if err != nil {
+				return nil, err
+			}
+			in.list = append(in.list, item)
+			if !p.acceptSymbol(",") {
+				break
+			}
+		}
+		if err = p.expectSymbol(")"); err != nil {
+			return nil, err
+		}
+		e = in
+	default:
+		return left, nil
+	}
+	if isNot {
+		e = &not{expr: e}
+	}
+	return e, nil
+}
+
+func (p *parser) parseAdditive() (expr, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if !t.isSymbol("+") && !t.isSymbol("-") {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = &arithmetic{op: t.text, left: left, right: right}
+	}
+}
+
+func (p *parser) parseMultiplicative() (expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if !t.isSymbol("*") && !t.isSymbol("/") && !t.isSymbol("%") {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &arithmetic{op: t.text, left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary() (expr, error) {
+	if p.acceptSymbol("-") {
+		e, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &arithmetic{op: "-", left: &literal{value: numberValue(0)}, right: e}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (expr, error) {
+	t := p.next()
+	switch t.typ {
+	case tokenNumber:
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", t.text)
+		}
+		return &literal{value: numberValue(f)}, nil
+	case tokenString:
+		return &literal{value: stringValue(t.text)}, nil
+	case tokenSymbol:
+		if t.text == "(" {
+			e, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err = p.expectSymbol(")"); err != nil {
+				return nil, err
+			}
+			return e, nil
+		}
+	case tokenQuotedIdent:
+		return p.parseColumn(t)
+	case tokenIdent:
+		switch strings.ToUpper(t.text) {
+		case "NULL", "MISSING":
+			return &literal{value: nullValue}, nil
+		case "TRUE":
+			return &literal{value: boolValue(true)}, nil
+		case "FALSE":
+			return &literal{value: boolValue(false)}, nil
+		}
+		if isReserved(t) {
+			break
+		}
+		if p.peek().isSymbol("(") {
+			return p.parseFunction(t.text)
+		}
+		return p.parseColumn(t)
+	}
+	return nil, fmt.Errorf("unexpected %q", t.text)
+}
+
+// parseColumn reads the column path, such as s.name, s._1, name, "Quoted Name", s.a.b[0]
+func (p *parser) parseColumn(first token) (expr, error) {
+	path := []string{first.text}
+	quoted := []bool{first.typ == tokenQuotedIdent}
+	for {
+		if p.acceptSymbol(".") {
+			t := p.next()
+			if t.typ != tokenIdent && t.typ != tokenQuotedIdent {
+				return nil, fmt.Errorf("unexpected %q after .", t.text)
+			}
+			path = append(path, t.text)
+			quoted = append(quoted, t.typ == tokenQuotedIdent)
+			continue
+		}
+		if p.acceptSymbol("[") {
+			t := p.next()
+			if t.typ == tokenNumber {
+				if _, err := strconv.Atoi(t.text); err != nil {
+					return nil, fmt.Errorf("invalid index %q", t.text)
+				}
+			} else if t.typ != tokenString && t.typ != tokenQuotedIdent {
+				return nil, fmt.Errorf("invalid index %q", t.text)
+			}
+			path = append(path, t.text)
+			quoted = append(quoted, true)
+			if err := p.expectSymbol("]"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		break
+	}
+	if len(path) > 1 && !quoted[0] && (p.alias != "" && strings.EqualFold(path[0], p.alias) || strings.EqualFold(path[0], "S3Object")) {
+		path = path[1:]
+	}
+	return &column{path: path}, nil
+}
+
+var aggregateFunctions = map[string]bool{"COUNT": true, "SUM": true, "AVG": true, "MIN": true, "MAX": true}
+
+func (p *parser) parseFunction(name string) (expr, error) {
+	name = strings.ToUpper(name)
+	p.next() // (
+
+	if aggregateFunctions[name] {
+		agg := &aggregate{name: name}
+		if name == "COUNT" && p.acceptSymbol("*") {
+			return agg, p.expectSymbol(")")
+		}
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if containsAggregate(arg) {
+			return nil, fmt.Errorf("nested aggregate function %s", name)
+		}
+		agg.arg = arg
+		return agg, p.expectSymbol(")")
+	}
+
+	if name == "CAST" {
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expectKeyword("AS"); err != nil {
+			return nil, err
+		}
+		t := p.next()
+		if t.typ != tokenIdent {
+			return nil, fmt.Errorf("invalid CAST type %q", t.text)
+		}
+		c := &cast{expr: arg, to: strings.ToUpper(t.text)}
+		if !castTypes[c.to] {
+			return nil, fmt.Errorf("unsupported CAST type %s", t.text)
+		}
+		return c, p.expectSymbol(")")
+	}
+
+	if _, found := scalarFunctions[name]; !found {
+		return nil, fmt.Errorf("unsupported function %s", name)
+	}
+	f := &function{name: name}
+	if !p.acceptSymbol(")") {
+		for {
+			arg, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			f.args = append(f.args, arg)
+			if !p.acceptSymbol(",") {
+				break
+			}
+		}
+		if err := p.expectSymbol(")"); err != nil {
+			return nil, err
+		}
+	}
+	if argCount := scalarFunctions[name]; argCount >= 0 && len(f.args) != argCount || len(f.args) == 0 {
+		return nil, fmt.Errorf("wrong number of arguments for %s", name)
+	}
+	return f, nil
+}
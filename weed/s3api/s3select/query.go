@@ -0,0 +1,144 @@
+package s3select
+
+type projection struct {
+	expr expr
+	name string
+}
+
+// Query is a parsed SELECT statement
+type Query struct {
+	selectAll   bool
+	projections []projection
+	where       expr
+	// limit is -1 if there is no LIMIT
+	limit       int64
+	isAggregate bool
+}
+
+// Filter is a simple "column op value" condition, which every selected record must satisfy
+type Filter struct {
+	Path  []string
+	Op    string
+	Value Value
+}
+
+// Filters lists the top level AND conditions in the WHERE clause comparing a column with a literal.
+// These can be evaluated separately to skip records early.
+func (q *Query) Filters() (filters []Filter) {
+	var visit func(e expr)
+	visit = func(e expr) {
+		switch t := e.(type) {
+		case *logical:
+			if t.op == "AND" {
+				visit(t.left)
+				visit(t.right)
+			}
+		case *comparison:
+			if c, ok := t.left.(*column); ok {
+				if l, ok := t.right.(*literal); ok && !l.value.IsNull() {
+					filters = append(filters, Filter{Path: c.path, Op: t.op, Value: l.value})
+				}
+			} else if c, ok := t.right.(*column); ok {
+				if l, ok := t.left.(*literal); ok && !l.value.IsNull() {
+					filters = append(filters, Filter{Path: c.path, Op: flipOperator(t.op), Value: l.value})
+				}
+			}
+		}
+	}
+	visit(q.where)
+	return
+}
+
+func flipOperator(op string) string {
+	switch op {
+	case "<":
+		return ">"
+	case "<=":
+		return ">="
+	case ">":
+		return "<"
+	case ">=":
+		return "<="
+	}
+	return op
+}
+
+// RecordWriter serializes the selected rows
+type RecordWriter interface {
+	WriteRow(buf []byte, names []string, values []Value) []byte
+}
+
+// Selector runs the query over the records of one object
+type Selector struct {
+	query      *Query
+	writer     RecordWriter
+	returned   int64
+	aggregates map[*aggregate]*aggregateState
+}
+
+func NewSelector(query *Query, writer RecordWriter) *Selector {
+	s := &Selector{
+		query:  query,
+		writer: writer,
+	}
+	if query.isAggregate {
+		s.aggregates = make(map[*aggregate]*aggregateState)
+		for _, p := range query.projections {
+			walk(p.expr, func(e expr) {
+				if agg, ok := e.(*aggregate); ok {
+					s.aggregates[agg] = &aggregateState{}
+				}
+			})
+		}
+	}
+	return s
+}
+
+// Done is true when no more records are needed
+func (s *Selector) Done() bool {
+	return !s.query.isAggregate && s.query.limit >= 0 && s.returned >= s.query.limit
+}
+
+// Select appends the output of the record to buf, if the record is selected
+func (s *Selector) Select(buf []byte, record Record) []byte {
+	if s.Done() {
+		return buf
+	}
+	ctx := &evalContext{record: record}
+	if s.query.where != nil && !s.query.where.eval(ctx).truthy() {
+		return buf
+	}
+
+	if s.query.isAggregate {
+		for agg, state := range s.aggregates {
+			agg.accumulate(ctx, state)
+		}
+		return buf
+	}
+
+	s.returned++
+	if s.query.selectAll {
+		names, values := record.Columns()
+		return s.writer.WriteRow(buf, names, values)
+	}
+	return s.writeProjections(buf, ctx)
+}
+
+// Finish appends the aggregated row, if the query has aggregate functions
+func (s *Selector) Finish(buf []byte) []byte {
+	if !s.query.isAggregate || s.query.limit == 0 {
+		return buf
+	}
+	s.returned++
+	return s.writeProjections(buf, &evalContext{aggregates: s.aggregates})
+}
+
+func (s *Selector) writeProjections(buf []byte, ctx *evalContext) []byte {
+	names := make([]string, len(s.query.projections))
+	values := make([]Value, len(s.query.projections))
+	for i, p := range s.query.projections {
+		names[i] = p.name
+		values[i] = p.expr.eval(ctx)
+	}
+	return s.writer.WriteRow(buf, names, values)
+}
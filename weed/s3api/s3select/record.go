@@ -0,0 +1,98 @@
+package s3select
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// Record is one csv row or one json document of the object
+type Record interface {
+	// Get returns the value of the column path, or null if it is missing
+	Get(path []string) Value
+	// Columns lists all the top level columns, as selected by SELECT *
+	Columns() (names []string, values []Value)
+}
+
+type CsvRecord struct {
+	Header []string
+	Fields []string
+}
+
+// Get looks up the field by the header name, or by the position as _1, _2, ...
+func (r *CsvRecord) Get(path []string) Value {
+	if len(path) != 1 {
+		return nullValue
+	}
+	name := path[0]
+	for i, h := range r.Header {
+		if h == name && i < len(r.Fields) {
+			return textValue(r.Fields[i])
+		}
+	}
+	for i, h := range r.Header {
+		if strings.EqualFold(h, name) && i < len(r.Fields) {
+			return textValue(r.Fields[i])
+		}
+	}
+	if strings.HasPrefix(name, "_") {
+		if i, err := strconv.Atoi(name[1:]); err == nil && 0 < i && i <= len(r.Fields) {
+			return textValue(r.Fields[i-1])
+		}
+	}
+	return nullValue
+}
+
+func (r *CsvRecord) Columns() (names []string, values []Value) {
+	for i, field := range r.Fields {
+		if i < len(r.Header) {
+			names = append(names, r.Header[i])
+		} else {
+			names = append(names, "_"+strconv.Itoa(i+1))
+		}
+		values = append(values, textValue(field))
+	}
+	return
+}
+
+type JsonRecord struct {
+	Raw string
+}
+
+func (r *JsonRecord) Get(path []string) Value {
+	escaped := make([]string, len(path))
+	for i, p := range path {
+		escaped[i] = escapeJsonPath(p)
+	}
+	result := gjson.Get(r.Raw, strings.Join(escaped, "."))
+	if !result.Exists() {
+		return nullValue
+	}
+	return jsonValue(result)
+}
+
+func (r *JsonRecord) Columns() (names []string, values []Value) {
+	parsed := gjson.Parse(r.Raw)
+	if !parsed.IsObject() {
+		return []string{"_1"}, []Value{jsonValue(parsed)}
+	}
+	parsed.ForEach(func(key, value gjson.Result) bool {
+		names = append(names, key.String())
+		values = append(values, jsonValue(value))
+		return true
+	})
+	return
+}
+
+func escapeJsonPath(name string) string {
+	var b strings.Builder
+	for _, c := range name {
+		switch c {
+		case '.', '*', '?', '|', '#', '@', '\\', '!', '=', '<', '>', '%', '(', ')', '[', ']', '{', '}', ',', ':':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
@@ -0,0 +1,125 @@
+package s3select
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/private/protocol/eventstream"
+)
+
+func selectAll(t *testing.T, sql string, writer RecordWriter, records ...Record) string {
+	q, err := Parse(sql)
+	if err != nil {
+		t.Fatalf("parse %s: %v", sql, err)
+	}
+	s := NewSelector(q, writer)
+	var buf []byte
+	for _, r := range records {
+		buf = s.Select(buf, r)
+	}
+	return string(s.Finish(buf))
+}
+
+func TestSelectCsv(t *testing.T) {
+	header := []string{"name", "city", "age"}
+	records := []Record{
+		&CsvRecord{Header: header, Fields: []string{"John", "Paris", "42"}},
+		&CsvRecord{Header: header, Fields: []string{"Jane", "London", "7"}},
+		&CsvRecord{Header: header, Fields: []string{"Joe", "Paris", "19"}},
+	}
+
+	tests := []struct {
+		sql      string
+		expected string
+	}{
+		{"SELECT * FROM S3Object", "John,Paris,42\nJane,London,7\nJoe,Paris,19\n"},
+		{"select s.name from S3Object s where s.age > 10 and city = 'Paris'", "John\nJoe\n"},
+		{"SELECT _1, _3 FROM S3Object WHERE age < 10 OR name LIKE '%oe'", "Jane,7\nJoe,19\n"},
+		{"SELECT name FROM S3Object[*] WHERE age BETWEEN 10 AND 20 OR city IN ('London')", "Jane\nJoe\n"},
+		{"SELECT name FROM S3Object WHERE NOT city = 'Paris' LIMIT 5", "Jane\n"},
+		{"SELECT UPPER(name), CAST(age AS INT) + 1 FROM S3Object LIMIT 1", "JOHN,43\n"},
+		{"SELECT COUNT(*), SUM(age), AVG(CAST(age AS FLOAT)), MIN(name), MAX(age) FROM S3Object WHERE city = 'Paris'", "2,61,30.5,Joe,42\n"},
+		{"SELECT name FROM S3Object WHERE missing IS NULL LIMIT 0", ""},
+	}
+	for _, tt := range tests {
+		if actual := selectAll(t, tt.sql, &CsvWriter{}, records...); actual != tt.expected {
+			t.Errorf("%s: expected %q, actual %q", tt.sql, tt.expected, actual)
+		}
+	}
+}
+
+func TestSelectJson(t *testing.T) {
+	records := []Record{
+		&JsonRecord{Raw: `{"name":"John","address":{"city":"Paris"},"tags":["a","b"],"age":42,"admin":true}`},
+		&JsonRecord{Raw: `{"name":"Jane","address":{"city":"London"},"tags":[],"age":7.5}`},
+	}
+
+	tests := []struct {
+		sql      string
+		expected string
+	}{
+		{"SELECT s.name, s.address.city FROM S3Object s WHERE s.age >= 7.5 AND s.tags[1] = 'b'", `{"name":"John","city":"Paris"}` + "\n"},
+		{"SELECT s.age AS years, s.admin FROM S3Object s", `{"years":42,"admin":true}` + "\n" + `{"years":7.5,"admin":null}` + "\n"},
+		{"SELECT * FROM S3Object WHERE admin = false", ""},
+		{"SELECT COUNT(s.admin), COUNT(*) FROM S3Object s", `{"_1":1,"_2":2}` + "\n"},
+	}
+	for _, tt := range tests {
+		if actual := selectAll(t, tt.sql, &JsonWriter{}, records...); actual != tt.expected {
+			t.Errorf("%s: expected %q, actual %q", tt.sql, tt.expected, actual)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, sql := range []string{
+		"SELECT name",
+		"SELECT name FROM table",
+		"SELECT name, COUNT(*) FROM S3Object",
+		"SELECT name FROM S3Object WHERE COUNT(*) > 1",
+		"SELECT name FROM S3Object WHERE name = 'a",
+		"SELECT name FROM S3Object LIMIT -1",
+		"SELECT foo(name) FROM S3Object",
+	} {
+		if _, err := Parse(sql); err == nil {
+			t.Errorf("%s: expected error", sql)
+		}
+	}
+}
+
+func TestFilters(t *testing.T) {
+	q, err := Parse("SELECT * FROM S3Object s WHERE s.age > 3 AND 'x' = name AND (a = 1 OR b = 2)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filters := q.Filters()
+	if len(filters) != 2 || filters[0].Path[0] != "age" || filters[1].Op != "=" || filters[1].Value.Str != "x" {
+		t.Errorf("filters: %+v", filters)
+	}
+}
+
+func TestEventStream(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewEventStreamWriter(&buf)
+	if err := w.WriteRecords([]byte("a,b\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteStats(10, 10, 4); err != nil {
+		t.Fatal(err)
+	}
+
+	decoder := eventstream.NewDecoder(&buf)
+	msg, err := decoder.Decode(nil)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if string(msg.Payload) != "a,b\n" || msg.Headers.Get(":event-type").String() != "Records" {
+		t.Errorf("records message: %+v", msg)
+	}
+	msg, err = decoder.Decode(nil)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if !bytes.Contains(msg.Payload, []byte("<BytesReturned>4</BytesReturned>")) {
+		t.Errorf("stats message: %s", msg.Payload)
+	}
+}
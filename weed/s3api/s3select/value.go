@@ -0,0 +1,138 @@
+package s3select
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+type ValueType int
+
+const (
+	Null ValueType = iota
+	Bool
+	Number
+	String
+	// Text is an untyped csv field, which is a number when it looks like one
+	Text
+	// Json is a json object or array
+	Json
+)
+
+type Value struct {
+	Type ValueType
+	Bool bool
+	Num  float64
+	Str  string
+	// Raw keeps the original json text of numbers, objects and arrays
+	Raw string
+}
+
+var nullValue = Value{Type: Null}
+
+func boolValue(b bool) Value {
+	return Value{Type: Bool, Bool: b}
+}
+
+func numberValue(f float64) Value {
+	return Value{Type: Number, Num: f}
+}
+
+func stringValue(s string) Value {
+	return Value{Type: String, Str: s}
+}
+
+func textValue(s string) Value {
+	return Value{Type: Text, Str: s}
+}
+
+func jsonValue(result gjson.Result) Value {
+	switch result.Type {
+	case gjson.True:
+		return boolValue(true)
+	case gjson.False:
+		return boolValue(false)
+	case gjson.Number:
+		return Value{Type: Number, Num: result.Num, Raw: result.Raw}
+	case gjson.String:
+		return stringValue(result.Str)
+	case gjson.JSON:
+		return Value{Type: Json, Raw: result.Raw}
+	}
+	return nullValue
+}
+
+func (v Value) IsNull() bool {
+	return v.Type == Null
+}
+
+// number returns the numeric value of numbers, and of the csv fields that look like numbers
+func (v Value) number() (float64, bool) {
+	switch v.Type {
+	case Number:
+		return v.Num, true
+	case Text:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v.Str), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// String is the text form of the value, as written in csv output
+func (v Value) String() string {
+	switch v.Type {
+	case Bool:
+		return strconv.FormatBool(v.Bool)
+	case Number:
+		if v.Raw != "" {
+			return v.Raw
+		}
+		return formatNumber(v.Num)
+	case String, Text:
+		return v.Str
+	case Json:
+		return v.Raw
+	}
+	return ""
+}
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func (v Value) truthy() bool {
+	return v.Type == Bool && v.Bool
+}
+
+// compareValues compares as numbers when both values are numeric, and as strings otherwise.
+// Comparing with null, booleans with others, or json objects is not defined.
+func compareValues(a, b Value) (int, bool) {
+	if a.IsNull() || b.IsNull() || a.Type == Json || b.Type == Json {
+		return 0, false
+	}
+	if an, ok := a.number(); ok {
+		if bn, ok := b.number(); ok {
+			switch {
+			case an < bn:
+				return -1, true
+			case an > bn:
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	if a.Type == Bool || b.Type == Bool {
+		if a.Type != b.Type {
+			return 0, false
+		}
+		switch {
+		case a.Bool == b.Bool:
+			return 0, true
+		case !a.Bool:
+			return -1, true
+		}
+		return 1, true
+	}
+	return strings.Compare(a.String(), b.String()), true
+}
@@ -0,0 +1,65 @@
+package s3select
+
+import (
+	"math"
+
+	"github.com/chrislusf/seaweedfs/weed/query/csv"
+	"github.com/chrislusf/seaweedfs/weed/query/json"
+)
+
+type CsvWriter struct {
+	Output csv.Output
+}
+
+func (w *CsvWriter) WriteRow(buf []byte, names []string, values []Value) []byte {
+	texts := make([]string, len(values))
+	for i, v := range values {
+		texts[i] = v.String()
+	}
+	return csv.ToCsv(buf, texts, w.Output)
+}
+
+type JsonWriter struct {
+	// RecordDelimiter defaults to "\n"
+	RecordDelimiter string
+}
+
+func (w *JsonWriter) WriteRow(buf []byte, names []string, values []Value) []byte {
+	buf = append(buf, '{')
+	for i, v := range values {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = json.ToJsonString(buf, names[i])
+		buf = append(buf, ':')
+		buf = appendJsonValue(buf, v)
+	}
+	buf = append(buf, '}')
+	if w.RecordDelimiter == "" {
+		return append(buf, '\n')
+	}
+	return append(buf, w.RecordDelimiter...)
+}
+
+func appendJsonValue(buf []byte, v Value) []byte {
+	switch v.Type {
+	case Bool:
+		if v.Bool {
+			return append(buf, "true"...)
+		}
+		return append(buf, "false"...)
+	case Number:
+		if v.Raw != "" {
+			return append(buf, v.Raw...)
+		}
+		if math.IsNaN(v.Num) || math.IsInf(v.Num, 0) {
+			break
+		}
+		return append(buf, formatNumber(v.Num)...)
+	case String, Text:
+		return json.ToJsonString(buf, v.Str)
+	case Json:
+		return append(buf, v.Raw...)
+	}
+	return append(buf, "null"...)
+}
@@ -30,8 +30,18 @@ func track(f http.HandlerFunc, action string) http.HandlerFunc {
 		w.Header().Set("Server", "SeaweedFS S3")
 		recorder := NewStatusResponseWriter(w)
 		start := time.Now()
+		stats_collect.S3InFlightRequestGauge.Inc()
 		f(recorder, r)
+		stats_collect.S3InFlightRequestGauge.Dec()
 		stats_collect.S3RequestHistogram.WithLabelValues(action).Observe(time.Since(start).Seconds())
 		stats_collect.S3RequestCounter.WithLabelValues(action, strconv.Itoa(recorder.Status)).Inc()
 	}
 }
+
+func recordThrottledRequest(rule, reason string) {
+	stats_collect.S3ThrottledRequestCounter.WithLabelValues(rule, reason).Inc()
+}
+
+func recordThrottledDelay(rule string, delay time.Duration) {
+	stats_collect.S3ThrottledSecondsCounter.WithLabelValues(rule).Add(delay.Seconds())
+}
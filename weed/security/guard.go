@@ -8,23 +8,48 @@ import (
 	"strings"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/stats"
+	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
 var (
 	ErrUnauthorized = errors.New("unauthorized token")
 )
 
+// AccessLevel classifies the http endpoints, each level having its own ip allow-list
+type AccessLevel int
+
+const (
+	AccessRead AccessLevel = iota
+	AccessWrite
+	AccessAdmin
+	accessLevelCount
+)
+
+func (level AccessLevel) String() string {
+	switch level {
+	case AccessRead:
+		return "read"
+	case AccessWrite:
+		return "write"
+	case AccessAdmin:
+		return "admin"
+	}
+	return fmt.Sprintf("AccessLevel(%d)", int(level))
+}
+
 /*
 Guard is to ensure data access security.
 There are 2 ways to check access:
-1. white list. It's checking request ip address.
+1. ip allow-lists of the read, write and admin endpoints. It's checking request ip address,
+   from the X-Forwarded-For header only if the request comes from a trusted proxy.
 2. JSON Web Token(JWT) generated from secretKey.
   The jwt can come from:
   1. url parameter jwt=...
   2. request header "Authorization"
   3. cookie with the name "jwt"
 
-The white list is checked first because it is easy.
+The allow-list is checked first because it is easy.
 Then the JWT is checked.
 
 The Guard will also check these claims if provided:
@@ -32,7 +57,8 @@ The Guard will also check these claims if provided:
 2. "nbf" Not Before
 
 Generating JWT:
-1. use HS256 to sign
+1. use HS256 to sign with the shared key, or RS256/ES256 with a private key,
+   the "kid" header selecting one of the public keys to verify it
 2. optionally set "exp", "nbf" fields, in Unix time,
    the number of seconds elapsed since January 1, 1970 UTC.
 
@@ -41,34 +67,77 @@ https://github.com/pkieltyka/jwtauth/blob/master/jwtauth.go
 
 */
 type Guard struct {
-	whiteList           []string
-	SigningKey          SigningKey
+	// server is the kind of server, "master", "volume" or "filer"
+	server         string
+	allowLists     [accessLevelCount]*IpAllowList
+	trustedProxies *IpAllowList
+
+	SigningKeys         *JwtKeys
 	ExpiresAfterSec     int
-	ReadSigningKey      SigningKey
+	ReadSigningKeys     *JwtKeys
 	ReadExpiresAfterSec int
-
-	isWriteActive bool
 }
 
-func NewGuard(whiteList []string, signingKey string, expiresAfterSec int, readSigningKey string, readExpiresAfterSec int) *Guard {
+// NewGuard creates a guard, the white list restricting the write and admin endpoints
+func NewGuard(whiteList []string, signingKeys *JwtKeys, expiresAfterSec int, readSigningKeys *JwtKeys, readExpiresAfterSec int) *Guard {
 	g := &Guard{
-		whiteList:           whiteList,
-		SigningKey:          SigningKey(signingKey),
+		SigningKeys:         signingKeys,
 		ExpiresAfterSec:     expiresAfterSec,
-		ReadSigningKey:      SigningKey(readSigningKey),
+		ReadSigningKeys:     readSigningKeys,
 		ReadExpiresAfterSec: readExpiresAfterSec,
 	}
-	g.isWriteActive = len(g.whiteList) != 0 || len(g.SigningKey) != 0
+	allowList, err := NewIpAllowList(whiteList)
+	if err != nil {
+		glog.Fatalf("whiteList: %v", err)
+	}
+	g.allowLists[AccessWrite] = allowList
+	g.allowLists[AccessAdmin] = allowList
 	return g
 }
 
-func (g *Guard) WhiteList(f http.HandlerFunc) http.HandlerFunc {
-	if !g.isWriteActive {
+// LoadAllowLists reads the [guard] section of security.toml, overridden by [guard.<server>],
+// the lists replacing the white list if set
+func (g *Guard) LoadAllowLists(config *util.ViperProxy, server string) error {
+	g.server = server
+	getList := func(key string) (*IpAllowList, error) {
+		entries := config.GetStringSlice("guard." + server + "." + key)
+		if len(entries) == 0 {
+			entries = config.GetStringSlice("guard." + key)
+		}
+		if len(entries) == 0 {
+			return nil, nil
+		}
+		allowList, err := NewIpAllowList(entries)
+		if err != nil {
+			return nil, fmt.Errorf("guard %s: %v", key, err)
+		}
+		return allowList, nil
+	}
+	for level := AccessRead; level < accessLevelCount; level++ {
+		allowList, err := getList(level.String())
+		if err != nil {
+			return err
+		}
+		if allowList != nil {
+			g.allowLists[level] = allowList
+		}
+	}
+	trustedProxies, err := getList("trusted_proxies")
+	if err != nil {
+		return err
+	}
+	g.trustedProxies = trustedProxies
+	return nil
+}
+
+// Allow only passes the requests from the allow-list of the access level to f
+func (g *Guard) Allow(level AccessLevel, f http.HandlerFunc) http.HandlerFunc {
+	if g.allowLists[level].IsEmpty() {
 		//if no security needed, just skip all checking
 		return f
 	}
 	return func(w http.ResponseWriter, r *http.Request) {
-		if err := g.checkWhiteList(w, r); err != nil {
+		if err := g.checkAllowList(level, r); err != nil {
 			w.WriteHeader(http.StatusUnauthorized)
 			return
 		}
@@ -76,6 +145,12 @@ func (g *Guard) WhiteList(f http.HandlerFunc) http.HandlerFunc {
 	}
 }
 
+// IsAllowed checks the request against the allow-list of the access level, for the handlers
+// serving endpoints of several access levels
+func (g *Guard) IsAllowed(level AccessLevel, r *http.Request) bool {
+	return g.allowLists[level].IsEmpty() || g.checkAllowList(level, r) == nil
+}
+
 func GetActualRemoteHost(r *http.Request) (host string, err error) {
 	host = r.Header.Get("HTTP_X_FORWARDED_FOR")
 	if host == "" {
@@ -90,38 +165,36 @@ func GetActualRemoteHost(r *http.Request) (host string, err error) {
 	return
 }
 
-func (g *Guard) checkWhiteList(w http.ResponseWriter, r *http.Request) error {
-	if len(g.whiteList) == 0 {
-		return nil
+// RemoteHost is the client ip, taken from the X-Forwarded-For header only if the request comes
+// through the trusted proxies. Without trusted proxies, the header is ignored.
+func (g *Guard) RemoteHost(r *http.Request) (host string, err error) {
+	host, _, err = net.SplitHostPort(r.RemoteAddr)
+	if err != nil || g.trustedProxies.IsEmpty() {
+		return
 	}
-
-	host, err := GetActualRemoteHost(r)
-	if err == nil {
-		for _, ip := range g.whiteList {
-
-			// If the whitelist entry contains a "/" it
-			// is a CIDR range, and we should check the
-			// remote host is within it
-			if strings.Contains(ip, "/") {
-				_, cidrnet, err := net.ParseCIDR(ip)
-				if err != nil {
-					panic(err)
-				}
-				remote := net.ParseIP(host)
-				if cidrnet.Contains(remote) {
-					return nil
-				}
-			}
-
-			//
-			// Otherwise we're looking for a literal match.
-			//
-			if ip == host {
-				return nil
-			}
+	forwarded := r.Header.Get("HTTP_X_FORWARDED_FOR")
+	if forwarded == "" {
+		forwarded = r.Header.Get("X-FORWARDED-FOR")
+	}
+	// the trusted proxies append the address they received the request from
+	hops := strings.Split(forwarded, ",")
+	for i := len(hops) - 1; i >= 0 && g.trustedProxies.Contains(host); i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			break
 		}
+		host = hop
+	}
+	return
+}
+
+func (g *Guard) checkAllowList(level AccessLevel, r *http.Request) error {
+	host, err := g.RemoteHost(r)
+	if err == nil && g.allowLists[level].Contains(host) {
+		return nil
 	}
 
-	glog.V(0).Infof("Not in whitelist: %s", r.RemoteAddr)
-	return fmt.Errorf("Not in whitelist: %s", r.RemoteAddr)
+	glog.V(1).Infof("audit: deny %s access to %s %s from %s (remote %s)", level, r.Method, r.URL.Path, host, r.RemoteAddr)
+	stats.GuardDeniedRequestCounter.WithLabelValues(g.server, level.String()).Inc()
+	return fmt.Errorf("Not in %s allow-list: %s", level, r.RemoteAddr)
 }
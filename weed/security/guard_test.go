@@ -0,0 +1,61 @@
+package security
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIpAllowList(t *testing.T) {
+	l, err := NewIpAllowList([]string{"10.0.0.1", "192.168.1.0/24", "fd00::/8", "::1"})
+	assert.NoError(t, err)
+	assert.True(t, l.Contains("10.0.0.1"))
+	assert.False(t, l.Contains("10.0.0.2"))
+	assert.True(t, l.Contains("192.168.1.200"))
+	assert.True(t, l.Contains("::ffff:192.168.1.200"))
+	assert.True(t, l.Contains("fd12:3456::1"))
+	assert.True(t, l.Contains("::1"))
+	assert.False(t, l.Contains("fe80::1"))
+	assert.False(t, l.Contains("not-an-ip"))
+
+	_, err = NewIpAllowList([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+	_, err = NewIpAllowList([]string{"localhost"})
+	assert.Error(t, err)
+}
+
+func TestGuardAllowLists(t *testing.T) {
+	g := NewGuard([]string{"10.0.0.0/8"}, nil, 0, nil, 0)
+	g.allowLists[AccessRead], _ = NewIpAllowList([]string{"192.168.0.0/16"})
+	g.trustedProxies, _ = NewIpAllowList([]string{"172.16.0.1"})
+
+	served := func(level AccessLevel, remoteAddr, forwardedFor string) bool {
+		ok := false
+		r := httptest.NewRequest("GET", "/dir/lookup", nil)
+		r.RemoteAddr = remoteAddr
+		if forwardedFor != "" {
+			r.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+		g.Allow(level, func(w http.ResponseWriter, r *http.Request) { ok = true })(httptest.NewRecorder(), r)
+		return ok
+	}
+
+	assert.True(t, served(AccessWrite, "10.1.2.3:1234", ""))
+	assert.True(t, served(AccessAdmin, "10.1.2.3:1234", ""))
+	assert.False(t, served(AccessRead, "10.1.2.3:1234", ""))
+	assert.True(t, served(AccessRead, "192.168.3.4:1234", ""))
+
+	// the header is only used from the trusted proxies
+	assert.False(t, served(AccessWrite, "192.168.3.4:1234", "10.1.2.3"))
+	assert.True(t, served(AccessWrite, "172.16.0.1:1234", "10.1.2.3"))
+	assert.False(t, served(AccessWrite, "172.16.0.1:1234", "10.1.2.3, 192.168.3.4"))
+	assert.True(t, served(AccessRead, "172.16.0.1:1234", "10.1.2.3, 192.168.3.4"))
+	assert.False(t, served(AccessWrite, "172.16.0.1:1234", ""))
+
+	// the header is ignored without trusted proxies
+	g.trustedProxies = nil
+	assert.False(t, served(AccessWrite, "192.168.3.4:1234", "10.1.2.3"))
+	assert.True(t, served(AccessWrite, "10.1.2.3:1234", "192.168.3.4"))
+}
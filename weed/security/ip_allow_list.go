@@ -0,0 +1,61 @@
+package security
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// IpAllowList matches the client ips against IPs and CIDR ranges, IPv4 or IPv6
+type IpAllowList struct {
+	nets []*net.IPNet
+}
+
+func NewIpAllowList(entries []string) (*IpAllowList, error) {
+	l := &IpAllowList{}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid ip %s", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			l.nets = append(l.nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, cidrnet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cidr %s: %v", entry, err)
+		}
+		l.nets = append(l.nets, cidrnet)
+	}
+	return l, nil
+}
+
+// IsEmpty is true if no ip is listed, in which case the list is not checked
+func (l *IpAllowList) IsEmpty() bool {
+	return l == nil || len(l.nets) == 0
+}
+
+func (l *IpAllowList) Contains(host string) bool {
+	if l == nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, n := range l.nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -13,54 +13,106 @@ import (
 type EncodedJwt string
 type SigningKey []byte
 
+// the operations a JWT is scoped to; a write token also allows reading
+const (
+	JwtOperationRead  = "read"
+	JwtOperationWrite = "write"
+	// the object lock operations are only granted by the S3 API after authorizing them,
+	// and do not allow reading or writing over HTTP
+	JwtOperationRemoveLegalHold  = "remove_legal_hold"
+	JwtOperationBypassGovernance = "bypass_governance"
+)
+
 // SeaweedFileIdClaims is created by Master server(s) and consumed by Volume server(s),
 // restricting the access this JWT allows to only a single file.
 type SeaweedFileIdClaims struct {
-	Fid string `json:"fid"`
+	Fid       string `json:"fid"`
+	Operation string `json:"op,omitempty"`
 	jwt.StandardClaims
 }
 
-// SeaweedFilerClaims is created e.g. by S3 proxy server and consumed by Filer server.
-// Right now, it only contains the standard claims; but this might be extended later
-// for more fine-grained permissions.
+// SeaweedFilerClaims is created e.g. by S3 proxy server and consumed by Filer server,
+// optionally restricting the access to the paths under a prefix and to an operation.
 type SeaweedFilerClaims struct {
+	PathPrefix string `json:"path,omitempty"`
+	Operation  string `json:"op,omitempty"`
 	jwt.StandardClaims
 }
 
-func GenJwtForVolumeServer(signingKey SigningKey, expiresAfterSec int, fileId string) EncodedJwt {
-	if len(signingKey) == 0 {
-		return ""
+// AllowsOperation is false for a read token used to write, and for the tokens of other operations
+func AllowsOperation(tokenOperation string, isWrite bool) bool {
+	switch tokenOperation {
+	case "", JwtOperationWrite:
+		return true
+	case JwtOperationRead:
+		return !isWrite
 	}
+	return false
+}
 
+// AllowsPath checks the path is the prefix or below it, with an empty prefix allowing any path
+func (c *SeaweedFilerClaims) AllowsPath(path string) bool {
+	prefix := c.PathPrefix
+	if prefix == "" || path == prefix {
+		return true
+	}
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return strings.HasPrefix(path, prefix)
+}
+
+// SeaweedS3SessionClaims is created by the STS endpoint of the IAM server and consumed by the S3 API server,
+// as the session token of a set of temporary credentials.
+type SeaweedS3SessionClaims struct {
+	AccessKey   string `json:"ak"`
+	Identity    string `json:"identity"`
+	SessionName string `json:"session,omitempty"`
+	// Actions scope down the actions of the identity, nil for no scoping
+	Actions []string `json:"actions"`
+	jwt.StandardClaims
+}
+
+func GenJwtForVolumeServer(signingKeys *JwtKeys, expiresAfterSec int, fileId string, operation string) EncodedJwt {
 	claims := SeaweedFileIdClaims{
-		fileId,
-		jwt.StandardClaims{},
+		Fid:       fileId,
+		Operation: operation,
 	}
 	if expiresAfterSec > 0 {
 		claims.ExpiresAt = time.Now().Add(time.Second * time.Duration(expiresAfterSec)).Unix()
 	}
-	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	encoded, e := t.SignedString([]byte(signingKey))
-	if e != nil {
-		glog.V(0).Infof("Failed to sign claims %+v: %v", t.Claims, e)
-		return ""
-	}
-	return EncodedJwt(encoded)
+	return signingKeys.Sign(claims)
 }
 
 // GenJwtForFilerServer creates a JSON-web-token for using the authenticated Filer API. Used f.e. inside
 // the S3 API
-func GenJwtForFilerServer(signingKey SigningKey, expiresAfterSec int) EncodedJwt {
-	if len(signingKey) == 0 {
-		return ""
-	}
-
+func GenJwtForFilerServer(signingKeys *JwtKeys, expiresAfterSec int, pathPrefix string, operation string) EncodedJwt {
 	claims := SeaweedFilerClaims{
-		jwt.StandardClaims{},
+		PathPrefix: pathPrefix,
+		Operation:  operation,
 	}
 	if expiresAfterSec > 0 {
 		claims.ExpiresAt = time.Now().Add(time.Second * time.Duration(expiresAfterSec)).Unix()
 	}
+	return signingKeys.Sign(claims)
+}
+
+// GenJwtForS3Session creates the session token of a set of temporary S3 credentials
+func GenJwtForS3Session(signingKey SigningKey, expiresAt time.Time, accessKey, identity, sessionName string, actions []string) EncodedJwt {
+	if len(signingKey) == 0 {
+		return ""
+	}
+
+	claims := SeaweedS3SessionClaims{
+		AccessKey:   accessKey,
+		Identity:    identity,
+		SessionName: sessionName,
+		Actions:     actions,
+		StandardClaims: jwt.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: expiresAt.Unix(),
+		},
+	}
 	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	encoded, e := t.SignedString([]byte(signingKey))
 	if e != nil {
@@ -0,0 +1,139 @@
+package security
+
+import (
+	"crypto/elliptic"
+	"fmt"
+	"os"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/golang-jwt/jwt"
+)
+
+// JwtKeys are the keys of a jwt.* section of security.toml, to sign and verify the JWTs.
+//
+// With the shared "key", every server signs and verifies with HS256.
+// With a "private_key_file", the tokens are signed with RS256 or ES256 instead,
+// and the servers only verifying the tokens need the public keys of the "jwks_file",
+// looked up by the "kid" header, so several keys can be active while rotating them.
+type JwtKeys struct {
+	secret SigningKey
+
+	privateKey interface{}
+	publicKey  interface{}
+	method     jwt.SigningMethod
+	keyId      string
+
+	jwks *jwksKeySet
+}
+
+// NewJwtKeys creates the keys of a HS256 shared secret, which may be empty to disable the JWTs
+func NewJwtKeys(secret string) *JwtKeys {
+	return &JwtKeys{secret: SigningKey(secret)}
+}
+
+// LoadJwtKeys reads the key, private_key_file, key_id and jwks_file options of the section
+func LoadJwtKeys(config *util.ViperProxy, section string) (*JwtKeys, error) {
+	keys := NewJwtKeys(config.GetString(section + ".key"))
+	keys.keyId = config.GetString(section + ".key_id")
+	if privateKeyFile := config.GetString(section + ".private_key_file"); privateKeyFile != "" {
+		if err := keys.loadPrivateKey(privateKeyFile); err != nil {
+			return nil, fmt.Errorf("%s.private_key_file: %v", section, err)
+		}
+	}
+	if jwksFile := config.GetString(section + ".jwks_file"); jwksFile != "" {
+		keys.jwks = &jwksKeySet{file: jwksFile}
+		if err := keys.jwks.refreshKeys(); err != nil {
+			return nil, fmt.Errorf("%s.jwks_file: %v", section, err)
+		}
+	}
+	return keys, nil
+}
+
+func (k *JwtKeys) loadPrivateKey(privateKeyFile string) error {
+	pemBytes, err := os.ReadFile(privateKeyFile)
+	if err != nil {
+		return err
+	}
+	if rsaKey, err := jwt.ParseRSAPrivateKeyFromPEM(pemBytes); err == nil {
+		k.privateKey, k.publicKey, k.method = rsaKey, &rsaKey.PublicKey, jwt.SigningMethodRS256
+		return nil
+	}
+	ecKey, err := jwt.ParseECPrivateKeyFromPEM(pemBytes)
+	if err != nil {
+		return fmt.Errorf("neither a RSA nor an EC private key")
+	}
+	k.privateKey, k.publicKey = ecKey, &ecKey.PublicKey
+	switch ecKey.Curve {
+	case elliptic.P256():
+		k.method = jwt.SigningMethodES256
+	case elliptic.P384():
+		k.method = jwt.SigningMethodES384
+	case elliptic.P521():
+		k.method = jwt.SigningMethodES512
+	default:
+		return fmt.Errorf("unsupported curve %s", ecKey.Curve.Params().Name)
+	}
+	return nil
+}
+
+// IsEmpty is true if the tokens are neither signed nor verified
+func (k *JwtKeys) IsEmpty() bool {
+	return k == nil || (len(k.secret) == 0 && k.privateKey == nil && k.jwks == nil)
+}
+
+// CanSign is false for the servers holding only the public keys
+func (k *JwtKeys) CanSign() bool {
+	return k != nil && (len(k.secret) != 0 || k.privateKey != nil)
+}
+
+// Sign signs with the private key if configured, or else with the shared secret
+func (k *JwtKeys) Sign(claims jwt.Claims) EncodedJwt {
+	if !k.CanSign() {
+		return ""
+	}
+	var t *jwt.Token
+	var encoded string
+	var e error
+	if k.privateKey != nil {
+		t = jwt.NewWithClaims(k.method, claims)
+		if k.keyId != "" {
+			t.Header["kid"] = k.keyId
+		}
+		encoded, e = t.SignedString(k.privateKey)
+	} else {
+		t = jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		encoded, e = t.SignedString([]byte(k.secret))
+	}
+	if e != nil {
+		glog.V(0).Infof("Failed to sign claims %+v: %v", t.Claims, e)
+		return ""
+	}
+	return EncodedJwt(encoded)
+}
+
+// Decode verifies the token, signed with either the shared secret or one of the public keys
+func (k *JwtKeys) Decode(tokenString EncodedJwt, claims jwt.Claims) (token *jwt.Token, err error) {
+	// check exp, nbf
+	return jwt.ParseWithClaims(string(tokenString), claims, k.lookupKey)
+}
+
+func (k *JwtKeys) lookupKey(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if len(k.secret) == 0 {
+			return nil, fmt.Errorf("no shared secret for %v", token.Header["alg"])
+		}
+		return []byte(k.secret), nil
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+		kid, _ := token.Header["kid"].(string)
+		if k.publicKey != nil && kid == k.keyId {
+			return k.publicKey, nil
+		}
+		if k.jwks != nil {
+			return k.jwks.lookupKey(kid)
+		}
+		return nil, fmt.Errorf("unknown key id %s", kid)
+	}
+	return nil, fmt.Errorf("unknown token method")
+}
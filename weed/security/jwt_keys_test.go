@@ -0,0 +1,97 @@
+package security
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJwtKeyPairRotation(t *testing.T) {
+	dir := t.TempDir()
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	newKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	ecBytes, err := x509.MarshalECPrivateKey(newKey)
+	assert.NoError(t, err)
+
+	oldSigner := &JwtKeys{keyId: "old"}
+	assert.NoError(t, oldSigner.loadPrivateKey(writePem(t, dir, "old.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(oldKey))))
+	newSigner := &JwtKeys{keyId: "new"}
+	assert.NoError(t, newSigner.loadPrivateKey(writePem(t, dir, "new.pem", "EC PRIVATE KEY", ecBytes)))
+
+	// the verifier only has the public keys, both active while rotating
+	jwksFile := filepath.Join(dir, "jwks.json")
+	jwks := fmt.Sprintf(`{"keys": [
+		{"kty": "RSA", "kid": "old", "n": "%s", "e": "%s"},
+		{"kty": "EC", "kid": "new", "crv": "P-256", "x": "%s", "y": "%s"}]}`,
+		base64.RawURLEncoding.EncodeToString(oldKey.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(oldKey.E)).Bytes()),
+		base64.RawURLEncoding.EncodeToString(newKey.X.Bytes()),
+		base64.RawURLEncoding.EncodeToString(newKey.Y.Bytes()))
+	assert.NoError(t, os.WriteFile(jwksFile, []byte(jwks), 0644))
+	verifier := &JwtKeys{jwks: &jwksKeySet{file: jwksFile}}
+	assert.NoError(t, verifier.jwks.refreshKeys())
+	assert.False(t, verifier.IsEmpty())
+	assert.False(t, verifier.CanSign())
+	assert.Equal(t, EncodedJwt(""), GenJwtForVolumeServer(verifier, 10, "3,01637037d6", JwtOperationWrite))
+
+	for _, signer := range []*JwtKeys{oldSigner, newSigner} {
+		encoded := GenJwtForVolumeServer(signer, 10, "3,01637037d6", JwtOperationRead)
+		assert.NotEqual(t, EncodedJwt(""), encoded)
+		claims := &SeaweedFileIdClaims{}
+		token, err := verifier.Decode(encoded, claims)
+		if assert.NoError(t, err) {
+			assert.True(t, token.Valid)
+			assert.Equal(t, "3,01637037d6", claims.Fid)
+			assert.False(t, AllowsOperation(claims.Operation, true))
+			assert.True(t, AllowsOperation(claims.Operation, false))
+		}
+	}
+
+	// tokens of a shared secret or an unknown key are rejected
+	_, err = verifier.Decode(GenJwtForVolumeServer(NewJwtKeys("secret"), 10, "3,01637037d6", JwtOperationWrite), &SeaweedFileIdClaims{})
+	assert.Error(t, err)
+	otherSigner := &JwtKeys{keyId: "other"}
+	assert.NoError(t, otherSigner.loadPrivateKey(writePem(t, dir, "other.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(oldKey))))
+	_, err = verifier.Decode(GenJwtForVolumeServer(otherSigner, 10, "3,01637037d6", JwtOperationWrite), &SeaweedFileIdClaims{})
+	assert.Error(t, err)
+}
+
+func TestJwtSharedSecret(t *testing.T) {
+	keys := NewJwtKeys("secret")
+	claims := &SeaweedFilerClaims{}
+	_, err := keys.Decode(GenJwtForFilerServer(keys, 10, "/buckets/b1/dir", JwtOperationWrite), claims)
+	assert.NoError(t, err)
+	assert.True(t, AllowsOperation(claims.Operation, true))
+	assert.True(t, claims.AllowsPath("/buckets/b1/dir"))
+	assert.True(t, claims.AllowsPath("/buckets/b1/dir/file"))
+	assert.False(t, claims.AllowsPath("/buckets/b1/dir2"))
+	assert.False(t, claims.AllowsPath("/buckets/b1"))
+
+	// the object lock tokens do not allow reading or writing
+	assert.False(t, AllowsOperation(JwtOperationRemoveLegalHold, false))
+	assert.False(t, AllowsOperation(JwtOperationBypassGovernance, true))
+
+	_, err = NewJwtKeys("other").Decode(GenJwtForFilerServer(keys, 10, "", ""), &SeaweedFilerClaims{})
+	assert.Error(t, err)
+	assert.True(t, NewJwtKeys("").IsEmpty())
+	assert.Equal(t, EncodedJwt(""), GenJwtForFilerServer(NewJwtKeys(""), 10, "", ""))
+}
+
+func writePem(t *testing.T, dir, name, blockType string, der []byte) string {
+	file := filepath.Join(dir, name)
+	assert.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+	return file
+}
@@ -0,0 +1,318 @@
+package security
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	// jwksRefreshInterval is how often the keys are reloaded, to pick up the rotated keys
+	jwksRefreshInterval = time.Hour
+	// jwksMinRefreshInterval throttles the reloading when a token is signed by an unknown key
+	jwksMinRefreshInterval = time.Minute
+)
+
+// OidcMapping maps the ID tokens with a matching claim to an identity,
+// optionally scoped down to the actions.
+type OidcMapping struct {
+	// Claim is the name of a string or string list claim, e.g. "groups" or "email"
+	Claim string
+	// Values are shell patterns, e.g. "ci-*" or "*@example.com"
+	Values   []string
+	Identity string
+	Actions  []string
+}
+
+func (m *OidcMapping) Matches(claims jwt.MapClaims) bool {
+	var values []string
+	switch v := claims[m.Claim].(type) {
+	case string:
+		values = append(values, v)
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	for _, value := range values {
+		for _, pattern := range m.Values {
+			if matched, _ := path.Match(pattern, value); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// OidcVerifier validates the ID tokens of an OpenID Connect provider,
+// signed by one of the keys of its JSON Web Key Set.
+type OidcVerifier struct {
+	Issuer   string
+	Audience string
+	Mappings []*OidcMapping
+
+	keySet *jwksKeySet
+}
+
+// LoadOidcVerifier reads the [jwt.oidc] section of security.toml, nil if it is not configured.
+// The audience is required, since the ID tokens minted for the other clients of the issuer must not be accepted.
+func LoadOidcVerifier(config *util.ViperProxy) *OidcVerifier {
+	issuer := config.GetString("jwt.oidc.issuer")
+	jwksUrl := config.GetString("jwt.oidc.jwks_url")
+	jwksFile := config.GetString("jwt.oidc.jwks_file")
+	if issuer == "" || (jwksUrl == "" && jwksFile == "") {
+		return nil
+	}
+	audience := config.GetString("jwt.oidc.audience")
+	if audience == "" {
+		glog.Errorf("jwt.oidc of %s is disabled: jwt.oidc.audience is not set", issuer)
+		return nil
+	}
+	ov := &OidcVerifier{
+		Issuer:   issuer,
+		Audience: audience,
+		keySet: &jwksKeySet{
+			url:        jwksUrl,
+			file:       jwksFile,
+			httpClient: &http.Client{Timeout: 10 * time.Second},
+		},
+	}
+	config.Lock()
+	err := config.UnmarshalKey("jwt.oidc.mapping", &ov.Mappings)
+	config.Unlock()
+	if err != nil {
+		glog.Errorf("invalid jwt.oidc.mapping: %v", err)
+	}
+	if err := ov.keySet.refreshKeys(); err != nil {
+		glog.Warningf("load jwks of %s: %v", issuer, err)
+	}
+	return ov
+}
+
+// Verify checks the signature, issuer, audience and expiration of the ID token
+func (ov *OidcVerifier) Verify(tokenString string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA, *jwt.SigningMethodRSAPSS:
+		default:
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		return ov.keySet.lookupKey(kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !claims.VerifyIssuer(ov.Issuer, true) {
+		return nil, fmt.Errorf("unexpected issuer %v", claims["iss"])
+	}
+	if ov.Audience == "" || !claims.VerifyAudience(ov.Audience, true) {
+		return nil, fmt.Errorf("unexpected audience %v", claims["aud"])
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, fmt.Errorf("token without expiration")
+	}
+	return claims, nil
+}
+
+// MatchingMappings returns the mappings of the verified claims
+func (ov *OidcVerifier) MatchingMappings(claims jwt.MapClaims) (mappings []*OidcMapping) {
+	for _, m := range ov.Mappings {
+		if m.Matches(claims) {
+			mappings = append(mappings, m)
+		}
+	}
+	return
+}
+
+// jwksKeySet caches the public keys of a JSON Web Key Set, from a file or else an url,
+// reloading them to pick up the rotated keys.
+// The keys are loaded without holding the lock, so that a slow issuer does not block the verifications.
+type jwksKeySet struct {
+	url        string
+	file       string
+	httpClient *http.Client
+
+	keysLock    sync.Mutex
+	keys        map[string]interface{}
+	keysTime    time.Time
+	keysFetched time.Time
+	refreshing  bool
+}
+
+func (ks *jwksKeySet) source() string {
+	if ks.file != "" {
+		return ks.file
+	}
+	return ks.url
+}
+
+func (ks *jwksKeySet) lookupKey(kid string) (interface{}, error) {
+	ks.keysLock.Lock()
+	key, found := ks.keys[kid]
+	now := time.Now()
+	refresh := !ks.refreshing && ((!found && now.Sub(ks.keysFetched) > jwksMinRefreshInterval) || now.Sub(ks.keysTime) > jwksRefreshInterval)
+	if refresh {
+		// the other verifications keep using the current keys meanwhile
+		ks.refreshing = true
+	}
+	ks.keysLock.Unlock()
+
+	if refresh {
+		if err := ks.refreshKeys(); err != nil {
+			glog.Warningf("refresh jwks %s: %v", ks.source(), err)
+		}
+	}
+
+	ks.keysLock.Lock()
+	defer ks.keysLock.Unlock()
+	key, found = ks.keys[kid]
+	if !found {
+		// tokens of a provider with a single key may omit the key id
+		if kid == "" && len(ks.keys) == 1 {
+			for _, k := range ks.keys {
+				return k, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown key id %s", kid)
+	}
+	return key, nil
+}
+
+func (ks *jwksKeySet) refreshKeys() error {
+	keys, err := ks.loadKeys()
+
+	ks.keysLock.Lock()
+	defer ks.keysLock.Unlock()
+	ks.keysFetched = time.Now()
+	ks.refreshing = false
+	if err != nil {
+		return err
+	}
+	ks.keys, ks.keysTime = keys, ks.keysFetched
+	return nil
+}
+
+func (ks *jwksKeySet) loadKeys() (map[string]interface{}, error) {
+	var content []byte
+	var err error
+	if ks.file != "" {
+		content, err = os.ReadFile(ks.file)
+	} else {
+		content, err = ks.fetchJwks()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParseJwks(content)
+}
+
+func (ks *jwksKeySet) fetchJwks() ([]byte, error) {
+	resp, err := ks.httpClient.Get(ks.url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get %s: %s", ks.url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// ParseJwks reads the RSA and EC public keys of a JSON Web Key Set, by key id
+func ParseJwks(content []byte) (map[string]interface{}, error) {
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(content, &jwks); err != nil {
+		return nil, fmt.Errorf("parse jwks: %v", err)
+	}
+	keys := make(map[string]interface{})
+	for _, k := range jwks.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("jwks key %s: %v", k.Kid, err)
+		}
+		if key != nil {
+			keys[k.Kid] = key
+		}
+	}
+	return keys, nil
+}
+
+func (k *jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	// other key types can not sign ID tokens
+	return nil, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
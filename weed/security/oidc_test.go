@@ -0,0 +1,144 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/golang-jwt/jwt"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOidcVerifier(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	jwks := fmt.Sprintf(`{"keys": [{"kty": "RSA", "kid": "k1", "use": "sig", "alg": "RS256", "n": "%s", "e": "%s"}]}`,
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()))
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	assert.NoError(t, os.WriteFile(jwksFile, []byte(jwks), 0644))
+
+	ov := &OidcVerifier{
+		Issuer:   "https://idp.example.com",
+		Audience: "seaweedfs",
+		Mappings: []*OidcMapping{
+			{Claim: "groups", Values: []string{"ci-*"}, Identity: "ci", Actions: []string{"Write:builds"}},
+			{Claim: "email", Values: []string{"*@example.com"}, Identity: "staff"},
+		},
+		keySet: &jwksKeySet{file: jwksFile},
+	}
+	assert.NoError(t, ov.keySet.refreshKeys())
+
+	sign := func(kid string, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = kid
+		s, err := token.SignedString(key)
+		assert.NoError(t, err)
+		return s
+	}
+	expiresAt := time.Now().Add(time.Hour).Unix()
+
+	claims, err := ov.Verify(sign("k1", jwt.MapClaims{
+		"iss":    "https://idp.example.com",
+		"aud":    []string{"seaweedfs"},
+		"sub":    "runner-1",
+		"exp":    expiresAt,
+		"groups": []string{"ci-runners", "dev"},
+		"email":  "runner@example.org",
+	}))
+	assert.NoError(t, err)
+	mappings := ov.MatchingMappings(claims)
+	if assert.Equal(t, 1, len(mappings)) {
+		assert.Equal(t, "ci", mappings[0].Identity)
+	}
+
+	_, err = ov.Verify(sign("k1", jwt.MapClaims{"iss": "https://other.example.com", "aud": "seaweedfs", "exp": expiresAt}))
+	assert.Error(t, err)
+	_, err = ov.Verify(sign("k1", jwt.MapClaims{"iss": "https://idp.example.com", "aud": "other", "exp": expiresAt}))
+	assert.Error(t, err)
+	_, err = ov.Verify(sign("k1", jwt.MapClaims{"iss": "https://idp.example.com", "aud": "seaweedfs"}))
+	assert.Error(t, err)
+	_, err = ov.Verify(sign("k1", jwt.MapClaims{"iss": "https://idp.example.com", "aud": "seaweedfs", "exp": time.Now().Add(-time.Minute).Unix()}))
+	assert.Error(t, err)
+	_, err = ov.Verify(sign("k2", jwt.MapClaims{"iss": "https://idp.example.com", "aud": "seaweedfs", "exp": expiresAt}))
+	assert.Error(t, err)
+
+	// tokens signed with the shared secrets are rejected
+	hs256, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iss": "https://idp.example.com", "aud": "seaweedfs", "exp": expiresAt}).SignedString([]byte("secret"))
+	_, err = ov.Verify(hs256)
+	assert.Error(t, err)
+}
+
+func TestOidcVerifierRequiresAudience(t *testing.T) {
+	config := &util.ViperProxy{Viper: viper.New()}
+	config.Set("jwt.oidc.issuer", "https://idp.example.com")
+	config.Set("jwt.oidc.jwks_file", filepath.Join(t.TempDir(), "jwks.json"))
+	assert.Nil(t, LoadOidcVerifier(config), "oidc should not be enabled without an audience")
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ov := &OidcVerifier{
+		Issuer: "https://idp.example.com",
+		keySet: &jwksKeySet{keys: map[string]interface{}{"k1": &key.PublicKey}, keysTime: time.Now()},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"iss": "https://idp.example.com", "aud": "other-client", "exp": time.Now().Add(time.Hour).Unix()})
+	token.Header["kid"] = "k1"
+	signed, err := token.SignedString(key)
+	assert.NoError(t, err)
+	_, err = ov.Verify(signed)
+	assert.Error(t, err, "the tokens of any client should not be accepted without an audience")
+}
+
+func TestJwksRefreshDoesNotBlock(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`{"keys": []}`))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ks := &jwksKeySet{
+		url:        server.URL,
+		httpClient: server.Client(),
+		keys:       map[string]interface{}{"k1": &key.PublicKey},
+		keysTime:   time.Now(),
+	}
+
+	// the unknown key triggers a refresh, which hangs on the slow issuer
+	go ks.lookupKey("k2")
+	for {
+		ks.keysLock.Lock()
+		refreshing := ks.refreshing
+		ks.keysLock.Unlock()
+		if refreshing {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// the known keys are still served meanwhile
+	done := make(chan error)
+	go func() {
+		_, err := ks.lookupKey("k1")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the key lookup is blocked by the jwks refresh")
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
@@ -182,7 +183,7 @@ func (fs *FilerServer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntr
 		return &filer_pb.UpdateEntryResponse{}, err
 	}
 
-	if err = fs.filer.UpdateEntry(ctx, entry, newEntry); err == nil {
+	if err = fs.filer.UpdateEntry(ctx, entry, newEntry, fs.objectLockRelease(fullpath, req.ObjectLockJwt)); err == nil {
 		fs.filer.DeleteChunks(garbage)
 
 		fs.filer.NotifyUpdateEvent(ctx, entry, newEntry, true, req.IsFromOtherCluster, req.Signatures)
@@ -194,6 +195,31 @@ func (fs *FilerServer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntr
 	return &filer_pb.UpdateEntryResponse{}, err
 }
 
+// objectLockRelease is what the s3 gateway authorized to release from the object lock of the entry.
+// The jwt must be signed with the jwt.filer_signing key, for exactly the path of the entry.
+func (fs *FilerServer) objectLockRelease(fullpath string, tokenStr string) (release filer.ObjectLockRelease) {
+	if tokenStr == "" {
+		return
+	}
+	claims := &security.SeaweedFilerClaims{}
+	token, err := fs.filerGuard.SigningKeys.Decode(security.EncodedJwt(tokenStr), claims)
+	if err != nil || !token.Valid {
+		glog.V(1).Infof("object lock jwt of %s: %v", fullpath, err)
+		return
+	}
+	if claims.PathPrefix != fullpath {
+		glog.V(1).Infof("object lock jwt of %s is for %s", fullpath, claims.PathPrefix)
+		return
+	}
+	switch claims.Operation {
+	case security.JwtOperationRemoveLegalHold:
+		release.RemoveLegalHold = true
+	case security.JwtOperationBypassGovernance:
+		release.BypassGovernance = true
+	}
+	return
+}
+
 func (fs *FilerServer) cleanupChunks(fullpath string, existingEntry *filer.Entry, newEntry *filer_pb.Entry) (chunks, garbage []*filer_pb.FileChunk, err error) {
 
 	// remove old chunks if not included in the new ones
@@ -220,7 +246,7 @@ func (fs *FilerServer) cleanupChunks(fullpath string, existingEntry *filer.Entry
 			"",
 			"",
 		) // ignore readonly error for capacity needed to manifestize
-		chunks, err = filer.MaybeManifestize(fs.saveAsChunk(so), chunks)
+		chunks, err = filer.MaybeManifestize(fs.saveAsChunk(so, fs.option.Cipher || hasCipherKey(chunks)), chunks)
 		if err != nil {
 			// not good, but should be ok
 			glog.V(0).Infof("MaybeManifestize: %v", err)
@@ -265,7 +291,7 @@ func (fs *FilerServer) AppendToEntry(ctx context.Context, req *filer_pb.AppendTo
 		glog.Warningf("detectStorageOption: %v", err)
 		return &filer_pb.AppendToEntryResponse{}, err
 	}
-	entry.Chunks, err = filer.MaybeManifestize(fs.saveAsChunk(so), entry.Chunks)
+	entry.Chunks, err = filer.MaybeManifestize(fs.saveAsChunk(so, fs.option.Cipher || hasCipherKey(entry.Chunks)), entry.Chunks)
 	if err != nil {
 		// not good, but should be ok
 		glog.V(0).Infof("MaybeManifestize: %v", err)
@@ -280,8 +306,14 @@ func (fs *FilerServer) DeleteEntry(ctx context.Context, req *filer_pb.DeleteEntr
 
 	glog.V(4).Infof("DeleteEntry %v", req)
 
-	err = fs.filer.DeleteEntryMetaAndData(ctx, util.JoinPath(req.Directory, req.Name), req.IsRecursive, req.IgnoreRecursiveError, req.IsDeleteData, req.IsFromOtherCluster, req.Signatures)
+	fullpath := util.JoinPath(req.Directory, req.Name)
 	resp = &filer_pb.DeleteEntryResponse{}
+	if err = fs.filer.CanDelete(ctx, fullpath, req.IsRecursive); err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+
+	err = fs.filer.DeleteEntryMetaAndData(ctx, fullpath, req.IsRecursive, req.IgnoreRecursiveError, req.IsDeleteData, req.IsFromOtherCluster, req.Signatures)
 	if err != nil && err != filer_pb.ErrNotFound {
 		resp.Error = err.Error()
 	}
@@ -392,6 +424,13 @@ func (fs *FilerServer) GetFilerConfiguration(ctx context.Context, req *filer_pb.
 
 	clusterId, _ := fs.filer.Store.KvGet(context.Background(), []byte("clusterId"))
 
+	var cipherKeyId string
+	if fs.filer.KeyManager != nil {
+		if cipherKeyId, err = fs.filer.KeyManager.CurrentKeyId(); err != nil {
+			glog.Errorf("current cipher key id: %v", err)
+		}
+	}
+
 	t := &filer_pb.GetFilerConfigurationResponse{
 		Masters:            pb.ToAddressStrings(fs.option.Masters),
 		Collection:         fs.option.Collection,
@@ -404,6 +443,7 @@ func (fs *FilerServer) GetFilerConfiguration(ctx context.Context, req *filer_pb.
 		MetricsIntervalSec: int32(fs.metricsIntervalSec),
 		Version:            util.Version(),
 		ClusterId:          string(clusterId),
+		CipherKeyId:        cipherKeyId,
 	}
 
 	glog.V(4).Infof("GetFilerConfiguration: %v", t)
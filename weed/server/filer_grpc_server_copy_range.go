@@ -0,0 +1,239 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/golang/protobuf/proto"
+)
+
+// UpdateChunkReferences changes the references of the shared chunks forwarded by the other filers to the owner filer
+func (fs *FilerServer) UpdateChunkReferences(ctx context.Context, req *filer_pb.UpdateChunkReferencesRequest) (*filer_pb.UpdateChunkReferencesResponse, error) {
+
+	glog.V(4).Infof("UpdateChunkReferences %d file ids, release %v", len(req.FileIds), req.Release)
+
+	unreferenced, err := fs.filer.UpdateChunkReferences(ctx, req.FileIds, req.Release)
+	if err != nil {
+		return nil, err
+	}
+	return &filer_pb.UpdateChunkReferencesResponse{
+		UnreferencedFileIds: unreferenced,
+	}, nil
+}
+
+// CopyFileRange copies a range of one file into another file without moving the data through the client.
+// Whole chunks are shared by both files if stored the same way, and other chunks are copied by the volume servers.
+func (fs *FilerServer) CopyFileRange(ctx context.Context, req *filer_pb.CopyFileRangeRequest) (*filer_pb.CopyFileRangeResponse, error) {
+
+	glog.V(4).Infof("CopyFileRange %v", req)
+
+	srcPath := util.NewFullPath(req.SrcDirectory, req.SrcName)
+	dstPath := util.NewFullPath(req.DstDirectory, req.DstName)
+	if req.SrcOffset < 0 || req.DstOffset < 0 || req.Length < 0 {
+		return nil, fmt.Errorf("invalid range")
+	}
+
+	srcEntry, err := fs.filer.FindEntry(ctx, srcPath)
+	if err != nil {
+		return nil, fmt.Errorf("find %s: %v", srcPath, err)
+	}
+	dstEntry, err := fs.filer.FindEntry(ctx, dstPath)
+	if err != nil {
+		return nil, fmt.Errorf("find %s: %v", dstPath, err)
+	}
+	if srcEntry.IsDirectory() || dstEntry.IsDirectory() {
+		return nil, fmt.Errorf("not a file")
+	}
+	if len(srcEntry.Content) > 0 || len(dstEntry.Content) > 0 || srcEntry.IsInRemoteOnly() || dstEntry.IsInRemoteOnly() {
+		return nil, fmt.Errorf("copying inline or remote content is not supported")
+	}
+
+	length := req.Length
+	if srcSize := int64(srcEntry.Size()); req.SrcOffset+length > srcSize {
+		length = srcSize - req.SrcOffset
+	}
+	if length <= 0 {
+		return &filer_pb.CopyFileRangeResponse{Entry: dstEntry.ToProtoEntry()}, nil
+	}
+
+	views := filer.ViewFromChunks(fs.lookupFileId, srcEntry.Chunks, req.SrcOffset, length)
+	dataChunks, _, err := filer.ResolveChunkManifest(fs.lookupFileId, srcEntry.Chunks, req.SrcOffset, req.SrcOffset+length)
+	if err != nil {
+		return nil, fmt.Errorf("resolve chunks of %s: %v", srcPath, err)
+	}
+	srcChunks := make(map[string]*filer_pb.FileChunk)
+	for _, chunk := range dataChunks {
+		srcChunks[chunk.GetFileIdString()] = chunk
+	}
+
+	// the holes in the source range have no chunks, which would leave the existing destination data visible
+	dstSize := int64(dstEntry.Size())
+	holeStart := req.SrcOffset
+	for _, view := range views {
+		if view.LogicOffset > holeStart && req.DstOffset+holeStart-req.SrcOffset < dstSize {
+			return nil, fmt.Errorf("copying holes over existing data is not supported")
+		}
+		holeStart = view.LogicOffset + int64(view.Size)
+	}
+	if holeStart < req.SrcOffset+length && req.DstOffset+holeStart-req.SrcOffset < dstSize {
+		return nil, fmt.Errorf("copying holes over existing data is not supported")
+	}
+
+	so, err := fs.detectStorageOption(string(dstPath), dstEntry.Collection, dstEntry.Replication, dstEntry.TtlSec, dstEntry.DiskType, "", "", "")
+	if err != nil {
+		return nil, err
+	}
+	srcSo, err := fs.detectStorageOption(string(srcPath), srcEntry.Collection, srcEntry.Replication, srcEntry.TtlSec, srcEntry.DiskType, "", "", "")
+	canShare := err == nil && canShareChunks(srcSo, so) && fs.filer.CanShareChunks()
+
+	var newChunks, copiedChunks []*filer_pb.FileChunk
+	var sharedFileIds []string
+	for _, view := range views {
+		dstOffset := req.DstOffset + view.LogicOffset - req.SrcOffset
+		if srcChunk := sharedChunk(view, srcChunks, canShare); srcChunk != nil {
+			chunk := proto.Clone(srcChunk).(*filer_pb.FileChunk)
+			chunk.Offset = dstOffset
+			chunk.Mtime = time.Now().UnixNano()
+			newChunks = append(newChunks, chunk)
+			sharedFileIds = append(sharedFileIds, view.FileId)
+			continue
+		}
+		chunk, copyErr := fs.copyChunkRange(so, view, dstOffset)
+		if copyErr != nil {
+			fs.filer.DeleteChunks(copiedChunks)
+			return nil, copyErr
+		}
+		newChunks = append(newChunks, chunk)
+		copiedChunks = append(copiedChunks, chunk)
+	}
+
+	// count the shared chunks before any entry could release them
+	if err = fs.filer.ReferenceChunks(ctx, sharedFileIds); err != nil {
+		fs.filer.DeleteChunks(copiedChunks)
+		return nil, fmt.Errorf("reference chunks: %v", err)
+	}
+
+	newEntry := dstEntry.ShallowClone()
+	newEntry.Chunks = append(append([]*filer_pb.FileChunk{}, dstEntry.Chunks...), newChunks...)
+	if copiedEnd := uint64(req.DstOffset + length); newEntry.FileSize < copiedEnd {
+		newEntry.FileSize = copiedEnd
+	}
+	newEntry.Mtime = time.Now()
+
+	chunks, garbage, err := fs.cleanupChunks(string(dstPath), dstEntry, newEntry.ToProtoEntry())
+	if err != nil {
+		fs.filer.DeleteChunks(newChunks)
+		return nil, fmt.Errorf("cleanup chunks of %s: %v", dstPath, err)
+	}
+	newEntry.Chunks = chunks
+
+	if err = fs.filer.UpdateEntry(ctx, dstEntry, newEntry, filer.ObjectLockRelease{}); err != nil {
+		// releases the references of the shared chunks, and deletes the copied chunks
+		fs.filer.DeleteChunks(newChunks)
+		return nil, fmt.Errorf("update %s: %v", dstPath, err)
+	}
+	fs.filer.DeleteChunks(garbage)
+	fs.filer.NotifyUpdateEvent(ctx, dstEntry, newEntry, true, false, req.Signatures)
+
+	glog.V(3).Infof("copied %s [%d,%d) to %s at %d: %d shared chunks, %d copied chunks",
+		srcPath, req.SrcOffset, req.SrcOffset+length, dstPath, req.DstOffset, len(sharedFileIds), len(copiedChunks))
+
+	return &filer_pb.CopyFileRangeResponse{
+		Copied: length,
+		Entry:  newEntry.ToProtoEntry(),
+	}, nil
+}
+
+// canShareChunks tells whether the chunks of the source can be kept for the destination.
+// Deleting a bucket drops the volumes of its collection, and TTL volumes expire as a whole,
+// both ignoring the chunk references, so only the chunks of the same collection without TTL are shared.
+func canShareChunks(src, dst *operation.StorageOption) bool {
+	return src.Collection == dst.Collection && src.Replication == dst.Replication &&
+		src.TtlSeconds == 0 && dst.TtlSeconds == 0
+}
+
+// sharedChunk returns the source chunk if the view covers all of it and the chunk can be shared, or nil to copy the view
+func sharedChunk(view *filer.ChunkView, srcChunks map[string]*filer_pb.FileChunk, canShare bool) *filer_pb.FileChunk {
+	srcChunk, found := srcChunks[view.FileId]
+	if !canShare || !found || view.Offset != 0 || !view.IsFullChunk() {
+		return nil
+	}
+	return srcChunk
+}
+
+// copyChunkRange asks the volume server of a newly assigned file id to copy the range of the chunk view
+func (fs *FilerServer) copyChunkRange(so *operation.StorageOption, view *filer.ChunkView, dstOffset int64) (*filer_pb.FileChunk, error) {
+
+	sourceUrls, err := fs.lookupFileId(view.FileId)
+	if err != nil {
+		return nil, fmt.Errorf("lookup %s: %v", view.FileId, err)
+	}
+
+	assignRequest, altRequest := so.ToAssignRequests(1)
+	assignResult, err := operation.Assign(fs.filer.GetMaster, fs.grpcDialOption, assignRequest, altRequest)
+	if err != nil {
+		return nil, err
+	}
+	if assignResult.Error != "" {
+		return nil, fmt.Errorf("assign: %v", assignResult.Error)
+	}
+	fileId, err := needle.ParseFileIdFromString(assignResult.Fid)
+	if err != nil {
+		return nil, fmt.Errorf("unrecognized file id %s: %v", assignResult.Fid, err)
+	}
+
+	var replicas []*volume_server_pb.FetchAndWriteNeedleRequest_Replica
+	for _, r := range assignResult.Replicas {
+		replicas = append(replicas, &volume_server_pb.FetchAndWriteNeedleRequest_Replica{
+			Url:       r.Url,
+			PublicUrl: r.PublicUrl,
+			GrpcPort:  int32(r.GrpcPort),
+		})
+	}
+
+	var resp *volume_server_pb.CopyNeedleRangeResponse
+	assignedServerAddress := pb.NewServerAddressWithGrpcPort(assignResult.Url, assignResult.GrpcPort)
+	err = operation.WithVolumeServerClient(false, assignedServerAddress, fs.grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) (copyErr error) {
+		resp, copyErr = volumeServerClient.CopyNeedleRange(context.Background(), &volume_server_pb.CopyNeedleRangeRequest{
+			VolumeId:           uint32(fileId.VolumeId),
+			NeedleId:           uint64(fileId.Key),
+			Cookie:             uint32(fileId.Cookie),
+			SourceFileId:       view.FileId,
+			SourceUrls:         sourceUrls,
+			SourceIsCompressed: view.IsGzipped,
+			SourceCipherKey:    view.CipherKey,
+			Offset:             view.Offset,
+			Size:               int64(view.Size),
+			Replicas:           replicas,
+			Auth:               string(assignResult.Auth),
+			Cipher:             len(view.CipherKey) > 0 || fs.filer.Cipher,
+		})
+		return copyErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf("volume server %s copy %s: %v", assignResult.Url, view.FileId, err)
+	}
+
+	return &filer_pb.FileChunk{
+		FileId:    assignResult.Fid,
+		Offset:    dstOffset,
+		Size:      view.Size,
+		Mtime:     time.Now().UnixNano(),
+		ETag:      resp.ETag,
+		CipherKey: resp.CipherKey,
+		Fid: &filer_pb.FileId{
+			VolumeId: uint32(fileId.VolumeId),
+			FileKey:  uint64(fileId.Key),
+			Cookie:   uint32(fileId.Cookie),
+		},
+	}, nil
+}
@@ -0,0 +1,67 @@
+package weed_server
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestCopyRangeSharedChunks(t *testing.T) {
+	chunks := []*filer_pb.FileChunk{
+		{FileId: "1,a", Offset: 0, Size: 100, Mtime: 1},
+		{FileId: "1,b", Offset: 100, Size: 100, Mtime: 1},
+		{FileId: "1,c", Offset: 200, Size: 100, Mtime: 1},
+	}
+	srcChunks := make(map[string]*filer_pb.FileChunk)
+	for _, chunk := range chunks {
+		srcChunks[chunk.FileId] = chunk
+	}
+
+	// [50, 250) only covers the chunk b fully
+	views := filer.ViewFromChunks(nil, chunks, 50, 200)
+	if len(views) != 3 {
+		t.Fatalf("expected 3 views, got %d", len(views))
+	}
+	var shared, copied []string
+	for _, view := range views {
+		if chunk := sharedChunk(view, srcChunks, true); chunk != nil {
+			shared = append(shared, chunk.FileId)
+		} else {
+			copied = append(copied, view.FileId)
+		}
+	}
+	if len(shared) != 1 || shared[0] != "1,b" {
+		t.Errorf("unexpected shared chunks %v", shared)
+	}
+	if len(copied) != 2 || copied[0] != "1,a" || copied[1] != "1,c" {
+		t.Errorf("unexpected copied chunks %v", copied)
+	}
+
+	// when the chunks can not be shared, all of them are copied
+	for _, view := range views {
+		if chunk := sharedChunk(view, srcChunks, false); chunk != nil {
+			t.Errorf("chunk %s should be copied", chunk.FileId)
+		}
+	}
+}
+
+func TestCanShareChunks(t *testing.T) {
+	tests := []struct {
+		name     string
+		src, dst operation.StorageOption
+		expected bool
+	}{
+		{"same collection", operation.StorageOption{Collection: "a", Replication: "001"}, operation.StorageOption{Collection: "a", Replication: "001"}, true},
+		{"other collection", operation.StorageOption{Collection: "a"}, operation.StorageOption{Collection: "b"}, false},
+		{"other replication", operation.StorageOption{Replication: "000"}, operation.StorageOption{Replication: "001"}, false},
+		{"ttl", operation.StorageOption{TtlSeconds: 60}, operation.StorageOption{TtlSeconds: 60}, false},
+		{"ttl destination", operation.StorageOption{}, operation.StorageOption{TtlSeconds: 60}, false},
+	}
+	for _, tt := range tests {
+		if actual := canShareChunks(&tt.src, &tt.dst); actual != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, actual)
+		}
+	}
+}
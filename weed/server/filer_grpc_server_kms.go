@@ -0,0 +1,44 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// RewrapCipherKeys saves the entry again if any chunk cipher key is wrapped by a master key other than the current one.
+// The cipher keys and the data stay the same, so no metadata event is sent.
+func (fs *FilerServer) RewrapCipherKeys(ctx context.Context, req *filer_pb.RewrapCipherKeysRequest) (*filer_pb.RewrapCipherKeysResponse, error) {
+
+	glog.V(4).Infof("RewrapCipherKeys %v", req)
+
+	if fs.filer.KeyManager == nil {
+		return nil, fmt.Errorf("no key manager is configured")
+	}
+	currentKeyId, err := fs.filer.KeyManager.CurrentKeyId()
+	if err != nil {
+		return nil, fmt.Errorf("current key id: %v", err)
+	}
+
+	fullpath := util.NewFullPath(req.Directory, req.Name)
+	var count int32
+	err = fs.filer.Store.UpdateEntryAtomically(ctx, fullpath, func(entry *filer.Entry) (changed bool, err error) {
+		for _, chunk := range entry.Chunks {
+			if len(chunk.CipherKey) > 0 && chunk.CipherKeyId != currentKeyId {
+				count++
+			}
+		}
+		return count > 0, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rewrap %s: %v", fullpath, err)
+	}
+
+	return &filer_pb.RewrapCipherKeysResponse{
+		RewrappedCount: count,
+	}, nil
+}
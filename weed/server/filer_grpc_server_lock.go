@@ -0,0 +1,196 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc/metadata"
+)
+
+/*
+	The file locks are served by one filer, the active filer with the smallest address.
+	The other filers forward the lock requests to it, so the mounts connected to different
+	filers, or failing over to another filer, see the same locks.
+
+	The lock table is saved in the filer store. When the owner restarts, or another filer
+	sharing the same filer store takes over, the locks are loaded from the store.
+	If the filers use separate filer stores, the locks are lost when the owner changes,
+	and the mounts need to lock the files again.
+
+	The locks are keyed by the inode of the file, which is kept when the file is renamed.
+	A file without an inode, e.g., uploaded via S3, is assigned the inode the mounts use for its path
+	when it is locked first. Testing and releasing the locks do not change the file.
+*/
+
+const fileLockForwardedHeader = "seaweedfs-file-lock-forwarded"
+
+// GetFileLock returns the lock conflicting with the requested one, if any
+func (fs *FilerServer) GetFileLock(ctx context.Context, req *filer_pb.GetFileLockRequest) (resp *filer_pb.GetFileLockResponse, err error) {
+	if err = checkFileLock(req.Path, req.Lock); err != nil {
+		return nil, err
+	}
+	err = fs.withFileLockOwner(ctx, func() error {
+		resp = &filer_pb.GetFileLockResponse{
+			Conflict: fs.fileLocks.Test(fs.fileLockKey(ctx, req.Path, false), req.Lock),
+		}
+		return nil
+	}, func(ctx context.Context, client filer_pb.SeaweedFilerClient) (forwardErr error) {
+		resp, forwardErr = client.GetFileLock(ctx, req)
+		return forwardErr
+	})
+	return
+}
+
+// SetFileLock places, changes or removes a lock. If req.Wait is set, it blocks until the lock is acquired.
+func (fs *FilerServer) SetFileLock(ctx context.Context, req *filer_pb.SetFileLockRequest) (resp *filer_pb.SetFileLockResponse, err error) {
+	if err = checkFileLock(req.Path, req.Lock); err != nil {
+		return nil, err
+	}
+	err = fs.withFileLockOwner(ctx, func() error {
+		conflict, lockErr := fs.fileLocks.Lock(ctx, fs.fileLockKey(ctx, req.Path, req.Lock.Type != filer_pb.FileLock_UNLOCK), req.Lock, req.Wait, req.LeaseSeconds)
+		if lockErr != nil {
+			return lockErr
+		}
+		glog.V(4).Infof("set lock %s %+v: %v", req.Path, req.Lock, conflict == nil)
+		resp = &filer_pb.SetFileLockResponse{
+			Acquired: conflict == nil,
+			Conflict: conflict,
+		}
+		return nil
+	}, func(ctx context.Context, client filer_pb.SeaweedFilerClient) (forwardErr error) {
+		resp, forwardErr = client.SetFileLock(ctx, req)
+		return forwardErr
+	})
+	return
+}
+
+func (fs *FilerServer) RenewFileLockLease(ctx context.Context, req *filer_pb.RenewFileLockLeaseRequest) (resp *filer_pb.RenewFileLockLeaseResponse, err error) {
+	err = fs.withFileLockOwner(ctx, func() error {
+		resp = &filer_pb.RenewFileLockLeaseResponse{
+			LockCount: int32(fs.fileLocks.Renew(req.ClientId, req.LeaseSeconds)),
+		}
+		return nil
+	}, func(ctx context.Context, client filer_pb.SeaweedFilerClient) (forwardErr error) {
+		resp, forwardErr = client.RenewFileLockLease(ctx, req)
+		return forwardErr
+	})
+	return
+}
+
+func (fs *FilerServer) ReleaseFileLocks(ctx context.Context, req *filer_pb.ReleaseFileLocksRequest) (resp *filer_pb.ReleaseFileLocksResponse, err error) {
+	err = fs.withFileLockOwner(ctx, func() error {
+		if req.Path == "" {
+			fs.fileLocks.ReleaseClient(req.ClientId)
+		} else {
+			fs.fileLocks.Release(fs.fileLockKey(ctx, req.Path, false), req.ClientId, req.Owner, req.IsFlock)
+		}
+		resp = &filer_pb.ReleaseFileLocksResponse{}
+		return nil
+	}, func(ctx context.Context, client filer_pb.SeaweedFilerClient) (forwardErr error) {
+		resp, forwardErr = client.ReleaseFileLocks(ctx, req)
+		return forwardErr
+	})
+	return
+}
+
+// withFileLockOwner serves the request by fn if this filer owns the file locks,
+// or forwards it to the owner by forwardFn
+func (fs *FilerServer) withFileLockOwner(ctx context.Context, fn func() error, forwardFn func(ctx context.Context, client filer_pb.SeaweedFilerClient) error) error {
+	owner, isOwner, err := fs.checkFileLockOwner()
+	if err != nil {
+		return err
+	}
+	if isOwner {
+		return fn()
+	}
+	// the filers may briefly disagree on the owner, and should not forward the requests back and forth
+	if md, found := metadata.FromIncomingContext(ctx); found && len(md.Get(fileLockForwardedHeader)) > 0 {
+		return fmt.Errorf("filer %s does not own the file locks, the owner is %s", fs.option.Host, owner)
+	}
+	return pb.WithFilerClient(false, owner, fs.grpcDialOption, func(client filer_pb.SeaweedFilerClient) error {
+		return forwardFn(metadata.AppendToOutgoingContext(ctx, fileLockForwardedHeader, string(fs.option.Host)), client)
+	})
+}
+
+// fileLockOwner is the active filer with the smallest address
+func (fs *FilerServer) fileLockOwner() pb.ServerAddress {
+	if fs.filer.MetaAggregator != nil {
+		return fs.filer.MetaAggregator.Owner()
+	}
+	return fs.option.Host
+}
+
+// checkFileLockOwner loads the saved locks when this filer becomes the owner, and drops them when another filer takes over
+func (fs *FilerServer) checkFileLockOwner() (owner pb.ServerAddress, isOwner bool, err error) {
+	owner = fs.fileLockOwner()
+	isOwner = owner == fs.option.Host
+
+	fs.fileLockOwnerLock.Lock()
+	defer fs.fileLockOwnerLock.Unlock()
+	if isOwner == fs.isFileLockOwner {
+		return
+	}
+	if isOwner {
+		if err = fs.fileLocks.Activate(fs.filer.Store); err != nil {
+			return owner, false, err
+		}
+		glog.V(0).Infof("serving the file locks")
+	} else {
+		fs.fileLocks.Deactivate()
+		glog.V(0).Infof("file locks are served by %s", owner)
+	}
+	fs.isFileLockOwner = isOwner
+	return
+}
+
+func (fs *FilerServer) loopCheckFileLockOwner() {
+	for {
+		if _, _, err := fs.checkFileLockOwner(); err != nil {
+			glog.Errorf("check file lock owner: %v", err)
+		}
+		time.Sleep(3 * time.Second)
+	}
+}
+
+// fileLockKey is the inode of the file, or the path if the file is not found or has no inode.
+// With assignInode, a file without inode is assigned one. Otherwise, it can not have been locked by its inode.
+func (fs *FilerServer) fileLockKey(ctx context.Context, path string, assignInode bool) string {
+	fullpath := util.FullPath(path)
+	entry, err := fs.filer.FindEntry(ctx, fullpath)
+	if err != nil {
+		return path
+	}
+	if entry.Attr.Inode == 0 {
+		if !assignInode {
+			return path
+		}
+		newEntry := entry.ShallowClone()
+		newEntry.Attr.Inode = fullpath.AsInode(entry.Attr.Mode)
+		if err = fs.filer.UpdateEntry(ctx, entry, newEntry, filer.ObjectLockRelease{}); err != nil {
+			glog.V(1).Infof("assign inode to %s: %v", path, err)
+			return path
+		}
+		fs.filer.NotifyUpdateEvent(ctx, entry, newEntry, false, false, nil)
+		entry = newEntry
+	}
+	return fmt.Sprintf("inode:%d", entry.Attr.Inode)
+}
+
+func checkFileLock(path string, lock *filer_pb.FileLock) error {
+	if path == "" || lock == nil {
+		return fmt.Errorf("missing file or lock")
+	}
+	if lock.ClientId == "" {
+		return fmt.Errorf("missing lock client id")
+	}
+	if lock.Start > lock.End {
+		return fmt.Errorf("invalid lock range [%d,%d]", lock.Start, lock.End)
+	}
+	return nil
+}
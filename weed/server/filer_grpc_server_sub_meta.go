@@ -204,6 +204,8 @@ func (fs *FilerServer) eachEventNotificationFn(req *filer_pb.SubscribeMetadataRe
 
 		if hasPrefixIn(fullpath, req.PathPrefixes) {
 			// good
+		} else if matchesDirectory(dirPath, eventNotification.NewParentPath, req.Directories) {
+			// good
 		} else {
 			if !strings.HasPrefix(fullpath, req.PathPrefix) {
 				if eventNotification.NewParentPath != "" {
@@ -232,6 +234,15 @@ func (fs *FilerServer) eachEventNotificationFn(req *filer_pb.SubscribeMetadataRe
 	}
 }
 
+func matchesDirectory(dirPath, newParentPath string, directories []string) bool {
+	for _, dir := range directories {
+		if dirPath == dir || newParentPath == dir {
+			return true
+		}
+	}
+	return false
+}
+
 func hasPrefixIn(text string, prefixes []string) bool {
 	for _, p := range prefixes {
 		if strings.HasPrefix(text, p) {
@@ -38,12 +38,16 @@ import (
 	_ "github.com/chrislusf/seaweedfs/weed/filer/redis3"
 	_ "github.com/chrislusf/seaweedfs/weed/filer/sqlite"
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/kms"
+	_ "github.com/chrislusf/seaweedfs/weed/kms/local"
+	_ "github.com/chrislusf/seaweedfs/weed/kms/vault"
 	"github.com/chrislusf/seaweedfs/weed/notification"
 	_ "github.com/chrislusf/seaweedfs/weed/notification/aws_sqs"
 	_ "github.com/chrislusf/seaweedfs/weed/notification/gocdk_pub_sub"
 	_ "github.com/chrislusf/seaweedfs/weed/notification/google_pub_sub"
 	_ "github.com/chrislusf/seaweedfs/weed/notification/kafka"
 	_ "github.com/chrislusf/seaweedfs/weed/notification/log"
+	_ "github.com/chrislusf/seaweedfs/weed/notification/webhook"
 	"github.com/chrislusf/seaweedfs/weed/security"
 )
 
@@ -73,6 +77,8 @@ type FilerServer struct {
 	filer          *filer.Filer
 	filerGuard     *security.Guard
 	grpcDialOption grpc.DialOption
+	// oidcVerifier accepts the ID tokens of an OpenID Connect provider besides the filer jwt
+	oidcVerifier *security.OidcVerifier
 
 	// metrics read from the master
 	metricsAddress     string
@@ -91,16 +97,26 @@ type FilerServer struct {
 
 	inFlightDataSize      int64
 	inFlightDataLimitCond *sync.Cond
+
+	fileLocks         *filer.FileLockTable
+	fileLockOwnerLock sync.Mutex
+	isFileLockOwner   bool
 }
 
 func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption) (fs *FilerServer, err error) {
 
 	v := util.GetViper()
-	signingKey := v.GetString("jwt.filer_signing.key")
+	signingKeys, err := security.LoadJwtKeys(v, "jwt.filer_signing")
+	if err != nil {
+		return nil, err
+	}
 	v.SetDefault("jwt.filer_signing.expires_after_seconds", 10)
 	expiresAfterSec := v.GetInt("jwt.filer_signing.expires_after_seconds")
 
-	readSigningKey := v.GetString("jwt.filer_signing.read.key")
+	readSigningKeys, err := security.LoadJwtKeys(v, "jwt.filer_signing.read")
+	if err != nil {
+		return nil, err
+	}
 	v.SetDefault("jwt.filer_signing.read.expires_after_seconds", 60)
 	readExpiresAfterSec := v.GetInt("jwt.filer_signing.read.expires_after_seconds")
 
@@ -110,6 +126,7 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 		knownListeners:        make(map[int32]struct{}),
 		brokers:               make(map[string]map[string]bool),
 		inFlightDataLimitCond: sync.NewCond(new(sync.Mutex)),
+		fileLocks:             filer.NewFileLockTable(),
 	}
 	fs.listenersCond = sync.NewCond(&fs.listenersLock)
 
@@ -121,8 +138,11 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 		fs.listenersCond.Broadcast()
 	})
 	fs.filer.Cipher = option.Cipher
-	// we do not support IP whitelist right now
-	fs.filerGuard = security.NewGuard([]string{}, signingKey, expiresAfterSec, readSigningKey, readExpiresAfterSec)
+	fs.filerGuard = security.NewGuard([]string{}, signingKeys, expiresAfterSec, readSigningKeys, readExpiresAfterSec)
+	if err = fs.filerGuard.LoadAllowLists(v, "filer"); err != nil {
+		return nil, err
+	}
+	fs.oidcVerifier = security.LoadOidcVerifier(v)
 
 	fs.checkWithMaster()
 
@@ -150,6 +170,12 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 	// fs.filer.FsyncBuckets = v.GetStringSlice("filer.options.buckets_fsync")
 	fs.filer.LoadConfiguration(v)
 
+	keyManager, err := kms.LoadConfiguration(v, "filer.kms.")
+	if err != nil {
+		return nil, err
+	}
+	fs.filer.SetKeyManager(keyManager)
+
 	notification.LoadConfiguration(v, "notification.")
 
 	handleStaticResources(defaultMux)
@@ -169,6 +195,8 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 
 	fs.filer.LoadRemoteStorageConfAndMapping()
 
+	go fs.loopCheckFileLockOwner()
+
 	grace.OnInterrupt(func() {
 		fs.filer.Shutdown()
 	})
@@ -3,9 +3,11 @@ package weed_server
 import (
 	"errors"
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"net/http"
+	"path"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -25,6 +27,10 @@ func (fs *FilerServer) filerHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	isReadHttpCall := r.Method == "GET" || r.Method == "HEAD"
+	if !fs.isAllowedByIp(r, !isReadHttpCall) {
+		writeJsonError(w, r, http.StatusUnauthorized, errors.New("ip not allowed"))
+		return
+	}
 	if !fs.maybeCheckJwtAuthorization(r, !isReadHttpCall) {
 		writeJsonError(w, r, http.StatusUnauthorized, errors.New("wrong jwt"))
 		return
@@ -108,6 +114,10 @@ func (fs *FilerServer) readonlyFilerHandler(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	if !fs.isAllowedByIp(r, false) {
+		writeJsonError(w, r, http.StatusUnauthorized, errors.New("ip not allowed"))
+		return
+	}
 	if !fs.maybeCheckJwtAuthorization(r, false) {
 		writeJsonError(w, r, http.StatusUnauthorized, errors.New("wrong jwt"))
 		return
@@ -139,22 +149,30 @@ func OptionsHandler(w http.ResponseWriter, r *http.Request, isReadOnly bool) {
 	w.Header().Add("Access-Control-Allow-Headers", "*")
 }
 
+// isAllowedByIp checks the client ip against the read or write allow-list
+func (fs *FilerServer) isAllowedByIp(r *http.Request, isWrite bool) bool {
+	if isWrite {
+		return fs.filerGuard.IsAllowed(security.AccessWrite, r)
+	}
+	return fs.filerGuard.IsAllowed(security.AccessRead, r)
+}
+
 // maybeCheckJwtAuthorization returns true if access should be granted, false if it should be denied
 func (fs *FilerServer) maybeCheckJwtAuthorization(r *http.Request, isWrite bool) bool {
 
-	var signingKey security.SigningKey
+	var signingKeys *security.JwtKeys
 
 	if isWrite {
-		if len(fs.filerGuard.SigningKey) == 0 {
+		if fs.filerGuard.SigningKeys.IsEmpty() {
 			return true
 		} else {
-			signingKey = fs.filerGuard.SigningKey
+			signingKeys = fs.filerGuard.SigningKeys
 		}
 	} else {
-		if len(fs.filerGuard.ReadSigningKey) == 0 {
+		if fs.filerGuard.ReadSigningKeys.IsEmpty() {
 			return true
 		} else {
-			signingKey = fs.filerGuard.ReadSigningKey
+			signingKeys = fs.filerGuard.ReadSigningKeys
 		}
 	}
 
@@ -164,7 +182,11 @@ func (fs *FilerServer) maybeCheckJwtAuthorization(r *http.Request, isWrite bool)
 		return false
 	}
 
-	token, err := security.DecodeJwt(signingKey, tokenStr, &security.SeaweedFilerClaims{})
+	claims := &security.SeaweedFilerClaims{}
+	token, err := signingKeys.Decode(tokenStr, claims)
+	if err != nil && fs.oidcVerifier != nil {
+		return fs.checkOidcAuthorization(r, tokenStr, isWrite)
+	}
 	if err != nil {
 		glog.V(1).Infof("jwt verification error from %s: %v", r.RemoteAddr, err)
 		return false
@@ -172,7 +194,90 @@ func (fs *FilerServer) maybeCheckJwtAuthorization(r *http.Request, isWrite bool)
 	if !token.Valid {
 		glog.V(1).Infof("jwt invalid from %s: %v", r.RemoteAddr, tokenStr)
 		return false
-	} else {
+	}
+	if !security.AllowsOperation(claims.Operation, isWrite) {
+		glog.V(1).Infof("jwt from %s only allows %s", r.RemoteAddr, claims.Operation)
+		return false
+	}
+	for _, p := range accessedPaths(r) {
+		if !claims.AllowsPath(p) {
+			glog.V(1).Infof("jwt from %s does not allow %s", r.RemoteAddr, p)
+			return false
+		}
+	}
+	return true
+}
+
+// accessedPaths are the paths read or changed by the request: the url path, and the source of a move
+func accessedPaths(r *http.Request) []string {
+	paths := []string{path.Clean("/" + r.URL.Path)}
+	if query := r.URL.Query(); query.Has("mv.from") {
+		paths = append(paths, path.Clean("/"+query.Get("mv.from")))
+	}
+	return paths
+}
+
+// checkOidcAuthorization grants the access by the "Admin", "Write" or "Read" actions
+// of the jwt.oidc.mapping entries matching the ID token
+func (fs *FilerServer) checkOidcAuthorization(r *http.Request, tokenStr security.EncodedJwt, isWrite bool) bool {
+	claims, err := fs.oidcVerifier.Verify(string(tokenStr))
+	if err != nil {
+		glog.V(1).Infof("oidc token verification error from %s: %v", r.RemoteAddr, err)
+		return false
+	}
+	mappings := fs.oidcVerifier.MatchingMappings(claims)
+	for _, p := range accessedPaths(r) {
+		if !oidcMappingsAllow(mappings, isWrite, fs.filer.DirBucketsPath, p) {
+			glog.V(1).Infof("oidc token of %v from %s is not mapped to access %s", claims["sub"], r.RemoteAddr, p)
+			return false
+		}
+	}
+	return true
+}
+
+func oidcMappingsAllow(mappings []*security.OidcMapping, isWrite bool, bucketsPath, urlPath string) bool {
+	for _, mapping := range mappings {
+		for _, action := range mapping.Actions {
+			if oidcActionAllows(action, isWrite, bucketsPath, urlPath) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// oidcActionAllows checks the path against the action. Only "Admin" covers the whole filer.
+// The other actions cover the buckets folder, or only the buckets they are limited to, e.g. "Write:builds" or "Read:logs-*".
+func oidcActionAllows(action string, isWrite bool, bucketsPath, urlPath string) bool {
+	parts := strings.SplitN(action, ":", 2)
+	switch parts[0] {
+	case s3_constants.ACTION_ADMIN:
+		if len(parts) == 1 {
+			return true
+		}
+	case s3_constants.ACTION_WRITE:
+	case s3_constants.ACTION_READ:
+		if isWrite {
+			return false
+		}
+	default:
+		return false
+	}
+
+	fullpath := path.Clean("/" + urlPath)
+	if fullpath == bucketsPath {
+		return len(parts) == 1 && !isWrite
+	}
+	if !strings.HasPrefix(fullpath, bucketsPath+"/") {
+		return false
+	}
+	if len(parts) == 1 {
 		return true
 	}
+	target := strings.TrimPrefix(fullpath, bucketsPath+"/")
+	limit := parts[1]
+	if strings.HasSuffix(limit, "*") {
+		return strings.HasPrefix(target, limit[:len(limit)-1])
+	}
+	return target == limit || strings.HasPrefix(target, limit+"/")
 }
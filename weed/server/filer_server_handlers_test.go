@@ -0,0 +1,128 @@
+package weed_server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/security"
+)
+
+func TestOidcActionAllows(t *testing.T) {
+	tests := []struct {
+		action   string
+		isWrite  bool
+		path     string
+		expected bool
+	}{
+		{"Admin", true, "/etc/seaweedfs/filer.conf", true},
+		{"Admin:builds", true, "/buckets/builds/a.txt", true},
+		{"Admin:builds", true, "/etc/seaweedfs/filer.conf", false},
+		{"Write", true, "/buckets/logs/a.txt", true},
+		{"Write", true, "/etc/seaweedfs/filer.conf", false},
+		{"Write", true, "/buckets", false},
+		{"Read", false, "/buckets", true},
+		{"Read", false, "/buckets/logs/a.txt", true},
+		{"Read", true, "/buckets/logs/a.txt", false},
+		{"Read", false, "/topics/a.txt", false},
+		{"Write:builds", true, "/buckets/builds", true},
+		{"Write:builds", true, "/buckets/builds/a/b.txt", true},
+		{"Write:builds", true, "/buckets/builds2/a.txt", false},
+		{"Write:builds", true, "/buckets/logs/a.txt", false},
+		{"Write:builds", true, "/buckets/builds/../logs/a.txt", false},
+		{"Read:builds", false, "/buckets", false},
+		{"Read:logs-*", false, "/buckets/logs-2021/a.txt", true},
+		{"Read:logs-*", false, "/buckets/metrics/a.txt", false},
+		{"Tagging", true, "/buckets/logs/a.txt", false},
+	}
+	for _, tt := range tests {
+		if actual := oidcActionAllows(tt.action, tt.isWrite, "/buckets", tt.path); actual != tt.expected {
+			t.Errorf("%s write=%v %s: expected %v, got %v", tt.action, tt.isWrite, tt.path, tt.expected, actual)
+		}
+	}
+}
+
+func TestJwtPathScope(t *testing.T) {
+	signingKeys := security.NewJwtKeys("secret")
+	fs := &FilerServer{filerGuard: security.NewGuard(nil, signingKeys, 10, security.NewJwtKeys(""), 0)}
+	token := security.GenJwtForFilerServer(signingKeys, 10, "/allowed/prefix", security.JwtOperationWrite)
+
+	tests := []struct {
+		url      string
+		expected bool
+	}{
+		{"/allowed/prefix/x", true},
+		{"/allowed/prefix/x?mv.from=/allowed/prefix/y", true},
+		{"/allowed/other", false},
+		{"/allowed/prefix/../other", false},
+		// the source of a move must be in the scope too
+		{"/allowed/prefix/x?mv.from=/etc/seaweedfs/filer.conf", false},
+		{"/allowed/prefix/x?mv.from=/allowed/prefix/../../etc", false},
+		{"/allowed/prefix/x?mv.from=", false},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodPost, tt.url, nil)
+		r.Header.Set("Authorization", "Bearer "+string(token))
+		if actual := fs.maybeCheckJwtAuthorization(r, true); actual != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.url, tt.expected, actual)
+		}
+	}
+}
+
+func TestOidcMoveSource(t *testing.T) {
+	mappings := []*security.OidcMapping{{Actions: []string{"Write:builds"}}}
+	allows := func(url string) bool {
+		for _, p := range accessedPaths(httptest.NewRequest(http.MethodPost, url, nil)) {
+			if !oidcMappingsAllow(mappings, true, "/buckets", p) {
+				return false
+			}
+		}
+		return true
+	}
+	if !allows("/buckets/builds/a.txt?mv.from=/buckets/builds/b.txt") {
+		t.Errorf("expected a move within the bucket to be allowed")
+	}
+	for _, url := range []string{
+		"/buckets/builds/a.txt?mv.from=/buckets/logs/a.txt",
+		"/buckets/builds/a.txt?mv.from=/etc/seaweedfs/filer.conf",
+		"/buckets/builds/a.txt?mv.from=/buckets/builds/../logs/a.txt",
+	} {
+		if allows(url) {
+			t.Errorf("%s: expected the move source to be refused", url)
+		}
+	}
+}
+
+func TestObjectLockRelease(t *testing.T) {
+	signingKeys := security.NewJwtKeys("secret")
+	fs := &FilerServer{filerGuard: security.NewGuard(nil, signingKeys, 10, security.NewJwtKeys(""), 0)}
+	sign := func(keys *security.JwtKeys, path, operation string) string {
+		return string(security.GenJwtForFilerServer(keys, 10, path, operation))
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		expected filer.ObjectLockRelease
+	}{
+		{"unsigned", "", filer.ObjectLockRelease{}},
+		{"forged", sign(security.NewJwtKeys("forged"), "/buckets/b/key", security.JwtOperationRemoveLegalHold), filer.ObjectLockRelease{}},
+		{"other path", sign(signingKeys, "/buckets/b/other", security.JwtOperationRemoveLegalHold), filer.ObjectLockRelease{}},
+		{"parent path", sign(signingKeys, "/buckets/b", security.JwtOperationBypassGovernance), filer.ObjectLockRelease{}},
+		{"write", sign(signingKeys, "/buckets/b/key", security.JwtOperationWrite), filer.ObjectLockRelease{}},
+		{"remove legal hold", sign(signingKeys, "/buckets/b/key", security.JwtOperationRemoveLegalHold), filer.ObjectLockRelease{RemoveLegalHold: true}},
+		{"bypass governance", sign(signingKeys, "/buckets/b/key", security.JwtOperationBypassGovernance), filer.ObjectLockRelease{BypassGovernance: true}},
+	}
+	for _, tt := range tests {
+		if actual := fs.objectLockRelease("/buckets/b/key", tt.token); actual != tt.expected {
+			t.Errorf("%s: expected %+v, got %+v", tt.name, tt.expected, actual)
+		}
+	}
+
+	// without the jwt.filer_signing key, nothing is released
+	fs = &FilerServer{filerGuard: security.NewGuard(nil, security.NewJwtKeys(""), 10, security.NewJwtKeys(""), 0)}
+	if actual := fs.objectLockRelease("/buckets/b/key", sign(signingKeys, "/buckets/b/key", security.JwtOperationRemoveLegalHold)); actual != (filer.ObjectLockRelease{}) {
+		t.Errorf("released %+v without signing keys", actual)
+	}
+}
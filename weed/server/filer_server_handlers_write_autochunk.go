@@ -210,7 +210,7 @@ func (fs *FilerServer) saveMetaData(ctx context.Context, r *http.Request, fileNa
 	}
 
 	// maybe compact entry chunks
-	mergedChunks, replyerr = filer.MaybeManifestize(fs.saveAsChunk(so), mergedChunks)
+	mergedChunks, replyerr = filer.MaybeManifestize(fs.saveAsChunk(so, fs.option.Cipher || hasCipherKey(mergedChunks)), mergedChunks)
 	if replyerr != nil {
 		glog.V(0).Infof("manifestize %s: %v", r.RequestURI, replyerr)
 		return
@@ -247,7 +247,17 @@ func (fs *FilerServer) saveMetaData(ctx context.Context, r *http.Request, fileNa
 	return filerResult, replyerr
 }
 
-func (fs *FilerServer) saveAsChunk(so *operation.StorageOption) filer.SaveDataAsChunkFunctionType {
+// hasCipherKey is true if the manifest chunks would list the cipher keys of the data chunks, so they are encrypted as well
+func hasCipherKey(chunks []*filer_pb.FileChunk) bool {
+	for _, chunk := range chunks {
+		if len(chunk.CipherKey) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (fs *FilerServer) saveAsChunk(so *operation.StorageOption, cipher bool) filer.SaveDataAsChunkFunctionType {
 
 	return func(reader io.Reader, name string, offset int64) (*filer_pb.FileChunk, string, string, error) {
 		// assign one file id for one chunk
@@ -260,7 +270,7 @@ func (fs *FilerServer) saveAsChunk(so *operation.StorageOption) filer.SaveDataAs
 		uploadOption := &operation.UploadOption{
 			UploadUrl:         urlLocation,
 			Filename:          name,
-			Cipher:            fs.option.Cipher,
+			Cipher:            cipher,
 			IsInputCompressed: false,
 			MimeType:          "",
 			PairMap:           nil,
@@ -324,6 +334,11 @@ func (fs *FilerServer) mkdir(ctx context.Context, w http.ResponseWriter, r *http
 	return filerResult, replyerr
 }
 
+var objectLockHeaders = []string{xhttp.AmzObjectLockMode, xhttp.AmzObjectLockRetainUntilDate, xhttp.AmzObjectLockLegalHold}
+
+var serverSideEncryptionHeaders = []string{xhttp.AmzServerSideEncryption, xhttp.AmzServerSideEncryptionAwsKmsKeyId,
+	xhttp.AmzServerSideEncryptionCustomerAlgorithm, xhttp.AmzSseIv, xhttp.AmzSseKeyHmac, xhttp.AmzSseDataKey, xhttp.AmzSseParts}
+
 func SaveAmzMetaData(r *http.Request, existing map[string][]byte, isReplace bool) (metadata map[string][]byte) {
 
 	metadata = make(map[string][]byte)
@@ -337,6 +352,26 @@ func SaveAmzMetaData(r *http.Request, existing map[string][]byte, isReplace bool
 		metadata[xhttp.AmzStorageClass] = []byte(sc)
 	}
 
+	// object lock is not replaced along with the metadata
+	for _, header := range objectLockHeaders {
+		if v, found := existing[header]; found {
+			metadata[header] = v
+		}
+		if v := r.Header.Get(header); v != "" {
+			metadata[header] = []byte(v)
+		}
+	}
+
+	// the encryption describes how the content is stored, so it is not replaced along with the metadata either
+	for _, header := range serverSideEncryptionHeaders {
+		if v, found := existing[header]; found {
+			metadata[header] = v
+		}
+		if v := r.Header.Get(header); v != "" {
+			metadata[header] = []byte(v)
+		}
+	}
+
 	if tags := r.Header.Get(xhttp.AmzObjectTagging); tags != "" {
 		for _, v := range strings.Split(tags, "&") {
 			tag := strings.Split(v, "=")
@@ -348,6 +383,14 @@ func SaveAmzMetaData(r *http.Request, existing map[string][]byte, isReplace bool
 		}
 	}
 
+	if versionId := r.Header.Get(xhttp.AmzVersionId); versionId != "" {
+		metadata[xhttp.AmzVersionId] = []byte(versionId)
+	}
+
+	if acl := r.Header.Get(xhttp.AmzAcl); acl != "" {
+		metadata[xhttp.AmzAcl] = []byte(acl)
+	}
+
 	for header, values := range r.Header {
 		if strings.HasPrefix(header, xhttp.AmzUserMetaPrefix) {
 			for _, value := range values {
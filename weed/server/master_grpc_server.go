@@ -161,6 +161,11 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 			}
 
 		}
+		if len(heartbeat.CorruptedNeedles) > 0 {
+			stats.MasterReceivedHeartbeatCounter.WithLabelValues("corruptedNeedles").Inc()
+			ms.Topo.ReportCorruptedNeedles(dn, heartbeat.CorruptedNeedles)
+		}
+
 		if len(message.NewVids) > 0 || len(message.DeletedVids) > 0 {
 			ms.broadcastToClients(&master_pb.KeepConnectedResponse{VolumeLocation: message})
 		}
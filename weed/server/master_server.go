@@ -24,6 +24,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/sequence"
 	"github.com/chrislusf/seaweedfs/weed/shell"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
 	"github.com/chrislusf/seaweedfs/weed/topology"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/chrislusf/seaweedfs/weed/wdclient"
@@ -78,11 +79,17 @@ type MasterServer struct {
 func NewMasterServer(r *mux.Router, option *MasterOption, peers []pb.ServerAddress) *MasterServer {
 
 	v := util.GetViper()
-	signingKey := v.GetString("jwt.signing.key")
+	signingKeys, err := security.LoadJwtKeys(v, "jwt.signing")
+	if err != nil {
+		glog.Fatalf("load jwt signing keys: %v", err)
+	}
 	v.SetDefault("jwt.signing.expires_after_seconds", 10)
 	expiresAfterSec := v.GetInt("jwt.signing.expires_after_seconds")
 
-	readSigningKey := v.GetString("jwt.signing.read.key")
+	readSigningKeys, err := security.LoadJwtKeys(v, "jwt.signing.read")
+	if err != nil {
+		glog.Fatalf("load jwt read signing keys: %v", err)
+	}
 	v.SetDefault("jwt.signing.read.expires_after_seconds", 60)
 	readExpiresAfterSec := v.GetInt("jwt.signing.read.expires_after_seconds")
 
@@ -94,6 +101,8 @@ func NewMasterServer(r *mux.Router, option *MasterOption, peers []pb.ServerAddre
 	v.SetDefault("master.volume_growth.copy_3", 3)
 	v.SetDefault("master.volume_growth.copy_other", 1)
 	v.SetDefault("master.volume_growth.threshold", 0.9)
+	v.SetDefault("master.erasure_coding.data_shards", erasure_coding.DataShardsCount)
+	v.SetDefault("master.erasure_coding.parity_shards", erasure_coding.ParityShardsCount)
 
 	var preallocateSize int64
 	if option.VolumePreallocate {
@@ -121,24 +130,27 @@ func NewMasterServer(r *mux.Router, option *MasterOption, peers []pb.ServerAddre
 	ms.vg = topology.NewDefaultVolumeGrowth()
 	glog.V(0).Infoln("Volume Size Limit is", ms.option.VolumeSizeLimitMB, "MB")
 
-	ms.guard = security.NewGuard(ms.option.WhiteList, signingKey, expiresAfterSec, readSigningKey, readExpiresAfterSec)
+	ms.guard = security.NewGuard(ms.option.WhiteList, signingKeys, expiresAfterSec, readSigningKeys, readExpiresAfterSec)
+	if err := ms.guard.LoadAllowLists(v, "master"); err != nil {
+		glog.Fatalf("load allow-lists: %v", err)
+	}
 
 	handleStaticResources2(r)
 	r.HandleFunc("/", ms.proxyToLeader(ms.uiStatusHandler))
 	r.HandleFunc("/ui/index.html", ms.uiStatusHandler)
 	if !ms.option.DisableHttp {
-		r.HandleFunc("/dir/assign", ms.proxyToLeader(ms.guard.WhiteList(ms.dirAssignHandler)))
-		r.HandleFunc("/dir/lookup", ms.guard.WhiteList(ms.dirLookupHandler))
-		r.HandleFunc("/dir/status", ms.proxyToLeader(ms.guard.WhiteList(ms.dirStatusHandler)))
-		r.HandleFunc("/col/delete", ms.proxyToLeader(ms.guard.WhiteList(ms.collectionDeleteHandler)))
-		r.HandleFunc("/vol/grow", ms.proxyToLeader(ms.guard.WhiteList(ms.volumeGrowHandler)))
-		r.HandleFunc("/vol/status", ms.proxyToLeader(ms.guard.WhiteList(ms.volumeStatusHandler)))
-		r.HandleFunc("/vol/vacuum", ms.proxyToLeader(ms.guard.WhiteList(ms.volumeVacuumHandler)))
-		r.HandleFunc("/submit", ms.guard.WhiteList(ms.submitFromMasterServerHandler))
+		r.HandleFunc("/dir/assign", ms.proxyToLeader(ms.guard.Allow(security.AccessWrite, ms.dirAssignHandler)))
+		r.HandleFunc("/dir/lookup", ms.guard.Allow(security.AccessRead, ms.dirLookupHandler))
+		r.HandleFunc("/dir/status", ms.proxyToLeader(ms.guard.Allow(security.AccessAdmin, ms.dirStatusHandler)))
+		r.HandleFunc("/col/delete", ms.proxyToLeader(ms.guard.Allow(security.AccessAdmin, ms.collectionDeleteHandler)))
+		r.HandleFunc("/vol/grow", ms.proxyToLeader(ms.guard.Allow(security.AccessAdmin, ms.volumeGrowHandler)))
+		r.HandleFunc("/vol/status", ms.proxyToLeader(ms.guard.Allow(security.AccessAdmin, ms.volumeStatusHandler)))
+		r.HandleFunc("/vol/vacuum", ms.proxyToLeader(ms.guard.Allow(security.AccessAdmin, ms.volumeVacuumHandler)))
+		r.HandleFunc("/submit", ms.guard.Allow(security.AccessWrite, ms.submitFromMasterServerHandler))
 		/*
-			r.HandleFunc("/stats/health", ms.guard.WhiteList(statsHealthHandler))
-			r.HandleFunc("/stats/counter", ms.guard.WhiteList(statsCounterHandler))
-			r.HandleFunc("/stats/memory", ms.guard.WhiteList(statsMemoryHandler))
+			r.HandleFunc("/stats/health", ms.guard.Allow(security.AccessAdmin, statsHealthHandler))
+			r.HandleFunc("/stats/counter", ms.guard.Allow(security.AccessAdmin, statsCounterHandler))
+			r.HandleFunc("/stats/memory", ms.guard.Allow(security.AccessAdmin, statsMemoryHandler))
 		*/
 		r.HandleFunc("/{fileId}", ms.redirectHandler)
 	}
@@ -194,7 +206,7 @@ func (ms *MasterServer) proxyToLeader(f http.HandlerFunc) http.HandlerFunc {
 			proxy := httputil.NewSingleHostReverseProxy(targetUrl)
 			director := proxy.Director
 			proxy.Director = func(req *http.Request) {
-				actualHost, err := security.GetActualRemoteHost(req)
+				actualHost, err := ms.guard.RemoteHost(req)
 				if err == nil {
 					req.Header.Set("HTTP_X_FORWARDED_FOR", actualHost)
 				}
@@ -98,6 +98,7 @@ func (ms *MasterServer) volumeStatusHandler(w http.ResponseWriter, r *http.Reque
 	m := make(map[string]interface{})
 	m["Version"] = util.Version()
 	m["Volumes"] = ms.Topo.ToVolumeMap()
+	m["CorruptedNeedles"] = ms.Topo.CorruptedNeedles()
 	writeJsonQuiet(w, r, http.StatusOK, m)
 }
 
@@ -169,5 +170,9 @@ func (ms *MasterServer) getVolumeGrowOption(r *http.Request) (*topology.VolumeGr
 		DataNode:           r.FormValue("dataNode"),
 		MemoryMapMaxSizeMb: memoryMapMaxSizeMb,
 	}
+	if _, isEcDirectWrite := volumeGrowOption.EcDirectWriteScheme(); isEcDirectWrite {
+		// the ec shards replace the replicas
+		volumeGrowOption.ReplicaPlacement = &super_block.ReplicaPlacement{}
+	}
 	return volumeGrowOption, nil
 }
@@ -33,16 +33,31 @@ func (vs *VolumeServer) AllocateVolume(ctx context.Context, req *volume_server_p
 
 	resp := &volume_server_pb.AllocateVolumeResponse{}
 
-	err := vs.store.AddVolume(
-		needle.VolumeId(req.VolumeId),
-		req.Collection,
-		vs.needleMapKind,
-		req.Replication,
-		req.Ttl,
-		req.Preallocate,
-		req.MemoryMapMaxSizeMb,
-		types.ToDiskType(req.DiskType),
-	)
+	var err error
+	if len(req.EcShardLocations) > 0 {
+		err = vs.store.AddEcDirectVolume(
+			needle.VolumeId(req.VolumeId),
+			req.Collection,
+			vs.needleMapKind,
+			req.Ttl,
+			req.MemoryMapMaxSizeMb,
+			types.ToDiskType(req.DiskType),
+			req.EcDataShards,
+			req.EcParityShards,
+			req.EcShardLocations,
+		)
+	} else {
+		err = vs.store.AddVolume(
+			needle.VolumeId(req.VolumeId),
+			req.Collection,
+			vs.needleMapKind,
+			req.Replication,
+			req.Ttl,
+			req.Preallocate,
+			req.MemoryMapMaxSizeMb,
+			types.ToDiskType(req.DiskType),
+		)
+	}
 
 	if err != nil {
 		glog.Errorf("assign volume %v: %v", req, err)
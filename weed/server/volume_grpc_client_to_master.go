@@ -195,6 +195,16 @@ func (vs *VolumeServer) doHeartbeat(masterAddress pb.ServerAddress, grpcDialOpti
 				glog.V(0).Infof("Volume Server Failed to update to master %s: %v", masterAddress, err)
 				return "", err
 			}
+		case corruptedNeedle := <-vs.store.CorruptedNeedlesChan:
+			deltaBeat := &master_pb.Heartbeat{
+				CorruptedNeedles: []*master_pb.CorruptedNeedleInformationMessage{
+					corruptedNeedle,
+				},
+			}
+			if err = stream.Send(deltaBeat); err != nil {
+				glog.V(0).Infof("Volume Server Failed to update to master %s: %v", masterAddress, err)
+				return "", err
+			}
 		case <-volumeTickChan:
 			glog.V(4).Infof("volume server %s:%d heartbeat", vs.store.Ip, vs.store.Port)
 			vs.store.MaybeAdjustVolumeMax()
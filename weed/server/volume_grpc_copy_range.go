@@ -0,0 +1,104 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// CopyNeedleRange writes a range of an existing needle as a new needle, so that the data does not go through the clients
+func (vs *VolumeServer) CopyNeedleRange(ctx context.Context, req *volume_server_pb.CopyNeedleRangeRequest) (*volume_server_pb.CopyNeedleRangeResponse, error) {
+	v := vs.store.GetVolume(needle.VolumeId(req.VolumeId))
+	if v == nil {
+		return nil, fmt.Errorf("not found volume id %d", req.VolumeId)
+	}
+	if req.Offset < 0 || req.Size <= 0 {
+		return nil, fmt.Errorf("invalid range [%d,%d)", req.Offset, req.Offset+req.Size)
+	}
+
+	data, err := vs.readNeedleRange(req)
+	if err != nil {
+		return nil, fmt.Errorf("read %s [%d,%d): %v", req.SourceFileId, req.Offset, req.Offset+req.Size, err)
+	}
+
+	resp := &volume_server_pb.CopyNeedleRangeResponse{
+		ETag: util.Base64Md5(data),
+	}
+	if req.Cipher {
+		resp.CipherKey = util.GenCipherKey()
+		if data, err = util.Encrypt(data, resp.CipherKey); err != nil {
+			return nil, fmt.Errorf("encrypt: %v", err)
+		}
+	}
+
+	if err = vs.writeNeedleWithReplicas(v, req.NeedleId, req.Cookie, data, req.Replicas, req.Auth); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// readNeedleRange reads the plain data range from the local volume if possible, or else from the source volume servers
+func (vs *VolumeServer) readNeedleRange(req *volume_server_pb.CopyNeedleRangeRequest) ([]byte, error) {
+	vid, idCookie, err := operation.ParseFileId(req.SourceFileId)
+	if err != nil {
+		return nil, err
+	}
+	volumeId, err := needle.NewVolumeId(vid)
+	if err != nil {
+		return nil, err
+	}
+
+	if vs.store.HasVolume(volumeId) {
+		n := new(needle.Needle)
+		if err = n.ParsePath(idCookie); err != nil {
+			return nil, err
+		}
+		cookie := n.Cookie
+		if _, err = vs.store.ReadVolumeNeedle(volumeId, n, nil, nil); err != nil {
+			return nil, err
+		}
+		if n.Cookie != cookie {
+			return nil, fmt.Errorf("cookie mismatch")
+		}
+		data := n.Data
+		if len(req.SourceCipherKey) > 0 {
+			if data, err = util.Decrypt(data, req.SourceCipherKey); err != nil {
+				return nil, err
+			}
+		}
+		if req.SourceIsCompressed || len(req.SourceCipherKey) == 0 && n.IsCompressed() {
+			if data, err = util.DecompressData(data); err != nil {
+				return nil, err
+			}
+		}
+		if req.Offset+req.Size > int64(len(data)) {
+			return nil, fmt.Errorf("out of range, needle size %d", len(data))
+		}
+		return data[req.Offset : req.Offset+req.Size], nil
+	}
+
+	buf := make([]byte, req.Size)
+	for _, url := range req.SourceUrls {
+		var n int64
+		n, err = util.ReadUrl(url, req.SourceCipherKey, req.SourceIsCompressed, false, req.Offset, int(req.Size), buf)
+		if err != nil {
+			glog.V(1).Infof("read %s: %v", url, err)
+			continue
+		}
+		if n != req.Size {
+			err = fmt.Errorf("read %d bytes from %s, expected %d", n, url, req.Size)
+			continue
+		}
+		return buf, nil
+	}
+	if err == nil {
+		err = fmt.Errorf("no source location")
+	}
+	return nil, err
+}
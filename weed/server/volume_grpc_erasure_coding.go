@@ -9,6 +9,8 @@ import (
 	"path"
 	"strings"
 
+	"google.golang.org/grpc"
+
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb"
@@ -25,13 +27,20 @@ import (
 
 Steps to apply erasure coding to .dat .idx files
 0. ensure the volume is readonly
-1. client call VolumeEcShardsGenerate to generate the .ecx and .ec00 ~ .ec13 files
+1. client call VolumeEcShardsGenerate to generate the .ecx and .ec00 ~ .ec13 files, or as many as the scheme has shards
 2. client ask master for possible servers to hold the ec files
 3. client call VolumeEcShardsCopy on above target servers to copy ec files from the source server
 4. target servers report the new ec files to the master
 5.   master stores vid -> [14]*DataNode
 6. client checks master. If all 14 slices are ready, delete the original .idx, .idx files
 
+The scheme, 10 data shards and 4 parity shards by default, is saved in the .vif file,
+which is copied along with the .ecx file.
+
+For the collections written directly into ec shards, the volume server writing the volume
+sends the shards and .ecx entries to their servers with VolumeEcShardsWrite at write time.
+VolumeEcShardsGenerate then only mounts the shards where they are.
+
 */
 
 // VolumeEcShardsGenerate generates the .ecx and .ec00 ~ .ec13 files
@@ -49,19 +58,32 @@ func (vs *VolumeServer) VolumeEcShardsGenerate(ctx context.Context, req *volume_
 		return nil, fmt.Errorf("existing collection:%v unexpected input: %v", v.Collection, req.Collection)
 	}
 
+	// the volume written directly into ec shards only needs to mount them where they are
+	if shardLocations, isEcDirectWrite := v.EcDirectWriteShardLocations(); isEcDirectWrite {
+		if err := mountEcDirectWriteShards(vs.grpcDialOption, req.VolumeId, req.Collection, shardLocations); err != nil {
+			return nil, err
+		}
+		return &volume_server_pb.VolumeEcShardsGenerateResponse{ShardsMounted: true}, nil
+	}
+
+	scheme, err := erasure_coding.NewScheme(req.DataShards, req.ParityShards)
+	if err != nil {
+		return nil, err
+	}
+
 	shouldCleanup := true
 	defer func() {
 		if !shouldCleanup {
 			return
 		}
-		for i := 0; i < erasure_coding.TotalShardsCount; i++ {
-			os.Remove(fmt.Sprintf("%s.ec%2d", baseFileName, i))
+		for i := 0; i < scheme.TotalShards(); i++ {
+			os.Remove(baseFileName + erasure_coding.ToExt(i))
 		}
 		os.Remove(v.IndexFileName() + ".ecx")
 	}()
 
 	// write .ec00 ~ .ec13 files
-	if err := erasure_coding.WriteEcFiles(baseFileName); err != nil {
+	if err := erasure_coding.WriteEcFiles(baseFileName, scheme); err != nil {
 		return nil, fmt.Errorf("WriteEcFiles %s: %v", baseFileName, err)
 	}
 
@@ -71,7 +93,11 @@ func (vs *VolumeServer) VolumeEcShardsGenerate(ctx context.Context, req *volume_
 	}
 
 	// write .vif files
-	if err := volume_info.SaveVolumeInfo(baseFileName+".vif", &volume_server_pb.VolumeInfo{Version: uint32(v.Version())}); err != nil {
+	if err := volume_info.SaveVolumeInfo(baseFileName+".vif", &volume_server_pb.VolumeInfo{
+		Version:        uint32(v.Version()),
+		EcDataShards:   uint32(scheme.DataShards),
+		EcParityShards: uint32(scheme.ParityShards),
+	}); err != nil {
 		return nil, fmt.Errorf("WriteEcFiles %s: %v", baseFileName, err)
 	}
 
@@ -80,6 +106,26 @@ func (vs *VolumeServer) VolumeEcShardsGenerate(ctx context.Context, req *volume_
 	return &volume_server_pb.VolumeEcShardsGenerateResponse{}, nil
 }
 
+func mountEcDirectWriteShards(grpcDialOption grpc.DialOption, volumeId uint32, collection string, shardLocations []pb.ServerAddress) error {
+	serverShardIds := make(map[pb.ServerAddress][]uint32)
+	for shardId, location := range shardLocations {
+		serverShardIds[location] = append(serverShardIds[location], uint32(shardId))
+	}
+	for server, shardIds := range serverShardIds {
+		if err := operation.WithVolumeServerClient(false, server, grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			_, mountErr := client.VolumeEcShardsMount(context.Background(), &volume_server_pb.VolumeEcShardsMountRequest{
+				VolumeId:   volumeId,
+				Collection: collection,
+				ShardIds:   shardIds,
+			})
+			return mountErr
+		}); err != nil {
+			return fmt.Errorf("mount ec shards %d.%v on %s: %v", volumeId, shardIds, server, err)
+		}
+	}
+	return nil
+}
+
 // VolumeEcShardsRebuild generates the any of the missing .ec00 ~ .ec13 files
 func (vs *VolumeServer) VolumeEcShardsRebuild(ctx context.Context, req *volume_server_pb.VolumeEcShardsRebuildRequest) (*volume_server_pb.VolumeEcShardsRebuildResponse, error) {
 
@@ -93,7 +139,15 @@ func (vs *VolumeServer) VolumeEcShardsRebuild(ctx context.Context, req *volume_s
 		if util.FileExists(path.Join(location.IdxDirectory, baseFileName+".ecx")) {
 			// write .ec00 ~ .ec13 files
 			dataBaseFileName := path.Join(location.Directory, baseFileName)
-			if generatedShardIds, err := erasure_coding.RebuildEcFiles(dataBaseFileName); err != nil {
+			volumeInfo, _, _, err := volume_info.MaybeLoadVolumeInfo(dataBaseFileName + ".vif")
+			if err != nil {
+				return nil, fmt.Errorf("load %s.vif: %v", dataBaseFileName, err)
+			}
+			scheme, err := erasure_coding.SchemeOf(volumeInfo)
+			if err != nil {
+				return nil, fmt.Errorf("%s.vif: %v", dataBaseFileName, err)
+			}
+			if generatedShardIds, err := erasure_coding.RebuildEcFiles(dataBaseFileName, scheme); err != nil {
 				return nil, fmt.Errorf("RebuildEcFiles %s: %v", dataBaseFileName, err)
 			} else {
 				rebuiltShardIds = generatedShardIds
@@ -141,7 +195,6 @@ func (vs *VolumeServer) VolumeEcShardsCopy(ctx context.Context, req *volume_serv
 			if _, err := vs.doCopyFile(client, true, req.Collection, req.VolumeId, math.MaxUint32, math.MaxInt64, indexBaseFileName, ".ecx", false, false, nil); err != nil {
 				return err
 			}
-			return nil
 		}
 
 		if req.CopyEcjFile {
@@ -283,21 +336,30 @@ func (vs *VolumeServer) VolumeEcShardsUnmount(ctx context.Context, req *volume_s
 
 func (vs *VolumeServer) VolumeEcShardRead(req *volume_server_pb.VolumeEcShardReadRequest, stream volume_server_pb.VolumeServer_VolumeEcShardReadServer) error {
 
+	var readAt func(p []byte, off int64) (n int, err error)
 	ecVolume, found := vs.store.FindEcVolume(needle.VolumeId(req.VolumeId))
 	if !found {
-		return fmt.Errorf("VolumeEcShardRead not found ec volume id %d", req.VolumeId)
-	}
-	ecShard, found := ecVolume.FindEcVolumeShard(erasure_coding.ShardId(req.ShardId))
-	if !found {
-		return fmt.Errorf("not found ec shard %d.%d", req.VolumeId, req.ShardId)
-	}
+		// the shard may still be written directly by another volume server
+		shardFile, err := vs.store.OpenEcShardBeingWritten(req.Collection, needle.VolumeId(req.VolumeId), erasure_coding.ShardId(req.ShardId))
+		if err != nil {
+			return fmt.Errorf("VolumeEcShardRead not found ec volume id %d: %v", req.VolumeId, err)
+		}
+		defer shardFile.Close()
+		readAt = shardFile.ReadAt
+	} else {
+		ecShard, found := ecVolume.FindEcVolumeShard(erasure_coding.ShardId(req.ShardId))
+		if !found {
+			return fmt.Errorf("not found ec shard %d.%d", req.VolumeId, req.ShardId)
+		}
+		readAt = ecShard.ReadAt
 
-	if req.FileKey != 0 {
-		_, size, _ := ecVolume.FindNeedleFromEcx(types.Uint64ToNeedleId(req.FileKey))
-		if size.IsDeleted() {
-			return stream.Send(&volume_server_pb.VolumeEcShardReadResponse{
-				IsDeleted: true,
-			})
+		if req.FileKey != 0 {
+			_, size, _ := ecVolume.FindNeedleFromEcx(types.Uint64ToNeedleId(req.FileKey))
+			if size.IsDeleted() {
+				return stream.Send(&volume_server_pb.VolumeEcShardReadResponse{
+					IsDeleted: true,
+				})
+			}
 		}
 	}
 
@@ -315,7 +377,7 @@ func (vs *VolumeServer) VolumeEcShardRead(req *volume_server_pb.VolumeEcShardRea
 		if bufferSize > bytesToRead {
 			bufferSize = bytesToRead
 		}
-		bytesread, err := ecShard.ReadAt(buffer[0:bufferSize], startOffset)
+		bytesread, err := readAt(buffer[0:bufferSize], startOffset)
 
 		// println("read", ecShard.FileName(), "startOffset", startOffset, bytesread, "bytes, with target", bufferSize)
 		if bytesread > 0 {
@@ -349,6 +411,18 @@ func (vs *VolumeServer) VolumeEcShardRead(req *volume_server_pb.VolumeEcShardRea
 
 }
 
+// VolumeEcShardsWrite writes the ec shards of a volume written directly by another volume server
+func (vs *VolumeServer) VolumeEcShardsWrite(ctx context.Context, req *volume_server_pb.VolumeEcShardsWriteRequest) (*volume_server_pb.VolumeEcShardsWriteResponse, error) {
+
+	glog.V(4).Infof("VolumeEcShardsWrite: volume %d, %d shards, %d index entries", req.VolumeId, len(req.Shards), len(req.IndexEntries))
+
+	if err := vs.store.WriteEcShards(req); err != nil {
+		return nil, fmt.Errorf("write ec shards %d: %v", req.VolumeId, err)
+	}
+
+	return &volume_server_pb.VolumeEcShardsWriteResponse{}, nil
+}
+
 func (vs *VolumeServer) VolumeEcBlobDelete(ctx context.Context, req *volume_server_pb.VolumeEcBlobDeleteRequest) (*volume_server_pb.VolumeEcBlobDeleteResponse, error) {
 
 	glog.V(0).Infof("VolumeEcBlobDelete: %v", req)
@@ -400,7 +474,7 @@ func (vs *VolumeServer) VolumeEcShardsToVolume(ctx context.Context, req *volume_
 	}
 
 	// write .dat file from .ec00 ~ .ec09 files
-	if err := erasure_coding.WriteDatFile(dataBaseFileName, datFileSize); err != nil {
+	if err := erasure_coding.WriteDatFile(dataBaseFileName, datFileSize, v.Scheme, v.DirectWrite); err != nil {
 		return nil, fmt.Errorf("WriteEcFiles %s: %v", dataBaseFileName, err)
 	}
 
@@ -1,16 +1,31 @@
 package weed_server
 
 import (
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/query/csv"
 	"github.com/chrislusf/seaweedfs/weed/query/json"
+	"github.com/chrislusf/seaweedfs/weed/query/sqltypes"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/tidwall/gjson"
 )
 
 func (vs *VolumeServer) Query(req *volume_server_pb.QueryRequest, stream volume_server_pb.VolumeServer_QueryServer) error {
 
+	if req.InputSerialization == nil {
+		return fmt.Errorf("missing input serialization")
+	}
+
 	for _, fid := range req.FromFileIds {
 
 		vid, id_cookie, err := operation.ParseFileId(fid)
@@ -30,40 +45,210 @@ func (vs *VolumeServer) Query(req *volume_server_pb.QueryRequest, stream volume_
 		}
 
 		if n.Cookie != cookie {
-			glog.V(0).Infof("volume query failed to read fid cookie %s: %v", fid, err)
+			glog.V(0).Infof("volume query failed to read fid cookie %s", fid)
+			return fmt.Errorf("fid %s cookie mismatch", fid)
+		}
+
+		data, err := queryInputData(n, req.InputSerialization.CompressionType)
+		if err != nil {
+			glog.V(0).Infof("volume query failed to decompress fid %s: %v", fid, err)
 			return err
 		}
 
-		if req.InputSerialization.CsvInput != nil {
+		stripe := &volume_server_pb.QueriedStripe{}
+		switch {
+		case req.InputSerialization.CsvInput != nil:
+			stripe.Records, err = queryCsv(data, req)
+		case req.InputSerialization.JsonInput != nil:
+			stripe.Records = queryJson(data, req)
+		default:
+			err = fmt.Errorf("unsupported input serialization")
+		}
+		if err != nil {
+			return err
+		}
 
+		err = stream.Send(stripe)
+		if err != nil {
+			return err
 		}
 
-		if req.InputSerialization.JsonInput != nil {
+	}
 
-			stripe := &volume_server_pb.QueriedStripe{
-				Records: nil,
-			}
+	return nil
+}
+
+// maxQueryInputSize caps the decompressed data of one needle, so a small compressed needle
+// can not expand to run the volume server out of memory
+var maxQueryInputSize int64 = 256 * 1024 * 1024
+
+// queryInputData undoes the compression of the stored needle, and then the compression of the input data
+func queryInputData(n *needle.Needle, compressionType string) (data []byte, err error) {
+	data = n.Data
+	if n.IsCompressed() {
+		if !util.IsGzippedContent(data) {
+			return nil, util.UnsupportedCompression
+		}
+		if data, err = gunzipQueryInput(data); err != nil {
+			return nil, err
+		}
+	}
+
+	switch strings.ToUpper(compressionType) {
+	case "", "NONE":
+		return data, nil
+	case "GZIP":
+		return gunzipQueryInput(data)
+	case "BZIP2":
+		return readQueryInput(bzip2.NewReader(bytes.NewReader(data)))
+	default:
+		return nil, fmt.Errorf("unsupported compression type %s", compressionType)
+	}
+}
+
+func gunzipQueryInput(data []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return readQueryInput(reader)
+}
+
+func readQueryInput(reader io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, maxQueryInputSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxQueryInputSize {
+		return nil, fmt.Errorf("decompressed query input exceeds %d bytes", maxQueryInputSize)
+	}
+	return data, nil
+}
+
+func queryCsv(data []byte, req *volume_server_pb.QueryRequest) (records []byte, err error) {
 
-			filter := json.Query{
-				Field: req.Filter.Field,
-				Op:    req.Filter.Operand,
-				Value: req.Filter.Value,
+	input := req.InputSerialization.CsvInput
+	reader := csv.NewReader(data, csv.Input{
+		FileHeaderInfo:             input.FileHeaderInfo,
+		RecordDelimiter:            input.RecordDelimiter,
+		FieldDelimiter:             input.FieldDelimiter,
+		QuoteCharacter:             input.QuoteCharactoer,
+		QuoteEscapeCharacter:       input.QuoteEscapeCharacter,
+		Comments:                   input.Comments,
+		AllowQuotedRecordDelimiter: input.AllowQuotedRecordDelimiter,
+	})
+	filter := csv.Query{
+		Field: req.Filter.GetField(),
+		Op:    req.Filter.GetOperand(),
+		Value: req.Filter.GetValue(),
+	}
+	jsonOutput := req.OutputSerialization.GetJsonOutput()
+
+	for {
+		record, readErr := reader.Read()
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return nil, readErr
+		}
+		passedFilter, values := csv.QueryCsv(reader.Header, record, req.Selections, filter)
+		if !passedFilter {
+			continue
+		}
+
+		if jsonOutput == nil {
+			records = csv.ToCsv(records, values, toCsvOutput(req.OutputSerialization.GetCsvOutput()))
+			continue
+		}
+
+		names := req.Selections
+		if len(names) == 0 {
+			names = csvFieldNames(reader.Header, len(values))
+		}
+		jsonValues := make([]sqltypes.Value, len(values))
+		for i, value := range values {
+			jsonValues[i] = sqltypes.MakeTrusted(sqltypes.VarChar, json.ToJsonString(nil, value))
+		}
+		records = json.ToJson(records, names, jsonValues)
+		records = append(records, jsonRecordDelimiter(jsonOutput)...)
+	}
+
+	return records, nil
+}
+
+// csvFieldNames names the fields by the header, or by the position as _1, _2, ...
+func csvFieldNames(header []string, count int) (names []string) {
+	for i := 0; i < count; i++ {
+		if i < len(header) {
+			names = append(names, header[i])
+		} else {
+			names = append(names, "_"+strconv.Itoa(i+1))
+		}
+	}
+	return
+}
+
+func queryJson(data []byte, req *volume_server_pb.QueryRequest) (records []byte) {
+
+	filter := json.Query{
+		Field: req.Filter.GetField(),
+		Op:    req.Filter.GetOperand(),
+		Value: req.Filter.GetValue(),
+	}
+	csvOutput := req.OutputSerialization.GetCsvOutput()
+
+	gjson.ForEachLine(string(data), func(line gjson.Result) bool {
+		passedFilter, values := json.QueryJson(line.Raw, req.Selections, filter)
+		if !passedFilter {
+			return true
+		}
+
+		if csvOutput == nil {
+			if len(req.Selections) == 0 {
+				records = append(records, line.Raw...)
+			} else {
+				records = json.ToJson(records, req.Selections, values)
 			}
-			gjson.ForEachLine(string(n.Data), func(line gjson.Result) bool {
-				passedFilter, values := json.QueryJson(line.Raw, req.Selections, filter)
-				if !passedFilter {
-					return true
-				}
-				stripe.Records = json.ToJson(stripe.Records, req.Selections, values)
+			records = append(records, jsonRecordDelimiter(req.OutputSerialization.GetJsonOutput())...)
+			return true
+		}
+
+		var texts []string
+		if len(req.Selections) == 0 {
+			line.ForEach(func(key, value gjson.Result) bool {
+				texts = append(texts, value.String())
 				return true
 			})
-			err = stream.Send(stripe)
-			if err != nil {
-				return err
+		} else {
+			for _, value := range values {
+				texts = append(texts, gjson.ParseBytes(value.Raw()).String())
 			}
 		}
+		records = csv.ToCsv(records, texts, toCsvOutput(csvOutput))
+		return true
+	})
+
+	return records
+}
 
+func toCsvOutput(output *volume_server_pb.QueryRequest_OutputSerialization_CSVOutput) csv.Output {
+	if output == nil {
+		return csv.Output{}
+	}
+	return csv.Output{
+		QuoteFields:          output.QuoteFields,
+		RecordDelimiter:      output.RecordDelimiter,
+		FieldDelimiter:       output.FieldDelimiter,
+		QuoteCharacter:       output.QuoteCharactoer,
+		QuoteEscapeCharacter: output.QuoteEscapeCharacter,
 	}
+}
 
-	return nil
+func jsonRecordDelimiter(output *volume_server_pb.QueryRequest_OutputSerialization_JSONOutput) string {
+	if output == nil || output.RecordDelimiter == "" {
+		return "\n"
+	}
+	return output.RecordDelimiter
 }
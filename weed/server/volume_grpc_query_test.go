@@ -0,0 +1,56 @@
+package weed_server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func TestQueryInputData(t *testing.T) {
+	csv := []byte("a,b\n1,2\n")
+	gzipped, err := util.GzipData(csv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the data compressed by the client, and the needle compressed by the volume server
+	data, err := queryInputData(&needle.Needle{Data: gzipped}, "GZIP")
+	if err != nil || !bytes.Equal(data, csv) {
+		t.Errorf("gzip input: %q %v", data, err)
+	}
+	n := &needle.Needle{Data: gzipped}
+	n.SetIsCompressed()
+	data, err = queryInputData(n, "")
+	if err != nil || !bytes.Equal(data, csv) {
+		t.Errorf("compressed needle: %q %v", data, err)
+	}
+	if _, err = queryInputData(&needle.Needle{Data: csv}, "ZIP"); err == nil {
+		t.Errorf("expected unsupported compression")
+	}
+}
+
+func TestQueryInputDataLimit(t *testing.T) {
+	defer func(limit int64) { maxQueryInputSize = limit }(maxQueryInputSize)
+	maxQueryInputSize = 4 * 1024 * 1024
+
+	// a small needle expanding beyond the limit
+	var buf bytes.Buffer
+	w, _ := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	zeros := make([]byte, 1024*1024)
+	for written := int64(0); written <= maxQueryInputSize; written += int64(len(zeros)) {
+		w.Write(zeros)
+	}
+	w.Close()
+
+	if _, err := queryInputData(&needle.Needle{Data: buf.Bytes()}, "GZIP"); err == nil {
+		t.Errorf("expected the decompressed size to be capped")
+	}
+	n := &needle.Needle{Data: buf.Bytes()}
+	n.SetIsCompressed()
+	if _, err := queryInputData(n, ""); err == nil {
+		t.Errorf("expected the decompressed needle size to be capped")
+	}
+}
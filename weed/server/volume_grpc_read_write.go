@@ -15,6 +15,16 @@ func (vs *VolumeServer) ReadNeedleBlob(ctx context.Context, req *volume_server_p
 		return nil, fmt.Errorf("not found volume id %d", req.VolumeId)
 	}
 
+	if req.Offset == 0 {
+		var size types.Size
+		resp.NeedleBlob, size, err = v.ReadNeedleBlobByNeedleId(types.NeedleId(req.NeedleId))
+		if err != nil {
+			return nil, fmt.Errorf("read needle blob %d: %v", req.NeedleId, err)
+		}
+		resp.Size = int32(size)
+		return resp, nil
+	}
+
 	resp.NeedleBlob, err = v.ReadNeedleBlob(req.Offset, types.Size(req.Size))
 	if err != nil {
 		return nil, fmt.Errorf("read needle blob offset %d size %d: %v", req.Offset, req.Size, err)
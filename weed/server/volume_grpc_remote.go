@@ -7,6 +7,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
 	"github.com/chrislusf/seaweedfs/weed/remote_storage"
 	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/storage/types"
 	"sync"
@@ -34,13 +35,20 @@ func (vs *VolumeServer) FetchAndWriteNeedle(ctx context.Context, req *volume_ser
 		return nil, fmt.Errorf("read from remote %+v: %v", remoteStorageLocation, ReadRemoteErr)
 	}
 
+	err = vs.writeNeedleWithReplicas(v, req.NeedleId, req.Cookie, data, req.Replicas, req.Auth)
+
+	return resp, err
+}
+
+// writeNeedleWithReplicas writes the data as a new needle to the local volume and its replicas
+func (vs *VolumeServer) writeNeedleWithReplicas(v *storage.Volume, needleId uint64, cookie uint32, data []byte, replicas []*volume_server_pb.FetchAndWriteNeedleRequest_Replica, auth string) (err error) {
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		n := new(needle.Needle)
-		n.Id = types.NeedleId(req.NeedleId)
-		n.Cookie = types.Cookie(req.Cookie)
+		n.Id = types.NeedleId(needleId)
+		n.Cookie = types.Cookie(cookie)
 		n.Data, n.DataSize = data, uint32(len(data))
 		// copied from *Needle.prepareWriteBuffer()
 		n.Size = 4 + types.Size(n.DataSize) + 1
@@ -49,13 +57,13 @@ func (vs *VolumeServer) FetchAndWriteNeedle(ctx context.Context, req *volume_ser
 		n.SetHasLastModifiedDate()
 		if _, localWriteErr := vs.store.WriteVolumeNeedle(v.Id, n, true, false); localWriteErr != nil {
 			if err == nil {
-				err = fmt.Errorf("local write needle %d size %d: %v", req.NeedleId, req.Size, err)
+				err = fmt.Errorf("local write needle %d size %d: %v", needleId, len(data), localWriteErr)
 			}
 		}
 	}()
-	if len(req.Replicas) > 0 {
-		fileId := needle.NewFileId(v.Id, req.NeedleId, req.Cookie)
-		for _, replica := range req.Replicas {
+	if len(replicas) > 0 {
+		fileId := needle.NewFileId(v.Id, needleId, cookie)
+		for _, replica := range replicas {
 			wg.Add(1)
 			go func(targetVolumeServer string) {
 				defer wg.Done()
@@ -66,11 +74,11 @@ func (vs *VolumeServer) FetchAndWriteNeedle(ctx context.Context, req *volume_ser
 					IsInputCompressed: false,
 					MimeType:          "",
 					PairMap:           nil,
-					Jwt:               security.EncodedJwt(req.Auth),
+					Jwt:               security.EncodedJwt(auth),
 				}
 				if _, replicaWriteErr := operation.UploadData(data, uploadOption); replicaWriteErr != nil {
 					if err == nil {
-						err = fmt.Errorf("remote write needle %d size %d: %v", req.NeedleId, req.Size, err)
+						err = fmt.Errorf("remote write needle %d size %d: %v", needleId, len(data), replicaWriteErr)
 					}
 				}
 			}(replica.Url)
@@ -79,5 +87,5 @@ func (vs *VolumeServer) FetchAndWriteNeedle(ctx context.Context, req *volume_ser
 
 	wg.Wait()
 
-	return resp, err
+	return err
 }
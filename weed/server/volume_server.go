@@ -6,6 +6,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/storage/types"
 	"net/http"
 	"sync"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -57,18 +58,26 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 	fixJpgOrientation bool,
 	readMode string,
 	compactionMBPerSecond int,
+	scrubMBPerSecond int,
+	scrubIntervalHours int,
 	fileSizeLimitMB int,
 	concurrentUploadLimit int64,
 	concurrentDownloadLimit int64,
 ) *VolumeServer {
 
 	v := util.GetViper()
-	signingKey := v.GetString("jwt.signing.key")
+	signingKeys, err := security.LoadJwtKeys(v, "jwt.signing")
+	if err != nil {
+		glog.Fatalf("load jwt signing keys: %v", err)
+	}
 	v.SetDefault("jwt.signing.expires_after_seconds", 10)
 	expiresAfterSec := v.GetInt("jwt.signing.expires_after_seconds")
 	enableUiAccess := v.GetBool("access.ui")
 
-	readSigningKey := v.GetString("jwt.signing.read.key")
+	readSigningKeys, err := security.LoadJwtKeys(v, "jwt.signing.read")
+	if err != nil {
+		glog.Fatalf("load jwt read signing keys: %v", err)
+	}
 	v.SetDefault("jwt.signing.read.expires_after_seconds", 60)
 	readExpiresAfterSec := v.GetInt("jwt.signing.read.expires_after_seconds")
 
@@ -94,18 +103,24 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 	vs.checkWithMaster()
 
 	vs.store = storage.NewStore(vs.grpcDialOption, ip, port, grpcPort, publicUrl, folders, maxCounts, minFreeSpaces, idxFolder, vs.needleMapKind, diskTypes)
-	vs.guard = security.NewGuard(whiteList, signingKey, expiresAfterSec, readSigningKey, readExpiresAfterSec)
+	if scrubMBPerSecond > 0 {
+		vs.store.StartScrubbing(int64(scrubMBPerSecond)*1024*1024, time.Duration(scrubIntervalHours)*time.Hour)
+	}
+	vs.guard = security.NewGuard(whiteList, signingKeys, expiresAfterSec, readSigningKeys, readExpiresAfterSec)
+	if err := vs.guard.LoadAllowLists(v, "volume"); err != nil {
+		glog.Fatalf("load allow-lists: %v", err)
+	}
 
 	handleStaticResources(adminMux)
 	adminMux.HandleFunc("/status", vs.statusHandler)
 	adminMux.HandleFunc("/healthz", vs.healthzHandler)
-	if signingKey == "" || enableUiAccess {
+	if signingKeys.IsEmpty() || enableUiAccess {
 		// only expose the volume server details for safe environments
 		adminMux.HandleFunc("/ui/index.html", vs.uiStatusHandler)
 		/*
-			adminMux.HandleFunc("/stats/counter", vs.guard.WhiteList(statsCounterHandler))
-			adminMux.HandleFunc("/stats/memory", vs.guard.WhiteList(statsMemoryHandler))
-			adminMux.HandleFunc("/stats/disk", vs.guard.WhiteList(vs.statsDiskHandler))
+			adminMux.HandleFunc("/stats/counter", vs.guard.Allow(security.AccessAdmin, statsCounterHandler))
+			adminMux.HandleFunc("/stats/memory", vs.guard.Allow(security.AccessAdmin, statsMemoryHandler))
+			adminMux.HandleFunc("/stats/disk", vs.guard.Allow(security.AccessAdmin, vs.statsDiskHandler))
 		*/
 	}
 	adminMux.HandleFunc("/", vs.privateStoreHandler)
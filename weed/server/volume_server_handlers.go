@@ -43,10 +43,10 @@ func (vs *VolumeServer) privateStoreHandler(w http.ResponseWriter, r *http.Reque
 			vs.inFlightDownloadDataLimitCond.Wait()
 		}
 		vs.inFlightDownloadDataLimitCond.L.Unlock()
-		vs.GetOrHeadHandler(w, r)
+		vs.guard.Allow(security.AccessRead, vs.GetOrHeadHandler)(w, r)
 	case "DELETE":
 		stats.DeleteRequest()
-		vs.guard.WhiteList(vs.DeleteHandler)(w, r)
+		vs.guard.Allow(security.AccessWrite, vs.DeleteHandler)(w, r)
 	case "PUT", "POST":
 
 		// wait until in flight data is less than the limit
@@ -65,7 +65,7 @@ func (vs *VolumeServer) privateStoreHandler(w http.ResponseWriter, r *http.Reque
 
 		// processs uploads
 		stats.WriteRequest()
-		vs.guard.WhiteList(vs.PostHandler)(w, r)
+		vs.guard.Allow(security.AccessWrite, vs.PostHandler)(w, r)
 
 	case "OPTIONS":
 		stats.ReadRequest()
@@ -101,7 +101,7 @@ func (vs *VolumeServer) publicReadOnlyHandler(w http.ResponseWriter, r *http.Req
 			vs.inFlightDownloadDataLimitCond.Wait()
 		}
 		vs.inFlightDownloadDataLimitCond.L.Unlock()
-		vs.GetOrHeadHandler(w, r)
+		vs.guard.Allow(security.AccessRead, vs.GetOrHeadHandler)(w, r)
 	case "OPTIONS":
 		stats.ReadRequest()
 		w.Header().Add("Access-Control-Allow-Methods", "GET, OPTIONS")
@@ -111,19 +111,19 @@ func (vs *VolumeServer) publicReadOnlyHandler(w http.ResponseWriter, r *http.Req
 
 func (vs *VolumeServer) maybeCheckJwtAuthorization(r *http.Request, vid, fid string, isWrite bool) bool {
 
-	var signingKey security.SigningKey
+	var signingKeys *security.JwtKeys
 
 	if isWrite {
-		if len(vs.guard.SigningKey) == 0 {
+		if vs.guard.SigningKeys.IsEmpty() {
 			return true
 		} else {
-			signingKey = vs.guard.SigningKey
+			signingKeys = vs.guard.SigningKeys
 		}
 	} else {
-		if len(vs.guard.ReadSigningKey) == 0 {
+		if vs.guard.ReadSigningKeys.IsEmpty() {
 			return true
 		} else {
-			signingKey = vs.guard.ReadSigningKey
+			signingKeys = vs.guard.ReadSigningKeys
 		}
 	}
 
@@ -133,7 +133,7 @@ func (vs *VolumeServer) maybeCheckJwtAuthorization(r *http.Request, vid, fid str
 		return false
 	}
 
-	token, err := security.DecodeJwt(signingKey, tokenStr, &security.SeaweedFileIdClaims{})
+	token, err := signingKeys.Decode(tokenStr, &security.SeaweedFileIdClaims{})
 	if err != nil {
 		glog.V(1).Infof("jwt verification error from %s: %v", r.RemoteAddr, err)
 		return false
@@ -144,6 +144,10 @@ func (vs *VolumeServer) maybeCheckJwtAuthorization(r *http.Request, vid, fid str
 	}
 
 	if sc, ok := token.Claims.(*security.SeaweedFileIdClaims); ok {
+		if !security.AllowsOperation(sc.Operation, isWrite) {
+			glog.V(1).Infof("jwt from %s only allows %s", r.RemoteAddr, sc.Operation)
+			return false
+		}
 		if sepIndex := strings.LastIndex(fid, "_"); sepIndex > 0 {
 			fid = fid[:sepIndex]
 		}
@@ -0,0 +1,166 @@
+package sftpd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/filetransfer"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// AuthorizedKeysKvPrefix is followed by the identity name, and keeps the public keys in the authorized_keys format
+	AuthorizedKeysKvPrefix = "sftp.authorized_keys."
+
+	identityExtension = "seaweedfs-identity"
+)
+
+var errNoMatchingIdentity = errors.New("no matching identity")
+
+// findIdentities returns the identities matching the user name, which is either the identity name or one of its access keys
+func (s *SftpServer) findIdentities(username string) ([]*iam_pb.Identity, error) {
+	config, err := filetransfer.LoadIdentities(s.option.IdentityConfig, s.option.Filer, s.option.GrpcDialOption)
+	if err != nil {
+		return nil, err
+	}
+	var identities []*iam_pb.Identity
+	for _, identity := range config.Identities {
+		if identity.Name == username {
+			identities = append(identities, identity)
+			continue
+		}
+		for _, cred := range identity.Credentials {
+			if cred.AccessKey == username {
+				identities = append(identities, identity)
+				break
+			}
+		}
+	}
+	return identities, nil
+}
+
+// passwordCallback accepts the secret key as the password.
+// The credentials holding a public key as the secret key can not be used as passwords.
+func (s *SftpServer) passwordCallback(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+	identities, err := s.findIdentities(conn.User())
+	if err != nil {
+		return nil, err
+	}
+	for _, identity := range identities {
+		for _, cred := range identity.Credentials {
+			if identity.Name != conn.User() && cred.AccessKey != conn.User() {
+				continue
+			}
+			if _, err := parsePublicKey(cred.SecretKey); err == nil {
+				continue
+			}
+			if subtle.ConstantTimeCompare(password, []byte(cred.SecretKey)) == 1 {
+				return newPermissions(identity), nil
+			}
+		}
+	}
+	return nil, errNoMatchingIdentity
+}
+
+// publicKeyCallback accepts the public keys stored for the identity in the filer kv,
+// and the public keys set as the secret key of its credentials
+func (s *SftpServer) publicKeyCallback(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+	identities, err := s.findIdentities(conn.User())
+	if err != nil {
+		return nil, err
+	}
+	marshaled := key.Marshal()
+	for _, identity := range identities {
+		var authorizedKeys []ssh.PublicKey
+		for _, cred := range identity.Credentials {
+			if publicKey, err := parsePublicKey(cred.SecretKey); err == nil {
+				authorizedKeys = append(authorizedKeys, publicKey)
+			}
+		}
+		kvKeys, err := s.readAuthorizedKeys(identity.Name)
+		if err != nil {
+			return nil, err
+		}
+		authorizedKeys = append(authorizedKeys, kvKeys...)
+		for _, authorizedKey := range authorizedKeys {
+			if subtle.ConstantTimeCompare(marshaled, authorizedKey.Marshal()) == 1 {
+				return newPermissions(identity), nil
+			}
+		}
+	}
+	return nil, errNoMatchingIdentity
+}
+
+func newPermissions(identity *iam_pb.Identity) *ssh.Permissions {
+	return &ssh.Permissions{
+		Extensions: map[string]string{
+			identityExtension: identity.Name,
+		},
+	}
+}
+
+// readAuthorizedKeys reads the public keys of the identity from the filer kv
+func (s *SftpServer) readAuthorizedKeys(name string) (keys []ssh.PublicKey, err error) {
+	var data []byte
+	err = pb.WithFilerClient(false, s.option.Filer, s.option.GrpcDialOption, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.KvGet(context.Background(), &filer_pb.KvGetRequest{Key: []byte(AuthorizedKeysKvPrefix + name)})
+		if err != nil {
+			return err
+		}
+		if len(resp.Error) != 0 {
+			return errors.New(resp.Error)
+		}
+		data = resp.Value
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read authorized keys of %s: %v", name, err)
+	}
+	return ParseAuthorizedKeys(data), nil
+}
+
+// ParseAuthorizedKeys parses the keys in the authorized_keys format, one per line, skipping the invalid lines
+func ParseAuthorizedKeys(data []byte) (keys []ssh.PublicKey) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		if key, err := parsePublicKey(scanner.Text()); err == nil {
+			keys = append(keys, key)
+		}
+	}
+	return
+}
+
+func parsePublicKey(line string) (ssh.PublicKey, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+	return key, err
+}
+
+// lookupUser loads the actions of the identity accepted during the authentication
+func (s *SftpServer) lookupUser(permissions *ssh.Permissions) (*filetransfer.User, error) {
+	if permissions == nil || permissions.Extensions[identityExtension] == "" {
+		return nil, errNoMatchingIdentity
+	}
+	name := permissions.Extensions[identityExtension]
+	config, err := filetransfer.LoadIdentities(s.option.IdentityConfig, s.option.Filer, s.option.GrpcDialOption)
+	if err != nil {
+		return nil, err
+	}
+	for _, identity := range config.Identities {
+		if identity.Name != name {
+			continue
+		}
+		user := filetransfer.NewUser(identity)
+		if !user.CanRead && !user.CanWrite {
+			return nil, fmt.Errorf("identity %s has no sftp permission", identity.Name)
+		}
+		return user, nil
+	}
+	return nil, errNoMatchingIdentity
+}
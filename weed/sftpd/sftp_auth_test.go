@@ -0,0 +1,104 @@
+package sftpd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const testPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAICTH9t+SGX+dsT+eG7BA5tgPWOPCe8ZC3Q7N9mpTIOLP"
+
+type testConnMetadata struct {
+	ssh.ConnMetadata
+	user string
+}
+
+func (c *testConnMetadata) User() string { return c.user }
+
+func newTestSftpServer(t *testing.T) *SftpServer {
+	identityConfig := filepath.Join(t.TempDir(), "identity.json")
+	if err := os.WriteFile(identityConfig, []byte(`{
+		"identities": [
+			{"name": "alice", "credentials": [{"accessKey": "alice_ak", "secretKey": "alice_sk"}], "actions": ["Read", "Write"]},
+			{"name": "bob", "credentials": [{"accessKey": "bob_ak", "secretKey": "`+testPublicKey+`"}], "actions": ["Read"]},
+			{"name": "carol", "credentials": [{"accessKey": "carol_ak", "secretKey": "carol_sk"}], "actions": ["Read:bucket1"]}
+		]
+	}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return &SftpServer{
+		option: &SftpServerOption{
+			SftpRoot:       "/sftp",
+			IdentityConfig: identityConfig,
+		},
+	}
+}
+
+func TestPasswordCallback(t *testing.T) {
+	s := newTestSftpServer(t)
+
+	tests := []struct {
+		user, password string
+		identity       string
+	}{
+		{"alice", "alice_sk", "alice"},
+		{"alice_ak", "alice_sk", "alice"},
+		{"alice", "wrong", ""},
+		{"bob_ak", "alice_sk", ""},
+		// the public keys set as secret keys can not be used as passwords
+		{"bob", testPublicKey, ""},
+		{"nobody", "alice_sk", ""},
+	}
+	for _, tt := range tests {
+		permissions, err := s.passwordCallback(&testConnMetadata{user: tt.user}, []byte(tt.password))
+		if tt.identity == "" {
+			if err == nil {
+				t.Errorf("%s with %q: expected to fail", tt.user, tt.password)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s with %q: %v", tt.user, tt.password, err)
+			continue
+		}
+		if permissions.Extensions[identityExtension] != tt.identity {
+			t.Errorf("%s: expected identity %s, got %v", tt.user, tt.identity, permissions.Extensions)
+		}
+	}
+}
+
+func TestLookupUser(t *testing.T) {
+	s := newTestSftpServer(t)
+
+	user, err := s.lookupUser(newPermissionsForName("alice"))
+	if err != nil {
+		t.Fatalf("lookup alice: %v", err)
+	}
+	if user.Name != "alice" || !user.CanRead || !user.CanWrite || user.IsAdmin {
+		t.Errorf("unexpected user %+v", user)
+	}
+	if home := user.HomeDir(s.option.SftpRoot); home != "/sftp/alice" {
+		t.Errorf("unexpected home %s", home)
+	}
+
+	// the identities with only bucket actions can not log in
+	if _, err = s.lookupUser(newPermissionsForName("carol")); err == nil {
+		t.Errorf("expected carol to have no sftp permission")
+	}
+	if _, err = s.lookupUser(nil); err == nil {
+		t.Errorf("expected an error without the authenticated identity")
+	}
+}
+
+func newPermissionsForName(name string) *ssh.Permissions {
+	return &ssh.Permissions{Extensions: map[string]string{identityExtension: name}}
+}
+
+func TestParseAuthorizedKeys(t *testing.T) {
+	keys := ParseAuthorizedKeys([]byte(testPublicKey + " bob@host\n# comment\nnot a key\n"))
+	if len(keys) != 1 || keys[0].Type() != ssh.KeyAlgoED25519 {
+		t.Errorf("unexpected keys %v", keys)
+	}
+}
@@ -0,0 +1,289 @@
+package sftpd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/chrislusf/seaweedfs/weed/util/chunk_cache"
+)
+
+const (
+	// chunkSizeLimit is the size of the buffered data uploaded as one chunk
+	chunkSizeLimit = 4 * 1024 * 1024
+	// pendingSizeLimit is the size of the writes held back while waiting for the data before them
+	pendingSizeLimit = 4 * 1024 * 1024
+)
+
+// sftpFileReader streams the file content from the volume servers
+type sftpFileReader struct {
+	fs       *SftpFileSystem
+	fullpath util.FullPath
+	entry    *filer_pb.Entry
+
+	sync.Mutex
+	reader *filer.ChunkReadAt
+}
+
+func newSftpFileReader(fs *SftpFileSystem, fullpath util.FullPath, entry *filer_pb.Entry) *sftpFileReader {
+	return &sftpFileReader{
+		fs:       fs,
+		fullpath: fullpath,
+		entry:    entry,
+	}
+}
+
+func (f *sftpFileReader) ReadAt(p []byte, off int64) (n int, err error) {
+	fileSize := int64(filer.FileSize(f.entry))
+	if off >= fileSize {
+		return 0, io.EOF
+	}
+	if len(f.entry.Content) > 0 {
+		n = copy(p, f.entry.Content[off:])
+		if off+int64(n) >= int64(len(f.entry.Content)) {
+			err = io.EOF
+		}
+		return
+	}
+
+	reader, err := f.chunkReader(fileSize)
+	if err != nil {
+		return 0, err
+	}
+	n, err = reader.ReadAt(p, off)
+	if err != nil && err != io.EOF {
+		glog.Errorf("file read %s: %v", f.fullpath, err)
+	}
+	return
+}
+
+// chunkReader is created on the first read, and shared by the concurrent reads
+func (f *sftpFileReader) chunkReader(fileSize int64) (*filer.ChunkReadAt, error) {
+	f.Lock()
+	defer f.Unlock()
+	if f.reader != nil {
+		return f.reader, nil
+	}
+	lookupFn := filer.LookupFn(f.fs)
+	visibles, err := filer.NonOverlappingVisibleIntervals(lookupFn, f.entry.Chunks, 0, fileSize)
+	if err != nil {
+		return nil, err
+	}
+	// the transfers are mostly sequential, so the chunks are not cached
+	var chunkCache *chunk_cache.TieredChunkCache
+	chunkViews := filer.ViewFromVisibleIntervals(visibles, 0, fileSize)
+	f.reader = filer.NewChunkReaderAtFromClient(lookupFn, chunkViews, chunkCache, fileSize)
+	return f.reader, nil
+}
+
+func (f *sftpFileReader) Close() error {
+	f.Lock()
+	defer f.Unlock()
+	if f.reader != nil {
+		f.reader.Close()
+		f.reader = nil
+	}
+	return nil
+}
+
+// sftpFileWriter uploads the written data as chunks, and saves the entry to the filer on Close.
+// The sftp clients send several writes in parallel, which can arrive out of order.
+// The writes after a gap are held back until the gap is filled, so that sequential uploads
+// are still saved as large chunks.
+type sftpFileWriter struct {
+	fs       *SftpFileSystem
+	fullpath util.FullPath
+	entry    *filer_pb.Entry
+	isNew    bool
+	truncate bool
+
+	sync.Mutex
+	buf         []byte
+	bufOffset   int64
+	pending     map[int64][]byte
+	pendingSize int
+	chunks      []*filer_pb.FileChunk
+	collection  string
+	replication string
+	err         error
+}
+
+func newSftpFileWriter(fs *SftpFileSystem, fullpath util.FullPath, entry *filer_pb.Entry, isNew, truncate bool) *sftpFileWriter {
+	return &sftpFileWriter{
+		fs:       fs,
+		fullpath: fullpath,
+		entry:    entry,
+		isNew:    isNew,
+		truncate: truncate,
+		pending:  make(map[int64][]byte),
+	}
+}
+
+func (f *sftpFileWriter) WriteAt(p []byte, off int64) (n int, err error) {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.err != nil {
+		return 0, f.err
+	}
+	if f.err = f.write(p, off); f.err != nil {
+		return 0, f.err
+	}
+	return len(p), nil
+}
+
+func (f *sftpFileWriter) write(p []byte, off int64) error {
+	bufStop := f.bufOffset + int64(len(f.buf))
+	if len(f.buf) == 0 && len(f.pending) == 0 {
+		f.bufOffset, bufStop = off, off
+	}
+
+	switch {
+	case off == bufStop:
+		f.buf = append(f.buf, p...)
+		f.takePending()
+	case off > bufStop && !f.overlapsPending(off, int64(len(p))):
+		// the sftp request buffers are reused, so the data is copied
+		f.pending[off] = append([]byte(nil), p...)
+		f.pendingSize += len(p)
+		if f.pendingSize < pendingSizeLimit {
+			return nil
+		}
+		return f.flushAll()
+	default:
+		// a rewrite, uploaded after the earlier writes so that it wins
+		if err := f.flushAll(); err != nil {
+			return err
+		}
+		f.bufOffset = off
+		f.buf = append(f.buf, p...)
+	}
+
+	if len(f.buf) >= chunkSizeLimit {
+		return f.flushBuf()
+	}
+	return nil
+}
+
+// takePending appends the held back writes that continue the buffer
+func (f *sftpFileWriter) takePending() {
+	for {
+		stop := f.bufOffset + int64(len(f.buf))
+		data, found := f.pending[stop]
+		if !found {
+			return
+		}
+		f.buf = append(f.buf, data...)
+		f.pendingSize -= len(data)
+		delete(f.pending, stop)
+	}
+}
+
+func (f *sftpFileWriter) overlapsPending(off, size int64) bool {
+	for pendingOff, data := range f.pending {
+		if off < pendingOff+int64(len(data)) && pendingOff < off+size {
+			return true
+		}
+	}
+	return false
+}
+
+// flushBuf uploads the buffered data as one chunk
+func (f *sftpFileWriter) flushBuf() error {
+	if len(f.buf) == 0 {
+		return nil
+	}
+	chunk, collection, replication, err := f.fs.SaveDataAsChunk(bytes.NewReader(f.buf), string(f.fullpath), f.bufOffset)
+	if err != nil {
+		return fmt.Errorf("%s upload result: %v", f.fullpath, err)
+	}
+	f.chunks = append(f.chunks, chunk)
+	f.collection, f.replication = collection, replication
+	f.bufOffset += int64(len(f.buf))
+	f.buf = f.buf[:0]
+	return nil
+}
+
+// flushAll uploads the buffer and the held back writes, merging the contiguous ones
+func (f *sftpFileWriter) flushAll() error {
+	if err := f.flushBuf(); err != nil {
+		return err
+	}
+	offsets := make([]int64, 0, len(f.pending))
+	for off := range f.pending {
+		offsets = append(offsets, off)
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	for _, off := range offsets {
+		if off != f.bufOffset+int64(len(f.buf)) || len(f.buf) >= chunkSizeLimit {
+			if err := f.flushBuf(); err != nil {
+				return err
+			}
+			f.bufOffset = off
+		}
+		f.buf = append(f.buf, f.pending[off]...)
+		delete(f.pending, off)
+	}
+	f.pendingSize = 0
+	return f.flushBuf()
+}
+
+func (f *sftpFileWriter) Close() error {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.err != nil {
+		return f.err
+	}
+	if f.err = f.flushAll(); f.err != nil {
+		return f.err
+	}
+	f.err = f.save()
+	return f.err
+}
+
+// save writes the entry with the uploaded chunks to the filer
+func (f *sftpFileWriter) save() error {
+	entry := f.entry
+	if f.truncate {
+		entry.Chunks, entry.Content = nil, nil
+		entry.Attributes.FileSize = 0
+	} else if len(entry.Content) > 0 && len(f.chunks) > 0 {
+		// the inlined content is moved to a chunk, placed under the new chunks
+		chunk, _, _, err := f.fs.SaveDataAsChunk(bytes.NewReader(entry.Content), string(f.fullpath), 0)
+		if err != nil {
+			return err
+		}
+		chunk.Mtime = 0
+		entry.Chunks = append(entry.Chunks, chunk)
+		entry.Content = nil
+	}
+	entry.Chunks = append(entry.Chunks, f.chunks...)
+
+	manifestedChunks, manifestErr := filer.MaybeManifestize(f.fs.SaveDataAsChunk, entry.Chunks)
+	if manifestErr != nil {
+		// not good, but should be ok
+		glog.V(0).Infof("file %s close MaybeManifestize: %v", f.fullpath, manifestErr)
+	} else {
+		entry.Chunks = manifestedChunks
+	}
+
+	entry.Attributes.Mtime = time.Now().Unix()
+	if totalSize := filer.TotalSize(entry.Chunks); totalSize > entry.Attributes.FileSize {
+		entry.Attributes.FileSize = totalSize
+	}
+	entry.Attributes.Md5 = nil
+	if f.collection != "" {
+		entry.Attributes.Collection = f.collection
+		entry.Attributes.Replication = f.replication
+	}
+
+	return f.fs.SaveEntry(f.fullpath, entry, f.isNew)
+}
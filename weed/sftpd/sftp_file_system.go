@@ -0,0 +1,30 @@
+package sftpd
+
+import (
+	"github.com/chrislusf/seaweedfs/weed/filetransfer"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// SftpFileSystem reads and writes the filer entries, shared by all the sftp sessions
+type SftpFileSystem struct {
+	*filetransfer.FilerClient
+}
+
+var _ = filer_pb.FilerClient(&SftpFileSystem{})
+
+func NewSftpFileSystem(option *SftpServerOption) *SftpFileSystem {
+	return &SftpFileSystem{
+		FilerClient: &filetransfer.FilerClient{
+			Filer:          option.Filer,
+			GrpcDialOption: option.GrpcDialOption,
+			Collection:     option.Collection,
+			Replication:    option.Replication,
+			DiskType:       option.DiskType,
+			Cipher:         option.Cipher,
+			Uid:            option.Uid,
+			Gid:            option.Gid,
+			Signature:      util.RandomInt32(),
+		},
+	}
+}
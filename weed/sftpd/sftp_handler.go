@@ -0,0 +1,288 @@
+package sftpd
+
+import (
+	"io"
+	"os"
+	"path"
+	"syscall"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/filetransfer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/pkg/sftp"
+)
+
+// sftpHandler serves the requests of one sftp session, confined to the home directory of the user
+type sftpHandler struct {
+	fs   *SftpFileSystem
+	user *filetransfer.User
+	home string
+}
+
+var _ = sftp.FileReader(&sftpHandler{})
+var _ = sftp.FileWriter(&sftpHandler{})
+var _ = sftp.PosixRenameFileCmder(&sftpHandler{})
+var _ = sftp.FileLister(&sftpHandler{})
+
+func newSftpHandler(fs *SftpFileSystem, user *filetransfer.User, home string) *sftpHandler {
+	return &sftpHandler{
+		fs:   fs,
+		user: user,
+		home: home,
+	}
+}
+
+// toFullPath maps the path seen by the client to the filer path.
+// The client path is cleaned as an absolute path, so that ".." can not leave the home directory.
+func (h *sftpHandler) toFullPath(name string) util.FullPath {
+	return util.FullPath(path.Join(h.home, path.Clean("/"+name)))
+}
+
+func (h *sftpHandler) Fileread(r *sftp.Request) (io.ReaderAt, error) {
+	glog.V(2).Infof("sftp %s %s %s", h.user.Name, r.Method, r.Filepath)
+
+	if !h.user.CanRead {
+		return nil, sftp.ErrSSHFxPermissionDenied
+	}
+	fullpath := h.toFullPath(r.Filepath)
+	entry, err := h.fs.Lookup(fullpath)
+	if err != nil {
+		return nil, err
+	}
+	if entry.IsDirectory {
+		return nil, syscall.EISDIR
+	}
+	return newSftpFileReader(h.fs, fullpath, entry), nil
+}
+
+// Filewrite opens the file to write at any offset.
+// The file content is replaced when opened with the truncate flag.
+func (h *sftpHandler) Filewrite(r *sftp.Request) (io.WriterAt, error) {
+	glog.V(2).Infof("sftp %s %s %s", h.user.Name, r.Method, r.Filepath)
+
+	if !h.user.CanWrite {
+		return nil, sftp.ErrSSHFxPermissionDenied
+	}
+	fullpath := h.toFullPath(r.Filepath)
+	entry, err := h.fs.Lookup(fullpath)
+	if err != nil && err != os.ErrNotExist {
+		return nil, err
+	}
+
+	flags := r.Pflags()
+	if entry == nil {
+		if !flags.Creat {
+			return nil, os.ErrNotExist
+		}
+		perm := os.FileMode(0644)
+		if r.AttrFlags().Permissions {
+			perm = r.Attributes().FileMode() & os.ModePerm
+		}
+		_, name := fullpath.DirAndName()
+		return newSftpFileWriter(h.fs, fullpath, h.fs.NewEntry(name, false, perm), true, true), nil
+	}
+
+	if entry.IsDirectory {
+		return nil, syscall.EISDIR
+	}
+	if flags.Creat && flags.Excl {
+		return nil, os.ErrExist
+	}
+	return newSftpFileWriter(h.fs, fullpath, entry, false, flags.Trunc), nil
+}
+
+func (h *sftpHandler) Filecmd(r *sftp.Request) error {
+	glog.V(2).Infof("sftp %s %s %s %s", h.user.Name, r.Method, r.Filepath, r.Target)
+
+	if !h.user.CanWrite {
+		return sftp.ErrSSHFxPermissionDenied
+	}
+	fullpath := h.toFullPath(r.Filepath)
+
+	switch r.Method {
+	case "Setstat":
+		return h.setstat(fullpath, r)
+	case "Rename":
+		return h.rename(fullpath, h.toFullPath(r.Target), false)
+	case "Rmdir":
+		return h.remove(fullpath, true)
+	case "Remove":
+		return h.remove(fullpath, false)
+	case "Mkdir":
+		perm := os.FileMode(0755)
+		if r.AttrFlags().Permissions {
+			perm = r.Attributes().FileMode() & os.ModePerm
+		}
+		return h.fs.CreateDirectory(fullpath, perm)
+	}
+	return sftp.ErrSSHFxOpUnsupported
+}
+
+// PosixRename replaces the target if it exists
+func (h *sftpHandler) PosixRename(r *sftp.Request) error {
+	glog.V(2).Infof("sftp %s %s %s %s", h.user.Name, r.Method, r.Filepath, r.Target)
+
+	if !h.user.CanWrite {
+		return sftp.ErrSSHFxPermissionDenied
+	}
+	return h.rename(h.toFullPath(r.Filepath), h.toFullPath(r.Target), true)
+}
+
+func (h *sftpHandler) setstat(fullpath util.FullPath, r *sftp.Request) error {
+	if string(fullpath) == h.home {
+		return sftp.ErrSSHFxPermissionDenied
+	}
+	entry, err := h.fs.Lookup(fullpath)
+	if err != nil {
+		return err
+	}
+
+	attrFlags, attrs := r.AttrFlags(), r.Attributes()
+	if attrFlags.Size {
+		if entry.IsDirectory {
+			return syscall.EISDIR
+		}
+		if err = truncateEntry(entry, attrs.Size); err != nil {
+			return err
+		}
+	}
+	if attrFlags.Permissions {
+		entry.Attributes.FileMode = uint32(os.FileMode(entry.Attributes.FileMode)&^os.ModePerm | attrs.FileMode()&os.ModePerm)
+	}
+	if attrFlags.UidGid {
+		entry.Attributes.Uid, entry.Attributes.Gid = attrs.UID, attrs.GID
+	}
+	if attrFlags.Acmodtime {
+		entry.Attributes.Mtime = int64(attrs.Mtime)
+	}
+	return h.fs.SaveEntry(fullpath, entry, false)
+}
+
+// truncateEntry cuts or extends the file size.
+// Files with chunk manifests can only be cut to zero, since dropping a manifest chunk deletes all its data chunks.
+func truncateEntry(entry *filer_pb.Entry, size uint64) error {
+	fileSize := filer.FileSize(entry)
+	if size >= fileSize {
+		entry.Attributes.FileSize = size
+		entry.Attributes.Mtime = time.Now().Unix()
+		return nil
+	}
+	if size == 0 {
+		entry.Chunks, entry.Content = nil, nil
+	} else {
+		if len(entry.Content) > 0 {
+			entry.Content = entry.Content[:size]
+		}
+		var chunks []*filer_pb.FileChunk
+		for _, chunk := range entry.Chunks {
+			if chunk.IsChunkManifest {
+				return sftp.ErrSSHFxOpUnsupported
+			}
+			if chunk.Offset >= int64(size) {
+				continue
+			}
+			if chunk.Offset+int64(chunk.Size) > int64(size) {
+				chunk.Size = size - uint64(chunk.Offset)
+			}
+			chunks = append(chunks, chunk)
+		}
+		entry.Chunks = chunks
+	}
+	entry.Attributes.FileSize = size
+	entry.Attributes.Md5 = nil
+	entry.Attributes.Mtime = time.Now().Unix()
+	return nil
+}
+
+func (h *sftpHandler) rename(oldPath, newPath util.FullPath, overwrite bool) error {
+	if string(oldPath) == h.home || string(newPath) == h.home {
+		return sftp.ErrSSHFxPermissionDenied
+	}
+	if _, err := h.fs.Lookup(oldPath); err != nil {
+		return err
+	}
+	if !overwrite {
+		if _, err := h.fs.Lookup(newPath); err == nil {
+			return os.ErrExist
+		}
+	}
+	return h.fs.RenameEntry(oldPath, newPath)
+}
+
+func (h *sftpHandler) remove(fullpath util.FullPath, isDirectory bool) error {
+	if string(fullpath) == h.home {
+		return sftp.ErrSSHFxPermissionDenied
+	}
+	entry, err := h.fs.Lookup(fullpath)
+	if err != nil {
+		return err
+	}
+	if isDirectory && !entry.IsDirectory {
+		return syscall.ENOTDIR
+	}
+	if !isDirectory && entry.IsDirectory {
+		return syscall.EISDIR
+	}
+	// directories must be empty, as with rmdir
+	return h.fs.RemoveEntry(fullpath, false)
+}
+
+func (h *sftpHandler) Filelist(r *sftp.Request) (sftp.ListerAt, error) {
+	glog.V(2).Infof("sftp %s %s %s", h.user.Name, r.Method, r.Filepath)
+
+	fullpath := h.toFullPath(r.Filepath)
+	entry, err := h.fs.Lookup(fullpath)
+	if err != nil {
+		return nil, err
+	}
+
+	switch r.Method {
+	case "List":
+		if !h.user.CanRead {
+			return nil, sftp.ErrSSHFxPermissionDenied
+		}
+		if !entry.IsDirectory {
+			return nil, syscall.ENOTDIR
+		}
+		var infos []os.FileInfo
+		err = filer_pb.ReadDirAllEntries(h.fs, fullpath, "", func(entry *filer_pb.Entry, isLast bool) error {
+			if entry.Attributes == nil {
+				entry.Attributes = &filer_pb.FuseAttributes{}
+			}
+			infos = append(infos, filetransfer.NewFileInfo(entry))
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return listerAt(infos), nil
+	case "Stat":
+		return listerAt{filetransfer.NewFileInfo(entry)}, nil
+	case "Readlink":
+		if entry.Attributes.SymlinkTarget == "" {
+			return nil, os.ErrInvalid
+		}
+		return listerAt{filetransfer.NewFileInfo(&filer_pb.Entry{
+			Name:       entry.Attributes.SymlinkTarget,
+			Attributes: entry.Attributes,
+		})}, nil
+	}
+	return nil, sftp.ErrSSHFxOpUnsupported
+}
+
+// listerAt returns the directory entries in batches
+type listerAt []os.FileInfo
+
+func (l listerAt) ListAt(infos []os.FileInfo, offset int64) (int, error) {
+	if offset >= int64(len(l)) {
+		return 0, io.EOF
+	}
+	n := copy(infos, l[offset:])
+	if n < len(infos) {
+		return n, io.EOF
+	}
+	return n, nil
+}
@@ -0,0 +1,115 @@
+package sftpd
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filetransfer"
+	"github.com/chrislusf/seaweedfs/weed/filetransfer/filertest"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/pkg/sftp"
+	"google.golang.org/grpc"
+)
+
+// the sftp open flags of the write requests
+const (
+	sshFxfWrite = 0x02
+	sshFxfCreat = 0x08
+	sshFxfTrunc = 0x10
+)
+
+// newTestSftpHandler serves the user from the in memory filer
+func newTestSftpHandler(t *testing.T, user *filetransfer.User) (*sftpHandler, *filertest.Filer) {
+	f := filertest.NewFiler(t)
+	fs := NewSftpFileSystem(&SftpServerOption{
+		Filer:          f.Address(),
+		GrpcDialOption: grpc.WithInsecure(),
+	})
+	home := user.HomeDir("/sftp")
+	if err := fs.EnsureDirectory(util.FullPath(home), 0755); err != nil {
+		t.Fatalf("home %s: %v", home, err)
+	}
+	return newSftpHandler(fs, user, home), f
+}
+
+func TestToFullPath(t *testing.T) {
+	h := newSftpHandler(nil, &filetransfer.User{Name: "alice"}, "/sftp/alice")
+	for name, expected := range map[string]util.FullPath{
+		"a.txt":             "/sftp/alice/a.txt",
+		"/a/b.txt":          "/sftp/alice/a/b.txt",
+		"":                  "/sftp/alice",
+		"..":                "/sftp/alice",
+		"../bob/a.txt":      "/sftp/alice/bob/a.txt",
+		"/../../etc/passwd": "/sftp/alice/etc/passwd",
+		"a/../../..":        "/sftp/alice",
+	} {
+		if actual := h.toFullPath(name); actual != expected {
+			t.Errorf("%q: expected %s, got %s", name, expected, actual)
+		}
+	}
+}
+
+func TestUserPermissions(t *testing.T) {
+	h, _ := newTestSftpHandler(t, &filetransfer.User{Name: "bob", CanRead: true})
+
+	put := sftp.NewRequest("Put", "/a.txt")
+	put.Flags = sshFxfWrite | sshFxfCreat
+	if _, err := h.Filewrite(put); err != sftp.ErrSSHFxPermissionDenied {
+		t.Errorf("read only user writes: %v", err)
+	}
+	for _, method := range []string{"Mkdir", "Remove", "Rename", "Setstat"} {
+		if err := h.Filecmd(sftp.NewRequest(method, "/a")); err != sftp.ErrSSHFxPermissionDenied {
+			t.Errorf("read only user %s: %v", method, err)
+		}
+	}
+
+	h.user = &filetransfer.User{Name: "bob", CanWrite: true}
+	if _, err := h.Fileread(sftp.NewRequest("Get", "/a.txt")); err != sftp.ErrSSHFxPermissionDenied {
+		t.Errorf("write only user reads: %v", err)
+	}
+	if _, err := h.Filelist(sftp.NewRequest("List", "/")); err != sftp.ErrSSHFxPermissionDenied {
+		t.Errorf("write only user lists: %v", err)
+	}
+}
+
+func TestUpload(t *testing.T) {
+	h, f := newTestSftpHandler(t, &filetransfer.User{Name: "alice", CanRead: true, CanWrite: true})
+
+	// the writes arriving out of order are uploaded as one chunk
+	put := sftp.NewRequest("Put", "/../a.bin")
+	put.Flags = sshFxfWrite | sshFxfCreat | sshFxfTrunc
+	writer, err := h.Filewrite(put)
+	if err != nil {
+		t.Fatalf("open to write: %v", err)
+	}
+	for _, off := range []int64{0, 4, 2} {
+		if _, err = writer.WriteAt([]byte{byte(off), byte(off + 1)}, off); err != nil {
+			t.Fatalf("write at %d: %v", off, err)
+		}
+	}
+	if err = writer.(io.Closer).Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	entry := f.Get("/sftp/alice/a.bin")
+	if entry == nil {
+		t.Fatalf("the file should be saved in the home directory")
+	}
+	if len(entry.Chunks) != 1 || entry.Chunks[0].Offset != 0 || entry.Chunks[0].Size != 6 || entry.Attributes.FileSize != 6 {
+		t.Fatalf("unexpected chunks %v", entry.Chunks)
+	}
+	if data := f.Upload(entry.Chunks[0].FileId); string(data) != "\x00\x01\x02\x03\x04\x05" {
+		t.Errorf("unexpected upload %v", data)
+	}
+
+	// reading the file lists it in the home directory
+	lister, err := h.Filelist(sftp.NewRequest("Stat", "/a.bin"))
+	if err != nil {
+		t.Fatalf("stat: %v", err)
+	}
+	infos := make([]os.FileInfo, 1)
+	if n, _ := lister.ListAt(infos, 0); n != 1 || infos[0].Size() != 6 || infos[0].Name() != "a.bin" {
+		t.Errorf("unexpected stat %d %v", n, infos)
+	}
+}
@@ -0,0 +1,211 @@
+package sftpd
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	"github.com/chrislusf/seaweedfs/weed/filetransfer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/grpc"
+)
+
+const (
+	// HostKeyKvKey keeps the generated host key in the filer, so that it survives restarts
+	HostKeyKvKey = "sftp.host_key"
+)
+
+type SftpServerOption struct {
+	Filer          pb.ServerAddress
+	SftpRoot       string
+	GrpcDialOption grpc.DialOption
+	IdentityConfig string
+	HostKeyFile    string
+	Collection     string
+	Replication    string
+	DiskType       string
+	Cipher         bool
+	Uid            uint32
+	Gid            uint32
+}
+
+type SftpServer struct {
+	option    *SftpServerOption
+	sshConfig *ssh.ServerConfig
+	fs        *SftpFileSystem
+}
+
+// NewSftpServer prepares the ssh server configuration with the host key and the user authentication
+func NewSftpServer(option *SftpServerOption) (*SftpServer, error) {
+	s := &SftpServer{
+		option: option,
+		fs:     NewSftpFileSystem(option),
+	}
+	s.sshConfig = &ssh.ServerConfig{
+		PasswordCallback:  s.passwordCallback,
+		PublicKeyCallback: s.publicKeyCallback,
+		ServerVersion:     "SSH-2.0-SeaweedFS",
+	}
+
+	hostKey, err := s.loadHostKey()
+	if err != nil {
+		return nil, err
+	}
+	s.sshConfig.AddHostKey(hostKey)
+
+	return s, nil
+}
+
+// loadHostKey reads the host key file, or else the host key stored in the filer, which is generated on first use
+func (s *SftpServer) loadHostKey() (ssh.Signer, error) {
+	if s.option.HostKeyFile != "" {
+		data, err := os.ReadFile(s.option.HostKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read host key %s: %v", s.option.HostKeyFile, err)
+		}
+		signer, err := ssh.ParsePrivateKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("parse host key %s: %v", s.option.HostKeyFile, err)
+		}
+		return signer, nil
+	}
+
+	var data []byte
+	err := pb.WithFilerClient(false, s.option.Filer, s.option.GrpcDialOption, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.KvGet(context.Background(), &filer_pb.KvGetRequest{Key: []byte(HostKeyKvKey)})
+		if err != nil {
+			return err
+		}
+		if len(resp.Error) != 0 {
+			return errors.New(resp.Error)
+		}
+		data = resp.Value
+		if len(data) > 0 {
+			return nil
+		}
+
+		glog.V(0).Infof("generate sftp host key, stored in filer kv %s", HostKeyKvKey)
+		data, err = generateHostKey()
+		if err != nil {
+			return err
+		}
+		putResp, err := client.KvPut(context.Background(), &filer_pb.KvPutRequest{
+			Key:   []byte(HostKeyKvKey),
+			Value: data,
+		})
+		if err != nil {
+			return err
+		}
+		if len(putResp.Error) != 0 {
+			return errors.New(putResp.Error)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load host key from filer: %v", err)
+	}
+	signer, err := ssh.ParsePrivateKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse host key from filer: %v", err)
+	}
+	return signer, nil
+}
+
+func generateHostKey() ([]byte, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// Serve accepts the ssh connections until the listener fails
+func (s *SftpServer) Serve(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go s.handleConn(conn)
+	}
+}
+
+func (s *SftpServer) handleConn(conn net.Conn) {
+	defer conn.Close()
+
+	sshConn, chans, reqs, err := ssh.NewServerConn(conn, s.sshConfig)
+	if err != nil {
+		glog.V(1).Infof("sftp handshake with %s: %v", conn.RemoteAddr(), err)
+		return
+	}
+	defer sshConn.Close()
+	go ssh.DiscardRequests(reqs)
+
+	user, err := s.lookupUser(sshConn.Permissions)
+	if err != nil {
+		glog.Errorf("sftp user %s: %v", sshConn.User(), err)
+		return
+	}
+	home := user.HomeDir(s.option.SftpRoot)
+	if err = s.fs.EnsureDirectory(util.FullPath(home), 0755); err != nil {
+		glog.Errorf("sftp user %s home %s: %v", user.Name, home, err)
+		return
+	}
+	glog.V(1).Infof("sftp user %s logged in from %s, home %s", user.Name, sshConn.RemoteAddr(), home)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			glog.V(1).Infof("sftp user %s accept channel: %v", user.Name, err)
+			continue
+		}
+		go s.handleSession(channel, requests, user, home)
+	}
+}
+
+// handleSession runs the sftp subsystem, the only request allowed on the session
+func (s *SftpServer) handleSession(channel ssh.Channel, requests <-chan *ssh.Request, user *filetransfer.User, home string) {
+	defer channel.Close()
+
+	for req := range requests {
+		if req.Type != "subsystem" || len(req.Payload) < 4 || string(req.Payload[4:]) != "sftp" {
+			req.Reply(false, nil)
+			continue
+		}
+		req.Reply(true, nil)
+		go ssh.DiscardRequests(requests)
+
+		handler := newSftpHandler(s.fs, user, home)
+		server := sftp.NewRequestServer(channel, sftp.Handlers{
+			FileGet:  handler,
+			FilePut:  handler,
+			FileCmd:  handler,
+			FileList: handler,
+		})
+		if err := server.Serve(); err != nil && err != io.EOF {
+			glog.V(1).Infof("sftp user %s session: %v", user.Name, err)
+		}
+		server.Close()
+		return
+	}
+}
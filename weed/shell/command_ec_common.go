@@ -172,7 +172,36 @@ func countFreeShardSlots(dn *master_pb.DataNodeInfo, diskType types.DiskType) (c
 	if diskInfo == nil {
 		return 0
 	}
-	return int(diskInfo.MaxVolumeCount-diskInfo.VolumeCount)*erasure_coding.DataShardsCount - countShards(diskInfo.EcShardInfos)
+	// the free slots are counted in the shards of the default scheme
+	freeSlotUnits := (diskInfo.MaxVolumeCount - diskInfo.VolumeCount) * erasure_coding.VolumeSlotUnits
+	for _, ecShardInfo := range diskInfo.EcShardInfos {
+		shardCount := erasure_coding.ShardBits(ecShardInfo.EcIndexBits).ShardIdCount()
+		freeSlotUnits -= int64(shardCount) * ecShardScheme(ecShardInfo).ShardSlotUnits()
+	}
+	return int(freeSlotUnits / erasure_coding.DefaultScheme.ShardSlotUnits())
+}
+
+// ecShardScheme returns the erasure coding scheme reported with the ec shards
+func ecShardScheme(shardInfo *master_pb.VolumeEcShardInformationMessage) erasure_coding.Scheme {
+	scheme, err := erasure_coding.NewScheme(shardInfo.DataShards, shardInfo.ParityShards)
+	if err != nil {
+		return erasure_coding.DefaultScheme
+	}
+	return scheme
+}
+
+// findEcVolumeScheme returns the erasure coding scheme of the ec volume, as reported by any of the ec nodes
+func findEcVolumeScheme(ecNodes []*EcNode, vid needle.VolumeId) erasure_coding.Scheme {
+	for _, ecNode := range ecNodes {
+		for _, diskInfo := range ecNode.info.DiskInfos {
+			for _, shardInfo := range diskInfo.EcShardInfos {
+				if needle.VolumeId(shardInfo.Id) == vid {
+					return ecShardScheme(shardInfo)
+				}
+			}
+		}
+	}
+	return erasure_coding.DefaultScheme
 }
 
 type RackId string
@@ -182,7 +211,7 @@ type EcNode struct {
 	info       *master_pb.DataNodeInfo
 	dc         string
 	rack       RackId
-	freeEcSlot int
+	freeEcSlot int // in the shards of the default scheme, counted one per moved shard of any scheme
 }
 
 func (ecNode *EcNode) localShardIdCount(vid uint32) int {
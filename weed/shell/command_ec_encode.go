@@ -36,15 +36,23 @@ func (c *commandEcEncode) Help() string {
 
 	ec.encode [-collection=""] [-fullPercent=95 -quietFor=1h]
 	ec.encode [-collection=""] [-volumeId=<volume_id>]
+	ec.encode [-collection=""] [-volumeId=<volume_id>] [-dataShards=6 -parityShards=3]
 
 	This command will:
 	1. freeze one volume
 	2. apply erasure coding to the volume
 	3. move the encoded shards to multiple volume servers
 
-	The erasure coding is 10.4. So ideally you have more than 14 volume servers, and you can afford
+	The erasure coding is 10.4 by default. So ideally you have more than 14 volume servers, and you can afford
 	to lose 4 volume servers.
 
+	Other schemes, e.g. 4.2, 6.3 or 16.4, can be set by -dataShards and -parityShards, with at most 32 shards.
+	The scheme is kept with each ec volume, and used when the volume is read, rebuilt, balanced or decoded.
+
+	The volumes of the collections in master.erasure_coding.direct_write_collections are written directly
+	into ec shards, with the scheme configured on the master. Encoding such a volume only seals it, and
+	mounts its shards where they were written, without copying any data.
+
 	If the number of volumes are not high, the worst case is that you only have 4 volume servers,
 	and the shards are spread as 4,4,3,3, respectively. You can afford to lose one volume server.
 
@@ -62,10 +70,17 @@ func (c *commandEcEncode) Do(args []string, commandEnv *CommandEnv, writer io.Wr
 	fullPercentage := encodeCommand.Float64("fullPercent", 95, "the volume reaches the percentage of max volume size")
 	quietPeriod := encodeCommand.Duration("quietFor", time.Hour, "select volumes without no writes for this period")
 	parallelCopy := encodeCommand.Bool("parallelCopy", true, "copy shards in parallel")
+	dataShards := encodeCommand.Uint("dataShards", erasure_coding.DataShardsCount, "the number of data shards")
+	parityShards := encodeCommand.Uint("parityShards", erasure_coding.ParityShardsCount, "the number of parity shards")
 	if err = encodeCommand.Parse(args); err != nil {
 		return nil
 	}
 
+	scheme, err := erasure_coding.NewScheme(uint32(*dataShards), uint32(*parityShards))
+	if err != nil {
+		return err
+	}
+
 	if err = commandEnv.confirmIsLocked(args); err != nil {
 		return
 	}
@@ -74,7 +89,7 @@ func (c *commandEcEncode) Do(args []string, commandEnv *CommandEnv, writer io.Wr
 
 	// volumeId is provided
 	if vid != 0 {
-		return doEcEncode(commandEnv, *collection, vid, *parallelCopy)
+		return doEcEncode(commandEnv, *collection, vid, scheme, *parallelCopy)
 	}
 
 	// apply to all volumes in the collection
@@ -84,7 +99,7 @@ func (c *commandEcEncode) Do(args []string, commandEnv *CommandEnv, writer io.Wr
 	}
 	fmt.Printf("ec encode volumes: %v\n", volumeIds)
 	for _, vid := range volumeIds {
-		if err = doEcEncode(commandEnv, *collection, vid, *parallelCopy); err != nil {
+		if err = doEcEncode(commandEnv, *collection, vid, scheme, *parallelCopy); err != nil {
 			return err
 		}
 	}
@@ -92,7 +107,7 @@ func (c *commandEcEncode) Do(args []string, commandEnv *CommandEnv, writer io.Wr
 	return nil
 }
 
-func doEcEncode(commandEnv *CommandEnv, collection string, vid needle.VolumeId, parallelCopy bool) (err error) {
+func doEcEncode(commandEnv *CommandEnv, collection string, vid needle.VolumeId, scheme erasure_coding.Scheme, parallelCopy bool) (err error) {
 	// find volume location
 	locations, found := commandEnv.MasterClient.GetLocations(uint32(vid))
 	if !found {
@@ -108,13 +123,18 @@ func doEcEncode(commandEnv *CommandEnv, collection string, vid needle.VolumeId,
 	}
 
 	// generate ec shards
-	err = generateEcShards(commandEnv.option.GrpcDialOption, vid, collection, locations[0].ServerAddress())
+	shardsMounted, err := generateEcShards(commandEnv.option.GrpcDialOption, vid, collection, scheme, locations[0].ServerAddress())
 	if err != nil {
 		return fmt.Errorf("generate ec shards for volume %d on %s: %v", vid, locations[0].Url, err)
 	}
 
+	// the volume written directly into ec shards has its shards mounted where they were written
+	if shardsMounted {
+		return deleteOriginalVolume(commandEnv, vid, locations)
+	}
+
 	// balance the ec shards to current cluster
-	err = spreadEcShards(commandEnv, vid, collection, locations, parallelCopy)
+	err = spreadEcShards(commandEnv, vid, collection, scheme, locations, parallelCopy)
 	if err != nil {
 		return fmt.Errorf("spread ec shards for volume %d from %s: %v", vid, locations[0].Url, err)
 	}
@@ -122,39 +142,44 @@ func doEcEncode(commandEnv *CommandEnv, collection string, vid needle.VolumeId,
 	return nil
 }
 
-func generateEcShards(grpcDialOption grpc.DialOption, volumeId needle.VolumeId, collection string, sourceVolumeServer pb.ServerAddress) error {
+func generateEcShards(grpcDialOption grpc.DialOption, volumeId needle.VolumeId, collection string, scheme erasure_coding.Scheme, sourceVolumeServer pb.ServerAddress) (shardsMounted bool, err error) {
 
-	fmt.Printf("generateEcShards %s %d on %s ...\n", collection, volumeId, sourceVolumeServer)
+	fmt.Printf("generateEcShards %s %d %s on %s ...\n", collection, volumeId, scheme, sourceVolumeServer)
 
-	err := operation.WithVolumeServerClient(false, sourceVolumeServer, grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
-		_, genErr := volumeServerClient.VolumeEcShardsGenerate(context.Background(), &volume_server_pb.VolumeEcShardsGenerateRequest{
-			VolumeId:   uint32(volumeId),
-			Collection: collection,
+	err = operation.WithVolumeServerClient(false, sourceVolumeServer, grpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+		resp, genErr := volumeServerClient.VolumeEcShardsGenerate(context.Background(), &volume_server_pb.VolumeEcShardsGenerateRequest{
+			VolumeId:     uint32(volumeId),
+			Collection:   collection,
+			DataShards:   uint32(scheme.DataShards),
+			ParityShards: uint32(scheme.ParityShards),
 		})
+		if genErr == nil {
+			shardsMounted = resp.ShardsMounted
+		}
 		return genErr
 	})
 
-	return err
+	return
 
 }
 
-func spreadEcShards(commandEnv *CommandEnv, volumeId needle.VolumeId, collection string, existingLocations []wdclient.Location, parallelCopy bool) (err error) {
+func spreadEcShards(commandEnv *CommandEnv, volumeId needle.VolumeId, collection string, scheme erasure_coding.Scheme, existingLocations []wdclient.Location, parallelCopy bool) (err error) {
 
 	allEcNodes, totalFreeEcSlots, err := collectEcNodes(commandEnv, "")
 	if err != nil {
 		return err
 	}
 
-	if totalFreeEcSlots < erasure_coding.TotalShardsCount {
+	if totalFreeEcSlots < scheme.TotalShards() {
 		return fmt.Errorf("not enough free ec shard slots. only %d left", totalFreeEcSlots)
 	}
 	allocatedDataNodes := allEcNodes
-	if len(allocatedDataNodes) > erasure_coding.TotalShardsCount {
-		allocatedDataNodes = allocatedDataNodes[:erasure_coding.TotalShardsCount]
+	if len(allocatedDataNodes) > scheme.TotalShards() {
+		allocatedDataNodes = allocatedDataNodes[:scheme.TotalShards()]
 	}
 
 	// calculate how many shards to allocate for these servers
-	allocatedEcIds := balancedEcDistribution(allocatedDataNodes)
+	allocatedEcIds := balancedEcDistribution(allocatedDataNodes, scheme.TotalShards())
 
 	// ask the data nodes to copy from the source volume server
 	copiedShardIds, err := parallelCopyEcShardsFromSource(commandEnv.option.GrpcDialOption, allocatedDataNodes, allocatedEcIds, volumeId, collection, existingLocations[0], parallelCopy)
@@ -174,16 +199,22 @@ func spreadEcShards(commandEnv *CommandEnv, volumeId needle.VolumeId, collection
 		return fmt.Errorf("source delete copied ecShards %s %d.%v: %v", existingLocations[0].Url, volumeId, copiedShardIds, err)
 	}
 
+	return deleteOriginalVolume(commandEnv, volumeId, existingLocations)
+
+}
+
+func deleteOriginalVolume(commandEnv *CommandEnv, volumeId needle.VolumeId, existingLocations []wdclient.Location) error {
+
 	// ask the source volume server to delete the original volume
 	for _, location := range existingLocations {
 		fmt.Printf("delete volume %d from %s\n", volumeId, location.Url)
-		err = deleteVolume(commandEnv.option.GrpcDialOption, volumeId, location.ServerAddress())
+		err := deleteVolume(commandEnv.option.GrpcDialOption, volumeId, location.ServerAddress())
 		if err != nil {
 			return fmt.Errorf("deleteVolume %s volume %d: %v", location.Url, volumeId, err)
 		}
 	}
 
-	return err
+	return nil
 
 }
 
@@ -246,11 +277,11 @@ func parallelCopyEcShardsFromSource(grpcDialOption grpc.DialOption, targetServer
 	return
 }
 
-func balancedEcDistribution(servers []*EcNode) (allocated [][]uint32) {
+func balancedEcDistribution(servers []*EcNode, totalShards int) (allocated [][]uint32) {
 	allocated = make([][]uint32, len(servers))
 	allocatedShardIdIndex := uint32(0)
 	serverIndex := rand.Intn(len(servers))
-	for allocatedShardIdIndex < erasure_coding.TotalShardsCount {
+	for allocatedShardIdIndex < uint32(totalShards) {
 		if servers[serverIndex].freeEcSlot > 0 {
 			allocated[serverIndex] = append(allocated[serverIndex], allocatedShardIdIndex)
 			allocatedShardIdIndex++
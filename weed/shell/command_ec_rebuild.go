@@ -106,21 +106,22 @@ func rebuildEcVolumes(commandEnv *CommandEnv, allEcNodes []*EcNode, collection s
 	}
 
 	for vid, locations := range ecShardMap {
+		scheme := findEcVolumeScheme(allEcNodes, vid)
 		shardCount := locations.shardCount()
-		if shardCount == erasure_coding.TotalShardsCount {
+		if shardCount == scheme.TotalShards() {
 			continue
 		}
-		if shardCount < erasure_coding.DataShardsCount {
+		if shardCount < scheme.DataShards {
 			return fmt.Errorf("ec volume %d is unrepairable with %d shards\n", vid, shardCount)
 		}
 
 		sortEcNodesByFreeslotsDecending(allEcNodes)
 
-		if allEcNodes[0].freeEcSlot < erasure_coding.TotalShardsCount {
+		if allEcNodes[0].freeEcSlot < scheme.TotalShards() {
 			return fmt.Errorf("disk space is not enough")
 		}
 
-		if err := rebuildOneEcVolume(commandEnv, allEcNodes[0], collection, vid, locations, writer, applyChanges); err != nil {
+		if err := rebuildOneEcVolume(commandEnv, allEcNodes[0], collection, vid, scheme, locations, writer, applyChanges); err != nil {
 			return err
 		}
 	}
@@ -128,13 +129,13 @@ func rebuildEcVolumes(commandEnv *CommandEnv, allEcNodes []*EcNode, collection s
 	return nil
 }
 
-func rebuildOneEcVolume(commandEnv *CommandEnv, rebuilder *EcNode, collection string, volumeId needle.VolumeId, locations EcShardLocations, writer io.Writer, applyChanges bool) error {
+func rebuildOneEcVolume(commandEnv *CommandEnv, rebuilder *EcNode, collection string, volumeId needle.VolumeId, scheme erasure_coding.Scheme, locations EcShardLocations, writer io.Writer, applyChanges bool) error {
 
 	fmt.Printf("rebuildOneEcVolume %s %d\n", collection, volumeId)
 
 	// collect shard files to rebuilder local disk
 	var generatedShardIds []uint32
-	copiedShardIds, _, err := prepareDataToRecover(commandEnv, rebuilder, collection, volumeId, locations, writer, applyChanges)
+	copiedShardIds, _, err := prepareDataToRecover(commandEnv, rebuilder, collection, volumeId, scheme, locations, writer, applyChanges)
 	if err != nil {
 		return err
 	}
@@ -185,7 +186,7 @@ func generateMissingShards(grpcDialOption grpc.DialOption, collection string, vo
 	return
 }
 
-func prepareDataToRecover(commandEnv *CommandEnv, rebuilder *EcNode, collection string, volumeId needle.VolumeId, locations EcShardLocations, writer io.Writer, applyBalancing bool) (copiedShardIds []uint32, localShardIds []uint32, err error) {
+func prepareDataToRecover(commandEnv *CommandEnv, rebuilder *EcNode, collection string, volumeId needle.VolumeId, scheme erasure_coding.Scheme, locations EcShardLocations, writer io.Writer, applyBalancing bool) (copiedShardIds []uint32, localShardIds []uint32, err error) {
 
 	needEcxFile := true
 	var localShardBits erasure_coding.ShardBits
@@ -238,7 +239,7 @@ func prepareDataToRecover(commandEnv *CommandEnv, rebuilder *EcNode, collection
 
 	}
 
-	if len(copiedShardIds)+len(localShardIds) >= erasure_coding.DataShardsCount {
+	if len(copiedShardIds)+len(localShardIds) >= scheme.DataShards {
 		return copiedShardIds, localShardIds, nil
 	}
 
@@ -255,7 +256,7 @@ func (ecShardMap EcShardMap) registerEcNode(ecNode *EcNode, collection string) {
 			if shardInfo.Collection == collection {
 				existing, found := ecShardMap[needle.VolumeId(shardInfo.Id)]
 				if !found {
-					existing = make([][]*EcNode, erasure_coding.TotalShardsCount)
+					existing = make([][]*EcNode, ecShardScheme(shardInfo).TotalShards())
 					ecShardMap[needle.VolumeId(shardInfo.Id)] = existing
 				}
 				for _, shardId := range erasure_coding.ShardBits(shardInfo.EcIndexBits).ShardIds() {
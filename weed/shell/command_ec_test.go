@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 )
 
@@ -15,7 +16,7 @@ func TestCommandEcDistribution(t *testing.T) {
 		newEcNode("dc1", "rack2", "dn2", 100),
 	}
 
-	allocated := balancedEcDistribution(allEcNodes)
+	allocated := balancedEcDistribution(allEcNodes, erasure_coding.TotalShardsCount)
 
 	fmt.Printf("allocated: %+v", allocated)
 }
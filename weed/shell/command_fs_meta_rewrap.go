@@ -0,0 +1,118 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsMetaRewrap{})
+}
+
+type commandFsMetaRewrap struct {
+}
+
+func (c *commandFsMetaRewrap) Name() string {
+	return "fs.meta.rewrap"
+}
+
+func (c *commandFsMetaRewrap) Help() string {
+	return `re-wrap the chunk cipher keys with the current master key of the filer key manager
+
+	fs.meta.rewrap /             # re-wrap the cipher keys of all files
+	fs.meta.rewrap -v /path/dir  # re-wrap the cipher keys under /path/dir, and print out each re-wrapped file
+
+	After the master key is rotated, e.g. by changing filer.kms.local.key_id in security.toml,
+	or rotating the transit key in vault, the existing cipher keys are still wrapped by the old key.
+	This command lets the filer wrap them with the current key, so the old key can be retired.
+	The data on the volume servers stays the same.
+
+`
+}
+
+func (c *commandFsMetaRewrap) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	fsMetaRewrapCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	verbose := fsMetaRewrapCommand.Bool("v", false, "print out each re-wrapped file")
+	if err = fsMetaRewrapCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	path, err := commandEnv.parseUrl(findInputDirectory(fsMetaRewrapCommand.Args()))
+	if err != nil {
+		return err
+	}
+
+	var currentKeyId string
+	if err = commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.GetFilerConfiguration(context.Background(), &filer_pb.GetFilerConfigurationRequest{})
+		if err != nil {
+			return err
+		}
+		currentKeyId = resp.CipherKeyId
+		return nil
+	}); err != nil {
+		return err
+	}
+	if currentKeyId == "" {
+		return fmt.Errorf("the filer has no key manager configured")
+	}
+
+	// the directories are traversed concurrently
+	var fileCount, chunkCount uint64
+	var rewrapErr error
+	var writerLock sync.Mutex
+	err = filer_pb.TraverseBfs(commandEnv, util.FullPath(path), func(parentPath util.FullPath, entry *filer_pb.Entry) {
+		if !hasStaleCipherKey(entry, currentKeyId) {
+			return
+		}
+		if err := commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+			resp, err := client.RewrapCipherKeys(context.Background(), &filer_pb.RewrapCipherKeysRequest{
+				Directory: string(parentPath),
+				Name:      entry.Name,
+			})
+			if err != nil {
+				return err
+			}
+			if resp.RewrappedCount > 0 {
+				atomic.AddUint64(&fileCount, 1)
+				atomic.AddUint64(&chunkCount, uint64(resp.RewrappedCount))
+				if *verbose {
+					writerLock.Lock()
+					fmt.Fprintf(writer, "%s: %d cipher keys\n", parentPath.Child(entry.Name), resp.RewrappedCount)
+					writerLock.Unlock()
+				}
+			}
+			return nil
+		}); err != nil {
+			writerLock.Lock()
+			fmt.Fprintf(writer, "fail to re-wrap %s: %v\n", parentPath.Child(entry.Name), err)
+			rewrapErr = err
+			writerLock.Unlock()
+		}
+	})
+
+	if err == nil {
+		fmt.Fprintf(writer, "\ntotal re-wrapped %d cipher keys in %d files with key %s\n", chunkCount, fileCount, currentKeyId)
+		err = rewrapErr
+	}
+
+	return err
+}
+
+// hasStaleCipherKey tells whether any chunk cipher key is not wrapped by the current master key
+func hasStaleCipherKey(entry *filer_pb.Entry, currentKeyId string) bool {
+	for _, chunk := range entry.Chunks {
+		if len(chunk.CipherKey) > 0 && chunk.CipherKeyId != currentKeyId {
+			return true
+		}
+	}
+	return false
+}
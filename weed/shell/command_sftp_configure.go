@@ -0,0 +1,160 @@
+package shell
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/sftpd"
+	"golang.org/x/crypto/ssh"
+)
+
+func init() {
+	Commands = append(Commands, &commandSftpConfigure{})
+}
+
+type commandSftpConfigure struct {
+}
+
+func (c *commandSftpConfigure) Name() string {
+	return "sftp.configure"
+}
+
+func (c *commandSftpConfigure) Help() string {
+	return `configure the public keys of an identity for sftp login
+
+	# see the current public keys of the identity
+	sftp.configure -user=<identity name>
+
+	# add a public key, given in the authorized_keys format, or read from a local file
+	sftp.configure -user=<identity name> -publicKey "ssh-ed25519 AAAA... comment" -apply
+	sftp.configure -user=<identity name> -publicKeyFile=id_ed25519.pub -apply
+
+	# delete one public key, or all the public keys of the identity
+	sftp.configure -user=<identity name> -publicKey "ssh-ed25519 AAAA... comment" -delete -apply
+	sftp.configure -user=<identity name> -delete -apply
+
+	The identity itself, with its actions, is configured by s3.configure.
+	The public keys are kept in the filer key-value store.
+	`
+}
+
+func (c *commandSftpConfigure) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	sftpConfigureCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	user := sftpConfigureCommand.String("user", "", "identity name")
+	publicKey := sftpConfigureCommand.String("publicKey", "", "public key in the authorized_keys format")
+	publicKeyFile := sftpConfigureCommand.String("publicKeyFile", "", "local file with public keys in the authorized_keys format")
+	isDelete := sftpConfigureCommand.Bool("delete", false, "delete the public key, or all the public keys if none is given")
+	apply := sftpConfigureCommand.Bool("apply", false, "update the public keys")
+
+	if err = sftpConfigureCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *user == "" {
+		return fmt.Errorf("need to specify -user")
+	}
+
+	var inputKeys []ssh.PublicKey
+	if *publicKey != "" {
+		key, _, _, _, parseErr := ssh.ParseAuthorizedKey([]byte(*publicKey))
+		if parseErr != nil {
+			return fmt.Errorf("parse public key: %v", parseErr)
+		}
+		inputKeys = append(inputKeys, key)
+	}
+	if *publicKeyFile != "" {
+		data, readErr := os.ReadFile(*publicKeyFile)
+		if readErr != nil {
+			return readErr
+		}
+		fileKeys := sftpd.ParseAuthorizedKeys(data)
+		if len(fileKeys) == 0 {
+			return fmt.Errorf("no public key found in %s", *publicKeyFile)
+		}
+		inputKeys = append(inputKeys, fileKeys...)
+	}
+	if !*isDelete && len(inputKeys) == 0 && *apply {
+		return fmt.Errorf("need to specify -publicKey or -publicKeyFile")
+	}
+
+	kvKey := []byte(sftpd.AuthorizedKeysKvPrefix + *user)
+	var value []byte
+	if err = commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.KvGet(context.Background(), &filer_pb.KvGetRequest{Key: kvKey})
+		if err != nil {
+			return err
+		}
+		if len(resp.Error) != 0 {
+			return errors.New(resp.Error)
+		}
+		value = resp.Value
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	keys := sftpd.ParseAuthorizedKeys(value)
+	switch {
+	case *isDelete && len(inputKeys) == 0:
+		keys = nil
+	case *isDelete:
+		var kept []ssh.PublicKey
+		for _, key := range keys {
+			if !containsPublicKey(inputKeys, key) {
+				kept = append(kept, key)
+			}
+		}
+		keys = kept
+	default:
+		for _, key := range inputKeys {
+			if !containsPublicKey(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	for _, key := range keys {
+		buf.Write(ssh.MarshalAuthorizedKey(key))
+	}
+	fmt.Fprintf(writer, "%s", buf.String())
+
+	if *apply {
+
+		// an empty value deletes the key
+		if err = commandEnv.WithFilerClient(false, func(client filer_pb.SeaweedFilerClient) error {
+			resp, err := client.KvPut(context.Background(), &filer_pb.KvPutRequest{
+				Key:   kvKey,
+				Value: buf.Bytes(),
+			})
+			if err != nil {
+				return err
+			}
+			if len(resp.Error) != 0 {
+				return errors.New(resp.Error)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func containsPublicKey(keys []ssh.PublicKey, key ssh.PublicKey) bool {
+	marshaled := key.Marshal()
+	for _, k := range keys {
+		if bytes.Equal(k.Marshal(), marshaled) {
+			return true
+		}
+	}
+	return false
+}
@@ -158,6 +158,34 @@ var (
 			Help:      "Bucketed histogram of s3 request processing time.",
 			Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 24),
 		}, []string{"type"})
+	S3InFlightRequestGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "s3",
+			Name:      "in_flight_requests",
+			Help:      "Number of s3 requests being processed.",
+		})
+	S3ThrottledRequestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "s3",
+			Name:      "throttled_request_total",
+			Help:      "Counter of s3 requests rejected by the rate limits.",
+		}, []string{"rule", "reason"})
+	S3ThrottledSecondsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "s3",
+			Name:      "throttled_seconds_total",
+			Help:      "Time of s3 requests delayed by the bandwidth limits.",
+		}, []string{"rule"})
+	GuardDeniedRequestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "guard",
+			Name:      "denied_request_total",
+			Help:      "Counter of http requests denied by the ip allow-lists.",
+		}, []string{"server", "level"})
 )
 
 func init() {
@@ -183,6 +211,11 @@ func init() {
 
 	Gather.MustRegister(S3RequestCounter)
 	Gather.MustRegister(S3RequestHistogram)
+	Gather.MustRegister(S3InFlightRequestGauge)
+	Gather.MustRegister(S3ThrottledRequestCounter)
+	Gather.MustRegister(S3ThrottledSecondsCounter)
+
+	Gather.MustRegister(GuardDeniedRequestCounter)
 }
 
 func LoopPushingMetric(name, instance, addr string, intervalSeconds int) {
@@ -96,7 +96,8 @@ func (l *DiskLocation) loadExistingVolume(dirEntry os.DirEntry, needleMapKind Ne
 
 	// skip if ec volumes exists
 	if skipIfEcVolumesExists {
-		if util.FileExists(l.Directory + "/" + volumeName + ".ecx") {
+		// but not the volume still writing its ec shards directly
+		if _, isWriter := ecDirectWriteState(l.Directory + "/" + volumeName); util.FileExists(l.Directory+"/"+volumeName+".ecx") && !isWriter {
 			return false
 		}
 	}
@@ -159,6 +159,10 @@ func (l *DiskLocation) loadAllEcShards() (err error) {
 		}
 
 		if ext == ".ecx" && volumeId == prevVolumeId {
+			// the shards written directly are mounted when the volume is sealed
+			if writing, _ := ecDirectWriteState(l.Directory + "/" + baseName); writing {
+				continue
+			}
 			if err = l.loadEcShards(sameVolumeShards, collection, volumeId); err != nil {
 				return fmt.Errorf("loadEcShards collection:%v volumeId:%d : %v", collection, volumeId, err)
 			}
@@ -200,3 +204,14 @@ func (l *DiskLocation) EcVolumesLen() int {
 
 	return len(l.ecVolumes)
 }
+
+// EcShardSlotUnits is the part of the volume slots taken by the local ec shards, in erasure_coding.VolumeSlotUnits per slot
+func (l *DiskLocation) EcShardSlotUnits() (units int64) {
+	l.ecVolumesLock.RLock()
+	defer l.ecVolumesLock.RUnlock()
+
+	for _, ecVolume := range l.ecVolumes {
+		units += int64(len(ecVolume.Shards)) * ecVolume.Scheme.ShardSlotUnits()
+	}
+	return
+}
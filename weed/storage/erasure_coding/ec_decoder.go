@@ -150,8 +150,9 @@ func iterateEcjFile(baseFileName string, processNeedleFn func(key types.NeedleId
 
 }
 
-// WriteDatFile generates .dat from from .ec00 ~ .ec09 files
-func WriteDatFile(baseFileName string, datFileSize int64) error {
+// WriteDatFile generates .dat from from .ec00 ~ .ec09 files, or as many as the scheme has data shards
+// The volumes written directly into ec shards only have small blocks.
+func WriteDatFile(baseFileName string, datFileSize int64, scheme Scheme, smallBlocksOnly bool) error {
 
 	datFile, openErr := os.OpenFile(baseFileName+".dat", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
 	if openErr != nil {
@@ -159,9 +160,9 @@ func WriteDatFile(baseFileName string, datFileSize int64) error {
 	}
 	defer datFile.Close()
 
-	inputFiles := make([]*os.File, DataShardsCount)
+	inputFiles := make([]*os.File, scheme.DataShards)
 
-	for shardId := 0; shardId < DataShardsCount; shardId++ {
+	for shardId := 0; shardId < scheme.DataShards; shardId++ {
 		shardFileName := baseFileName + ToExt(shardId)
 		inputFiles[shardId], openErr = os.OpenFile(shardFileName, os.O_RDONLY, 0)
 		if openErr != nil {
@@ -170,8 +171,8 @@ func WriteDatFile(baseFileName string, datFileSize int64) error {
 		defer inputFiles[shardId].Close()
 	}
 
-	for datFileSize >= DataShardsCount*ErasureCodingLargeBlockSize {
-		for shardId := 0; shardId < DataShardsCount; shardId++ {
+	for !smallBlocksOnly && datFileSize >= int64(scheme.DataShards)*ErasureCodingLargeBlockSize {
+		for shardId := 0; shardId < scheme.DataShards; shardId++ {
 			w, err := io.CopyN(datFile, inputFiles[shardId], ErasureCodingLargeBlockSize)
 			if w != ErasureCodingLargeBlockSize {
 				return fmt.Errorf("copy %s large block %d: %v", baseFileName, shardId, err)
@@ -181,7 +182,7 @@ func WriteDatFile(baseFileName string, datFileSize int64) error {
 	}
 
 	for datFileSize > 0 {
-		for shardId := 0; shardId < DataShardsCount; shardId++ {
+		for shardId := 0; shardId < scheme.DataShards; shardId++ {
 			toRead := min(datFileSize, ErasureCodingSmallBlockSize)
 			w, err := io.CopyN(datFile, inputFiles[shardId], toRead)
 			if w != toRead {
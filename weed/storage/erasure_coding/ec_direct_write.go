@@ -0,0 +1,59 @@
+package erasure_coding
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle_map"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+// LocateDirectWriteData locates the data of the volumes written directly into ec shards.
+// These volumes grow one small block row at a time, so they have no large blocks.
+func LocateDirectWriteData(dataShards int, offset int64, size types.Size) []Interval {
+	return LocateData(ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize, dataShards, 0, offset, size)
+}
+
+// InsertNeedleToSortedIndex keeps the .ecx file sorted while the ec shards are being written.
+// The needle ids are mostly increasing, so the position is searched from the end.
+// A tombstone size only marks an existing entry as deleted.
+func InsertNeedleToSortedIndex(ecxFile *os.File, needleId types.NeedleId, offset types.Offset, size types.Size) (err error) {
+	fi, err := ecxFile.Stat()
+	if err != nil {
+		return fmt.Errorf("stat %s: %v", ecxFile.Name(), err)
+	}
+	entryCount := fi.Size() / types.NeedleMapEntrySize
+
+	buf := make([]byte, types.NeedleMapEntrySize)
+	position := entryCount
+	for position > 0 {
+		if _, err = ecxFile.ReadAt(buf, (position-1)*types.NeedleMapEntrySize); err != nil {
+			return fmt.Errorf("read %s: %v", ecxFile.Name(), err)
+		}
+		key := types.BytesToNeedleId(buf[:types.NeedleIdSize])
+		if key == needleId {
+			if size.IsDeleted() {
+				return MarkNeedleDeleted(ecxFile, (position-1)*types.NeedleMapEntrySize)
+			}
+			_, err = ecxFile.WriteAt(needle_map.ToBytes(needleId, offset, size), (position-1)*types.NeedleMapEntrySize)
+			return err
+		}
+		if key < needleId {
+			break
+		}
+		position--
+	}
+	if size.IsDeleted() {
+		return nil
+	}
+
+	tail := make([]byte, (entryCount-position)*types.NeedleMapEntrySize)
+	if len(tail) > 0 {
+		if _, err = ecxFile.ReadAt(tail, position*types.NeedleMapEntrySize); err != nil && err != io.EOF {
+			return fmt.Errorf("read %s: %v", ecxFile.Name(), err)
+		}
+	}
+	_, err = ecxFile.WriteAt(append(needle_map.ToBytes(needleId, offset, size), tail...), position*types.NeedleMapEntrySize)
+	return err
+}
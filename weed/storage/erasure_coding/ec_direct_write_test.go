@@ -0,0 +1,80 @@
+package erasure_coding
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func TestInsertNeedleToSortedIndex(t *testing.T) {
+	ecxFile, err := os.OpenFile(path.Join(t.TempDir(), "1.ecx"), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		t.Fatalf("open ecx: %v", err)
+	}
+	defer ecxFile.Close()
+
+	for _, entry := range []struct {
+		id     types.NeedleId
+		offset int64
+		size   types.Size
+	}{
+		{3, 8, 100},
+		{1, 200, 100},
+		{5, 400, 100},
+		{2, 600, 100},
+		{5, 800, 50},
+		{1, 1000, types.TombstoneFileSize},
+		{4, 1200, types.TombstoneFileSize},
+	} {
+		if err := InsertNeedleToSortedIndex(ecxFile, entry.id, types.ToOffset(entry.offset), entry.size); err != nil {
+			t.Fatalf("insert needle %d: %v", entry.id, err)
+		}
+	}
+
+	fi, _ := ecxFile.Stat()
+	if fi.Size() != 4*types.NeedleMapEntrySize {
+		t.Fatalf("ecx has %d bytes, expecting %d entries", fi.Size(), 4)
+	}
+	for _, expected := range []struct {
+		id      types.NeedleId
+		offset  int64
+		size    types.Size
+		deleted bool
+	}{
+		{1, 200, 100, true},
+		{2, 600, 100, false},
+		{3, 8, 100, false},
+		{5, 800, 50, false},
+	} {
+		offset, size, err := SearchNeedleFromSortedIndex(ecxFile, fi.Size(), expected.id, nil)
+		if err != nil {
+			t.Fatalf("search needle %d: %v", expected.id, err)
+		}
+		if offset.ToActualOffset() != expected.offset || size.IsDeleted() != expected.deleted || !expected.deleted && size != expected.size {
+			t.Errorf("needle %d: offset %d size %d", expected.id, offset.ToActualOffset(), size)
+		}
+	}
+	if _, _, err := SearchNeedleFromSortedIndex(ecxFile, fi.Size(), 4, nil); err != NotFoundError {
+		t.Errorf("needle 4 should not be found: %v", err)
+	}
+}
+
+func TestLocateDirectWriteData(t *testing.T) {
+	// the data after 10 rows of large blocks is still in small blocks
+	offset := int64(10*ErasureCodingLargeBlockSize + 3*ErasureCodingSmallBlockSize + 100)
+	intervals := LocateDirectWriteData(4, offset, types.Size(ErasureCodingSmallBlockSize))
+	if len(intervals) != 2 {
+		t.Fatalf("expecting 2 intervals: %+v", intervals)
+	}
+	shardId, shardOffset := intervals[0].ToShardIdAndOffset(ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize, 4)
+	row := offset / (4 * ErasureCodingSmallBlockSize)
+	if shardId != 3 || shardOffset != row*ErasureCodingSmallBlockSize+100 {
+		t.Errorf("first interval at shard %d offset %d", shardId, shardOffset)
+	}
+	shardId, shardOffset = intervals[1].ToShardIdAndOffset(ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize, 4)
+	if shardId != 0 || shardOffset != (row+1)*ErasureCodingSmallBlockSize {
+		t.Errorf("second interval at shard %d offset %d", shardId, shardOffset)
+	}
+}
@@ -12,11 +12,11 @@ type Interval struct {
 	LargeBlockRowsCount int
 }
 
-func LocateData(largeBlockLength, smallBlockLength int64, datSize int64, offset int64, size types.Size) (intervals []Interval) {
-	blockIndex, isLargeBlock, innerBlockOffset := locateOffset(largeBlockLength, smallBlockLength, datSize, offset)
+func LocateData(largeBlockLength, smallBlockLength int64, dataShards int, datSize int64, offset int64, size types.Size) (intervals []Interval) {
+	blockIndex, isLargeBlock, innerBlockOffset := locateOffset(largeBlockLength, smallBlockLength, dataShards, datSize, offset)
 
-	// adding DataShardsCount*smallBlockLength to ensure we can derive the number of large block size from a shard size
-	nLargeBlockRows := int((datSize + DataShardsCount*smallBlockLength) / (largeBlockLength * DataShardsCount))
+	// adding dataShards*smallBlockLength to ensure we can derive the number of large block size from a shard size
+	nLargeBlockRows := int((datSize + int64(dataShards)*smallBlockLength) / (largeBlockLength * int64(dataShards)))
 
 	for size > 0 {
 		interval := Interval{
@@ -41,7 +41,7 @@ func LocateData(largeBlockLength, smallBlockLength int64, datSize int64, offset
 
 		size -= interval.Size
 		blockIndex += 1
-		if isLargeBlock && blockIndex == nLargeBlockRows*DataShardsCount {
+		if isLargeBlock && blockIndex == nLargeBlockRows*dataShards {
 			isLargeBlock = false
 			blockIndex = 0
 		}
@@ -51,9 +51,9 @@ func LocateData(largeBlockLength, smallBlockLength int64, datSize int64, offset
 	return
 }
 
-func locateOffset(largeBlockLength, smallBlockLength int64, datSize int64, offset int64) (blockIndex int, isLargeBlock bool, innerBlockOffset int64) {
-	largeRowSize := largeBlockLength * DataShardsCount
-	nLargeBlockRows := datSize / (largeBlockLength * DataShardsCount)
+func locateOffset(largeBlockLength, smallBlockLength int64, dataShards int, datSize int64, offset int64) (blockIndex int, isLargeBlock bool, innerBlockOffset int64) {
+	largeRowSize := largeBlockLength * int64(dataShards)
+	nLargeBlockRows := datSize / largeRowSize
 
 	// if offset is within the large block area
 	if offset < nLargeBlockRows*largeRowSize {
@@ -74,14 +74,14 @@ func locateOffsetWithinBlocks(blockLength int64, offset int64) (blockIndex int,
 	return
 }
 
-func (interval Interval) ToShardIdAndOffset(largeBlockSize, smallBlockSize int64) (ShardId, int64) {
+func (interval Interval) ToShardIdAndOffset(largeBlockSize, smallBlockSize int64, dataShards int) (ShardId, int64) {
 	ecFileOffset := interval.InnerBlockOffset
-	rowIndex := interval.BlockIndex / DataShardsCount
+	rowIndex := interval.BlockIndex / dataShards
 	if interval.IsLargeBlock {
 		ecFileOffset += int64(rowIndex) * largeBlockSize
 	} else {
 		ecFileOffset += int64(interval.LargeBlockRowsCount)*largeBlockSize + int64(rowIndex)*smallBlockSize
 	}
-	ecFileIndex := interval.BlockIndex % DataShardsCount
+	ecFileIndex := interval.BlockIndex % dataShards
 	return ShardId(ecFileIndex), ecFileOffset
 }
@@ -0,0 +1,55 @@
+package erasure_coding
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+)
+
+// MaxShardCount is limited by the 32 bits of ShardBits
+const MaxShardCount = 32
+
+// Scheme is the number of data shards and parity shards of an ec volume.
+// It is recorded in the .vif file, and the volumes without it use the default 10+4.
+type Scheme struct {
+	DataShards   int
+	ParityShards int
+}
+
+var DefaultScheme = Scheme{DataShards: DataShardsCount, ParityShards: ParityShardsCount}
+
+// NewScheme returns the default scheme if both counts are 0
+func NewScheme(dataShards, parityShards uint32) (Scheme, error) {
+	if dataShards == 0 && parityShards == 0 {
+		return DefaultScheme, nil
+	}
+	scheme := Scheme{DataShards: int(dataShards), ParityShards: int(parityShards)}
+	if dataShards == 0 || parityShards == 0 || scheme.TotalShards() > MaxShardCount {
+		return scheme, fmt.Errorf("invalid erasure coding scheme %s, expecting at least 1 data and 1 parity shard, and at most %d shards", scheme, MaxShardCount)
+	}
+	return scheme, nil
+}
+
+// SchemeOf reads the scheme recorded in the volume info
+func SchemeOf(volumeInfo *volume_server_pb.VolumeInfo) (Scheme, error) {
+	if volumeInfo == nil {
+		return DefaultScheme, nil
+	}
+	return NewScheme(volumeInfo.EcDataShards, volumeInfo.EcParityShards)
+}
+
+// VolumeSlotUnits divides a volume slot, so the shards of the schemes with up to 16 data shards take whole units
+const VolumeSlotUnits = 720720
+
+// ShardSlotUnits is the part of a volume slot taken by one shard, which holds 1/DataShards of the volume
+func (s Scheme) ShardSlotUnits() int64 {
+	return (VolumeSlotUnits + int64(s.DataShards) - 1) / int64(s.DataShards)
+}
+
+func (s Scheme) TotalShards() int {
+	return s.DataShards + s.ParityShards
+}
+
+func (s Scheme) String() string {
+	return fmt.Sprintf("%d+%d", s.DataShards, s.ParityShards)
+}
@@ -95,3 +95,17 @@ func (shard *EcVolumeShard) ReadAt(buf []byte, offset int64) (int, error) {
 	return shard.ecdFile.ReadAt(buf, offset)
 
 }
+
+// WriteAt overwrites a corrupted part of the shard with the reconstructed data
+func (shard *EcVolumeShard) WriteAt(buf []byte, offset int64) (int, error) {
+	f, err := os.OpenFile(shard.FileName()+ToExt(int(shard.ShardId)), os.O_WRONLY, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	n, err := f.WriteAt(buf, offset)
+	if err != nil {
+		return n, err
+	}
+	return n, f.Sync()
+}
@@ -22,26 +22,29 @@ func TestEncodingDecoding(t *testing.T) {
 	bufferSize := 50
 	baseFileName := "1"
 
-	err := generateEcFiles(baseFileName, bufferSize, largeBlockSize, smallBlockSize)
-	if err != nil {
-		t.Logf("generateEcFiles: %v", err)
-	}
+	for _, scheme := range []Scheme{DefaultScheme, {4, 2}, {6, 3}, {16, 4}} {
 
-	err = WriteSortedFileFromIdx(baseFileName, ".ecx")
-	if err != nil {
-		t.Logf("WriteSortedFileFromIdx: %v", err)
-	}
+		err := generateEcFiles(baseFileName, scheme, bufferSize, largeBlockSize, smallBlockSize)
+		if err != nil {
+			t.Errorf("generateEcFiles %s: %v", scheme, err)
+		}
 
-	err = validateFiles(baseFileName)
-	if err != nil {
-		t.Logf("WriteSortedFileFromIdx: %v", err)
-	}
+		err = WriteSortedFileFromIdx(baseFileName, ".ecx")
+		if err != nil {
+			t.Logf("WriteSortedFileFromIdx: %v", err)
+		}
+
+		err = validateFiles(baseFileName, scheme)
+		if err != nil {
+			t.Errorf("validateFiles %s: %v", scheme, err)
+		}
 
-	removeGeneratedFiles(baseFileName)
+		removeGeneratedFiles(baseFileName, scheme)
+	}
 
 }
 
-func validateFiles(baseFileName string) error {
+func validateFiles(baseFileName string, scheme Scheme) error {
 	nm, err := readNeedleMap(baseFileName)
 	defer nm.Close()
 	if err != nil {
@@ -59,11 +62,11 @@ func validateFiles(baseFileName string) error {
 		return fmt.Errorf("failed to stat dat file: %v", err)
 	}
 
-	ecFiles, err := openEcFiles(baseFileName, true)
+	ecFiles, err := openEcFiles(baseFileName, scheme.TotalShards(), true)
 	defer closeEcFiles(ecFiles)
 
 	err = nm.AscendingVisit(func(value needle_map.NeedleValue) error {
-		return assertSame(datFile, fi.Size(), ecFiles, value.Offset, value.Size)
+		return assertSame(datFile, fi.Size(), scheme, ecFiles, value.Offset, value.Size)
 	})
 	if err != nil {
 		return fmt.Errorf("failed to check ec files: %v", err)
@@ -71,14 +74,14 @@ func validateFiles(baseFileName string) error {
 	return nil
 }
 
-func assertSame(datFile *os.File, datSize int64, ecFiles []*os.File, offset types.Offset, size types.Size) error {
+func assertSame(datFile *os.File, datSize int64, scheme Scheme, ecFiles []*os.File, offset types.Offset, size types.Size) error {
 
 	data, err := readDatFile(datFile, offset, size)
 	if err != nil {
 		return fmt.Errorf("failed to read dat file: %v", err)
 	}
 
-	ecData, err := readEcFile(datSize, ecFiles, offset, size)
+	ecData, err := readEcFile(datSize, scheme, ecFiles, offset, size)
 	if err != nil {
 		return fmt.Errorf("failed to read ec file: %v", err)
 	}
@@ -103,12 +106,12 @@ func readDatFile(datFile *os.File, offset types.Offset, size types.Size) ([]byte
 	return data, nil
 }
 
-func readEcFile(datSize int64, ecFiles []*os.File, offset types.Offset, size types.Size) (data []byte, err error) {
+func readEcFile(datSize int64, scheme Scheme, ecFiles []*os.File, offset types.Offset, size types.Size) (data []byte, err error) {
 
-	intervals := LocateData(largeBlockSize, smallBlockSize, datSize, offset.ToActualOffset(), size)
+	intervals := LocateData(largeBlockSize, smallBlockSize, scheme.DataShards, datSize, offset.ToActualOffset(), size)
 
 	for i, interval := range intervals {
-		if d, e := readOneInterval(interval, ecFiles); e != nil {
+		if d, e := readOneInterval(interval, scheme, ecFiles); e != nil {
 			return nil, e
 		} else {
 			if i == 0 {
@@ -122,14 +125,14 @@ func readEcFile(datSize int64, ecFiles []*os.File, offset types.Offset, size typ
 	return data, nil
 }
 
-func readOneInterval(interval Interval, ecFiles []*os.File) (data []byte, err error) {
+func readOneInterval(interval Interval, scheme Scheme, ecFiles []*os.File) (data []byte, err error) {
 
-	ecFileIndex, ecFileOffset := interval.ToShardIdAndOffset(largeBlockSize, smallBlockSize)
+	ecFileIndex, ecFileOffset := interval.ToShardIdAndOffset(largeBlockSize, smallBlockSize, scheme.DataShards)
 
 	data = make([]byte, interval.Size)
 	err = readFromFile(ecFiles[ecFileIndex], data, ecFileOffset)
 	{ // do some ec testing
-		ecData, err := readFromOtherEcFiles(ecFiles, int(ecFileIndex), ecFileOffset, interval.Size)
+		ecData, err := readFromOtherEcFiles(scheme, ecFiles, int(ecFileIndex), ecFileOffset, interval.Size)
 		if err != nil {
 			return nil, fmt.Errorf("ec reconstruct error: %v", err)
 		}
@@ -140,15 +143,15 @@ func readOneInterval(interval Interval, ecFiles []*os.File) (data []byte, err er
 	return
 }
 
-func readFromOtherEcFiles(ecFiles []*os.File, ecFileIndex int, ecFileOffset int64, size types.Size) (data []byte, err error) {
-	enc, err := reedsolomon.New(DataShardsCount, ParityShardsCount)
+func readFromOtherEcFiles(scheme Scheme, ecFiles []*os.File, ecFileIndex int, ecFileOffset int64, size types.Size) (data []byte, err error) {
+	enc, err := reedsolomon.New(scheme.DataShards, scheme.ParityShards)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create encoder: %v", err)
 	}
 
-	bufs := make([][]byte, TotalShardsCount)
-	for i := 0; i < DataShardsCount; {
-		n := int(rand.Int31n(TotalShardsCount))
+	bufs := make([][]byte, scheme.TotalShards())
+	for i := 0; i < scheme.DataShards; {
+		n := int(rand.Int31n(int32(scheme.TotalShards())))
 		if n == ecFileIndex || bufs[n] != nil {
 			continue
 		}
@@ -178,8 +181,8 @@ func readFromFile(file *os.File, data []byte, ecFileOffset int64) (err error) {
 	return
 }
 
-func removeGeneratedFiles(baseFileName string) {
-	for i := 0; i < DataShardsCount+ParityShardsCount; i++ {
+func removeGeneratedFiles(baseFileName string, scheme Scheme) {
+	for i := 0; i < scheme.TotalShards(); i++ {
 		fname := fmt.Sprintf("%s.ec%02d", baseFileName, i)
 		os.Remove(fname)
 	}
@@ -187,7 +190,7 @@ func removeGeneratedFiles(baseFileName string) {
 }
 
 func TestLocateData(t *testing.T) {
-	intervals := LocateData(largeBlockSize, smallBlockSize, DataShardsCount*largeBlockSize+1, DataShardsCount*largeBlockSize, 1)
+	intervals := LocateData(largeBlockSize, smallBlockSize, DataShardsCount, DataShardsCount*largeBlockSize+1, DataShardsCount*largeBlockSize, 1)
 	if len(intervals) != 1 {
 		t.Errorf("unexpected interval size %d", len(intervals))
 	}
@@ -195,7 +198,7 @@ func TestLocateData(t *testing.T) {
 		t.Errorf("unexpected interval %+v", intervals[0])
 	}
 
-	intervals = LocateData(largeBlockSize, smallBlockSize, DataShardsCount*largeBlockSize+1, DataShardsCount*largeBlockSize/2+100, DataShardsCount*largeBlockSize+1-DataShardsCount*largeBlockSize/2-100)
+	intervals = LocateData(largeBlockSize, smallBlockSize, DataShardsCount, DataShardsCount*largeBlockSize+1, DataShardsCount*largeBlockSize/2+100, DataShardsCount*largeBlockSize+1-DataShardsCount*largeBlockSize/2-100)
 	fmt.Printf("%+v\n", intervals)
 }
 
@@ -205,3 +208,42 @@ func (this Interval) sameAs(that Interval) bool {
 		this.BlockIndex == that.BlockIndex &&
 		this.Size == that.Size
 }
+
+func TestRebuildingWithScheme(t *testing.T) {
+	bufferSize := 50
+	baseFileName := "1"
+	scheme := Scheme{DataShards: 4, ParityShards: 2}
+
+	if err := generateEcFiles(baseFileName, scheme, bufferSize, largeBlockSize, smallBlockSize); err != nil {
+		t.Fatalf("generateEcFiles: %v", err)
+	}
+	defer removeGeneratedFiles(baseFileName, scheme)
+
+	// lose one data shard and one parity shard
+	lost := map[int][]byte{}
+	for _, shardId := range []int{1, 5} {
+		data, err := os.ReadFile(baseFileName + ToExt(shardId))
+		if err != nil {
+			t.Fatalf("read shard %d: %v", shardId, err)
+		}
+		lost[shardId] = data
+		os.Remove(baseFileName + ToExt(shardId))
+	}
+
+	generated, err := generateMissingEcFiles(baseFileName, scheme, bufferSize, largeBlockSize, smallBlockSize)
+	if err != nil {
+		t.Fatalf("generateMissingEcFiles: %v", err)
+	}
+	if len(generated) != len(lost) {
+		t.Errorf("generated shards %v", generated)
+	}
+	for shardId, expected := range lost {
+		data, err := os.ReadFile(baseFileName + ToExt(shardId))
+		if err != nil {
+			t.Fatalf("read rebuilt shard %d: %v", shardId, err)
+		}
+		if !bytes.Equal(expected, data) {
+			t.Errorf("rebuilt shard %d differs", shardId)
+		}
+	}
+}
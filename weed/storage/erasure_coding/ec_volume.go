@@ -38,6 +38,9 @@ type EcVolume struct {
 	ecjFile                   *os.File
 	ecjFileAccessLock         sync.Mutex
 	diskType                  types.DiskType
+	Scheme                    Scheme
+	// written directly into ec shards, without large blocks
+	DirectWrite bool
 }
 
 func NewEcVolume(diskType types.DiskType, dir string, dirIdx string, collection string, vid needle.VolumeId) (ev *EcVolume, err error) {
@@ -64,8 +67,19 @@ func NewEcVolume(diskType types.DiskType, dir string, dirIdx string, collection
 
 	// read volume info
 	ev.Version = needle.Version3
+	ev.Scheme = DefaultScheme
 	if volumeInfo, _, found, _ := volume_info.MaybeLoadVolumeInfo(dataBaseFileName + ".vif"); found {
 		ev.Version = needle.Version(volumeInfo.Version)
+		if ev.Scheme, err = SchemeOf(volumeInfo); err != nil {
+			return nil, fmt.Errorf("ec volume %s.vif: %v", dataBaseFileName, err)
+		}
+		ev.DirectWrite = volumeInfo.EcDirectWrite
+		if volumeInfo.EcWriting {
+			// mounting the shards seals the volume written directly into ec shards
+			volumeInfo.EcWriting = false
+			volumeInfo.EcShardLocations = nil
+			volume_info.SaveVolumeInfo(dataBaseFileName+".vif", volumeInfo)
+		}
 	} else {
 		volume_info.SaveVolumeInfo(dataBaseFileName+".vif", &volume_server_pb.VolumeInfo{Version: uint32(ev.Version)})
 	}
@@ -191,9 +205,11 @@ func (ev *EcVolume) ToVolumeEcShardInformationMessage() (messages []*master_pb.V
 	for _, s := range ev.Shards {
 		if s.VolumeId != prevVolumeId {
 			m = &master_pb.VolumeEcShardInformationMessage{
-				Id:         uint32(s.VolumeId),
-				Collection: s.Collection,
-				DiskType:   string(ev.diskType),
+				Id:           uint32(s.VolumeId),
+				Collection:   s.Collection,
+				DiskType:     string(ev.diskType),
+				DataShards:   uint32(ev.Scheme.DataShards),
+				ParityShards: uint32(ev.Scheme.ParityShards),
 			}
 			messages = append(messages, m)
 		}
@@ -211,14 +227,25 @@ func (ev *EcVolume) LocateEcShardNeedle(needleId types.NeedleId, version needle.
 		return types.Offset{}, 0, nil, fmt.Errorf("FindNeedleFromEcx: %v", err)
 	}
 
-	shard := ev.Shards[0]
-
-	// calculate the locations in the ec shards
-	intervals = LocateData(ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize, DataShardsCount*shard.ecdFileSize, offset.ToActualOffset(), types.Size(needle.GetActualSize(size, version)))
+	intervals = ev.LocateNeedle(offset, size, version)
 
 	return
 }
 
+// LocateNeedle calculates the locations of the needle in the ec shards
+func (ev *EcVolume) LocateNeedle(offset types.Offset, size types.Size, version needle.Version) []Interval {
+	if ev.DirectWrite {
+		return LocateDirectWriteData(ev.Scheme.DataShards, offset.ToActualOffset(), types.Size(needle.GetActualSize(size, version)))
+	}
+	shard := ev.Shards[0]
+	return LocateData(ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize, ev.Scheme.DataShards, int64(ev.Scheme.DataShards)*shard.ecdFileSize, offset.ToActualOffset(), types.Size(needle.GetActualSize(size, version)))
+}
+
+// WalkIndex walks through the .ecx entries in the order of the needle ids
+func (ev *EcVolume) WalkIndex(fn func(key types.NeedleId, offset types.Offset, size types.Size) error) error {
+	return idx.WalkIndexFile(ev.ecxFile, fn)
+}
+
 func (ev *EcVolume) FindNeedleFromEcx(needleId types.NeedleId) (offset types.Offset, size types.Size, err error) {
 	return SearchNeedleFromSortedIndex(ev.ecxFile, ev.ecxFileSize, needleId, nil)
 }
@@ -11,6 +11,7 @@ type EcVolumeInfo struct {
 	Collection string
 	ShardBits  ShardBits
 	DiskType   string
+	Scheme     Scheme
 }
 
 func NewEcVolumeInfo(diskType string, collection string, vid needle.VolumeId, shardBits ShardBits) *EcVolumeInfo {
@@ -19,6 +20,7 @@ func NewEcVolumeInfo(diskType string, collection string, vid needle.VolumeId, sh
 		VolumeId:   vid,
 		ShardBits:  shardBits,
 		DiskType:   diskType,
+		Scheme:     DefaultScheme,
 	}
 }
 
@@ -48,6 +50,7 @@ func (ecInfo *EcVolumeInfo) Minus(other *EcVolumeInfo) *EcVolumeInfo {
 		Collection: ecInfo.Collection,
 		ShardBits:  ecInfo.ShardBits.Minus(other.ShardBits),
 		DiskType:   ecInfo.DiskType,
+		Scheme:     ecInfo.Scheme,
 	}
 
 	return ret
@@ -55,10 +58,12 @@ func (ecInfo *EcVolumeInfo) Minus(other *EcVolumeInfo) *EcVolumeInfo {
 
 func (ecInfo *EcVolumeInfo) ToVolumeEcShardInformationMessage() (ret *master_pb.VolumeEcShardInformationMessage) {
 	return &master_pb.VolumeEcShardInformationMessage{
-		Id:          uint32(ecInfo.VolumeId),
-		EcIndexBits: uint32(ecInfo.ShardBits),
-		Collection:  ecInfo.Collection,
-		DiskType:    ecInfo.DiskType,
+		Id:           uint32(ecInfo.VolumeId),
+		EcIndexBits:  uint32(ecInfo.ShardBits),
+		Collection:   ecInfo.Collection,
+		DiskType:     ecInfo.DiskType,
+		DataShards:   uint32(ecInfo.Scheme.DataShards),
+		ParityShards: uint32(ecInfo.Scheme.ParityShards),
 	}
 }
 
@@ -77,7 +82,7 @@ func (b ShardBits) HasShardId(id ShardId) bool {
 }
 
 func (b ShardBits) ShardIds() (ret []ShardId) {
-	for i := ShardId(0); i < TotalShardsCount; i++ {
+	for i := ShardId(0); i < MaxShardCount; i++ {
 		if b.HasShardId(i) {
 			ret = append(ret, i)
 		}
@@ -86,7 +91,7 @@ func (b ShardBits) ShardIds() (ret []ShardId) {
 }
 
 func (b ShardBits) ToUint32Slice() (ret []uint32) {
-	for i := uint32(0); i < TotalShardsCount; i++ {
+	for i := uint32(0); i < MaxShardCount; i++ {
 		if b.HasShardId(ShardId(i)) {
 			ret = append(ret, i)
 		}
@@ -109,8 +114,8 @@ func (b ShardBits) Plus(other ShardBits) ShardBits {
 	return b | other
 }
 
-func (b ShardBits) MinusParityShards() ShardBits {
-	for i := DataShardsCount; i < TotalShardsCount; i++ {
+func (b ShardBits) MinusParityShards(scheme Scheme) ShardBits {
+	for i := scheme.DataShards; i < scheme.TotalShards(); i++ {
 		b = b.RemoveShardId(ShardId(i))
 	}
 	return b
@@ -7,6 +7,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"path/filepath"
 	"strings"
+	"sync"
 	"sync/atomic"
 
 	"google.golang.org/grpc"
@@ -48,7 +49,10 @@ type Store struct {
 	DeletedVolumesChan  chan master_pb.VolumeShortInformationMessage
 	NewEcShardsChan     chan master_pb.VolumeEcShardInformationMessage
 	DeletedEcShardsChan chan master_pb.VolumeEcShardInformationMessage
-	isStopping          bool
+	// needles found corrupted by scrubbing
+	CorruptedNeedlesChan chan *master_pb.CorruptedNeedleInformationMessage
+	isStopping           bool
+	ecDirectWriteLock    sync.Mutex
 }
 
 func (s *Store) String() (str string) {
@@ -59,6 +63,7 @@ func (s *Store) String() (str string) {
 func NewStore(grpcDialOption grpc.DialOption, ip string, port int, grpcPort int, publicUrl string, dirnames []string, maxVolumeCounts []int,
 	minFreeSpaces []util.MinFreeSpace, idxFolder string, needleMapKind NeedleMapKind, diskTypes []DiskType) (s *Store) {
 	s = &Store{grpcDialOption: grpcDialOption, Port: port, Ip: ip, GrpcPort: grpcPort, PublicUrl: publicUrl, NeedleMapKind: needleMapKind}
+	ecDirectShardClient = &grpcEcShardClient{grpcDialOption: grpcDialOption}
 	s.Locations = make([]*DiskLocation, 0)
 	for i := 0; i < len(dirnames); i++ {
 		location := NewDiskLocation(dirnames[i], maxVolumeCounts[i], minFreeSpaces[i], idxFolder, diskTypes[i])
@@ -72,6 +77,8 @@ func NewStore(grpcDialOption grpc.DialOption, ip string, port int, grpcPort int,
 	s.NewEcShardsChan = make(chan master_pb.VolumeEcShardInformationMessage, 3)
 	s.DeletedEcShardsChan = make(chan master_pb.VolumeEcShardInformationMessage, 3)
 
+	s.CorruptedNeedlesChan = make(chan *master_pb.CorruptedNeedleInformationMessage, 1024)
+
 	return
 }
 func (s *Store) AddVolume(volumeId needle.VolumeId, collection string, needleMapKind NeedleMapKind, replicaPlacement string, ttlString string, preallocate int64, MemoryMapMaxSizeMb uint32, diskType DiskType) error {
@@ -114,10 +121,8 @@ func (s *Store) FindFreeLocation(diskType DiskType) (ret *DiskLocation) {
 		if location.isDiskSpaceLow {
 			continue
 		}
-		currentFreeCount := location.MaxVolumeCount - location.VolumesLen()
-		currentFreeCount *= erasure_coding.DataShardsCount
-		currentFreeCount -= location.EcVolumesLen()
-		currentFreeCount /= erasure_coding.DataShardsCount
+		freeSlotUnits := int64(location.MaxVolumeCount-location.VolumesLen())*erasure_coding.VolumeSlotUnits - location.EcShardSlotUnits()
+		currentFreeCount := int(freeSlotUnits / erasure_coding.VolumeSlotUnits)
 		if currentFreeCount > max {
 			max = currentFreeCount
 			ret = location
@@ -130,27 +135,31 @@ func (s *Store) addVolume(vid needle.VolumeId, collection string, needleMapKind
 		return fmt.Errorf("Volume Id %d already exists!", vid)
 	}
 	if location := s.FindFreeLocation(diskType); location != nil {
-		glog.V(0).Infof("In dir %s adds volume:%v collection:%s replicaPlacement:%v ttl:%v",
-			location.Directory, vid, collection, replicaPlacement, ttl)
-		if volume, err := NewVolume(location.Directory, location.IdxDirectory, collection, vid, needleMapKind, replicaPlacement, ttl, preallocate, memoryMapMaxSizeMb); err == nil {
-			location.SetVolume(vid, volume)
-			glog.V(0).Infof("add volume %d", vid)
-			s.NewVolumesChan <- master_pb.VolumeShortInformationMessage{
-				Id:               uint32(vid),
-				Collection:       collection,
-				ReplicaPlacement: uint32(replicaPlacement.Byte()),
-				Version:          uint32(volume.Version()),
-				Ttl:              ttl.ToUint32(),
-				DiskType:         string(diskType),
-			}
-			return nil
-		} else {
-			return err
-		}
+		return s.addVolumeToLocation(location, vid, collection, needleMapKind, replicaPlacement, ttl, preallocate, memoryMapMaxSizeMb, diskType)
 	}
 	return fmt.Errorf("No more free space left")
 }
 
+func (s *Store) addVolumeToLocation(location *DiskLocation, vid needle.VolumeId, collection string, needleMapKind NeedleMapKind, replicaPlacement *super_block.ReplicaPlacement, ttl *needle.TTL, preallocate int64, memoryMapMaxSizeMb uint32, diskType DiskType) error {
+	glog.V(0).Infof("In dir %s adds volume:%v collection:%s replicaPlacement:%v ttl:%v",
+		location.Directory, vid, collection, replicaPlacement, ttl)
+	volume, err := NewVolume(location.Directory, location.IdxDirectory, collection, vid, needleMapKind, replicaPlacement, ttl, preallocate, memoryMapMaxSizeMb)
+	if err != nil {
+		return err
+	}
+	location.SetVolume(vid, volume)
+	glog.V(0).Infof("add volume %d", vid)
+	s.NewVolumesChan <- master_pb.VolumeShortInformationMessage{
+		Id:               uint32(vid),
+		Collection:       collection,
+		ReplicaPlacement: uint32(replicaPlacement.Byte()),
+		Version:          uint32(volume.Version()),
+		Ttl:              ttl.ToUint32(),
+		DiskType:         string(diskType),
+	}
+	return nil
+}
+
 func (s *Store) VolumeInfos() (allStats []*VolumeInfo) {
 	for _, location := range s.Locations {
 		stats := collectStatsForOneLocation(location)
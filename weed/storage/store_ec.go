@@ -54,12 +54,18 @@ func (s *Store) MountEcShards(collection string, vid needle.VolumeId, shardId er
 			glog.V(0).Infof("MountEcShards %d.%d", vid, shardId)
 
 			var shardBits erasure_coding.ShardBits
+			scheme := erasure_coding.DefaultScheme
+			if ecVolume, found := location.FindEcVolume(vid); found {
+				scheme = ecVolume.Scheme
+			}
 
 			s.NewEcShardsChan <- master_pb.VolumeEcShardInformationMessage{
-				Id:          uint32(vid),
-				Collection:  collection,
-				EcIndexBits: uint32(shardBits.AddShardId(shardId)),
-				DiskType:    string(location.DiskType),
+				Id:           uint32(vid),
+				Collection:   collection,
+				EcIndexBits:  uint32(shardBits.AddShardId(shardId)),
+				DiskType:     string(location.DiskType),
+				DataShards:   uint32(scheme.DataShards),
+				ParityShards: uint32(scheme.ParityShards),
 			}
 			return nil
 		} else if err == os.ErrNotExist {
@@ -80,11 +86,17 @@ func (s *Store) UnmountEcShards(vid needle.VolumeId, shardId erasure_coding.Shar
 	}
 
 	var shardBits erasure_coding.ShardBits
+	scheme := erasure_coding.DefaultScheme
+	if ecVolume, found := s.FindEcVolume(vid); found {
+		scheme = ecVolume.Scheme
+	}
 	message := master_pb.VolumeEcShardInformationMessage{
-		Id:          uint32(vid),
-		Collection:  ecShard.Collection,
-		EcIndexBits: uint32(shardBits.AddShardId(shardId)),
-		DiskType:    string(ecShard.DiskType),
+		Id:           uint32(vid),
+		Collection:   ecShard.Collection,
+		EcIndexBits:  uint32(shardBits.AddShardId(shardId)),
+		DiskType:     string(ecShard.DiskType),
+		DataShards:   uint32(scheme.DataShards),
+		ParityShards: uint32(scheme.ParityShards),
 	}
 
 	for _, location := range s.Locations {
@@ -186,7 +198,7 @@ func (s *Store) readEcShardIntervals(vid needle.VolumeId, needleId types.NeedleI
 }
 
 func (s *Store) readOneEcShardInterval(needleId types.NeedleId, ecVolume *erasure_coding.EcVolume, interval erasure_coding.Interval) (data []byte, is_deleted bool, err error) {
-	shardId, actualOffset := interval.ToShardIdAndOffset(erasure_coding.ErasureCodingLargeBlockSize, erasure_coding.ErasureCodingSmallBlockSize)
+	shardId, actualOffset := interval.ToShardIdAndOffset(erasure_coding.ErasureCodingLargeBlockSize, erasure_coding.ErasureCodingSmallBlockSize, ecVolume.Scheme.DataShards)
 	data = make([]byte, interval.Size)
 	if shard, found := ecVolume.FindEcVolumeShard(shardId); found {
 		if _, err = shard.ReadAt(data, actualOffset); err != nil {
@@ -227,11 +239,11 @@ func forgetShardId(ecVolume *erasure_coding.EcVolume, shardId erasure_coding.Sha
 func (s *Store) cachedLookupEcShardLocations(ecVolume *erasure_coding.EcVolume) (err error) {
 
 	shardCount := len(ecVolume.ShardLocations)
-	if shardCount < erasure_coding.DataShardsCount &&
+	if shardCount < ecVolume.Scheme.DataShards &&
 		ecVolume.ShardLocationsRefreshTime.Add(11*time.Second).After(time.Now()) ||
-		shardCount == erasure_coding.TotalShardsCount &&
+		shardCount == ecVolume.Scheme.TotalShards() &&
 			ecVolume.ShardLocationsRefreshTime.Add(37*time.Minute).After(time.Now()) ||
-		shardCount >= erasure_coding.DataShardsCount &&
+		shardCount >= ecVolume.Scheme.DataShards &&
 			ecVolume.ShardLocationsRefreshTime.Add(7*time.Minute).After(time.Now()) {
 		// still fresh
 		return nil
@@ -247,8 +259,8 @@ func (s *Store) cachedLookupEcShardLocations(ecVolume *erasure_coding.EcVolume)
 		if err != nil {
 			return fmt.Errorf("lookup ec volume %d: %v", ecVolume.VolumeId, err)
 		}
-		if len(resp.ShardIdLocations) < erasure_coding.DataShardsCount {
-			return fmt.Errorf("only %d shards found but %d required", len(resp.ShardIdLocations), erasure_coding.DataShardsCount)
+		if len(resp.ShardIdLocations) < ecVolume.Scheme.DataShards {
+			return fmt.Errorf("only %d shards found but %d required", len(resp.ShardIdLocations), ecVolume.Scheme.DataShards)
 		}
 
 		ecVolume.ShardLocationsLock.Lock()
@@ -328,12 +340,12 @@ func (s *Store) doReadRemoteEcShardInterval(sourceDataNode pb.ServerAddress, nee
 func (s *Store) recoverOneRemoteEcShardInterval(needleId types.NeedleId, ecVolume *erasure_coding.EcVolume, shardIdToRecover erasure_coding.ShardId, buf []byte, offset int64) (n int, is_deleted bool, err error) {
 	glog.V(3).Infof("recover ec shard %d.%d from other locations", ecVolume.VolumeId, shardIdToRecover)
 
-	enc, err := reedsolomon.New(erasure_coding.DataShardsCount, erasure_coding.ParityShardsCount)
+	enc, err := reedsolomon.New(ecVolume.Scheme.DataShards, ecVolume.Scheme.ParityShards)
 	if err != nil {
 		return 0, false, fmt.Errorf("failed to create encoder: %v", err)
 	}
 
-	bufs := make([][]byte, erasure_coding.TotalShardsCount)
+	bufs := make([][]byte, ecVolume.Scheme.TotalShards())
 
 	var wg sync.WaitGroup
 	ecVolume.ShardLocationsLock.RLock()
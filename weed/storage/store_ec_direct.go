@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/super_block"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/storage/volume_info"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// AddEcDirectVolume adds a volume whose needles are written directly into ec shards on the given servers.
+// The shards are mounted as a normal ec volume when the volume is sealed by ec.encode.
+func (s *Store) AddEcDirectVolume(vid needle.VolumeId, collection string, needleMapKind NeedleMapKind, ttlString string, memoryMapMaxSizeMb uint32, diskType types.DiskType, dataShards, parityShards uint32, shardLocations []string) error {
+	ttl, err := needle.ReadTTL(ttlString)
+	if err != nil {
+		return err
+	}
+	scheme, err := erasure_coding.NewScheme(dataShards, parityShards)
+	if err != nil {
+		return err
+	}
+	if s.findVolume(vid) != nil {
+		return fmt.Errorf("Volume Id %d already exists!", vid)
+	}
+	if len(shardLocations) != scheme.TotalShards() {
+		return fmt.Errorf("volume %d has %d ec shard locations, expecting %d", vid, len(shardLocations), scheme.TotalShards())
+	}
+	location := s.FindFreeLocation(diskType)
+	if location == nil {
+		return fmt.Errorf("No more free space left")
+	}
+
+	volumeInfo := &volume_server_pb.VolumeInfo{
+		Version:          uint32(needle.CurrentVersion),
+		EcDataShards:     uint32(scheme.DataShards),
+		EcParityShards:   uint32(scheme.ParityShards),
+		EcDirectWrite:    true,
+		EcWriting:        true,
+		EcShardLocations: shardLocations,
+	}
+	if err := volume_info.SaveVolumeInfo(VolumeFileName(location.Directory, collection, int(vid))+".vif", volumeInfo); err != nil {
+		return err
+	}
+
+	// the replication is done by the ec shards
+	return s.addVolumeToLocation(location, vid, collection, needleMapKind, &super_block.ReplicaPlacement{}, ttl, 0, memoryMapMaxSizeMb, diskType)
+}
+
+// WriteEcShards writes the ec shard data and index entries sent by the server writing the volume.
+// The shards are not mounted until the volume is sealed.
+func (s *Store) WriteEcShards(req *volume_server_pb.VolumeEcShardsWriteRequest) error {
+	s.ecDirectWriteLock.Lock()
+	defer s.ecDirectWriteLock.Unlock()
+
+	vid := needle.VolumeId(req.VolumeId)
+	if _, found := s.FindEcVolume(vid); found {
+		return fmt.Errorf("ec volume %d is already sealed", vid)
+	}
+	location := s.findEcDirectWriteLocation(req.Collection, vid)
+	if location == nil {
+		return fmt.Errorf("no space left")
+	}
+	dataBaseFileName := erasure_coding.EcShardFileName(req.Collection, location.Directory, int(vid))
+	indexBaseFileName := erasure_coding.EcShardFileName(req.Collection, location.IdxDirectory, int(vid))
+
+	if !util.FileExists(dataBaseFileName + ".vif") {
+		if err := volume_info.SaveVolumeInfo(dataBaseFileName+".vif", &volume_server_pb.VolumeInfo{
+			Version:        req.Version,
+			EcDataShards:   req.DataShards,
+			EcParityShards: req.ParityShards,
+			EcDirectWrite:  true,
+			EcWriting:      true,
+		}); err != nil {
+			return err
+		}
+	}
+
+	scheme, err := erasure_coding.NewScheme(req.DataShards, req.ParityShards)
+	if err != nil {
+		return err
+	}
+	for _, shard := range req.Shards {
+		if int(shard.ShardId) >= scheme.TotalShards() {
+			return fmt.Errorf("ec shard %d.%d is out of scheme %s", vid, shard.ShardId, scheme)
+		}
+		if err := writeEcShardFile(dataBaseFileName+erasure_coding.ToExt(int(shard.ShardId)), shard.Data, shard.Offset, req.ShardSize); err != nil {
+			return err
+		}
+	}
+
+	return writeEcIndexEntries(indexBaseFileName, req.IndexEntries)
+}
+
+// findEcDirectWriteLocation finds the location already having the volume files, or a free location for them
+func (s *Store) findEcDirectWriteLocation(collection string, vid needle.VolumeId) *DiskLocation {
+	for _, location := range s.Locations {
+		if util.FileExists(erasure_coding.EcShardFileName(collection, location.Directory, int(vid)) + ".vif") {
+			return location
+		}
+	}
+	return s.FindFreeLocation(types.HardDriveType)
+}
+
+// writeEcShardFile writes the data, and extends the shard file to keep all the shards in the same size
+func writeEcShardFile(fileName string, data []byte, offset int64, shardSize int64) error {
+	f, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("open %s: %v", fileName, err)
+	}
+	defer f.Close()
+	if len(data) > 0 {
+		if _, err = f.WriteAt(data, offset); err != nil {
+			return fmt.Errorf("write %s: %v", fileName, err)
+		}
+	}
+	if fi, statErr := f.Stat(); statErr == nil && fi.Size() < shardSize {
+		if err = f.Truncate(shardSize); err != nil {
+			return fmt.Errorf("extend %s to %d: %v", fileName, shardSize, err)
+		}
+	}
+	return f.Sync()
+}
+
+func writeEcIndexEntries(indexBaseFileName string, entries []*volume_server_pb.EcIndexEntry) error {
+	ecxFile, err := os.OpenFile(indexBaseFileName+".ecx", os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("open %s.ecx: %v", indexBaseFileName, err)
+	}
+	defer ecxFile.Close()
+	for _, entry := range entries {
+		needleId, size := types.NeedleId(entry.NeedleId), types.Size(entry.Size)
+		if err = erasure_coding.InsertNeedleToSortedIndex(ecxFile, needleId, types.ToOffset(entry.Offset), size); err != nil {
+			return fmt.Errorf("update %s.ecx: %v", indexBaseFileName, err)
+		}
+		if size.IsDeleted() {
+			if err = appendToEcjFile(indexBaseFileName, needleId); err != nil {
+				return err
+			}
+		}
+	}
+	return ecxFile.Sync()
+}
+
+func appendToEcjFile(indexBaseFileName string, needleId types.NeedleId) error {
+	ecjFile, err := os.OpenFile(indexBaseFileName+".ecj", os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("open %s.ecj: %v", indexBaseFileName, err)
+	}
+	defer ecjFile.Close()
+	b := make([]byte, types.NeedleIdSize)
+	types.NeedleIdToBytes(b, needleId)
+	_, err = ecjFile.Write(b)
+	return err
+}
+
+// OpenEcShardBeingWritten opens a shard written directly by another server, before it is mounted
+func (s *Store) OpenEcShardBeingWritten(collection string, vid needle.VolumeId, shardId erasure_coding.ShardId) (*os.File, error) {
+	for _, location := range s.Locations {
+		dataBaseFileName := erasure_coding.EcShardFileName(collection, location.Directory, int(vid))
+		if writing, _ := ecDirectWriteState(dataBaseFileName); writing {
+			return os.Open(dataBaseFileName + erasure_coding.ToExt(int(shardId)))
+		}
+	}
+	return nil, fmt.Errorf("not found ec shard %d.%d being written", vid, shardId)
+}
+
+// ecDirectWriteState tells whether the ec shards of the volume are still being written,
+// and whether this server is writing them
+func ecDirectWriteState(baseFileName string) (writing, isWriter bool) {
+	volumeInfo, _, found, err := volume_info.MaybeLoadVolumeInfo(baseFileName + ".vif")
+	if !found || err != nil {
+		return false, false
+	}
+	return volumeInfo.EcWriting, volumeInfo.EcWriting && len(volumeInfo.EcShardLocations) > 0
+}
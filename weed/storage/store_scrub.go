@@ -0,0 +1,354 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+var errScrubbingStopped = errors.New("scrubbing stopped")
+
+// corruptedNeedle is a current needle failing the crc or index verification
+type corruptedNeedle struct {
+	id     NeedleId
+	cookie Cookie
+	offset Offset
+	err    error
+}
+
+// StartScrubbing walks through all volumes and ec volumes in the background,
+// verifying the needle crc and the index entries, reading at most bytesPerSecond.
+// The corrupted needles are repaired from a healthy replica or by ec reconstruction,
+// and reported to the master.
+func (s *Store) StartScrubbing(bytesPerSecond int64, interval time.Duration) {
+	go func() {
+		throttler := util.NewWriteThrottler(bytesPerSecond)
+		for !s.isStopping {
+			start := time.Now()
+			s.scrubAll(throttler)
+			glog.V(0).Infof("scrubbed all volumes in %v", time.Since(start))
+			time.Sleep(interval)
+		}
+	}()
+}
+
+func (s *Store) scrubAll(throttler *util.WriteThrottler) {
+	var volumes []*Volume
+	for _, location := range s.Locations {
+		location.volumesLock.RLock()
+		for _, v := range location.volumes {
+			volumes = append(volumes, v)
+		}
+		location.volumesLock.RUnlock()
+	}
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].Id < volumes[j].Id
+	})
+
+	for _, v := range volumes {
+		if s.isStopping {
+			return
+		}
+		if v.HasRemoteFile() {
+			continue
+		}
+		if _, isEcDirectWrite := v.EcDirectWriteShardLocations(); isEcDirectWrite {
+			// verified as ec shards after being sealed
+			continue
+		}
+		if err := s.scrubVolume(v, throttler); err != nil && err != errScrubbingStopped {
+			glog.Warningf("scrub volume %d: %v", v.Id, err)
+		}
+	}
+
+	for _, ev := range s.EcVolumes() {
+		if s.isStopping {
+			return
+		}
+		if err := s.scrubEcVolume(ev, throttler); err != nil && err != errScrubbingStopped {
+			glog.Warningf("scrub ec volume %d: %v", ev.VolumeId, err)
+		}
+	}
+}
+
+func (s *Store) scrubVolume(v *Volume, throttler *util.WriteThrottler) error {
+	corrupted, err := v.scrub(throttler, func() bool { return s.isStopping })
+	for _, c := range corrupted {
+		repairErr := s.repairNeedle(v, c)
+		s.reportCorruptedNeedle(v.Id, v.Collection, false, c, repairErr)
+	}
+	return err
+}
+
+// scrub verifies the crc of the current needles, and that their index entries point to the needles in the .dat file
+func (v *Volume) scrub(throttler *util.WriteThrottler, isStopping func() bool) (corrupted []*corruptedNeedle, err error) {
+	var checked int
+	for i := int64(0); ; i++ {
+		if isStopping() {
+			return corrupted, errScrubbingStopped
+		}
+		c, readSize, done, err := v.scrubIndexEntry(i)
+		if err != nil || done {
+			glog.V(1).Infof("scrubbed volume %d: %d needles, %d corrupted", v.Id, checked, len(corrupted))
+			return corrupted, err
+		}
+		if c != nil {
+			glog.Warningf("volume %d needle %d is corrupted: %v", v.Id, c.id, c.err)
+			corrupted = append(corrupted, c)
+		}
+		if readSize > 0 {
+			checked++
+			throttler.MaybeSlowdown(readSize)
+		}
+	}
+}
+
+func (v *Volume) scrubIndexEntry(i int64) (c *corruptedNeedle, readSize int64, done bool, err error) {
+	v.dataFileAccessLock.RLock()
+	defer v.dataFileAccessLock.RUnlock()
+
+	if v.nm == nil || v.DataBackend == nil || v.isCompacting {
+		return nil, 0, true, nil
+	}
+	if i >= int64(v.nm.IndexFileSize())/NeedleMapEntrySize {
+		return nil, 0, true, nil
+	}
+	key, offset, size, err := v.nm.ReadIndexEntry(i)
+	if err != nil {
+		return nil, 0, true, fmt.Errorf("read index entry %d: %v", i, err)
+	}
+	if offset.IsZero() || size.IsDeleted() {
+		return nil, 0, false, nil
+	}
+	if nv, ok := v.nm.Get(key); !ok || nv.Offset != offset || nv.Size != size {
+		// overwritten or deleted later
+		return nil, 0, false, nil
+	}
+
+	actualOffset, actualSize := offset.ToActualOffset(), needle.GetActualSize(size, v.Version())
+	if datSize, _, _ := v.DataBackend.GetStat(); actualOffset+actualSize > datSize {
+		return &corruptedNeedle{id: key, offset: offset,
+			err: fmt.Errorf("index entry offset %d size %d is beyond the .dat file size %d", actualOffset, size, datSize)}, 0, false, nil
+	}
+
+	n := new(needle.Needle)
+	err = n.ReadData(v.DataBackend, actualOffset, size, v.Version())
+	if err == needle.ErrorSizeMismatch && OffsetSize == 4 {
+		err = n.ReadData(v.DataBackend, actualOffset+int64(MaxPossibleVolumeSize), size, v.Version())
+	}
+	if err == nil && n.Id != key {
+		err = fmt.Errorf("found needle %d at offset %d", n.Id, actualOffset)
+	}
+	if err != nil {
+		return &corruptedNeedle{id: key, cookie: n.Cookie, offset: offset, err: err}, actualSize, false, nil
+	}
+	return nil, actualSize, false, nil
+}
+
+// repairNeedle replaces the corrupted needle with the copy from a healthy replica
+func (s *Store) repairNeedle(v *Volume, c *corruptedNeedle) (err error) {
+	if !v.NeedToReplicate() {
+		return fmt.Errorf("volume %d has no replicas", v.Id)
+	}
+	lookupResult, err := operation.LookupVolumeId(func() pb.ServerAddress { return s.MasterAddress }, s.grpcDialOption, v.Id.String())
+	if err != nil {
+		return fmt.Errorf("lookup volume %d: %v", v.Id, err)
+	}
+	if lookupResult.Error != "" {
+		return fmt.Errorf("lookup volume %d: %s", v.Id, lookupResult.Error)
+	}
+
+	err = fmt.Errorf("no replicas found for volume %d", v.Id)
+	selfUrl := util.JoinHostPort(s.Ip, s.Port)
+	for _, location := range lookupResult.Locations {
+		if location.Url == selfUrl {
+			continue
+		}
+		if err = s.repairNeedleFromReplica(v, c, location.ServerAddress()); err == nil {
+			glog.V(0).Infof("repaired volume %d needle %d from %s", v.Id, c.id, location.Url)
+			return nil
+		}
+		glog.V(0).Infof("repair volume %d needle %d from %s: %v", v.Id, c.id, location.Url, err)
+	}
+	return err
+}
+
+func (s *Store) repairNeedleFromReplica(v *Volume, c *corruptedNeedle, replica pb.ServerAddress) error {
+	return operation.WithVolumeServerClient(false, replica, s.grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+		resp, err := client.ReadNeedleBlob(context.Background(), &volume_server_pb.ReadNeedleBlobRequest{
+			VolumeId: uint32(v.Id),
+			NeedleId: uint64(c.id),
+		})
+		if err != nil {
+			return err
+		}
+		return v.writeRepairedNeedleBlob(c, resp.NeedleBlob, Size(resp.Size))
+	})
+}
+
+// writeRepairedNeedleBlob verifies the needle copied from a replica, and appends it to replace the corrupted needle
+func (v *Volume) writeRepairedNeedleBlob(c *corruptedNeedle, needleBlob []byte, size Size) error {
+	n := new(needle.Needle)
+	if err := n.ReadBytes(needleBlob, 0, size, v.Version()); err != nil {
+		return fmt.Errorf("verify the copied needle: %v", err)
+	}
+	if n.Id != c.id {
+		return fmt.Errorf("copied needle %d, expecting %d", n.Id, c.id)
+	}
+
+	v.dataFileAccessLock.Lock()
+	defer v.dataFileAccessLock.Unlock()
+
+	if nv, ok := v.nm.Get(c.id); !ok || nv.Offset != c.offset {
+		// overwritten or deleted after being scrubbed
+		return nil
+	}
+	return v.doWriteNeedleBlob(c.id, needleBlob, size)
+}
+
+// scrubEcVolume verifies the needles having data in the local ec shards
+func (s *Store) scrubEcVolume(ev *erasure_coding.EcVolume, throttler *util.WriteThrottler) error {
+	var checked, corrupted int
+	err := ev.WalkIndex(func(key NeedleId, offset Offset, size Size) error {
+		if s.isStopping {
+			return errScrubbingStopped
+		}
+		if offset.IsZero() || size.IsDeleted() {
+			return nil
+		}
+		intervals := ev.LocateNeedle(offset, size, ev.Version)
+		if !hasLocalEcShardInterval(ev, intervals) {
+			return nil
+		}
+		throttler.MaybeSlowdown(needle.GetActualSize(size, ev.Version))
+		checked++
+
+		c, err := s.verifyEcNeedle(ev, key, offset, size, intervals)
+		if err != nil {
+			glog.V(0).Infof("scrub ec volume %d needle %d: %v", ev.VolumeId, key, err)
+			return nil
+		}
+		if c == nil {
+			return nil
+		}
+		corrupted++
+		glog.Warningf("ec volume %d needle %d is corrupted: %v", ev.VolumeId, key, c.err)
+		repairErr := s.repairEcNeedle(ev, key, offset, size, intervals)
+		if repairErr == nil {
+			glog.V(0).Infof("repaired ec volume %d needle %d", ev.VolumeId, key)
+		}
+		s.reportCorruptedNeedle(ev.VolumeId, ev.Collection, true, c, repairErr)
+		return nil
+	})
+	glog.V(1).Infof("scrubbed ec volume %d: %d needles, %d corrupted", ev.VolumeId, checked, corrupted)
+	return err
+}
+
+func hasLocalEcShardInterval(ev *erasure_coding.EcVolume, intervals []erasure_coding.Interval) bool {
+	for _, interval := range intervals {
+		shardId, _ := interval.ToShardIdAndOffset(erasure_coding.ErasureCodingLargeBlockSize, erasure_coding.ErasureCodingSmallBlockSize, ev.Scheme.DataShards)
+		if _, found := ev.FindEcVolumeShard(shardId); found {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyEcNeedle returns the corrupted needle if the needle fails the verification,
+// or an error if the needle cannot be read
+func (s *Store) verifyEcNeedle(ev *erasure_coding.EcVolume, key NeedleId, offset Offset, size Size, intervals []erasure_coding.Interval) (*corruptedNeedle, error) {
+	data, isDeleted, err := s.readEcShardIntervals(ev.VolumeId, key, ev, intervals)
+	if err != nil || isDeleted {
+		return nil, err
+	}
+	n := new(needle.Needle)
+	err = n.ReadBytes(data, offset.ToActualOffset(), size, ev.Version)
+	if err == nil && n.Id != key {
+		err = fmt.Errorf("found needle %d at offset %d", n.Id, offset.ToActualOffset())
+	}
+	if err != nil {
+		return &corruptedNeedle{id: key, cookie: n.Cookie, offset: offset, err: err}, nil
+	}
+	return nil, nil
+}
+
+// repairEcNeedle reconstructs the intervals in the local shards from the other shards,
+// and overwrites the local intervals having different data if the reconstructed needle passes the verification
+func (s *Store) repairEcNeedle(ev *erasure_coding.EcVolume, key NeedleId, offset Offset, size Size, intervals []erasure_coding.Interval) error {
+	type shardRepair struct {
+		shard  *erasure_coding.EcVolumeShard
+		offset int64
+		data   []byte
+	}
+	var repairs []shardRepair
+	var data []byte
+	for _, interval := range intervals {
+		shardId, shardOffset := interval.ToShardIdAndOffset(erasure_coding.ErasureCodingLargeBlockSize, erasure_coding.ErasureCodingSmallBlockSize, ev.Scheme.DataShards)
+		shard, found := ev.FindEcVolumeShard(shardId)
+		if !found {
+			d, _, err := s.readOneEcShardInterval(key, ev, interval)
+			if err != nil {
+				return err
+			}
+			data = append(data, d...)
+			continue
+		}
+		buf := make([]byte, interval.Size)
+		if _, _, err := s.recoverOneRemoteEcShardInterval(key, ev, shardId, buf, shardOffset); err != nil {
+			return fmt.Errorf("reconstruct ec shard %d.%d: %v", ev.VolumeId, shardId, err)
+		}
+		local := make([]byte, interval.Size)
+		if _, err := shard.ReadAt(local, shardOffset); err != nil || !bytes.Equal(local, buf) {
+			repairs = append(repairs, shardRepair{shard: shard, offset: shardOffset, data: buf})
+		}
+		data = append(data, buf...)
+	}
+	if len(repairs) == 0 {
+		return fmt.Errorf("the corrupted data is not in the local ec shards")
+	}
+
+	n := new(needle.Needle)
+	if err := n.ReadBytes(data, offset.ToActualOffset(), size, ev.Version); err != nil {
+		return fmt.Errorf("verify the reconstructed needle: %v", err)
+	}
+	for _, repair := range repairs {
+		if _, err := repair.shard.WriteAt(repair.data, repair.offset); err != nil {
+			return fmt.Errorf("write ec shard %d.%d: %v", ev.VolumeId, repair.shard.ShardId, err)
+		}
+	}
+	return nil
+}
+
+func (s *Store) reportCorruptedNeedle(vid needle.VolumeId, collection string, isEcVolume bool, c *corruptedNeedle, repairErr error) {
+	message := &master_pb.CorruptedNeedleInformationMessage{
+		VolumeId:   uint32(vid),
+		NeedleId:   uint64(c.id),
+		Cookie:     uint32(c.cookie),
+		Collection: collection,
+		IsEcVolume: isEcVolume,
+		Repaired:   repairErr == nil,
+		Error:      c.err.Error(),
+	}
+	if repairErr != nil {
+		glog.Warningf("failed to repair volume %d needle %d: %v", vid, c.id, repairErr)
+		message.Error = fmt.Sprintf("%v, not repaired: %v", c.err, repairErr)
+	}
+	select {
+	case s.CorruptedNeedlesChan <- message:
+	default:
+		glog.Warningf("too many corrupted needles, not reporting volume %d needle %d to the master", vid, c.id)
+	}
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/super_block"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func TestScrubAndRepairVolume(t *testing.T) {
+	dir := t.TempDir()
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	defer v.Close()
+	replicaDir := t.TempDir()
+	replica, err := NewVolume(replicaDir, replicaDir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0)
+	if err != nil {
+		t.Fatalf("replica creation: %v", err)
+	}
+	defer replica.Close()
+
+	written := make(map[uint64][]byte)
+	for i := uint64(1); i <= 3; i++ {
+		n := newEmptyNeedle(i)
+		n.Data = make([]byte, 1024+rand.Intn(1024))
+		rand.Read(n.Data)
+		n.Checksum = needle.NewCRC(n.Data)
+		for _, target := range []*Volume{v, replica} {
+			if _, _, _, err := target.writeNeedle2(n, true, false); err != nil {
+				t.Fatalf("write needle %d: %v", i, err)
+			}
+		}
+		written[i] = n.Data
+	}
+
+	throttler := util.NewWriteThrottler(0)
+	neverStop := func() bool { return false }
+	if corrupted, err := v.scrub(throttler, neverStop); err != nil || len(corrupted) != 0 {
+		t.Fatalf("scrub healthy volume: %d corrupted, %v", len(corrupted), err)
+	}
+
+	// flip one byte in the data of needle 2
+	nv, _ := v.nm.Get(types.NeedleId(2))
+	b := make([]byte, 1)
+	corruptAt := nv.Offset.ToActualOffset() + types.NeedleHeaderSize + 100
+	v.DataBackend.ReadAt(b, corruptAt)
+	b[0] ^= 0xff
+	v.DataBackend.WriteAt(b, corruptAt)
+
+	corrupted, err := v.scrub(throttler, neverStop)
+	if err != nil {
+		t.Fatalf("scrub corrupted volume: %v", err)
+	}
+	if len(corrupted) != 1 || corrupted[0].id != 2 {
+		t.Fatalf("found corrupted needles %+v, expecting needle 2", corrupted)
+	}
+
+	blob, size, err := replica.ReadNeedleBlobByNeedleId(2)
+	if err != nil {
+		t.Fatalf("read needle 2 from replica: %v", err)
+	}
+	if err := v.writeRepairedNeedleBlob(corrupted[0], blob, size); err != nil {
+		t.Fatalf("repair needle 2: %v", err)
+	}
+
+	if corrupted, err := v.scrub(throttler, neverStop); err != nil || len(corrupted) != 0 {
+		t.Fatalf("scrub repaired volume: %d corrupted, %v", len(corrupted), err)
+	}
+	for id, data := range written {
+		n := newEmptyNeedle(id)
+		if _, err := v.readNeedle(n, nil, nil); err != nil {
+			t.Fatalf("read needle %d: %v", id, err)
+		}
+		if !bytes.Equal(n.Data, data) {
+			t.Fatalf("needle %d data mismatch", id)
+		}
+	}
+}
@@ -0,0 +1,467 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/klauspost/reedsolomon"
+	"google.golang.org/grpc"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/idx"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/super_block"
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// ecShardClient sends the ec shard data to the servers holding the ec shards, and reads them back
+type ecShardClient interface {
+	WriteEcShards(server pb.ServerAddress, req *volume_server_pb.VolumeEcShardsWriteRequest) error
+	ReadEcShard(server pb.ServerAddress, req *volume_server_pb.VolumeEcShardReadRequest) ([]byte, error)
+}
+
+// set by NewStore, before the existing volumes are loaded
+var ecDirectShardClient ecShardClient
+
+type grpcEcShardClient struct {
+	grpcDialOption grpc.DialOption
+}
+
+func (c *grpcEcShardClient) WriteEcShards(server pb.ServerAddress, req *volume_server_pb.VolumeEcShardsWriteRequest) error {
+	return operation.WithVolumeServerClient(false, server, c.grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+		_, err := client.VolumeEcShardsWrite(context.Background(), req)
+		return err
+	})
+}
+
+func (c *grpcEcShardClient) ReadEcShard(server pb.ServerAddress, req *volume_server_pb.VolumeEcShardReadRequest) (data []byte, err error) {
+	err = operation.WithVolumeServerClient(true, server, c.grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+		stream, err := client.VolumeEcShardRead(context.Background(), req)
+		if err != nil {
+			return err
+		}
+		for {
+			resp, receiveErr := stream.Recv()
+			if receiveErr == io.EOF {
+				return nil
+			}
+			if receiveErr != nil {
+				return receiveErr
+			}
+			data = append(data, resp.Data...)
+		}
+	})
+	if err == nil && int64(len(data)) != req.Size {
+		err = fmt.Errorf("read %d bytes, expecting %d", len(data), req.Size)
+	}
+	return
+}
+
+// ecDirectFile is the .dat file of a volume written directly into ec shards.
+// The needles are striped by small blocks into the data shards, and the parity shards are
+// computed for the current row, which is kept in memory until the next row starts.
+// Every write is acknowledged by all the servers holding the shards, so no replication is needed.
+type ecDirectFile struct {
+	name       string
+	volumeId   needle.VolumeId
+	collection string
+	version    needle.Version
+	scheme     erasure_coding.Scheme
+	locations  []pb.ServerAddress
+	client     ecShardClient
+	encoder    reedsolomon.Encoder
+
+	accessLock sync.RWMutex
+	size       int64
+	modTime    time.Time
+	rowIndex   int64
+	row        [][]byte
+}
+
+func newEcDirectFile(v *Volume, client ecShardClient) (*ecDirectFile, error) {
+	scheme, err := erasure_coding.SchemeOf(v.volumeInfo)
+	if err != nil {
+		return nil, err
+	}
+	if len(v.volumeInfo.EcShardLocations) != scheme.TotalShards() {
+		return nil, fmt.Errorf("volume %d has %d ec shard locations, expecting %d", v.Id, len(v.volumeInfo.EcShardLocations), scheme.TotalShards())
+	}
+	if client == nil {
+		return nil, fmt.Errorf("volume %d: no client to write ec shards", v.Id)
+	}
+	encoder, err := reedsolomon.New(scheme.DataShards, scheme.ParityShards)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create encoder: %v", err)
+	}
+	f := &ecDirectFile{
+		name:       v.FileName(".dat"),
+		volumeId:   v.Id,
+		collection: v.Collection,
+		version:    needle.Version(v.volumeInfo.Version),
+		scheme:     scheme,
+		client:     client,
+		encoder:    encoder,
+		modTime:    time.Now(),
+		rowIndex:   -1,
+		row:        make([][]byte, scheme.TotalShards()),
+	}
+	if f.version == 0 {
+		f.version = needle.CurrentVersion
+	}
+	for _, location := range v.volumeInfo.EcShardLocations {
+		f.locations = append(f.locations, pb.ServerAddress(location))
+	}
+	for i := range f.row {
+		f.row[i] = make([]byte, erasure_coding.ErasureCodingSmallBlockSize)
+	}
+	if f.size, err = f.findSize(v.FileName(".idx")); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *ecDirectFile) rowSize() int64 {
+	return int64(f.scheme.DataShards) * erasure_coding.ErasureCodingSmallBlockSize
+}
+
+// findSize finds the end of the last needle in the .idx file, or of the super block
+func (f *ecDirectFile) findSize(indexFileName string) (size int64, err error) {
+	if indexFile, openErr := os.OpenFile(indexFileName, os.O_RDONLY, 0644); openErr == nil {
+		err = idx.WalkIndexFile(indexFile, func(key NeedleId, offset Offset, entrySize Size) error {
+			if entrySize.IsDeleted() {
+				entrySize = 0
+			}
+			if stop := offset.ToActualOffset() + needle.GetActualSize(entrySize, f.version); stop > size {
+				size = stop
+			}
+			return nil
+		})
+		indexFile.Close()
+		if err != nil {
+			return 0, fmt.Errorf("walk %s: %v", indexFileName, err)
+		}
+	}
+	if size > 0 {
+		return size, nil
+	}
+
+	header := make([]byte, super_block.SuperBlockSize)
+	if err := f.readData(header, 0); err != nil {
+		glog.V(0).Infof("volume %d has no super block in the ec shards: %v", f.volumeId, err)
+		return 0, nil
+	}
+	if header[0] == 0 {
+		return 0, nil
+	}
+	return super_block.SuperBlockSize + int64(util.BytesToUint16(header[6:8])), nil
+}
+
+func (f *ecDirectFile) ReadAt(p []byte, off int64) (n int, err error) {
+	f.accessLock.RLock()
+	defer f.accessLock.RUnlock()
+
+	if off >= f.size {
+		return 0, io.EOF
+	}
+	toRead := p
+	if off+int64(len(p)) > f.size {
+		toRead = p[:f.size-off]
+	}
+	if err = f.readData(toRead, off); err != nil {
+		return 0, err
+	}
+	if len(toRead) < len(p) {
+		return len(toRead), io.EOF
+	}
+	return len(p), nil
+}
+
+// readData reads from the cached row, or from the servers holding the data shards
+func (f *ecDirectFile) readData(p []byte, off int64) error {
+	for _, interval := range erasure_coding.LocateDirectWriteData(f.scheme.DataShards, off, Size(len(p))) {
+		shardId, shardOffset := interval.ToShardIdAndOffset(erasure_coding.ErasureCodingLargeBlockSize, erasure_coding.ErasureCodingSmallBlockSize, f.scheme.DataShards)
+		data := p[:interval.Size]
+		p = p[interval.Size:]
+		if shardOffset/erasure_coding.ErasureCodingSmallBlockSize == f.rowIndex {
+			copy(data, f.row[shardId][interval.InnerBlockOffset:])
+			continue
+		}
+		shardData, err := f.readShard(shardId, shardOffset, int64(len(data)))
+		if err != nil {
+			return err
+		}
+		copy(data, shardData)
+	}
+	return nil
+}
+
+// readShard reads one range of an ec shard, and reconstructs it from the other shards if the read fails
+func (f *ecDirectFile) readShard(shardId erasure_coding.ShardId, offset, size int64) ([]byte, error) {
+	data, err := f.client.ReadEcShard(f.locations[shardId], f.readRequest(shardId, offset, size))
+	if err == nil {
+		return data, nil
+	}
+	glog.V(0).Infof("read ec shard %d.%d from %s: %v, reconstructing", f.volumeId, shardId, f.locations[shardId], err)
+
+	bufs := make([][]byte, f.scheme.TotalShards())
+	var wg sync.WaitGroup
+	for i := range bufs {
+		if erasure_coding.ShardId(i) == shardId {
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if data, readErr := f.client.ReadEcShard(f.locations[i], f.readRequest(erasure_coding.ShardId(i), offset, size)); readErr == nil {
+				bufs[i] = data
+			}
+		}(i)
+	}
+	wg.Wait()
+	if reconstructErr := f.encoder.ReconstructData(bufs); reconstructErr != nil {
+		return nil, fmt.Errorf("read ec shard %d.%d: %v, and reconstruct: %v", f.volumeId, shardId, err, reconstructErr)
+	}
+	return bufs[shardId], nil
+}
+
+func (f *ecDirectFile) readRequest(shardId erasure_coding.ShardId, offset, size int64) *volume_server_pb.VolumeEcShardReadRequest {
+	return &volume_server_pb.VolumeEcShardReadRequest{
+		VolumeId:   uint32(f.volumeId),
+		ShardId:    uint32(shardId),
+		Offset:     offset,
+		Size:       size,
+		Collection: f.collection,
+	}
+}
+
+func (f *ecDirectFile) WriteAt(p []byte, off int64) (n int, err error) {
+	f.accessLock.Lock()
+	defer f.accessLock.Unlock()
+
+	for len(p) > 0 {
+		rowIndex := off / f.rowSize()
+		if err = f.loadRow(rowIndex); err != nil {
+			return
+		}
+		innerRowOffset := off - rowIndex*f.rowSize()
+		toWrite := int64(len(p))
+		if toWrite > f.rowSize()-innerRowOffset {
+			toWrite = f.rowSize() - innerRowOffset
+		}
+		if err = f.writeRow(p[:toWrite], innerRowOffset); err != nil {
+			return
+		}
+		n += int(toWrite)
+		off += toWrite
+		p = p[toWrite:]
+		if off > f.size {
+			f.size = off
+		}
+		f.modTime = time.Now()
+	}
+	return
+}
+
+// loadRow caches the row to write into, with the data written before
+func (f *ecDirectFile) loadRow(rowIndex int64) error {
+	if rowIndex == f.rowIndex {
+		return nil
+	}
+	f.rowIndex = -1
+	for _, block := range f.row {
+		for i := range block {
+			block[i] = 0
+		}
+	}
+	rowStart := rowIndex * f.rowSize()
+	if rowStart < f.size {
+		rowEnd := rowStart + f.rowSize()
+		if rowEnd > f.size {
+			rowEnd = f.size
+		}
+		data := make([]byte, rowEnd-rowStart)
+		if err := f.readData(data, rowStart); err != nil {
+			return fmt.Errorf("load row %d of volume %d: %v", rowIndex, f.volumeId, err)
+		}
+		for i := 0; i < f.scheme.DataShards && len(data) > 0; i++ {
+			data = data[copy(f.row[i], data):]
+		}
+	}
+	if err := f.encoder.Encode(f.row); err != nil {
+		return fmt.Errorf("encode row %d of volume %d: %v", rowIndex, f.volumeId, err)
+	}
+	f.rowIndex = rowIndex
+	return nil
+}
+
+// writeRow updates the cached row, and sends the changed data and parity to all the servers
+func (f *ecDirectFile) writeRow(p []byte, innerRowOffset int64) error {
+	blockSize := int64(erasure_coding.ErasureCodingSmallBlockSize)
+	firstShard, lastShard := innerRowOffset/blockSize, (innerRowOffset+int64(len(p))-1)/blockSize
+
+	// the changed columns of the row
+	start, stop := int64(0), blockSize
+	if firstShard == lastShard {
+		start = innerRowOffset % blockSize
+		stop = start + int64(len(p))
+	}
+
+	changed := make(map[erasure_coding.ShardId]*volume_server_pb.EcShardData)
+	shardOffset := f.rowIndex * blockSize
+	for data, offset := p, innerRowOffset; len(data) > 0; {
+		shardId, innerBlockOffset := erasure_coding.ShardId(offset/blockSize), offset%blockSize
+		written := copy(f.row[shardId][innerBlockOffset:], data)
+		changed[shardId] = &volume_server_pb.EcShardData{
+			ShardId: uint32(shardId),
+			Offset:  shardOffset + innerBlockOffset,
+			Data:    f.row[shardId][innerBlockOffset : innerBlockOffset+int64(written)],
+		}
+		data = data[written:]
+		offset += int64(written)
+	}
+
+	columns := make([][]byte, f.scheme.TotalShards())
+	for i := range columns {
+		columns[i] = f.row[i][start:stop]
+	}
+	if err := f.encoder.Encode(columns); err != nil {
+		return fmt.Errorf("encode row %d of volume %d: %v", f.rowIndex, f.volumeId, err)
+	}
+	for i := f.scheme.DataShards; i < f.scheme.TotalShards(); i++ {
+		changed[erasure_coding.ShardId(i)] = &volume_server_pb.EcShardData{
+			ShardId: uint32(i),
+			Offset:  shardOffset + start,
+			Data:    columns[i],
+		}
+	}
+
+	return f.sendToAllServers(func(shardIds []erasure_coding.ShardId) *volume_server_pb.VolumeEcShardsWriteRequest {
+		req := f.writeRequest()
+		for _, shardId := range shardIds {
+			if shardData, found := changed[shardId]; found {
+				req.Shards = append(req.Shards, shardData)
+			} else {
+				// only extend the shard
+				req.Shards = append(req.Shards, &volume_server_pb.EcShardData{ShardId: uint32(shardId)})
+			}
+		}
+		req.ShardSize = (f.rowIndex + 1) * blockSize
+		return req
+	})
+}
+
+// writeIndexEntry sends the index entry to all the servers, to keep their .ecx files up to date
+func (f *ecDirectFile) writeIndexEntry(key NeedleId, offset Offset, size Size) error {
+	return f.sendToAllServers(func(shardIds []erasure_coding.ShardId) *volume_server_pb.VolumeEcShardsWriteRequest {
+		req := f.writeRequest()
+		req.IndexEntries = []*volume_server_pb.EcIndexEntry{{
+			NeedleId: uint64(key),
+			Offset:   offset.ToActualOffset(),
+			Size:     int32(size),
+		}}
+		return req
+	})
+}
+
+func (f *ecDirectFile) writeRequest() *volume_server_pb.VolumeEcShardsWriteRequest {
+	return &volume_server_pb.VolumeEcShardsWriteRequest{
+		VolumeId:     uint32(f.volumeId),
+		Collection:   f.collection,
+		DataShards:   uint32(f.scheme.DataShards),
+		ParityShards: uint32(f.scheme.ParityShards),
+		Version:      uint32(f.version),
+	}
+}
+
+// sendToAllServers sends one request to each server, with the shards it holds, and waits for all of them
+func (f *ecDirectFile) sendToAllServers(requestFn func(shardIds []erasure_coding.ShardId) *volume_server_pb.VolumeEcShardsWriteRequest) error {
+	serverShardIds := make(map[pb.ServerAddress][]erasure_coding.ShardId)
+	for shardId, location := range f.locations {
+		serverShardIds[location] = append(serverShardIds[location], erasure_coding.ShardId(shardId))
+	}
+
+	var wg sync.WaitGroup
+	var errLock sync.Mutex
+	var lastErr error
+	for server, shardIds := range serverShardIds {
+		wg.Add(1)
+		go func(server pb.ServerAddress, req *volume_server_pb.VolumeEcShardsWriteRequest) {
+			defer wg.Done()
+			if err := f.client.WriteEcShards(server, req); err != nil {
+				errLock.Lock()
+				lastErr = fmt.Errorf("write ec shards of volume %d to %s: %v", f.volumeId, server, err)
+				errLock.Unlock()
+			}
+		}(server, requestFn(shardIds))
+	}
+	wg.Wait()
+	return lastErr
+}
+
+func (f *ecDirectFile) Truncate(off int64) error {
+	f.accessLock.Lock()
+	defer f.accessLock.Unlock()
+
+	if off < f.size {
+		f.size = off
+		// the cached row is reloaded on the next write
+		f.rowIndex = -1
+	}
+	return nil
+}
+
+func (f *ecDirectFile) Close() error {
+	return nil
+}
+
+func (f *ecDirectFile) GetStat() (datSize int64, modTime time.Time, err error) {
+	f.accessLock.RLock()
+	defer f.accessLock.RUnlock()
+	return f.size, f.modTime, nil
+}
+
+func (f *ecDirectFile) Name() string {
+	return f.name
+}
+
+// Sync does nothing, since every write is already acknowledged by all the servers
+func (f *ecDirectFile) Sync() error {
+	return nil
+}
+
+// EcDirectWriteShardLocations returns the servers holding each ec shard, if the volume is written directly into ec shards
+func (v *Volume) EcDirectWriteShardLocations() (locations []pb.ServerAddress, isEcDirectWrite bool) {
+	if f, ok := v.DataBackend.(*ecDirectFile); ok {
+		return f.locations, true
+	}
+	return nil, false
+}
+
+// ecDirectNeedleMap also sends the index entries to the servers holding the ec shards
+type ecDirectNeedleMap struct {
+	NeedleMapper
+	file *ecDirectFile
+}
+
+func (nm *ecDirectNeedleMap) Put(key NeedleId, offset Offset, size Size) error {
+	if err := nm.file.writeIndexEntry(key, offset, size); err != nil {
+		return err
+	}
+	return nm.NeedleMapper.Put(key, offset, size)
+}
+
+func (nm *ecDirectNeedleMap) Delete(key NeedleId, offset Offset) error {
+	if err := nm.file.writeIndexEntry(key, offset, TombstoneFileSize); err != nil {
+		return err
+	}
+	return nm.NeedleMapper.Delete(key, offset)
+}
@@ -0,0 +1,177 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/klauspost/reedsolomon"
+
+	"github.com/chrislusf/seaweedfs/weed/pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/super_block"
+	"github.com/chrislusf/seaweedfs/weed/storage/volume_info"
+)
+
+// fakeEcShardClient keeps the ec shards in memory, one server per shard
+type fakeEcShardClient struct {
+	sync.Mutex
+	shards       map[pb.ServerAddress][]byte
+	indexEntries map[pb.ServerAddress]int
+	down         map[pb.ServerAddress]bool
+}
+
+func newFakeEcShardClient() *fakeEcShardClient {
+	return &fakeEcShardClient{
+		shards:       make(map[pb.ServerAddress][]byte),
+		indexEntries: make(map[pb.ServerAddress]int),
+		down:         make(map[pb.ServerAddress]bool),
+	}
+}
+
+func (c *fakeEcShardClient) WriteEcShards(server pb.ServerAddress, req *volume_server_pb.VolumeEcShardsWriteRequest) error {
+	c.Lock()
+	defer c.Unlock()
+	for _, shard := range req.Shards {
+		data := c.shards[server]
+		if int64(len(data)) < req.ShardSize {
+			data = append(data, make([]byte, req.ShardSize-int64(len(data)))...)
+		}
+		copy(data[shard.Offset:], shard.Data)
+		c.shards[server] = data
+	}
+	c.indexEntries[server] += len(req.IndexEntries)
+	return nil
+}
+
+func (c *fakeEcShardClient) ReadEcShard(server pb.ServerAddress, req *volume_server_pb.VolumeEcShardReadRequest) ([]byte, error) {
+	c.Lock()
+	defer c.Unlock()
+	data := c.shards[server]
+	if c.down[server] || req.Offset+req.Size > int64(len(data)) {
+		return nil, fmt.Errorf("cannot read %d bytes at %d from %s", req.Size, req.Offset, server)
+	}
+	return append([]byte(nil), data[req.Offset:req.Offset+req.Size]...), nil
+}
+
+func TestEcDirectWrite(t *testing.T) {
+	dir := t.TempDir()
+	client := newFakeEcShardClient()
+	ecDirectShardClient = client
+	defer func() {
+		ecDirectShardClient = nil
+	}()
+
+	scheme := erasure_coding.Scheme{DataShards: 4, ParityShards: 2}
+	volumeInfo := &volume_server_pb.VolumeInfo{
+		Version:        uint32(needle.CurrentVersion),
+		EcDataShards:   uint32(scheme.DataShards),
+		EcParityShards: uint32(scheme.ParityShards),
+		EcDirectWrite:  true,
+		EcWriting:      true,
+	}
+	for i := 0; i < scheme.TotalShards(); i++ {
+		volumeInfo.EcShardLocations = append(volumeInfo.EcShardLocations, fmt.Sprintf("server%d:8080", i))
+	}
+	if err := volume_info.SaveVolumeInfo(VolumeFileName(dir, "", 1)+".vif", volumeInfo); err != nil {
+		t.Fatalf("save volume info: %v", err)
+	}
+
+	v, err := NewVolume(dir, dir, "", 1, NeedleMapInMemory, &super_block.ReplicaPlacement{}, &needle.TTL{}, 0, 0)
+	if err != nil {
+		t.Fatalf("volume creation: %v", err)
+	}
+	if _, isEcDirectWrite := v.EcDirectWriteShardLocations(); !isEcDirectWrite {
+		t.Fatalf("volume is not written directly into ec shards")
+	}
+
+	// about 3 rows of 4 small blocks
+	count := 40
+	written := make(map[uint64][]byte)
+	for i := 1; i <= count; i++ {
+		n := newEcDirectWriteNeedle(uint64(i), 256*1024+rand.Intn(64*1024))
+		if _, _, _, err := v.writeNeedle2(n, true, false); err != nil {
+			t.Fatalf("write needle %d: %v", i, err)
+		}
+		written[uint64(i)] = n.Data
+	}
+	if _, err := v.doDeleteRequest(newEmptyNeedle(uint64(count))); err != nil {
+		t.Fatalf("delete needle %d: %v", count, err)
+	}
+	delete(written, uint64(count))
+
+	for _, location := range volumeInfo.EcShardLocations {
+		if entries := client.indexEntries[pb.ServerAddress(location)]; entries != count+1 {
+			t.Errorf("%s received %d index entries, expecting %d", location, entries, count+1)
+		}
+	}
+	verifyEcDirectWriteParity(t, client, volumeInfo.EcShardLocations, scheme)
+	verifyEcDirectWriteNeedles(t, v, written)
+
+	// read the data shards of one server from the other shards
+	client.down[pb.ServerAddress(volumeInfo.EcShardLocations[1])] = true
+	verifyEcDirectWriteNeedles(t, v, written)
+	client.down[pb.ServerAddress(volumeInfo.EcShardLocations[1])] = false
+
+	// reload the volume, with the size from the .idx file
+	datSize, _, _ := v.DataBackend.GetStat()
+	v.Close()
+	if v, err = NewVolume(dir, dir, "", 1, NeedleMapInMemory, nil, nil, 0, 0); err != nil {
+		t.Fatalf("volume reloading: %v", err)
+	}
+	defer v.Close()
+	if reloadedSize, _, _ := v.DataBackend.GetStat(); reloadedSize != datSize {
+		t.Errorf("reloaded volume size %d, expecting %d", reloadedSize, datSize)
+	}
+	verifyEcDirectWriteNeedles(t, v, written)
+
+	n := newEcDirectWriteNeedle(uint64(count+1), 1024)
+	if _, _, _, err := v.writeNeedle2(n, true, false); err != nil {
+		t.Fatalf("write needle after reloading: %v", err)
+	}
+	written[uint64(count+1)] = n.Data
+	verifyEcDirectWriteParity(t, client, volumeInfo.EcShardLocations, scheme)
+	verifyEcDirectWriteNeedles(t, v, written)
+}
+
+func newEcDirectWriteNeedle(id uint64, size int) *needle.Needle {
+	n := newEmptyNeedle(id)
+	n.Data = make([]byte, size)
+	rand.Read(n.Data)
+	n.Checksum = needle.NewCRC(n.Data)
+	return n
+}
+
+func verifyEcDirectWriteNeedles(t *testing.T, v *Volume, written map[uint64][]byte) {
+	for id, data := range written {
+		n := newEmptyNeedle(id)
+		if _, err := v.readNeedle(n, nil, nil); err != nil {
+			t.Fatalf("read needle %d: %v", id, err)
+		}
+		if !bytes.Equal(n.Data, data) {
+			t.Fatalf("needle %d data mismatch", id)
+		}
+	}
+}
+
+func verifyEcDirectWriteParity(t *testing.T, client *fakeEcShardClient, locations []string, scheme erasure_coding.Scheme) {
+	enc, err := reedsolomon.New(scheme.DataShards, scheme.ParityShards)
+	if err != nil {
+		t.Fatalf("create encoder: %v", err)
+	}
+	var shards [][]byte
+	for _, location := range locations {
+		shard := client.shards[pb.ServerAddress(location)]
+		if len(shard)%erasure_coding.ErasureCodingSmallBlockSize != 0 || len(shards) > 0 && len(shard) != len(shards[0]) {
+			t.Fatalf("shard on %s has %d bytes", location, len(shard))
+		}
+		shards = append(shards, shard)
+	}
+	if ok, err := enc.Verify(shards); !ok || err != nil {
+		t.Fatalf("parity shards do not match the data shards: %v", err)
+	}
+}
@@ -47,6 +47,13 @@ func (v *Volume) load(alsoLoadIndex bool, createDatIfMissing bool, needleMapKind
 		glog.V(0).Infof("loading volume %d from remote %v", v.Id, v.volumeInfo)
 		v.LoadRemoteFile()
 		alreadyHasSuperBlock = true
+	} else if v.volumeInfo.EcWriting && len(v.volumeInfo.EcShardLocations) > 0 {
+		glog.V(0).Infof("loading volume %d written directly into ec shards on %v", v.Id, v.volumeInfo.EcShardLocations)
+		var ecFile *ecDirectFile
+		if ecFile, err = newEcDirectFile(v, ecDirectShardClient); err == nil {
+			v.DataBackend = ecFile
+			alreadyHasSuperBlock = ecFile.size >= super_block.SuperBlockSize
+		}
 	} else if exists, canRead, canWrite, modifiedTime, fileSize := util.CheckFile(v.FileName(".dat")); exists {
 		// open dat file
 		if !canRead {
@@ -169,6 +176,9 @@ func (v *Volume) load(alsoLoadIndex bool, createDatIfMissing bool, needleMapKind
 				}
 			}
 		}
+		if ecFile, ok := v.DataBackend.(*ecDirectFile); ok && v.nm != nil {
+			v.nm = &ecDirectNeedleMap{NeedleMapper: v.nm, file: ecFile}
+		}
 	}
 
 	if !hasVolumeInfoFile {
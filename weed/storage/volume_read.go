@@ -69,6 +69,22 @@ func (v *Volume) ReadNeedleBlob(offset int64, size Size) ([]byte, error) {
 	return needle.ReadNeedleBlob(v.DataBackend, offset, size, v.Version())
 }
 
+// ReadNeedleBlobByNeedleId reads the raw bytes of the current needle with the id
+func (v *Volume) ReadNeedleBlobByNeedleId(needleId NeedleId) ([]byte, Size, error) {
+	v.dataFileAccessLock.RLock()
+	defer v.dataFileAccessLock.RUnlock()
+
+	nv, ok := v.nm.Get(needleId)
+	if !ok || nv.Offset.IsZero() {
+		return nil, 0, ErrorNotFound
+	}
+	if nv.Size.IsDeleted() {
+		return nil, 0, ErrorDeleted
+	}
+	blob, err := needle.ReadNeedleBlob(v.DataBackend, nv.Offset.ToActualOffset(), nv.Size, v.Version())
+	return blob, nv.Size, err
+}
+
 type VolumeFileScanner interface {
 	VisitSuperBlock(super_block.SuperBlock) error
 	ReadNeedleBody() bool
@@ -23,6 +23,10 @@ func (v *Volume) garbageLevel() float64 {
 	if v.ContentSize() == 0 {
 		return 0
 	}
+	// the ec shards written directly are only cleaned up after the volume is sealed
+	if _, isEcDirectWrite := v.DataBackend.(*ecDirectFile); isEcDirectWrite {
+		return 0
+	}
 	deletedSize := v.DeletedSize()
 	fileSize := v.ContentSize()
 	if v.DeletedCount() > 0 && v.DeletedSize() == 0 {
@@ -9,8 +9,10 @@ import (
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/storage/backend"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
 var ErrorNotFound = errors.New("not found")
@@ -75,7 +77,10 @@ func removeVolumeFiles(filename string) {
 	// basic
 	os.Remove(filename + ".dat")
 	os.Remove(filename + ".idx")
-	os.Remove(filename + ".vif")
+	// the ec shards of the same volume also use the .vif file
+	if !hasEcFiles(filename) {
+		os.Remove(filename + ".vif")
+	}
 	// sorted index file
 	os.Remove(filename + ".sdx")
 	// compaction
@@ -87,6 +92,18 @@ func removeVolumeFiles(filename string) {
 	os.Remove(filename + ".note")
 }
 
+func hasEcFiles(filename string) bool {
+	if util.FileExists(filename + ".ecx") {
+		return true
+	}
+	for i := 0; i < erasure_coding.MaxShardCount; i++ {
+		if util.FileExists(filename + erasure_coding.ToExt(i)) {
+			return true
+		}
+	}
+	return false
+}
+
 func (v *Volume) asyncRequestAppend(request *needle.AsyncRequest) {
 	v.asyncRequestsChan <- request
 }
@@ -310,6 +327,11 @@ func (v *Volume) WriteNeedleBlob(needleId NeedleId, needleBlob []byte, size Size
 	v.dataFileAccessLock.Lock()
 	defer v.dataFileAccessLock.Unlock()
 
+	return v.doWriteNeedleBlob(needleId, needleBlob, size)
+}
+
+func (v *Volume) doWriteNeedleBlob(needleId NeedleId, needleBlob []byte, size Size) error {
+
 	if MaxPossibleVolumeSize < v.nm.ContentSize()+uint64(len(needleBlob)) {
 		return fmt.Errorf("volume size limit %d exceeded! current size is %d", MaxPossibleVolumeSize, v.nm.ContentSize())
 	}
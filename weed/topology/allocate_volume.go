@@ -5,6 +5,7 @@ import (
 
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"google.golang.org/grpc"
 )
@@ -30,3 +31,28 @@ func AllocateVolume(dn *DataNode, grpcDialOption grpc.DialOption, vid needle.Vol
 	})
 
 }
+
+// AllocateEcDirectVolume allocates a volume on the data node, which writes its needles directly into the ec shards on the shard locations
+func AllocateEcDirectVolume(dn *DataNode, grpcDialOption grpc.DialOption, vid needle.VolumeId, option *VolumeGrowOption, scheme erasure_coding.Scheme, shardLocations []*DataNode) error {
+
+	var locations []string
+	for _, location := range shardLocations {
+		locations = append(locations, string(location.ServerAddress()))
+	}
+
+	return operation.WithVolumeServerClient(false, dn.ServerAddress(), grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+
+		_, allocateErr := client.AllocateVolume(context.Background(), &volume_server_pb.AllocateVolumeRequest{
+			VolumeId:           uint32(vid),
+			Collection:         option.Collection,
+			Ttl:                option.Ttl.String(),
+			MemoryMapMaxSizeMb: option.MemoryMapMaxSizeMb,
+			DiskType:           string(option.DiskType),
+			EcDataShards:       uint32(scheme.DataShards),
+			EcParityShards:     uint32(scheme.ParityShards),
+			EcShardLocations:   locations,
+		})
+		return allocateErr
+	})
+
+}
@@ -34,25 +34,30 @@ func (dn *DataNode) UpdateEcShards(actualShards []*erasure_coding.EcVolumeInfo)
 		deltaDiskUsage := deltaDiskUsages.getOrCreateDisk(types.ToDiskType(ecShards.DiskType))
 
 		vid := ecShards.VolumeId
+		var volumeShardDelta int
 		if actualEcShards, ok := actualEcShardMap[vid]; !ok {
 			// dn registered ec shards not found in the new set of ec shards
 			deletedShards = append(deletedShards, ecShards)
 			deletedShardCount += ecShards.ShardIdCount()
+			volumeShardDelta -= ecShards.ShardIdCount()
 		} else {
 			// found, but maybe the actual shard could be missing
 			a := actualEcShards.Minus(ecShards)
 			if a.ShardIdCount() > 0 {
 				newShards = append(newShards, a)
 				newShardCount += a.ShardIdCount()
+				volumeShardDelta += a.ShardIdCount()
 			}
 			d := ecShards.Minus(actualEcShards)
 			if d.ShardIdCount() > 0 {
 				deletedShards = append(deletedShards, d)
 				deletedShardCount += d.ShardIdCount()
+				volumeShardDelta -= d.ShardIdCount()
 			}
 		}
 
 		deltaDiskUsage.ecShardCount = int64(newShardCount - deletedShardCount)
+		deltaDiskUsage.ecShardSlotUnits = int64(volumeShardDelta) * ecShards.Scheme.ShardSlotUnits()
 		disk.UpAdjustDiskUsageDelta(deltaDiskUsages)
 
 	}
@@ -68,6 +73,7 @@ func (dn *DataNode) UpdateEcShards(actualShards []*erasure_coding.EcVolumeInfo)
 		deltaDiskUsages := newDiskUsages()
 		deltaDiskUsage := deltaDiskUsages.getOrCreateDisk(types.ToDiskType(ecShards.DiskType))
 		deltaDiskUsage.ecShardCount = int64(ecShards.ShardIdCount())
+		deltaDiskUsage.ecShardSlotUnits = int64(ecShards.ShardIdCount()) * ecShards.Scheme.ShardSlotUnits()
 		disk.UpAdjustDiskUsageDelta(deltaDiskUsages)
 	}
 
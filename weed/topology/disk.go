@@ -52,6 +52,7 @@ func (d *DiskUsages) negative() *DiskUsages {
 		a.remoteVolumeCount = -b.remoteVolumeCount
 		a.activeVolumeCount = -b.activeVolumeCount
 		a.ecShardCount = -b.ecShardCount
+		a.ecShardSlotUnits = -b.ecShardSlotUnits
 		a.maxVolumeCount = -b.maxVolumeCount
 
 	}
@@ -96,6 +97,7 @@ type DiskUsageCounts struct {
 	remoteVolumeCount int64
 	activeVolumeCount int64
 	ecShardCount      int64
+	ecShardSlotUnits  int64 // in erasure_coding.VolumeSlotUnits per volume slot
 	maxVolumeCount    int64
 }
 
@@ -104,13 +106,14 @@ func (a *DiskUsageCounts) addDiskUsageCounts(b *DiskUsageCounts) {
 	a.remoteVolumeCount += b.remoteVolumeCount
 	a.activeVolumeCount += b.activeVolumeCount
 	a.ecShardCount += b.ecShardCount
+	a.ecShardSlotUnits += b.ecShardSlotUnits
 	a.maxVolumeCount += b.maxVolumeCount
 }
 
 func (a *DiskUsageCounts) FreeSpace() int64 {
 	freeVolumeSlotCount := a.maxVolumeCount + a.remoteVolumeCount - a.volumeCount
 	if a.ecShardCount > 0 {
-		freeVolumeSlotCount = freeVolumeSlotCount - a.ecShardCount/erasure_coding.DataShardsCount - 1
+		freeVolumeSlotCount = freeVolumeSlotCount - a.ecShardSlotUnits/erasure_coding.VolumeSlotUnits - 1
 	}
 	return freeVolumeSlotCount
 }
@@ -121,6 +124,7 @@ func (a *DiskUsageCounts) minus(b *DiskUsageCounts) *DiskUsageCounts {
 		remoteVolumeCount: a.remoteVolumeCount - b.remoteVolumeCount,
 		activeVolumeCount: a.activeVolumeCount - b.activeVolumeCount,
 		ecShardCount:      a.ecShardCount - b.ecShardCount,
+		ecShardSlotUnits:  a.ecShardSlotUnits - b.ecShardSlotUnits,
 		maxVolumeCount:    a.maxVolumeCount - b.maxVolumeCount,
 	}
 }
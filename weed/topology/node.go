@@ -140,7 +140,7 @@ func (n *NodeImpl) AvailableSpaceFor(option *VolumeGrowOption) int64 {
 	t := n.getOrCreateDisk(option.DiskType)
 	freeVolumeSlotCount := t.maxVolumeCount + t.remoteVolumeCount - t.volumeCount
 	if t.ecShardCount > 0 {
-		freeVolumeSlotCount = freeVolumeSlotCount - t.ecShardCount/erasure_coding.DataShardsCount - 1
+		freeVolumeSlotCount = freeVolumeSlotCount - t.ecShardSlotUnits/erasure_coding.VolumeSlotUnits - 1
 	}
 	return freeVolumeSlotCount
 }
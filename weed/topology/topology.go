@@ -28,6 +28,8 @@ type Topology struct {
 	ecShardMap     map[needle.VolumeId]*EcShardLocations
 	ecShardMapLock sync.RWMutex
 
+	ecShardReservations *ecShardReservations
+
 	pulse int64
 
 	volumeSizeLimit  uint64
@@ -38,6 +40,9 @@ type Topology struct {
 	chanFullVolumes    chan storage.VolumeInfo
 	chanCrowdedVolumes chan storage.VolumeInfo
 
+	corruptedNeedles     []CorruptedNeedle
+	corruptedNeedlesLock sync.RWMutex
+
 	Configuration *Configuration
 
 	RaftServer raft.Server
@@ -52,6 +57,7 @@ func NewTopology(id string, seq sequence.Sequencer, volumeSizeLimit uint64, puls
 	t.children = make(map[NodeId]Node)
 	t.collectionMap = util.NewConcurrentReadMap()
 	t.ecShardMap = make(map[needle.VolumeId]*EcShardLocations)
+	t.ecShardReservations = newEcShardReservations()
 	t.pulse = int64(pulse)
 	t.volumeSizeLimit = volumeSizeLimit
 	t.replicationAsMin = replicationAsMin
@@ -186,6 +192,7 @@ func (t *Topology) FindCollection(collectionName string) (*Collection, bool) {
 
 func (t *Topology) DeleteCollection(collectionName string) {
 	t.collectionMap.Delete(collectionName)
+	t.ecShardReservations.releaseCollection(collectionName)
 }
 
 func (t *Topology) DeleteLayout(collectionName string, rp *super_block.ReplicaPlacement, ttl *needle.TTL, diskType types.DiskType) {
@@ -10,7 +10,7 @@ import (
 
 type EcShardLocations struct {
 	Collection string
-	Locations  [erasure_coding.TotalShardsCount][]*DataNode
+	Locations  [erasure_coding.MaxShardCount][]*DataNode
 }
 
 func (t *Topology) SyncDataNodeEcShards(shardInfos []*master_pb.VolumeEcShardInformationMessage, dn *DataNode) (newShards, deletedShards []*erasure_coding.EcVolumeInfo) {
@@ -18,11 +18,7 @@ func (t *Topology) SyncDataNodeEcShards(shardInfos []*master_pb.VolumeEcShardInf
 	var shards []*erasure_coding.EcVolumeInfo
 	for _, shardInfo := range shardInfos {
 		shards = append(shards,
-			erasure_coding.NewEcVolumeInfo(
-				shardInfo.DiskType,
-				shardInfo.Collection,
-				needle.VolumeId(shardInfo.Id),
-				erasure_coding.ShardBits(shardInfo.EcIndexBits)))
+			newEcVolumeInfo(shardInfo))
 	}
 	// find out the delta volumes
 	newShards, deletedShards = dn.UpdateEcShards(shards)
@@ -40,19 +36,11 @@ func (t *Topology) IncrementalSyncDataNodeEcShards(newEcShards, deletedEcShards
 	var newShards, deletedShards []*erasure_coding.EcVolumeInfo
 	for _, shardInfo := range newEcShards {
 		newShards = append(newShards,
-			erasure_coding.NewEcVolumeInfo(
-				shardInfo.DiskType,
-				shardInfo.Collection,
-				needle.VolumeId(shardInfo.Id),
-				erasure_coding.ShardBits(shardInfo.EcIndexBits)))
+			newEcVolumeInfo(shardInfo))
 	}
 	for _, shardInfo := range deletedEcShards {
 		deletedShards = append(deletedShards,
-			erasure_coding.NewEcVolumeInfo(
-				shardInfo.DiskType,
-				shardInfo.Collection,
-				needle.VolumeId(shardInfo.Id),
-				erasure_coding.ShardBits(shardInfo.EcIndexBits)))
+			newEcVolumeInfo(shardInfo))
 	}
 
 	dn.DeltaUpdateEcShards(newShards, deletedShards)
@@ -66,6 +54,21 @@ func (t *Topology) IncrementalSyncDataNodeEcShards(newEcShards, deletedEcShards
 	return
 }
 
+func newEcVolumeInfo(shardInfo *master_pb.VolumeEcShardInformationMessage) *erasure_coding.EcVolumeInfo {
+	ecVolumeInfo := erasure_coding.NewEcVolumeInfo(
+		shardInfo.DiskType,
+		shardInfo.Collection,
+		needle.VolumeId(shardInfo.Id),
+		erasure_coding.ShardBits(shardInfo.EcIndexBits))
+	scheme, err := erasure_coding.NewScheme(shardInfo.DataShards, shardInfo.ParityShards)
+	if err != nil {
+		glog.Warningf("ec volume %d: %v", shardInfo.Id, err)
+	} else {
+		ecVolumeInfo.Scheme = scheme
+	}
+	return ecVolumeInfo
+}
+
 func NewEcShardLocations(collection string) *EcShardLocations {
 	return &EcShardLocations{
 		Collection: collection,
@@ -100,6 +103,9 @@ func (loc *EcShardLocations) DeleteShard(shardId erasure_coding.ShardId, dn *Dat
 
 func (t *Topology) RegisterEcShards(ecShardInfos *erasure_coding.EcVolumeInfo, dn *DataNode) {
 
+	// the reported shards are counted in the disk usages of their data nodes
+	t.ecShardReservations.release(ecShardInfos.VolumeId)
+
 	t.ecShardMapLock.Lock()
 	defer t.ecShardMapLock.Unlock()
 
@@ -0,0 +1,56 @@
+package topology
+
+import (
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+// keep the latest reports only
+const maxCorruptedNeedles = 1024
+
+// CorruptedNeedle is a needle found corrupted by a volume server scrubbing its volumes
+type CorruptedNeedle struct {
+	FileId     string
+	Collection string
+	Url        string
+	IsEcVolume bool
+	Repaired   bool
+	Error      string
+	ReportedAt time.Time
+}
+
+func (t *Topology) ReportCorruptedNeedles(dn *DataNode, messages []*master_pb.CorruptedNeedleInformationMessage) {
+	t.corruptedNeedlesLock.Lock()
+	defer t.corruptedNeedlesLock.Unlock()
+
+	for _, m := range messages {
+		c := CorruptedNeedle{
+			FileId:     needle.NewFileId(needle.VolumeId(m.VolumeId), m.NeedleId, m.Cookie).String(),
+			Collection: m.Collection,
+			Url:        dn.Url(),
+			IsEcVolume: m.IsEcVolume,
+			Repaired:   m.Repaired,
+			Error:      m.Error,
+			ReportedAt: time.Now(),
+		}
+		if c.Repaired {
+			glog.V(0).Infof("volume server %s repaired corrupted %s: %s", c.Url, c.FileId, c.Error)
+		} else {
+			glog.Warningf("volume server %s found corrupted %s: %s", c.Url, c.FileId, c.Error)
+		}
+		t.corruptedNeedles = append(t.corruptedNeedles, c)
+	}
+	if len(t.corruptedNeedles) > maxCorruptedNeedles {
+		t.corruptedNeedles = append([]CorruptedNeedle(nil), t.corruptedNeedles[len(t.corruptedNeedles)-maxCorruptedNeedles:]...)
+	}
+}
+
+// CorruptedNeedles lists the latest reported corrupted needles
+func (t *Topology) CorruptedNeedles() []CorruptedNeedle {
+	t.corruptedNeedlesLock.RLock()
+	defer t.corruptedNeedlesLock.RUnlock()
+	return append([]CorruptedNeedle(nil), t.corruptedNeedles...)
+}
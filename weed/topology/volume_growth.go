@@ -10,6 +10,7 @@ import (
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/storage/super_block"
 	"github.com/chrislusf/seaweedfs/weed/storage/types"
@@ -56,6 +57,23 @@ func (o *VolumeGrowOption) Threshold() float64 {
 	return v.GetFloat64("master.volume_growth.threshold")
 }
 
+// EcDirectWriteScheme tells whether the collection is written directly into ec shards, and with which scheme
+func (o *VolumeGrowOption) EcDirectWriteScheme() (scheme erasure_coding.Scheme, isEcDirectWrite bool) {
+	v := util.GetViper()
+	for _, collection := range v.GetStringSlice("master.erasure_coding.direct_write_collections") {
+		if collection != o.Collection {
+			continue
+		}
+		scheme, err := erasure_coding.NewScheme(v.GetUint32("master.erasure_coding.data_shards"), v.GetUint32("master.erasure_coding.parity_shards"))
+		if err != nil {
+			glog.Errorf("master.erasure_coding: %v", err)
+			return scheme, false
+		}
+		return scheme, true
+	}
+	return erasure_coding.Scheme{}, false
+}
+
 func NewDefaultVolumeGrowth() *VolumeGrowth {
 	return &VolumeGrowth{}
 }
@@ -219,8 +237,20 @@ func (vg *VolumeGrowth) findEmptySlotsForOneVolume(topo *Topology, option *Volum
 }
 
 func (vg *VolumeGrowth) grow(grpcDialOption grpc.DialOption, topo *Topology, vid needle.VolumeId, option *VolumeGrowOption, servers ...*DataNode) error {
+	scheme, isEcDirectWrite := option.EcDirectWriteScheme()
 	for _, server := range servers {
-		if err := AllocateVolume(server, grpcDialOption, vid, option); err == nil {
+		var err error
+		if isEcDirectWrite {
+			var shardLocations []*DataNode
+			if shardLocations, err = topo.pickEcShardLocations(server, vid, scheme, option); err == nil {
+				if err = AllocateEcDirectVolume(server, grpcDialOption, vid, option, scheme, shardLocations); err != nil {
+					topo.ecShardReservations.release(vid)
+				}
+			}
+		} else {
+			err = AllocateVolume(server, grpcDialOption, vid, option)
+		}
+		if err == nil {
 			vi := storage.VolumeInfo{
 				Id:               vid,
 				Size:             0,
@@ -0,0 +1,154 @@
+package topology
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+// ecShardReservations count the shards of the volumes written directly into ec shards against the free space
+// of their data nodes. The shards are only reported by the volume servers once the volume is written,
+// so without the reservations the next volumes would be spread to the same data nodes.
+type ecShardReservations struct {
+	sync.Mutex
+	volumes map[needle.VolumeId]*ecShardReservation
+}
+
+type ecShardReservation struct {
+	collection string
+	slotUnits  map[*DataNode]int64 // in erasure_coding.VolumeSlotUnits
+}
+
+func newEcShardReservations() *ecShardReservations {
+	return &ecShardReservations{
+		volumes: make(map[needle.VolumeId]*ecShardReservation),
+	}
+}
+
+// reservedSlotUnits are the slot units reserved on the data node
+func (r *ecShardReservations) reservedSlotUnits(dn *DataNode) (units int64) {
+	for _, reservation := range r.volumes {
+		units += reservation.slotUnits[dn]
+	}
+	return
+}
+
+func (r *ecShardReservations) release(vid needle.VolumeId) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.volumes, vid)
+}
+
+func (r *ecShardReservations) releaseCollection(collection string) {
+	r.Lock()
+	defer r.Unlock()
+	for vid, reservation := range r.volumes {
+		if reservation.collection == collection {
+			delete(r.volumes, vid)
+		}
+	}
+}
+
+// pickEcShardLocations spreads the ec shards of a volume written directly into ec shards, and reserves their space.
+// The writer holds the first shard, and the other shards go to the data nodes with free space on the disk type,
+// in the data center of the option if set. The shards are spread over the racks first, then over the data nodes,
+// and a data node holds at most as many shards as the parity shards, so losing one data node does not lose the volume.
+func (t *Topology) pickEcShardLocations(writer *DataNode, vid needle.VolumeId, scheme erasure_coding.Scheme, option *VolumeGrowOption) (locations []*DataNode, err error) {
+	reservations := t.ecShardReservations
+	reservations.Lock()
+	defer reservations.Unlock()
+
+	type candidate struct {
+		dn        *DataNode
+		rack      *Rack
+		freeUnits int64
+		shards    int
+	}
+	shardUnits := scheme.ShardSlotUnits()
+	var candidates []*candidate
+	for _, dc := range t.Children() {
+		if option.DataCenter != "" && dc.Id() != NodeId(option.DataCenter) {
+			continue
+		}
+		for _, rack := range dc.Children() {
+			for _, n := range rack.Children() {
+				dn := n.(*DataNode)
+				freeUnits := dn.AvailableSpaceFor(option)*erasure_coding.VolumeSlotUnits - reservations.reservedSlotUnits(dn)
+				if dn == writer {
+					// the writer also keeps the volume itself
+					freeUnits = freeUnits - erasure_coding.VolumeSlotUnits + shardUnits
+				}
+				if freeUnits < shardUnits {
+					if dn == writer {
+						return nil, fmt.Errorf("no free space on writer %s for ec shards of volume %d", dn.Id(), vid)
+					}
+					continue
+				}
+				candidates = append(candidates, &candidate{dn: dn, rack: rack.(*Rack), freeUnits: freeUnits})
+			}
+		}
+	}
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+
+	maxShardsPerNode := scheme.ParityShards
+	if maxShardsPerNode < 1 {
+		maxShardsPerNode = 1
+	}
+	rackShards := make(map[*Rack]int)
+	place := func(c *candidate) {
+		locations = append(locations, c.dn)
+		c.shards++
+		c.freeUnits -= shardUnits
+		rackShards[c.rack]++
+	}
+	for _, c := range candidates {
+		if c.dn == writer {
+			place(c)
+		}
+	}
+	if len(locations) == 0 {
+		return nil, fmt.Errorf("writer %s not found for ec shards of volume %d", writer.Id(), vid)
+	}
+
+	for len(locations) < scheme.TotalShards() {
+		// the least used rack first, then the least used data node, then the most free space
+		sort.SliceStable(candidates, func(i, j int) bool {
+			a, b := candidates[i], candidates[j]
+			if rackShards[a.rack] != rackShards[b.rack] {
+				return rackShards[a.rack] < rackShards[b.rack]
+			}
+			if a.shards != b.shards {
+				return a.shards < b.shards
+			}
+			return a.freeUnits > b.freeUnits
+		})
+		placed := false
+		for _, c := range candidates {
+			if c.shards < maxShardsPerNode && c.freeUnits >= shardUnits {
+				place(c)
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			return nil, fmt.Errorf("only %d of %d ec shards of volume %d can be placed with at most %d shards per data node",
+				len(locations), scheme.TotalShards(), vid, maxShardsPerNode)
+		}
+	}
+
+	reservation := &ecShardReservation{
+		collection: option.Collection,
+		slotUnits:  make(map[*DataNode]int64),
+	}
+	for _, dn := range locations {
+		reservation.slotUnits[dn] += shardUnits
+	}
+	reservations.volumes[vid] = reservation
+	return
+}
@@ -0,0 +1,130 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+var ecDirectTopologyLayout = `
+{
+  "dc1":{
+    "rack1":{
+      "server111":{"volumes":[], "limit":10},
+      "server112":{"volumes":[], "limit":10}
+    },
+    "rack2":{
+      "server121":{"volumes":[], "limit":10},
+      "server122":{"volumes":[], "limit":10}
+    },
+    "rack3":{
+      "server131":{"volumes":[], "limit":10},
+      "server132":{"volumes":[], "limit":10}
+    }
+  }
+}
+`
+
+func listDataNodes(topo *Topology) (dataNodes []*DataNode) {
+	for _, dc := range topo.Children() {
+		for _, rack := range dc.Children() {
+			for _, n := range rack.Children() {
+				dataNodes = append(dataNodes, n.(*DataNode))
+			}
+		}
+	}
+	return
+}
+
+func findDataNode(topo *Topology, id string) *DataNode {
+	for _, dn := range listDataNodes(topo) {
+		if dn.Id() == NodeId(id) {
+			return dn
+		}
+	}
+	return nil
+}
+
+func totalReservedSlotUnits(topo *Topology) (units int64) {
+	for _, dn := range listDataNodes(topo) {
+		units += topo.ecShardReservations.reservedSlotUnits(dn)
+	}
+	return
+}
+
+func TestPickEcShardLocations(t *testing.T) {
+	topo := setup(ecDirectTopologyLayout)
+	writer := findDataNode(topo, "server111")
+	scheme := erasure_coding.Scheme{DataShards: 10, ParityShards: 4}
+
+	locations, err := topo.pickEcShardLocations(writer, needle.VolumeId(1), scheme, &VolumeGrowOption{})
+	if err != nil {
+		t.Fatalf("pick ec shard locations: %v", err)
+	}
+	if len(locations) != scheme.TotalShards() || locations[0] != writer {
+		t.Fatalf("unexpected locations %v", locations)
+	}
+
+	nodeShards := make(map[*DataNode]int)
+	rackShards := make(map[*Rack]int)
+	for _, dn := range locations {
+		nodeShards[dn]++
+		rackShards[dn.GetRack()]++
+	}
+	if len(nodeShards) != 6 {
+		t.Errorf("the shards should be spread over all data nodes: %v", nodeShards)
+	}
+	for dn, count := range nodeShards {
+		if count > scheme.ParityShards {
+			t.Errorf("%s holds %d shards", dn.Id(), count)
+		}
+	}
+	for rack, count := range rackShards {
+		if count > 5 {
+			t.Errorf("%s holds %d shards", rack.Id(), count)
+		}
+	}
+
+	// the shards are reserved until they are reported
+	if units := totalReservedSlotUnits(topo); units != int64(len(locations))*scheme.ShardSlotUnits() {
+		t.Errorf("unexpected reserved slot units %d", units)
+	}
+	topo.RegisterEcShards(erasure_coding.NewEcVolumeInfo("", "", needle.VolumeId(1), 0), writer)
+	if units := totalReservedSlotUnits(topo); units != 0 {
+		t.Errorf("the reported shards should not be reserved, got %d", units)
+	}
+}
+
+func TestPickEcShardLocationsTooFewDataNodes(t *testing.T) {
+	topo := setup(ecDirectTopologyLayout)
+	writer := findDataNode(topo, "server111")
+
+	// 6 data nodes holding at most 2 shards each
+	if _, err := topo.pickEcShardLocations(writer, needle.VolumeId(1), erasure_coding.Scheme{DataShards: 11, ParityShards: 2}, &VolumeGrowOption{}); err == nil {
+		t.Errorf("6 data nodes with 2 shards each should not hold 11+2 shards")
+	}
+	if units := totalReservedSlotUnits(topo); units != 0 {
+		t.Errorf("the failed growth should not reserve, got %d", units)
+	}
+}
+
+func TestPickEcShardLocationsFreeSpace(t *testing.T) {
+	topo := setup(`{"dc1":{"rack1":{"server1":{"volumes":[], "limit":1}, "server2":{"volumes":[], "limit":1}, "server3":{"volumes":[], "limit":1}}}}`)
+	scheme := erasure_coding.Scheme{DataShards: 2, ParityShards: 2}
+
+	// each data node has room for 2 shards, and the writer for 1 besides its volume
+	if _, err := topo.pickEcShardLocations(findDataNode(topo, "server1"), needle.VolumeId(1), scheme, &VolumeGrowOption{}); err != nil {
+		t.Fatalf("pick ec shard locations: %v", err)
+	}
+
+	// the reserved shards leave no room for another volume
+	if _, err := topo.pickEcShardLocations(findDataNode(topo, "server2"), needle.VolumeId(2), scheme, &VolumeGrowOption{}); err == nil {
+		t.Errorf("the reserved shards should be counted")
+	}
+
+	topo.ecShardReservations.release(needle.VolumeId(1))
+	if _, err := topo.pickEcShardLocations(findDataNode(topo, "server2"), needle.VolumeId(2), scheme, &VolumeGrowOption{}); err != nil {
+		t.Errorf("pick ec shard locations after release: %v", err)
+	}
+}